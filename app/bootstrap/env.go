@@ -1,18 +1,40 @@
 package bootstrap
 
 import (
+	"fmt"
+	"time"
+
+	"github.com/ogurilab/school-lunch-api/domain"
 	"github.com/spf13/viper"
 )
 
 type Env struct {
-	ENVIRONMENT       string `mapstructure:"ENVIRONMENT"`
-	DBSource          string `mapstructure:"DB_SOURCE"`
-	MigrationURL      string `mapstructure:"MIGRATION_URL"`
-	ServerAddress     string `mapstructure:"SERVER_ADDRESS"`
-	ContextTimeout    int    `mapstructure:"CONTEXT_TIMEOUT"`
-	ADMIN_KEY         string `mapstructure:"ADMIN_KEY"`
-	WikimediaUserName string `mapstructure:"WIKIMEDIA_USERNAME"`
-	WikimediaPassword string `mapstructure:"WIKIMEDIA_PASSWORD"`
+	ENVIRONMENT            string        `mapstructure:"ENVIRONMENT"`
+	DBSource               string        `mapstructure:"DB_SOURCE"`
+	MigrationURL           string        `mapstructure:"MIGRATION_URL"`
+	ServerAddress          string        `mapstructure:"SERVER_ADDRESS"`
+	PublicBaseURL          string        `mapstructure:"PUBLIC_BASE_URL"` // 外部から API にアクセスする URL。フィードのリンクに使用する
+	ContextTimeout         int           `mapstructure:"CONTEXT_TIMEOUT"`
+	TokenSymmetricKey      string        `mapstructure:"TOKEN_SYMMETRIC_KEY"`
+	AccessTokenDuration    time.Duration `mapstructure:"ACCESS_TOKEN_DURATION"`
+	WikimediaUserName      string        `mapstructure:"WIKIMEDIA_USERNAME"`
+	WikimediaPassword      string        `mapstructure:"WIKIMEDIA_PASSWORD"`
+	WikimediaAPIURL        string        `mapstructure:"WIKIMEDIA_API_URL"`         // 空の場合は Wikimedia Commons の API を使用する
+	WikimediaLicense       string        `mapstructure:"WIKIMEDIA_LICENSE"`         // Commons のライセンステンプレート。空の場合は cc-by-sa-4.0
+	DataSourceSyncInterval int           `mapstructure:"DATA_SOURCE_SYNC_INTERVAL"` // 分単位。0の場合は同期しない
+	AnonymousRateLimit     int32         `mapstructure:"ANONYMOUS_RATE_LIMIT"`      // APIキーがないリクエストの1分あたりのリクエスト数
+	AnonymousBurst         int32         `mapstructure:"ANONYMOUS_BURST"`
+	AnonymousDailyQuota    int32         `mapstructure:"ANONYMOUS_DAILY_QUOTA"` // 0の場合はAPIキーを必須とする
+	TrustedProxies         string        `mapstructure:"TRUSTED_PROXIES"`       // X-Forwarded-For を信頼するプロキシの CIDR (カンマ区切り)。空の場合は接続元の IP を使う
+	StorageDriver          string        `mapstructure:"STORAGE_DRIVER"`        // local または r2
+	LocalStorageDir        string        `mapstructure:"LOCAL_STORAGE_DIR"`
+	LocalStorageURL        string        `mapstructure:"LOCAL_STORAGE_URL"`
+	R2Token                string        `mapstructure:"R2_TOKEN"` // Cloudflare の API トークン。S3 互換 API では使用しない
+	R2BucketName           string        `mapstructure:"R2_BUCKET_NAME"`
+	R2AccessID             string        `mapstructure:"R2_ACCESS_ID"`
+	R2Secret               string        `mapstructure:"R2_SECRET"`
+	R2URL                  string        `mapstructure:"R2_URL"`        // S3 互換 API のエンドポイント
+	R2PublicURL            string        `mapstructure:"R2_PUBLIC_URL"` // 保存した写真を配信するURL
 }
 
 func NewEnv(path string) (env Env, err error) {
@@ -31,5 +53,34 @@ func NewEnv(path string) (env Env, err error) {
 
 	err = viper.Unmarshal(&env)
 
+	if err != nil {
+		return
+	}
+
+	err = env.validate()
+
 	return
 }
+
+// validate は起動後に全てのリクエストを失敗させる設定値をエラーにする
+func (env Env) validate() error {
+	// 0以下の場合は発行したトークンが最初から期限切れになる
+	if env.AccessTokenDuration <= 0 {
+		return fmt.Errorf("invalid ACCESS_TOKEN_DURATION: must be greater than 0")
+	}
+
+	anonymous := domain.RateLimit{
+		PerMinute:  env.AnonymousRateLimit,
+		Burst:      env.AnonymousBurst,
+		DailyQuota: env.AnonymousDailyQuota,
+	}
+
+	// DailyQuota が0の場合は API キーを必須とし、anonymous の制限は使わない
+	if anonymous.DailyQuota > 0 {
+		if err := anonymous.Validate(); err != nil {
+			return fmt.Errorf("invalid ANONYMOUS_BURST: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"flag"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/ogurilab/school-lunch-api/bootstrap"
+	"github.com/ogurilab/school-lunch-api/domain"
+	db "github.com/ogurilab/school-lunch-api/infrastructure/db/sqlc"
+	"github.com/ogurilab/school-lunch-api/infrastructure/repository"
+	"github.com/ogurilab/school-lunch-api/infrastructure/token"
+	"github.com/ogurilab/school-lunch-api/usecase"
+	"github.com/ogurilab/school-lunch-api/util"
+	"github.com/rs/zerolog/log"
+)
+
+// ユーザーを登録する
+// 最初の管理者は /admin/users から登録できないため、このコマンドで登録する
+// パスワードはシェルの履歴に残らないよう標準入力から読み込む
+//
+//	echo "password" | go run ./cmd/createuser -username admin -email admin@example.com -role admin
+func main() {
+	username := flag.String("username", "", "ユーザー名")
+	email := flag.String("email", "", "メールアドレス")
+	role := flag.String("role", domain.UserRoleAdmin, "admin, municipality または guest")
+	city := flag.String("city", "", "市区町村コード (role が municipality の場合は必須)")
+	envPath := flag.String("env", ".", ".envのあるディレクトリ")
+
+	flag.Parse()
+
+	if *username == "" || *email == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	var cityCode int32
+
+	switch *role {
+	case domain.UserRoleAdmin, domain.UserRoleGuest:
+	case domain.UserRoleMunicipality:
+		code, err := util.ParseCityCode(*city)
+
+		if err != nil {
+			log.Fatal().Err(err).Msg("invalid city code")
+		}
+
+		cityCode = code
+	default:
+		log.Fatal().Str("role", *role).Msg("invalid role")
+	}
+
+	password, err := bufio.NewReader(os.Stdin).ReadString('\n')
+
+	if err != nil && password == "" {
+		log.Fatal().Err(err).Msg("failed to read password from stdin")
+	}
+
+	password = strings.TrimRight(password, "\r\n")
+
+	if len(password) < 8 || len(password) > 72 {
+		log.Fatal().Msg("password must be between 8 and 72 characters")
+	}
+
+	app := bootstrap.NewApp(*envPath)
+	defer bootstrap.CloseDatabase(app.DB)
+
+	maker, err := token.NewJWTMaker(app.Env.TokenSymmetricKey)
+
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to create token maker")
+	}
+
+	timeout := time.Duration(app.Env.ContextTimeout) * time.Second
+
+	query := db.NewQuery(app.DB)
+	uu := usecase.NewUserUsecase(repository.NewUserRepository(query), maker, app.Env.AccessTokenDuration, timeout)
+
+	user, err := uu.Create(context.Background(), domain.NewUser(*username, *email, *role, cityCode), password)
+
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to create user")
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+
+	if err := encoder.Encode(user); err != nil {
+		log.Fatal().Err(err).Msg("failed to write user")
+	}
+}
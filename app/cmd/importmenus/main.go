@@ -0,0 +1,75 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"os"
+	"time"
+
+	"github.com/ogurilab/school-lunch-api/bootstrap"
+	db "github.com/ogurilab/school-lunch-api/infrastructure/db/sqlc"
+	"github.com/ogurilab/school-lunch-api/infrastructure/repository"
+	"github.com/ogurilab/school-lunch-api/usecase"
+	"github.com/ogurilab/school-lunch-api/util"
+	"github.com/rs/zerolog/log"
+)
+
+// 献立CSVを読み込み、1ヶ月分の献立を一括で登録する
+// 結果は行ごとのレポートとして標準出力にJSONで出力する
+//
+//	go run ./cmd/importmenus -city 23205 -file menus.csv
+func main() {
+	city := flag.String("city", "", "市区町村コード (5桁または6桁)")
+	file := flag.String("file", "", "献立CSVのパス")
+	envPath := flag.String("env", ".", ".envのあるディレクトリ")
+
+	flag.Parse()
+
+	if *city == "" || *file == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	cityCode, err := util.ParseCityCode(*city)
+
+	if err != nil {
+		log.Fatal().Err(err).Msg("invalid city code")
+	}
+
+	f, err := os.Open(*file)
+
+	if err != nil {
+		log.Fatal().Err(err).Msg("cannot open csv")
+	}
+
+	defer f.Close()
+
+	app := bootstrap.NewApp(*envPath)
+	defer bootstrap.CloseDatabase(app.DB)
+
+	timeout := time.Duration(app.Env.ContextTimeout) * time.Second
+
+	query := db.NewQuery(app.DB)
+	mu := usecase.NewMenuUsecase(repository.NewMenuRepository(query), timeout)
+
+	report, err := mu.Import(context.Background(), cityCode, f)
+
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to import menus")
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+
+	if err := encoder.Encode(report); err != nil {
+		log.Fatal().Err(err).Msg("failed to write report")
+	}
+
+	if !report.Imported {
+		log.Error().Msg("menus were not imported")
+		os.Exit(1)
+	}
+
+	log.Info().Int("rows", len(report.Rows)).Msg("menus imported")
+}
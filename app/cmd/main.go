@@ -1,9 +1,16 @@
 package main
 
 import (
+	"context"
+	"time"
+
 	"github.com/ogurilab/school-lunch-api/bootstrap"
+	"github.com/ogurilab/school-lunch-api/infrastructure/dataset"
 	db "github.com/ogurilab/school-lunch-api/infrastructure/db/sqlc"
+	"github.com/ogurilab/school-lunch-api/infrastructure/repository"
 	"github.com/ogurilab/school-lunch-api/server"
+	"github.com/ogurilab/school-lunch-api/usecase"
+	"github.com/ogurilab/school-lunch-api/worker"
 )
 
 func main() {
@@ -14,6 +21,21 @@ func main() {
 	bootstrap.RunMigration(env.MigrationURL, env.DBSource)
 	defer bootstrap.CloseDatabase(app.DB)
 
+	if env.DataSourceSyncInterval > 0 {
+		timeout := time.Duration(env.ContextTimeout) * time.Second
+
+		su := usecase.NewExternalDataSourceUsecase(
+			repository.NewExternalDataSourceRepository(query),
+			repository.NewMenuRepository(query),
+			dataset.NewHTTPFetcher(nil),
+			timeout,
+		)
+
+		w := worker.NewSyncWorker(su, time.Duration(env.DataSourceSyncInterval)*time.Minute)
+
+		go w.Run(context.Background())
+	}
+
 	server.Run(env, query)
 
 }
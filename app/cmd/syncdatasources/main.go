@@ -0,0 +1,55 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"os"
+	"time"
+
+	"github.com/ogurilab/school-lunch-api/bootstrap"
+	"github.com/ogurilab/school-lunch-api/infrastructure/dataset"
+	db "github.com/ogurilab/school-lunch-api/infrastructure/db/sqlc"
+	"github.com/ogurilab/school-lunch-api/infrastructure/repository"
+	"github.com/ogurilab/school-lunch-api/usecase"
+	"github.com/ogurilab/school-lunch-api/worker"
+	"github.com/rs/zerolog/log"
+)
+
+// Active な外部データソースを1度だけ同期する
+// cron などから定期的に実行することを想定している
+//
+//	go run ./cmd/syncdatasources
+func main() {
+	envPath := flag.String("env", ".", ".envのあるディレクトリ")
+
+	flag.Parse()
+
+	app := bootstrap.NewApp(*envPath)
+	defer bootstrap.CloseDatabase(app.DB)
+
+	timeout := time.Duration(app.Env.ContextTimeout) * time.Second
+
+	query := db.NewQuery(app.DB)
+	su := usecase.NewExternalDataSourceUsecase(
+		repository.NewExternalDataSourceRepository(query),
+		repository.NewMenuRepository(query),
+		dataset.NewHTTPFetcher(nil),
+		timeout,
+	)
+
+	results := worker.NewSyncWorker(su, 0).RunOnce(context.Background())
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+
+	if err := encoder.Encode(results); err != nil {
+		log.Fatal().Err(err).Msg("failed to write results")
+	}
+
+	for _, result := range results {
+		if result.Error != "" {
+			os.Exit(1)
+		}
+	}
+}
@@ -4,6 +4,18 @@ import "github.com/labstack/echo/v4"
 
 type AdminController interface {
 	CreateMenu(c echo.Context) error
+	UpdateMenu(c echo.Context) error
+	PatchMenu(c echo.Context) error
+	DeleteMenu(c echo.Context) error
+	ImportMenus(c echo.Context) error
 	CreateDish(c echo.Context) error
 	CreateDishes(c echo.Context) error
+	UpdateDish(c echo.Context) error
+	DetachDish(c echo.Context) error
+	DeleteDish(c echo.Context) error
+	CreateAllergen(c echo.Context) error
+	CreateDishAllergens(c echo.Context) error
+	DeleteDishAllergen(c echo.Context) error
+	CreateCity(c echo.Context) error
+	UpdateCity(c echo.Context) error
 }
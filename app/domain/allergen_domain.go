@@ -2,39 +2,132 @@ package domain
 
 import (
 	"context"
+	"time"
 
 	"github.com/labstack/echo/v4"
 )
 
+// AllergenKind は食品表示基準におけるアレルゲンの区分
+type AllergenKind string
+
+const (
+	AllergenKindSpecified   AllergenKind = "specified"   // 特定原材料 (表示義務のある8品目)
+	AllergenKindRecommended AllergenKind = "recommended" // 特定原材料に準ずるもの (表示を推奨する20品目)
+	AllergenKindOther       AllergenKind = "other"
+)
+
+// Label は区分の表示名を返す
+func (k AllergenKind) Label() string {
+	switch k {
+	case AllergenKindSpecified:
+		return "特定原材料"
+	case AllergenKindRecommended:
+		return "特定原材料に準ずるもの"
+	default:
+		return "その他"
+	}
+}
+
+// AllergenCategory は料理にアレルゲンがどのように含まれるか
+type AllergenCategory int32
+
+const (
+	AllergenCategoryContains   AllergenCategory = 0 // 原材料として含む
+	AllergenCategoryMayContain AllergenCategory = 1 // 製造工程で混入する可能性がある
+)
+
+// Label は含まれ方の表示名を返す
+func (c AllergenCategory) Label() string {
+	switch c {
+	case AllergenCategoryContains:
+		return "含む"
+	case AllergenCategoryMayContain:
+		return "混入の可能性あり"
+	default:
+		return ""
+	}
+}
+
+// Symbol はアレルギー一覧表で含まれ方を示す記号を返す
+func (c AllergenCategory) Symbol() string {
+	switch c {
+	case AllergenCategoryContains:
+		return "●"
+	case AllergenCategoryMayContain:
+		return "△"
+	default:
+		return ""
+	}
+}
+
 type Allergen struct {
-	ID       int32  `json:"id"`
-	Name     string `json:"name"`
-	Category int32  `json:"category"`
+	ID            int32            `json:"id"`
+	Name          string           `json:"name"`
+	Kind          AllergenKind     `json:"kind"`
+	KindLabel     string           `json:"kind_label"`
+	Category      AllergenCategory `json:"category"`
+	CategoryLabel string           `json:"category_label"`
+}
+
+// StandardAllergen は食品表示基準で定められたアレルゲンの品目
+type StandardAllergen struct {
+	ID        int32        `json:"id"`
+	Code      string       `json:"code"`
+	Name      string       `json:"name"`
+	Kind      AllergenKind `json:"kind"`
+	KindLabel string       `json:"kind_label"`
 }
 
 type AllergenRepository interface {
+	Create(ctx context.Context, name string) (*Allergen, error)
+	AttachToDish(ctx context.Context, dishID string, allergens []*Allergen) error
+	DetachFromDish(ctx context.Context, dishID string, allergenID int32, category AllergenCategory) error
 	FetchByDishID(ctx context.Context, dishID string) ([]*Allergen, error)
+	FetchByDishIDs(ctx context.Context, dishIDs []string) (map[string][]*Allergen, error)
+	FetchByIDs(ctx context.Context, ids []int32) ([]*Allergen, error)
 	FetchInDish(ctx context.Context, dishIDs []string) ([]*Allergen, error)
+	FetchMatchedInDish(ctx context.Context, dishIDs []string, allergenIDs []int32) (map[string][]*Allergen, error)
+	FetchMenuDishesByCity(ctx context.Context, start time.Time, end time.Time, city int32) ([]*MenuDishAllergens, error)
+	FetchStandard(ctx context.Context) ([]*StandardAllergen, error)
 }
 
 type AllergenUsecase interface {
+	Create(ctx context.Context, name string) (*Allergen, error)
+	AttachToDish(ctx context.Context, dishID string, allergens []*Allergen) error
+	DetachFromDish(ctx context.Context, dishID string, allergenID int32, category AllergenCategory) error
 	FetchByDishID(ctx context.Context, dishID string) ([]*Allergen, error)
+	FetchByDishIDs(ctx context.Context, dishIDs []string) (map[string][]*Allergen, error)
 	FetchByMenuID(ctx context.Context, menuID string) ([]*Allergen, error)
+	FetchStandard(ctx context.Context) ([]*StandardAllergen, error)
 }
 
 type AllergenController interface {
 	FetchByDishID(c echo.Context) error
 	FetchByMenuID(c echo.Context) error
+	FetchStandard(c echo.Context) error
 }
 
-func newAllergen(id int32, name string, category int32) *Allergen {
+func newAllergen(id int32, name string, kind AllergenKind, category AllergenCategory) *Allergen {
 	return &Allergen{
-		ID:       id,
-		Name:     name,
-		Category: category,
+		ID:            id,
+		Name:          name,
+		Kind:          kind,
+		KindLabel:     kind.Label(),
+		Category:      category,
+		CategoryLabel: category.Label(),
 	}
 }
 
-func ReNewAllergen(id int32, name string, category int32) *Allergen {
-	return newAllergen(id, name, category)
+func ReNewAllergen(id int32, name string, kind AllergenKind, category AllergenCategory) *Allergen {
+	return newAllergen(id, name, kind, category)
+}
+
+func ReNewStandardAllergen(id int32, code string, name string, kind AllergenKind) *StandardAllergen {
+	return &StandardAllergen{
+		ID:        id,
+		Code:      code,
+		Name:      name,
+		Kind:      kind,
+		KindLabel: kind.Label(),
+	}
 }
@@ -0,0 +1,61 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReNewAllergen(t *testing.T) {
+
+	testCases := []struct {
+		name     string
+		kind     AllergenKind
+		category AllergenCategory
+		check    func(*Allergen)
+	}{
+		{
+			name:     "Specified - Contains",
+			kind:     AllergenKindSpecified,
+			category: AllergenCategoryContains,
+			check: func(allergen *Allergen) {
+				require.Equal(t, "特定原材料", allergen.KindLabel)
+				require.Equal(t, "含む", allergen.CategoryLabel)
+			},
+		},
+		{
+			name:     "Recommended - May Contain",
+			kind:     AllergenKindRecommended,
+			category: AllergenCategoryMayContain,
+			check: func(allergen *Allergen) {
+				require.Equal(t, "特定原材料に準ずるもの", allergen.KindLabel)
+				require.Equal(t, "混入の可能性あり", allergen.CategoryLabel)
+			},
+		},
+		{
+			name:     "Other",
+			kind:     AllergenKindOther,
+			category: AllergenCategoryContains,
+			check: func(allergen *Allergen) {
+				require.Equal(t, "その他", allergen.KindLabel)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			allergen := ReNewAllergen(1, "allergen", tc.kind, tc.category)
+
+			require.Equal(t, int32(1), allergen.ID)
+			require.Equal(t, tc.kind, allergen.Kind)
+			require.Equal(t, tc.category, allergen.Category)
+			tc.check(allergen)
+		})
+	}
+}
+
+func TestAllergenCategorySymbol(t *testing.T) {
+	require.Equal(t, "●", AllergenCategoryContains.Symbol())
+	require.Equal(t, "△", AllergenCategoryMayContain.Symbol())
+	require.Equal(t, "", AllergenCategory(2).Symbol())
+}
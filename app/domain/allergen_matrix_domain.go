@@ -0,0 +1,82 @@
+package domain
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+// MenuDishAllergens は献立の料理1品と、その料理のアレルゲン
+// アレルゲンのない料理の Allergens は空になる
+type MenuDishAllergens struct {
+	MenuID    string
+	OfferedAt time.Time
+	DishID    string
+	DishName  string
+	Allergens []*Allergen
+}
+
+// AllergenMatrixColumn はアレルギー一覧表の列となるアレルゲン
+type AllergenMatrixColumn struct {
+	ID        int32        `json:"id"`
+	Name      string       `json:"name"`
+	Kind      AllergenKind `json:"kind"`
+	KindLabel string       `json:"kind_label"`
+}
+
+// AllergenMatrixRow はアレルギー一覧表の1行で、献立の料理1品にあたる
+// Contains と MayContain はアレルゲンの ID で、原材料として含むアレルゲンは MayContain に入れない
+type AllergenMatrixRow struct {
+	MenuID     string    `json:"menu_id"`
+	OfferedAt  time.Time `json:"offered_at"`
+	DishID     string    `json:"dish_id"`
+	DishName   string    `json:"dish_name"`
+	Contains   []int32   `json:"contains"`
+	MayContain []int32   `json:"may_contain"`
+}
+
+// Category は料理に allergenID のアレルゲンがどのように含まれるかを返す
+// 含まれない場合は false を返す
+func (r *AllergenMatrixRow) Category(allergenID int32) (AllergenCategory, bool) {
+	for _, id := range r.Contains {
+		if id == allergenID {
+			return AllergenCategoryContains, true
+		}
+	}
+
+	for _, id := range r.MayContain {
+		if id == allergenID {
+			return AllergenCategoryMayContain, true
+		}
+	}
+
+	return 0, false
+}
+
+// AllergenMatrix は自治体の1か月分のアレルギー一覧表
+// Allergens は特定原材料、特定原材料に準ずるものの順に並び、その月の料理に含まれるその他のアレルゲンが続く
+// Rows は提供日の昇順に並ぶ
+type AllergenMatrix struct {
+	City      *City                   `json:"city"`
+	Month     time.Time               `json:"month"`
+	Allergens []*AllergenMatrixColumn `json:"allergens"`
+	Rows      []*AllergenMatrixRow    `json:"rows"`
+}
+
+// AllergenMatrixRenderer はアレルギー一覧表を印刷用の PDF として書き出す
+// 同じ一覧表からは常に同じ内容を書き出す
+type AllergenMatrixRenderer interface {
+	Render(w io.Writer, matrix *AllergenMatrix) error
+}
+
+type AllergenMatrixUsecase interface {
+	FetchByCity(ctx context.Context, month time.Time, city int32) (*AllergenMatrix, error)
+	ExportCSVByCity(ctx context.Context, month time.Time, city int32) ([]byte, error)
+	ExportPDFByCity(ctx context.Context, month time.Time, city int32) ([]byte, error)
+}
+
+type AllergenMatrixController interface {
+	FetchByCity(c echo.Context) error
+}
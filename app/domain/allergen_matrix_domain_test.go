@@ -0,0 +1,25 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAllergenMatrixRowCategory(t *testing.T) {
+	row := &AllergenMatrixRow{
+		Contains:   []int32{1, 2},
+		MayContain: []int32{3},
+	}
+
+	category, ok := row.Category(2)
+	require.True(t, ok)
+	require.Equal(t, AllergenCategoryContains, category)
+
+	category, ok = row.Category(3)
+	require.True(t, ok)
+	require.Equal(t, AllergenCategoryMayContain, category)
+
+	_, ok = row.Category(4)
+	require.False(t, ok)
+}
@@ -0,0 +1,144 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+var (
+	ErrInvalidAPIKey  = errors.New("api key is invalid")
+	ErrAPIKeyRevoked  = errors.New("api key has been revoked")
+	ErrAPIKeyRequired = errors.New("api key is required")
+	ErrRateLimited    = errors.New("rate limit exceeded")
+	ErrQuotaExceeded  = errors.New("daily quota exceeded")
+	// ErrInvalidRateLimit は Burst が0以下など、全てのリクエストを拒否してしまう制限値
+	ErrInvalidRateLimit = errors.New("rate limit burst must be greater than 0")
+)
+
+// APIKeyHeader は /v1 の利用者がAPIキーを送るヘッダー
+const APIKeyHeader = "X-API-Key"
+
+// APIKeyContextKey は認証済みのAPIキーを echo.Context に保存する際のキー
+const APIKeyContextKey = "api_key"
+
+// APIKey は /v1 を利用するクライアントに発行するキー
+// キーそのものは保存せず、ハッシュ化した値のみを保存する
+type APIKey struct {
+	ID         int32     `json:"id"`
+	Name       string    `json:"name"`
+	Prefix     string    `json:"prefix"`
+	HashedKey  string    `json:"-"`
+	RateLimit  int32     `json:"rate_limit"`
+	Burst      int32     `json:"burst"`
+	DailyQuota int32     `json:"daily_quota"`
+	Revoked    bool      `json:"revoked"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// IssuedAPIKey は発行時のレスポンス
+// Key は発行時にのみ返し、以降は取得できない
+type IssuedAPIKey struct {
+	*APIKey
+	Key string `json:"key"`
+}
+
+// RateLimit はリクエストの制限値
+// PerMinute は1分あたりのリクエスト数、Burst は連続して受け付けるリクエスト数
+type RateLimit struct {
+	PerMinute  int32
+	Burst      int32
+	DailyQuota int32
+}
+
+// Validate は Burst が0以下の場合に ErrInvalidRateLimit を返す
+// Burst が0のトークンバケットは1つもリクエストを受け付けないため
+func (l RateLimit) Validate() error {
+	if l.Burst <= 0 {
+		return ErrInvalidRateLimit
+	}
+
+	return nil
+}
+
+type RateLimitResult struct {
+	Allowed    bool
+	Limit      int32
+	Remaining  int32
+	Reset      time.Time
+	RetryAfter time.Duration
+	Err        error
+}
+
+type APIKeyRepository interface {
+	Create(ctx context.Context, key *APIKey) (*APIKey, error)
+	GetByID(ctx context.Context, id int32) (*APIKey, error)
+	GetByHashedKey(ctx context.Context, hashedKey string) (*APIKey, error)
+	Fetch(ctx context.Context, limit int32, offset int32) ([]*APIKey, error)
+	Revoke(ctx context.Context, id int32) error
+}
+
+type APIKeyUsecase interface {
+	Create(ctx context.Context, key *APIKey) (*IssuedAPIKey, error)
+	GetByID(ctx context.Context, id int32) (*APIKey, error)
+	Fetch(ctx context.Context, limit int32, offset int32) ([]*APIKey, error)
+	Revoke(ctx context.Context, id int32) error
+	Authenticate(ctx context.Context, key string) (*APIKey, error)
+}
+
+// RateLimiter はキーごとのトークンバケットと1日あたりのリクエスト数を管理する
+type RateLimiter interface {
+	Allow(key string, limit RateLimit) *RateLimitResult
+}
+
+type APIKeyController interface {
+	Create(c echo.Context) error
+	GetByID(c echo.Context) error
+	Fetch(c echo.Context) error
+	Revoke(c echo.Context) error
+}
+
+func NewAPIKey(
+	name string,
+	rateLimit int32,
+	burst int32,
+	dailyQuota int32,
+) *APIKey {
+	return &APIKey{
+		Name:       name,
+		RateLimit:  rateLimit,
+		Burst:      burst,
+		DailyQuota: dailyQuota,
+	}
+}
+
+func ReNewAPIKey(
+	id int32,
+	name string,
+	prefix string,
+	hashedKey string,
+	rateLimit int32,
+	burst int32,
+	dailyQuota int32,
+	revoked bool,
+	createdAt time.Time,
+) *APIKey {
+	key := NewAPIKey(name, rateLimit, burst, dailyQuota)
+	key.ID = id
+	key.Prefix = prefix
+	key.HashedKey = hashedKey
+	key.Revoked = revoked
+	key.CreatedAt = createdAt
+
+	return key
+}
+
+func (k *APIKey) Limit() RateLimit {
+	return RateLimit{
+		PerMinute:  k.RateLimit,
+		Burst:      k.Burst,
+		DailyQuota: k.DailyQuota,
+	}
+}
@@ -0,0 +1,80 @@
+package domain
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	AuditEntityMenu       = "menu"
+	AuditEntityDish       = "dish"
+	AuditEntityAllergen   = "allergen"
+	AuditEntityCity       = "city"
+	AuditEntityDataSource = "data_source"
+	AuditEntityUser       = "user"
+	AuditEntityAPIKey     = "api_key"
+	AuditEntityIngredient = "ingredient"
+)
+
+const (
+	AuditActionCreate           = "create"
+	AuditActionUpdate           = "update"
+	AuditActionDelete           = "delete"
+	AuditActionAddDishes        = "add_dishes"
+	AuditActionDetachDish       = "detach_dish"
+	AuditActionAddAllergens     = "add_allergens"
+	AuditActionRemoveAllergen   = "remove_allergen"
+	AuditActionAddIngredients   = "add_ingredients"
+	AuditActionRemoveIngredient = "remove_ingredient"
+	AuditActionImportMenus      = "import_menus"
+	AuditActionSync             = "sync"
+	AuditActionRevoke           = "revoke"
+	AuditActionUploadPhoto      = "upload_photo"
+	AuditActionUpdateNutrition  = "update_nutrition"
+	AuditActionDeleteNutrition  = "delete_nutrition"
+)
+
+// AuditLog は /admin での更新操作の記録
+// Before と After は操作した対象の変更前後の状態を JSON で保存する
+type AuditLog struct {
+	ID            int64           `json:"id"`
+	ActorID       int32           `json:"actor_id"`
+	ActorUsername string          `json:"actor_username"`
+	Action        string          `json:"action"`
+	EntityType    string          `json:"entity_type"`
+	EntityID      string          `json:"entity_id"`
+	CityCode      *int32          `json:"city_code"`
+	Before        json.RawMessage `json:"before"`
+	After         json.RawMessage `json:"after"`
+	RequestID     string          `json:"request_id"`
+	CreatedAt     time.Time       `json:"created_at"`
+}
+
+// AuditLogFilter は監査ログの検索条件
+// 値が設定されていない条件は無視する
+type AuditLogFilter struct {
+	CityCode   *int32
+	EntityType string
+	EntityID   string
+	From       *time.Time
+	To         *time.Time
+	Limit      int32
+	Offset     int32
+}
+
+type AuditLogRepository interface {
+	Create(ctx context.Context, log *AuditLog) error
+	Fetch(ctx context.Context, filter *AuditLogFilter) ([]*AuditLog, error)
+}
+
+type AuditLogUsecase interface {
+	Record(ctx context.Context, log *AuditLog) error
+	Fetch(ctx context.Context, filter *AuditLogFilter) ([]*AuditLog, error)
+}
+
+type AuditLogController interface {
+	Fetch(c echo.Context) error
+}
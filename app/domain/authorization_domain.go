@@ -0,0 +1,16 @@
+package domain
+
+import (
+	"context"
+	"errors"
+)
+
+var ErrForbidden = errors.New("you are not allowed to modify this resource")
+
+// AuthorizationUsecase は管理APIでの更新操作の権限を判定する
+// admin はすべて、municipality は自分の市区町村のデータのみ更新でき、guest は更新できない
+type AuthorizationUsecase interface {
+	AuthorizeCity(ctx context.Context, payload *TokenPayload, cityCode int32) error
+	AuthorizeMenu(ctx context.Context, payload *TokenPayload, menuID string) error
+	AuthorizeDish(ctx context.Context, payload *TokenPayload, dishID string) error
+}
@@ -2,10 +2,13 @@ package domain
 
 import (
 	"context"
+	"errors"
 
 	"github.com/labstack/echo/v4"
 )
 
+var ErrPrefectureCodeMismatch = errors.New("prefecture_code does not match city_code")
+
 type City struct {
 	CityCode                 int32  `json:"city_code"`
 	CityName                 string `json:"city_name"`
@@ -19,12 +22,16 @@ type CityRepository interface {
 	FetchByName(ctx context.Context, limit int32, offset int32, search string) ([]*City, error)
 	Fetch(ctx context.Context, limit int32, offset int32) ([]*City, error)
 	FetchByPrefectureCode(ctx context.Context, limit int32, offset int32, prefectureCode int32) ([]*City, error)
+	Create(ctx context.Context, city *City) error
+	Update(ctx context.Context, city *City) error
 }
 
 type CityUsecase interface {
 	GetByCityCode(ctx context.Context, code int32) (*City, error)
 	Fetch(ctx context.Context, limit int32, offset int32, search string) ([]*City, error)
 	FetchByPrefectureCode(ctx context.Context, limit int32, offset int32, prefectureCode int32) ([]*City, error)
+	Create(ctx context.Context, city *City) error
+	Update(ctx context.Context, city *City) error
 }
 
 type CityController interface {
@@ -46,3 +53,16 @@ func NewCity(
 		PrefectureName: prefectureName,
 	}
 }
+
+func ReNewCity(
+	cityCode int32,
+	cityName string,
+	prefectureCode int32,
+	prefectureName string,
+	schoolLunchInfoAvailable bool,
+) *City {
+	city := NewCity(cityCode, cityName, prefectureCode, prefectureName)
+	city.SchoolLunchInfoAvailable = schoolLunchInfoAvailable
+
+	return city
+}
@@ -49,3 +49,14 @@ func TestNewCity(t *testing.T) {
 	}
 
 }
+
+func TestReNewCity(t *testing.T) {
+	c := ReNewCity(1, "cityName", 1, "prefectureName", true)
+
+	require.NotNil(t, c)
+	require.Equal(t, int32(1), c.CityCode)
+	require.Equal(t, "cityName", c.CityName)
+	require.Equal(t, int32(1), c.PrefectureCode)
+	require.Equal(t, "prefectureName", c.PrefectureName)
+	require.True(t, c.SchoolLunchInfoAvailable)
+}
@@ -2,11 +2,14 @@ package domain
 
 import (
 	"context"
+	"errors"
 
 	"github.com/labstack/echo/v4"
 	"github.com/ogurilab/school-lunch-api/util"
 )
 
+var ErrDishInUse = errors.New("dish is still linked to menus")
+
 type Dish struct {
 	ID   string `json:"id"`
 	Name string `json:"name"`
@@ -17,23 +20,49 @@ type DishWithMenuIDs struct {
 	MenuIDs []string `json:"menu_ids"`
 }
 
+type CreatedDishes struct {
+	Created []*Dish `json:"created"`
+	Reused  []*Dish `json:"reused"`
+}
+
+type DeletedDish struct {
+	ID                 string   `json:"id"`
+	MenuIDs            []string `json:"menu_ids"`
+	AllergensRemoved   int64    `json:"allergens_removed"`
+	IngredientsRemoved int64    `json:"ingredients_removed"`
+}
+
 type DishRepository interface {
-	Create(ctx context.Context, dish *Dish, menuID string) error
-	CreateMany(ctx context.Context, dishes []*Dish, menuID string) error
+	Create(ctx context.Context, dish *Dish, menuID string) (*CreatedDishes, error)
+	CreateMany(ctx context.Context, dishes []*Dish, menuID string) (*CreatedDishes, error)
 	GetByID(ctx context.Context, id string, limit int32, offset int32) (*DishWithMenuIDs, error)
 	GetByIdInCity(ctx context.Context, id string, limit int32, offset int32, city int32) (*DishWithMenuIDs, error)
 	FetchByMenuID(ctx context.Context, menuID string) ([]*Dish, error)
 	FetchByName(ctx context.Context, search string, limit int32, offset int32) ([]*Dish, error)
 	Fetch(ctx context.Context, limit int32, offset int32) ([]*Dish, error)
+	FetchByNameExcludingAllergens(ctx context.Context, search string, allergenIDs []int32, limit int32, offset int32) ([]*Dish, error)
+	FetchByAllergenID(ctx context.Context, allergenID int32, limit int32, offset int32) ([]*Dish, error)
+	FetchByAllergenIDInCity(ctx context.Context, allergenID int32, limit int32, offset int32, city int32) ([]*Dish, error)
+	FindByID(ctx context.Context, id string) (*Dish, error)
+	FetchCityCodes(ctx context.Context, id string) ([]int32, error)
+	Update(ctx context.Context, dish *Dish) error
+	DetachFromMenu(ctx context.Context, id string, menuID string) error
+	Delete(ctx context.Context, id string, force bool) (*DeletedDish, error)
 }
 
 type DishUsecase interface {
-	Create(ctx context.Context, dish *Dish, menuID string) error
-	CreateMany(ctx context.Context, dishes []*Dish, menuID string) error
+	Create(ctx context.Context, dish *Dish, menuID string) (*CreatedDishes, error)
+	CreateMany(ctx context.Context, dishes []*Dish, menuID string) (*CreatedDishes, error)
 	GetByID(ctx context.Context, id string, limit int32, offset int32) (*DishWithMenuIDs, error)
 	GetByIdInCity(ctx context.Context, id string, limit int32, offset int32, city int32) (*DishWithMenuIDs, error)
 	FetchByMenuID(ctx context.Context, menuID string) ([]*Dish, error)
 	Fetch(ctx context.Context, search string, limit int32, offset int32) ([]*Dish, error)
+	FetchExcludingAllergens(ctx context.Context, search string, allergenIDs []int32, limit int32, offset int32) ([]*Dish, error)
+	FetchByAllergenID(ctx context.Context, allergenID int32, limit int32, offset int32) ([]*Dish, error)
+	FetchByAllergenIDInCity(ctx context.Context, allergenID int32, limit int32, offset int32, city int32) ([]*Dish, error)
+	Update(ctx context.Context, dish *Dish) error
+	DetachFromMenu(ctx context.Context, id string, menuID string) error
+	Delete(ctx context.Context, id string, force bool) (*DeletedDish, error)
 }
 
 type DishController interface {
@@ -41,6 +70,7 @@ type DishController interface {
 	GetByIdInCity(c echo.Context) error
 	FetchByMenuID(c echo.Context) error
 	Fetch(c echo.Context) error
+	FetchByAllergenID(c echo.Context) error
 }
 
 func newDish(id string, name string) (*Dish, error) {
@@ -12,6 +12,10 @@ const (
 	ErrNotFound            ErrorType = "Your requested Item is not found"
 	ErrConflict            ErrorType = "Your Item already exist"
 	ErrBadRequest          ErrorType = "Bad Request"
+	ErrUnauthorized        ErrorType = "Unauthorized"
+	ErrForbidden           ErrorType = "Forbidden"
+	ErrTooManyRequests     ErrorType = "Too Many Requests"
+	ErrBadGateway          ErrorType = "Bad Gateway"
 	ErrorMaxLimit          ErrorType = "Max limit reached"
 )
 
@@ -41,6 +45,22 @@ func NewBadRequestError(err error) (int, *ErrorResponse) {
 	return http.StatusBadRequest, NewErrorResponse(ErrBadRequest, err)
 }
 
+func NewUnauthorizedError(err error) (int, *ErrorResponse) {
+	return http.StatusUnauthorized, NewErrorResponse(ErrUnauthorized, err)
+}
+
+func NewForbiddenError(err error) (int, *ErrorResponse) {
+	return http.StatusForbidden, NewErrorResponse(ErrForbidden, err)
+}
+
+func NewTooManyRequestsError(err error) (int, *ErrorResponse) {
+	return http.StatusTooManyRequests, NewErrorResponse(ErrTooManyRequests, err)
+}
+
+func NewBadGatewayError(err error) (int, *ErrorResponse) {
+	return http.StatusBadGateway, NewErrorResponse(ErrBadGateway, err)
+}
+
 func NewMaxLimitError() (int, *ErrorResponse) {
 	err := fmt.Errorf("max limit reached")
 	return http.StatusBadRequest, NewErrorResponse(ErrorMaxLimit, err)
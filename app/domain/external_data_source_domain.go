@@ -0,0 +1,134 @@
+package domain
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ogurilab/school-lunch-api/util"
+)
+
+var (
+	ErrDataSourceUpdating            = errors.New("data source is already updating")
+	ErrUnsupportedDataSourceCategory = errors.New("unsupported data source category")
+)
+
+const (
+	DataSourceStatusActive   = "Active"
+	DataSourceStatusInactive = "Inactive"
+	DataSourceStatusUpdating = "Updating"
+	DataSourceStatusError    = "Error"
+)
+
+const (
+	DataSourceCategoryMenu      = "menu"
+	DataSourceCategoryDish      = "dish"
+	DataSourceCategoryAllergens = "allergens"
+)
+
+type ExternalDataSource struct {
+	ID          int32          `json:"id"`
+	CityCode    int32          `json:"city_code"`
+	DatasetID   string         `json:"dataset_id"`
+	Year        int32          `json:"year"`
+	UpdatedAt   time.Time      `json:"updated_at"`
+	Status      string         `json:"status"`
+	Category    string         `json:"category"`
+	Description sql.NullString `json:"description"`
+}
+
+type DataSourceSyncResult struct {
+	SourceID int32  `json:"source_id"`
+	Status   string `json:"status"`
+	Imported int    `json:"imported"`
+	Skipped  int    `json:"skipped"`
+	Error    string `json:"error,omitempty"`
+}
+
+type ExternalDataSourceRepository interface {
+	Create(ctx context.Context, source *ExternalDataSource) (*ExternalDataSource, error)
+	GetByID(ctx context.Context, id int32) (*ExternalDataSource, error)
+	Fetch(ctx context.Context, limit int32, offset int32) ([]*ExternalDataSource, error)
+	FetchByStatus(ctx context.Context, status string) ([]*ExternalDataSource, error)
+	Update(ctx context.Context, source *ExternalDataSource) error
+	UpdateStatus(ctx context.Context, id int32, status string, description sql.NullString) error
+	StartSync(ctx context.Context, id int32) error
+	Delete(ctx context.Context, id int32) error
+}
+
+type ExternalDataSourceUsecase interface {
+	Create(ctx context.Context, source *ExternalDataSource) (*ExternalDataSource, error)
+	GetByID(ctx context.Context, id int32) (*ExternalDataSource, error)
+	Fetch(ctx context.Context, limit int32, offset int32) ([]*ExternalDataSource, error)
+	Update(ctx context.Context, source *ExternalDataSource) error
+	Delete(ctx context.Context, id int32) error
+	Sync(ctx context.Context, id int32) (*DataSourceSyncResult, error)
+	SyncActive(ctx context.Context) ([]*DataSourceSyncResult, error)
+}
+
+// DatasetFetcher は外部のデータセットを取得する
+type DatasetFetcher interface {
+	Fetch(ctx context.Context, url string) (io.ReadCloser, error)
+}
+
+type ExternalDataSourceController interface {
+	Create(c echo.Context) error
+	GetByID(c echo.Context) error
+	Fetch(c echo.Context) error
+	Update(c echo.Context) error
+	Delete(c echo.Context) error
+	Sync(c echo.Context) error
+}
+
+func (s *ExternalDataSource) MarshalJSON() ([]byte, error) {
+	type Alias ExternalDataSource
+
+	return json.Marshal(
+		&struct {
+			Description *string `json:"description"`
+			*Alias
+		}{
+			Description: util.NullStringToPointer(s.Description),
+			Alias:       (*Alias)(s),
+		},
+	)
+}
+
+func NewExternalDataSource(
+	cityCode int32,
+	datasetID string,
+	year int32,
+	status string,
+	category string,
+	description sql.NullString,
+) *ExternalDataSource {
+	return &ExternalDataSource{
+		CityCode:    cityCode,
+		DatasetID:   datasetID,
+		Year:        year,
+		Status:      status,
+		Category:    category,
+		Description: description,
+	}
+}
+
+func ReNewExternalDataSource(
+	id int32,
+	cityCode int32,
+	datasetID string,
+	year int32,
+	updatedAt time.Time,
+	status string,
+	category string,
+	description sql.NullString,
+) *ExternalDataSource {
+	source := NewExternalDataSource(cityCode, datasetID, year, status, category, description)
+	source.ID = id
+	source.UpdatedAt = updatedAt
+
+	return source
+}
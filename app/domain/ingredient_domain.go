@@ -0,0 +1,107 @@
+package domain
+
+import (
+	"context"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ogurilab/school-lunch-api/util"
+)
+
+type Ingredient struct {
+	ID   int32  `json:"id"`
+	Name string `json:"name"`
+}
+
+// Prefecture は食材の産地となる都道府県
+type Prefecture struct {
+	Code int32  `json:"code"`
+	Name string `json:"name"`
+}
+
+// DishIngredient は料理に使われている食材と、その1人分の使用量 (g) と産地
+// 使用量や産地が献立表に記載されていない場合は nil にする
+type DishIngredient struct {
+	ID       int32       `json:"id"`
+	Name     string      `json:"name"`
+	DishID   string      `json:"dish_id"`
+	Quantity *float64    `json:"quantity"`
+	Origin   *Prefecture `json:"origin"`
+}
+
+type IngredientRepository interface {
+	Create(ctx context.Context, name string) (*Ingredient, error)
+	Fetch(ctx context.Context, limit int32, offset int32) ([]*Ingredient, error)
+	FetchByIDs(ctx context.Context, ids []int32) ([]*Ingredient, error)
+	GetByID(ctx context.Context, id int32) (*Ingredient, error)
+	Update(ctx context.Context, ingredient *Ingredient) error
+	Delete(ctx context.Context, id int32) error
+	AttachToDish(ctx context.Context, dishID string, ingredients []*DishIngredient) error
+	DetachFromDish(ctx context.Context, dishID string, ingredientID int32) error
+	FetchByDishID(ctx context.Context, dishID string) ([]*DishIngredient, error)
+	FetchInDish(ctx context.Context, dishIDs []string) ([]*DishIngredient, error)
+}
+
+type IngredientUsecase interface {
+	Create(ctx context.Context, name string) (*Ingredient, error)
+	Fetch(ctx context.Context, limit int32, offset int32) ([]*Ingredient, error)
+	GetByID(ctx context.Context, id int32) (*Ingredient, error)
+	Update(ctx context.Context, ingredient *Ingredient) error
+	Delete(ctx context.Context, id int32) error
+	AttachToDish(ctx context.Context, dishID string, ingredients []*DishIngredient) error
+	DetachFromDish(ctx context.Context, dishID string, ingredientID int32) error
+	FetchByDishID(ctx context.Context, dishID string) ([]*DishIngredient, error)
+	FetchByMenuID(ctx context.Context, menuID string) ([]*DishIngredient, error)
+}
+
+type IngredientController interface {
+	Create(c echo.Context) error
+	Fetch(c echo.Context) error
+	GetByID(c echo.Context) error
+	Update(c echo.Context) error
+	Delete(c echo.Context) error
+	AttachToDish(c echo.Context) error
+	DetachFromDish(c echo.Context) error
+	FetchByDishID(c echo.Context) error
+	FetchByMenuID(c echo.Context) error
+}
+
+func newIngredient(id int32, name string) *Ingredient {
+	return &Ingredient{
+		ID:   id,
+		Name: name,
+	}
+}
+
+func ReNewIngredient(id int32, name string) *Ingredient {
+	return newIngredient(id, name)
+}
+
+// ReNewDishIngredient は産地の都道府県コードから都道府県名を補う
+// 不明な都道府県コードの場合は産地を nil にする
+func ReNewDishIngredient(id int32, name string, dishID string, quantity *float64, originCode *int32) *DishIngredient {
+	ingredient := &DishIngredient{
+		ID:       id,
+		Name:     name,
+		DishID:   dishID,
+		Quantity: quantity,
+	}
+
+	if originCode == nil {
+		return ingredient
+	}
+
+	if prefecture, ok := util.PrefectureName(*originCode); ok {
+		ingredient.Origin = &Prefecture{Code: *originCode, Name: prefecture}
+	}
+
+	return ingredient
+}
+
+// OriginCode は産地の都道府県コードを返す
+func (i *DishIngredient) OriginCode() *int32 {
+	if i.Origin == nil {
+		return nil
+	}
+
+	return &i.Origin.Code
+}
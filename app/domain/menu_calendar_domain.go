@@ -0,0 +1,47 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+// CalendarContentType は iCalendar の Content-Type
+const CalendarContentType = "text/calendar; charset=utf-8"
+
+// MaxCalendarDays は1つのカレンダーに含められる最大の日数
+const MaxCalendarDays = 366
+
+// ErrInvalidCalendarRange は期間の終わりが始まりより前か、MaxCalendarDays を超える場合のエラー
+var ErrInvalidCalendarRange = errors.New("to must be on or after from and the range must be within 366 days")
+
+// MenuCalendarDay はカレンダーに載せる1日分の献立
+// Allergens には料理IDごとのアレルゲン、Highlighted には指定されたアレルゲンのうち献立に含まれるものが入る
+type MenuCalendarDay struct {
+	Menu        *MenuWithDishes
+	Allergens   map[string][]*Allergen
+	Highlighted []*Allergen
+}
+
+// MenuCalendar は自治体の献立を提供日順に並べたカレンダー
+type MenuCalendar struct {
+	CityCode int32
+	Days     []*MenuCalendarDay
+}
+
+// MenuCalendarEncoder は献立のカレンダーを iCalendar (RFC 5545) 形式で書き出す
+// 同じカレンダーからは常に同じ内容を書き出す
+type MenuCalendarEncoder interface {
+	Encode(w io.Writer, calendar *MenuCalendar) error
+}
+
+type MenuCalendarUsecase interface {
+	ExportByCity(ctx context.Context, from time.Time, to time.Time, city int32, highlight []int32) ([]byte, error)
+}
+
+type MenuCalendarController interface {
+	ExportByCity(c echo.Context) error
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"database/sql"
 	"encoding/json"
+	"errors"
+	"io"
 	"time"
 
 	"github.com/labstack/echo/v4"
@@ -17,6 +19,10 @@ type Menu struct {
 	ElementarySchoolCalories int32          `json:"elementary_school_calories"`
 	JuniorHighSchoolCalories int32          `json:"junior_high_school_calories"`
 	CityCode                 int32          `json:"city_code"`
+	PhotoCredit              *PhotoCredit   `json:"photo_credit"`
+	Photos                   *MenuPhotos    `json:"photos"`
+	Nutrition                *MenuNutrition `json:"nutrition"`
+	CreatedAt                time.Time      `json:"created_at"`
 }
 
 type MenuWithDishes struct {
@@ -24,19 +30,71 @@ type MenuWithDishes struct {
 	Dishes []*Dish `json:"dishes"`
 }
 
+type DeletedMenu struct {
+	ID      string   `json:"id"`
+	DishIDs []string `json:"dish_ids"`
+}
+
+var ErrInvalidMenuCSV = errors.New("invalid menu csv")
+
+func IsInvalidMenuCSV(err error) bool {
+	return errors.Is(err, ErrInvalidMenuCSV)
+}
+
+// ImportDish はCSVの1行に含まれる料理と、その料理に含まれるアレルゲン名
+// MayContain は製造工程で混入する可能性があるアレルゲン名
+type ImportDish struct {
+	Dish       *Dish
+	Allergens  []string
+	MayContain []string
+}
+
+// ImportMenuRow はCSVの1行(1日分の献立)を表す
+type ImportMenuRow struct {
+	Line   int
+	Menu   *Menu
+	Dishes []*ImportDish
+}
+
+type ImportMenuResult struct {
+	Line      int      `json:"line"`
+	OfferedAt string   `json:"offered_at"`
+	MenuID    string   `json:"menu_id,omitempty"`
+	DishIDs   []string `json:"dish_ids,omitempty"`
+	Errors    []string `json:"errors,omitempty"`
+}
+
+type ImportMenuReport struct {
+	CityCode int32               `json:"city_code"`
+	Imported bool                `json:"imported"`
+	Rows     []*ImportMenuResult `json:"rows"`
+}
+
 type MenuRepository interface {
 	Create(ctx context.Context, menu *Menu) error
+	Update(ctx context.Context, menu *Menu) error
+	Delete(ctx context.Context, id string) (*DeletedMenu, error)
+	FindByID(ctx context.Context, id string) (*Menu, error)
 	GetByID(ctx context.Context, id string, city int32) (*Menu, error)
 	FetchByCity(ctx context.Context, limit int32, offset int32, offered time.Time, city int32) ([]*Menu, error)
+	FetchByCityExcludingAllergens(ctx context.Context, limit int32, offset int32, offered time.Time, city int32, allergenIDs []int32) ([]*Menu, error)
 	Fetch(ctx context.Context, limit int32, offset int32, offered time.Time) ([]*Menu, error)
 	FetchByIDs(ctx context.Context, Limit int32, Offset int32, offered time.Time, ids []string) ([]*Menu, error)
+	FetchOfferedAtByCity(ctx context.Context, city int32, start time.Time, end time.Time) ([]time.Time, error)
+	Import(ctx context.Context, cityCode int32, rows []*ImportMenuRow) (*ImportMenuReport, error)
+	UpdatePhoto(ctx context.Context, id string, photoUrl sql.NullString, photos *MenuPhotos, credit *PhotoCredit) error
 }
 
 type MenuUsecase interface {
 	Create(ctx context.Context, menu *Menu) error
+	Update(ctx context.Context, menu *Menu) error
+	Delete(ctx context.Context, id string) (*DeletedMenu, error)
+	FindByID(ctx context.Context, id string) (*Menu, error)
 	GetByID(ctx context.Context, id string, city int32) (*Menu, error)
 	FetchByCity(ctx context.Context, limit int32, offset int32, offered time.Time, city int32) ([]*Menu, error)
+	FetchByCityExcludingAllergens(ctx context.Context, limit int32, offset int32, offered time.Time, city int32, allergenIDs []int32) ([]*Menu, error)
 	Fetch(ctx context.Context, limit int32, offset int32, offered time.Time, ids []string) ([]*Menu, error)
+	Import(ctx context.Context, cityCode int32, r io.Reader) (*ImportMenuReport, error)
 }
 
 type MenuController interface {
@@ -2,6 +2,7 @@ package domain
 
 import (
 	"database/sql"
+	"encoding/json"
 	"fmt"
 	"testing"
 	"time"
@@ -248,6 +249,74 @@ func TestMenuMarshalJSON(t *testing.T) {
 	}
 }
 
+func TestMenuMarshalJSONWithPhotoCredit(t *testing.T) {
+	menu := randomMenu(t, true)
+	menu.PhotoCredit = &PhotoCredit{
+		FilePage:    "https://commons.wikimedia.org/wiki/File:School_lunch.jpg",
+		License:     "CC BY-SA 4.0",
+		Attribution: "author, CC BY-SA 4.0, via Wikimedia Commons",
+	}
+
+	b, err := menu.MarshalJSON()
+	require.NoError(t, err)
+	requireEqualMenuJSON(t, menu, b)
+
+	var actual Menu
+	require.NoError(t, actual.UnmarshalJSON(b))
+	require.Equal(t, menu.PhotoCredit, actual.PhotoCredit)
+}
+
+func TestMenuMarshalJSONWithPhotos(t *testing.T) {
+	menu := randomMenu(t, true)
+	menu.Photos = &MenuPhotos{
+		Small:  "http://localhost:8080/storage/menus/photo_240.jpg",
+		Medium: "http://localhost:8080/storage/menus/photo_640.jpg",
+		Large:  "http://localhost:8080/storage/menus/photo_1280.jpg",
+	}
+
+	b, err := menu.MarshalJSON()
+	require.NoError(t, err)
+	requireEqualMenuJSON(t, menu, b)
+
+	var actual Menu
+	require.NoError(t, actual.UnmarshalJSON(b))
+	require.Equal(t, menu.Photos, actual.Photos)
+}
+
+func TestMenuMarshalJSONWithNutrition(t *testing.T) {
+	protein := 25.3
+	salt := 2.1
+
+	menu := randomMenu(t, true)
+	menu.Nutrition = &MenuNutrition{
+		Elementary: &NutritionFacts{Protein: &protein, Salt: &salt},
+	}
+
+	b, err := menu.MarshalJSON()
+	require.NoError(t, err)
+	requireEqualMenuJSON(t, menu, b)
+	require.Contains(t, string(b), `"nutrition":{"elementary":{"protein":25.3,"fat":null,`)
+	require.Contains(t, string(b), `"junior_high":null}`)
+
+	var actual Menu
+	require.NoError(t, actual.UnmarshalJSON(b))
+	require.Equal(t, menu.Nutrition, actual.Nutrition)
+}
+
+func TestMenuMarshalJSONWithCreatedAt(t *testing.T) {
+	menu := randomMenu(t, true)
+	menu.CreatedAt = time.Date(2024, 4, 1, 9, 30, 0, 0, time.UTC)
+
+	b, err := menu.MarshalJSON()
+	require.NoError(t, err)
+	requireEqualMenuJSON(t, menu, b)
+	require.Contains(t, string(b), `"created_at":"2024-04-01T09:30:00Z"`)
+
+	var actual Menu
+	require.NoError(t, actual.UnmarshalJSON(b))
+	require.Equal(t, menu.CreatedAt, actual.CreatedAt)
+}
+
 func TestMenuUnmarshalJSON(t *testing.T) {
 
 	validPhotoUrlMenu := randomMenu(t, true)
@@ -326,18 +395,50 @@ func requireEqualMenuJSON(t *testing.T, m Menu, actual []byte) {
 		photoUrlStr = "null"
 	}
 
-	expect := fmt.Sprintf(`{"id":"%s","offered_at":"%s","photo_url":%s,"elementary_school_calories":%d,"junior_high_school_calories":%d,"city_code":%d}`,
+	expect := fmt.Sprintf(`{"id":"%s","offered_at":"%s","photo_url":%s,"elementary_school_calories":%d,"junior_high_school_calories":%d,"city_code":%d,"photo_credit":%s,"photos":%s,"nutrition":%s,"created_at":%s}`,
 		m.ID,
 		m.OfferedAt.Format("2006-01-02"),
 		photoUrlStr,
 		m.ElementarySchoolCalories,
 		m.JuniorHighSchoolCalories,
 		m.CityCode,
+		photoCreditJSON(t, m.PhotoCredit),
+		menuPhotosJSON(t, m.Photos),
+		menuNutritionJSON(t, m.Nutrition),
+		createdAtJSON(t, m.CreatedAt),
 	)
 
 	require.Equal(t, expect, string(actual))
 }
 
+func photoCreditJSON(t *testing.T, credit *PhotoCredit) string {
+	b, err := json.Marshal(credit)
+	require.NoError(t, err)
+
+	return string(b)
+}
+
+func menuPhotosJSON(t *testing.T, photos *MenuPhotos) string {
+	b, err := json.Marshal(photos)
+	require.NoError(t, err)
+
+	return string(b)
+}
+
+func createdAtJSON(t *testing.T, createdAt time.Time) string {
+	b, err := json.Marshal(createdAt)
+	require.NoError(t, err)
+
+	return string(b)
+}
+
+func menuNutritionJSON(t *testing.T, nutrition *MenuNutrition) string {
+	b, err := json.Marshal(nutrition)
+	require.NoError(t, err)
+
+	return string(b)
+}
+
 func requireEqualMenu(t *testing.T, expect Menu, actual Menu) {
 	require.Equal(t, expect.ID, actual.ID)
 	require.Equal(t, expect.OfferedAt.Format("2006-01-02"), actual.OfferedAt.Format("2006-01-02"))
@@ -0,0 +1,53 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+// ExportFormat は献立を書き出す表計算ファイルの形式
+type ExportFormat string
+
+const (
+	ExportFormatCSV  ExportFormat = "csv"
+	ExportFormatXLSX ExportFormat = "xlsx"
+)
+
+// ContentType は書き出す形式に対応する Content-Type を返す
+func (f ExportFormat) ContentType() string {
+	switch f {
+	case ExportFormatCSV:
+		return "text/csv; charset=utf-8"
+	case ExportFormatXLSX:
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	default:
+		return ""
+	}
+}
+
+var ErrUnsupportedExportFormat = errors.New("export format must be csv or xlsx")
+
+// SpreadsheetWriter は表計算ファイルを1行ずつ書き出す
+// Close を呼ぶまでファイルは完成しない
+type SpreadsheetWriter interface {
+	WriteRow(cells []string) error
+	Flush() error
+	Close() error
+}
+
+// SpreadsheetEncoder は w に書き出す SpreadsheetWriter を返す
+type SpreadsheetEncoder interface {
+	NewWriter(w io.Writer, format ExportFormat, sheetName string) (SpreadsheetWriter, error)
+}
+
+type MenuExportUsecase interface {
+	ExportByCity(ctx context.Context, w io.Writer, format ExportFormat, month time.Time, city int32) error
+}
+
+type MenuExportController interface {
+	ExportByCity(c echo.Context) error
+}
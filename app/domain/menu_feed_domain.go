@@ -0,0 +1,54 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/labstack/echo/v4"
+)
+
+// FeedFormat は献立のフィードの形式
+type FeedFormat string
+
+const (
+	FeedFormatAtom FeedFormat = "atom"
+	FeedFormatRSS  FeedFormat = "rss"
+)
+
+// ContentType はフィードの形式に対応する Content-Type を返す
+func (f FeedFormat) ContentType() string {
+	switch f {
+	case FeedFormatAtom:
+		return "application/atom+xml; charset=utf-8"
+	case FeedFormatRSS:
+		return "application/rss+xml; charset=utf-8"
+	default:
+		return ""
+	}
+}
+
+var ErrUnsupportedFeedFormat = errors.New("feed format must be atom or rss")
+
+// MenuFeed は自治体の献立を登録日時が新しい順に並べたフィード
+// BaseURL はフィードや献立へのリンクに使う API の URL (例: https://example.com/v1)
+type MenuFeed struct {
+	City    *City
+	BaseURL string
+	Menus   []*MenuWithDishes
+}
+
+// MenuFeedEncoder は献立のフィードを Atom または RSS 2.0 形式で書き出す
+// 同じフィードからは常に同じ内容を書き出す
+type MenuFeedEncoder interface {
+	Encode(w io.Writer, format FeedFormat, feed *MenuFeed) error
+}
+
+type MenuFeedUsecase interface {
+	ExportByCity(ctx context.Context, format FeedFormat, limit int32, city int32, baseURL string) ([]byte, error)
+}
+
+type MenuFeedController interface {
+	FetchAtomByCity(c echo.Context) error
+	FetchRSSByCity(c echo.Context) error
+}
@@ -0,0 +1,42 @@
+package domain
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+// PDFContentType は PDF の Content-Type
+const PDFContentType = "application/pdf"
+
+// MenuSheetDay は献立表の1日分
+// Allergens は料理IDごとのアレルゲン、Thumbnail は JPEG のサムネイルで、写真がない場合は nil
+type MenuSheetDay struct {
+	Menu      *MenuWithDishes
+	Allergens map[string][]*Allergen
+	Thumbnail []byte
+}
+
+// MenuSheet は自治体の1か月分の献立表
+// Month はその月の1日、Days は提供日の昇順に並ぶ
+type MenuSheet struct {
+	City  *City
+	Month time.Time
+	Days  []*MenuSheetDay
+}
+
+// MenuSheetRenderer は献立表を印刷用の PDF として書き出す
+// 同じ献立表からは常に同じ内容を書き出す
+type MenuSheetRenderer interface {
+	Render(w io.Writer, sheet *MenuSheet) error
+}
+
+type MenuSheetUsecase interface {
+	ExportByCity(ctx context.Context, month time.Time, city int32) ([]byte, error)
+}
+
+type MenuSheetController interface {
+	ExportByCity(c echo.Context) error
+}
@@ -17,31 +17,57 @@ import (
 type MenuWithDishesRepository interface {
 	GetByID(ctx context.Context, id string, city int32) (*MenuWithDishes, error)
 	FetchByCity(ctx context.Context, limit int32, offset int32, offered time.Time, city int32) ([]*MenuWithDishes, error)
+	FetchByCityBetween(ctx context.Context, from time.Time, to time.Time, city int32) ([]*MenuWithDishes, error)
+	FetchByCityExcludingAllergens(ctx context.Context, limit int32, offset int32, offered time.Time, city int32, allergenIDs []int32) ([]*MenuWithDishes, error)
 	Fetch(ctx context.Context, limit int32, offset int32, offered time.Time) ([]*MenuWithDishes, error)
+	FetchLatestByCity(ctx context.Context, limit int32, city int32) ([]*MenuWithDishes, error)
 }
 
 type MenuWithDishesUsecase interface {
 	GetByID(ctx context.Context, id string, city int32) (*MenuWithDishes, error)
 	FetchByCity(ctx context.Context, limit int32, offset int32, offered time.Time, city int32) ([]*MenuWithDishes, error)
+	FetchByCityExcludingAllergens(ctx context.Context, limit int32, offset int32, offered time.Time, city int32, allergenIDs []int32) ([]*MenuWithDishes, error)
 	Fetch(ctx context.Context, limit int32, offset int32, offered time.Time) ([]*MenuWithDishes, error)
+	FetchAllergenRisksByCity(ctx context.Context, limit int32, offset int32, offered time.Time, city int32, allergenIDs []int32) ([]*MenuAllergenRisk, error)
 }
 
 type MenuWithDishesController interface {
 	GetByID(c echo.Context) error
 	FetchByCity(c echo.Context) error
 	Fetch(c echo.Context) error
+	FetchAllergenRisksByCity(c echo.Context) error
+}
+
+// DishAllergenRisk は指定されたアレルゲンを含む料理と、該当したアレルゲン
+type DishAllergenRisk struct {
+	ID        string      `json:"id"`
+	Name      string      `json:"name"`
+	Allergens []*Allergen `json:"allergens"`
+}
+
+// MenuAllergenRisk は1日分の献立に指定されたアレルゲンが含まれるかどうか
+// Flagged が true の場合、Dishes に該当した料理が入る
+type MenuAllergenRisk struct {
+	MenuID    string              `json:"menu_id"`
+	OfferedAt string              `json:"offered_at"`
+	Flagged   bool                `json:"flagged"`
+	Dishes    []*DishAllergenRisk `json:"dishes"`
 }
 
 func (m *MenuWithDishes) MarshalJSON() ([]byte, error) {
 
 	type Date struct {
-		ID                       string  `json:"id"`
-		OfferedAt                string  `json:"offered_at"`
-		PhotoUrl                 *string `json:"photo_url"`
-		ElementarySchoolCalories int32   `json:"elementary_school_calories"`
-		JuniorHighSchoolCalories int32   `json:"junior_high_school_calories"`
-		CityCode                 int32   `json:"city_code"`
-		Dishes                   []*Dish `json:"dishes"`
+		ID                       string         `json:"id"`
+		OfferedAt                string         `json:"offered_at"`
+		PhotoUrl                 *string        `json:"photo_url"`
+		ElementarySchoolCalories int32          `json:"elementary_school_calories"`
+		JuniorHighSchoolCalories int32          `json:"junior_high_school_calories"`
+		CityCode                 int32          `json:"city_code"`
+		PhotoCredit              *PhotoCredit   `json:"photo_credit"`
+		Photos                   *MenuPhotos    `json:"photos"`
+		Nutrition                *MenuNutrition `json:"nutrition"`
+		CreatedAt                time.Time      `json:"created_at"`
+		Dishes                   []*Dish        `json:"dishes"`
 	}
 
 	if m.Dishes == nil {
@@ -56,6 +82,10 @@ func (m *MenuWithDishes) MarshalJSON() ([]byte, error) {
 		ElementarySchoolCalories: m.ElementarySchoolCalories,
 		JuniorHighSchoolCalories: m.JuniorHighSchoolCalories,
 		CityCode:                 m.CityCode,
+		PhotoCredit:              m.PhotoCredit,
+		Photos:                   m.Photos,
+		Nutrition:                m.Nutrition,
+		CreatedAt:                m.CreatedAt,
 	})
 }
 
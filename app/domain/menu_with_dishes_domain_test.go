@@ -249,13 +249,17 @@ func requireEqualMenuWithDishesJSON(t *testing.T, m MenuWithDishes, actual []byt
 		photoUrlStr = "null"
 	}
 
-	expect := fmt.Sprintf(`{"id":"%s","offered_at":"%s","photo_url":%s,"elementary_school_calories":%d,"junior_high_school_calories":%d,"city_code":%d,"dishes":[{"id":"%s","name":"%s"}]}`,
+	expect := fmt.Sprintf(`{"id":"%s","offered_at":"%s","photo_url":%s,"elementary_school_calories":%d,"junior_high_school_calories":%d,"city_code":%d,"photo_credit":%s,"photos":%s,"nutrition":%s,"created_at":%s,"dishes":[{"id":"%s","name":"%s"}]}`,
 		m.ID,
 		m.OfferedAt.Format("2006-01-02"),
 		photoUrlStr,
 		m.ElementarySchoolCalories,
 		m.JuniorHighSchoolCalories,
 		m.CityCode,
+		photoCreditJSON(t, m.PhotoCredit),
+		menuPhotosJSON(t, m.Photos),
+		menuNutritionJSON(t, m.Nutrition),
+		createdAtJSON(t, m.CreatedAt),
 		m.Dishes[0].ID,
 		m.Dishes[0].Name,
 	)
@@ -271,13 +275,17 @@ func requireEqualMenuWithEmptyDishesJSON(t *testing.T, m MenuWithDishes, actual
 		photoUrlStr = "null"
 	}
 
-	expect := fmt.Sprintf(`{"id":"%s","offered_at":"%s","photo_url":%s,"elementary_school_calories":%d,"junior_high_school_calories":%d,"city_code":%d,"dishes":[]}`,
+	expect := fmt.Sprintf(`{"id":"%s","offered_at":"%s","photo_url":%s,"elementary_school_calories":%d,"junior_high_school_calories":%d,"city_code":%d,"photo_credit":%s,"photos":%s,"nutrition":%s,"created_at":%s,"dishes":[]}`,
 		m.ID,
 		m.OfferedAt.Format("2006-01-02"),
 		photoUrlStr,
 		m.ElementarySchoolCalories,
 		m.JuniorHighSchoolCalories,
 		m.CityCode,
+		photoCreditJSON(t, m.PhotoCredit),
+		menuPhotosJSON(t, m.Photos),
+		menuNutritionJSON(t, m.Nutrition),
+		createdAtJSON(t, m.CreatedAt),
 	)
 
 	require.Equal(t, expect, string(actual))
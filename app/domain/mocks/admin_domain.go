@@ -38,6 +38,34 @@ func (m *MockAdminController) EXPECT() *MockAdminControllerMockRecorder {
 	return m.recorder
 }
 
+// CreateAllergen mocks base method.
+func (m *MockAdminController) CreateAllergen(c echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAllergen", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAllergen indicates an expected call of CreateAllergen.
+func (mr *MockAdminControllerMockRecorder) CreateAllergen(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAllergen", reflect.TypeOf((*MockAdminController)(nil).CreateAllergen), c)
+}
+
+// CreateCity mocks base method.
+func (m *MockAdminController) CreateCity(c echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCity", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateCity indicates an expected call of CreateCity.
+func (mr *MockAdminControllerMockRecorder) CreateCity(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCity", reflect.TypeOf((*MockAdminController)(nil).CreateCity), c)
+}
+
 // CreateDish mocks base method.
 func (m *MockAdminController) CreateDish(c echo.Context) error {
 	m.ctrl.T.Helper()
@@ -52,6 +80,20 @@ func (mr *MockAdminControllerMockRecorder) CreateDish(c any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDish", reflect.TypeOf((*MockAdminController)(nil).CreateDish), c)
 }
 
+// CreateDishAllergens mocks base method.
+func (m *MockAdminController) CreateDishAllergens(c echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDishAllergens", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateDishAllergens indicates an expected call of CreateDishAllergens.
+func (mr *MockAdminControllerMockRecorder) CreateDishAllergens(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDishAllergens", reflect.TypeOf((*MockAdminController)(nil).CreateDishAllergens), c)
+}
+
 // CreateDishes mocks base method.
 func (m *MockAdminController) CreateDishes(c echo.Context) error {
 	m.ctrl.T.Helper()
@@ -79,3 +121,129 @@ func (mr *MockAdminControllerMockRecorder) CreateMenu(c any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMenu", reflect.TypeOf((*MockAdminController)(nil).CreateMenu), c)
 }
+
+// DeleteDish mocks base method.
+func (m *MockAdminController) DeleteDish(c echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDish", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteDish indicates an expected call of DeleteDish.
+func (mr *MockAdminControllerMockRecorder) DeleteDish(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDish", reflect.TypeOf((*MockAdminController)(nil).DeleteDish), c)
+}
+
+// DeleteDishAllergen mocks base method.
+func (m *MockAdminController) DeleteDishAllergen(c echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDishAllergen", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteDishAllergen indicates an expected call of DeleteDishAllergen.
+func (mr *MockAdminControllerMockRecorder) DeleteDishAllergen(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDishAllergen", reflect.TypeOf((*MockAdminController)(nil).DeleteDishAllergen), c)
+}
+
+// DeleteMenu mocks base method.
+func (m *MockAdminController) DeleteMenu(c echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMenu", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMenu indicates an expected call of DeleteMenu.
+func (mr *MockAdminControllerMockRecorder) DeleteMenu(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMenu", reflect.TypeOf((*MockAdminController)(nil).DeleteMenu), c)
+}
+
+// DetachDish mocks base method.
+func (m *MockAdminController) DetachDish(c echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DetachDish", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DetachDish indicates an expected call of DetachDish.
+func (mr *MockAdminControllerMockRecorder) DetachDish(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetachDish", reflect.TypeOf((*MockAdminController)(nil).DetachDish), c)
+}
+
+// ImportMenus mocks base method.
+func (m *MockAdminController) ImportMenus(c echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportMenus", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ImportMenus indicates an expected call of ImportMenus.
+func (mr *MockAdminControllerMockRecorder) ImportMenus(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportMenus", reflect.TypeOf((*MockAdminController)(nil).ImportMenus), c)
+}
+
+// PatchMenu mocks base method.
+func (m *MockAdminController) PatchMenu(c echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PatchMenu", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PatchMenu indicates an expected call of PatchMenu.
+func (mr *MockAdminControllerMockRecorder) PatchMenu(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchMenu", reflect.TypeOf((*MockAdminController)(nil).PatchMenu), c)
+}
+
+// UpdateCity mocks base method.
+func (m *MockAdminController) UpdateCity(c echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCity", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateCity indicates an expected call of UpdateCity.
+func (mr *MockAdminControllerMockRecorder) UpdateCity(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCity", reflect.TypeOf((*MockAdminController)(nil).UpdateCity), c)
+}
+
+// UpdateDish mocks base method.
+func (m *MockAdminController) UpdateDish(c echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDish", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateDish indicates an expected call of UpdateDish.
+func (mr *MockAdminControllerMockRecorder) UpdateDish(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDish", reflect.TypeOf((*MockAdminController)(nil).UpdateDish), c)
+}
+
+// UpdateMenu mocks base method.
+func (m *MockAdminController) UpdateMenu(c echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMenu", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateMenu indicates an expected call of UpdateMenu.
+func (mr *MockAdminControllerMockRecorder) UpdateMenu(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMenu", reflect.TypeOf((*MockAdminController)(nil).UpdateMenu), c)
+}
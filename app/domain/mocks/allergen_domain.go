@@ -11,6 +11,7 @@ package mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	echo "github.com/labstack/echo/v4"
 	domain "github.com/ogurilab/school-lunch-api/domain"
@@ -40,6 +41,49 @@ func (m *MockAllergenRepository) EXPECT() *MockAllergenRepositoryMockRecorder {
 	return m.recorder
 }
 
+// AttachToDish mocks base method.
+func (m *MockAllergenRepository) AttachToDish(ctx context.Context, dishID string, allergens []*domain.Allergen) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AttachToDish", ctx, dishID, allergens)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AttachToDish indicates an expected call of AttachToDish.
+func (mr *MockAllergenRepositoryMockRecorder) AttachToDish(ctx, dishID, allergens any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttachToDish", reflect.TypeOf((*MockAllergenRepository)(nil).AttachToDish), ctx, dishID, allergens)
+}
+
+// Create mocks base method.
+func (m *MockAllergenRepository) Create(ctx context.Context, name string) (*domain.Allergen, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, name)
+	ret0, _ := ret[0].(*domain.Allergen)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockAllergenRepositoryMockRecorder) Create(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockAllergenRepository)(nil).Create), ctx, name)
+}
+
+// DetachFromDish mocks base method.
+func (m *MockAllergenRepository) DetachFromDish(ctx context.Context, dishID string, allergenID int32, category domain.AllergenCategory) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DetachFromDish", ctx, dishID, allergenID, category)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DetachFromDish indicates an expected call of DetachFromDish.
+func (mr *MockAllergenRepositoryMockRecorder) DetachFromDish(ctx, dishID, allergenID, category any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetachFromDish", reflect.TypeOf((*MockAllergenRepository)(nil).DetachFromDish), ctx, dishID, allergenID, category)
+}
+
 // FetchByDishID mocks base method.
 func (m *MockAllergenRepository) FetchByDishID(ctx context.Context, dishID string) ([]*domain.Allergen, error) {
 	m.ctrl.T.Helper()
@@ -55,6 +99,36 @@ func (mr *MockAllergenRepositoryMockRecorder) FetchByDishID(ctx, dishID any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchByDishID", reflect.TypeOf((*MockAllergenRepository)(nil).FetchByDishID), ctx, dishID)
 }
 
+// FetchByDishIDs mocks base method.
+func (m *MockAllergenRepository) FetchByDishIDs(ctx context.Context, dishIDs []string) (map[string][]*domain.Allergen, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchByDishIDs", ctx, dishIDs)
+	ret0, _ := ret[0].(map[string][]*domain.Allergen)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchByDishIDs indicates an expected call of FetchByDishIDs.
+func (mr *MockAllergenRepositoryMockRecorder) FetchByDishIDs(ctx, dishIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchByDishIDs", reflect.TypeOf((*MockAllergenRepository)(nil).FetchByDishIDs), ctx, dishIDs)
+}
+
+// FetchByIDs mocks base method.
+func (m *MockAllergenRepository) FetchByIDs(ctx context.Context, ids []int32) ([]*domain.Allergen, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchByIDs", ctx, ids)
+	ret0, _ := ret[0].([]*domain.Allergen)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchByIDs indicates an expected call of FetchByIDs.
+func (mr *MockAllergenRepositoryMockRecorder) FetchByIDs(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchByIDs", reflect.TypeOf((*MockAllergenRepository)(nil).FetchByIDs), ctx, ids)
+}
+
 // FetchInDish mocks base method.
 func (m *MockAllergenRepository) FetchInDish(ctx context.Context, dishIDs []string) ([]*domain.Allergen, error) {
 	m.ctrl.T.Helper()
@@ -70,6 +144,51 @@ func (mr *MockAllergenRepositoryMockRecorder) FetchInDish(ctx, dishIDs any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchInDish", reflect.TypeOf((*MockAllergenRepository)(nil).FetchInDish), ctx, dishIDs)
 }
 
+// FetchMatchedInDish mocks base method.
+func (m *MockAllergenRepository) FetchMatchedInDish(ctx context.Context, dishIDs []string, allergenIDs []int32) (map[string][]*domain.Allergen, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchMatchedInDish", ctx, dishIDs, allergenIDs)
+	ret0, _ := ret[0].(map[string][]*domain.Allergen)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchMatchedInDish indicates an expected call of FetchMatchedInDish.
+func (mr *MockAllergenRepositoryMockRecorder) FetchMatchedInDish(ctx, dishIDs, allergenIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchMatchedInDish", reflect.TypeOf((*MockAllergenRepository)(nil).FetchMatchedInDish), ctx, dishIDs, allergenIDs)
+}
+
+// FetchMenuDishesByCity mocks base method.
+func (m *MockAllergenRepository) FetchMenuDishesByCity(ctx context.Context, start, end time.Time, city int32) ([]*domain.MenuDishAllergens, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchMenuDishesByCity", ctx, start, end, city)
+	ret0, _ := ret[0].([]*domain.MenuDishAllergens)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchMenuDishesByCity indicates an expected call of FetchMenuDishesByCity.
+func (mr *MockAllergenRepositoryMockRecorder) FetchMenuDishesByCity(ctx, start, end, city any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchMenuDishesByCity", reflect.TypeOf((*MockAllergenRepository)(nil).FetchMenuDishesByCity), ctx, start, end, city)
+}
+
+// FetchStandard mocks base method.
+func (m *MockAllergenRepository) FetchStandard(ctx context.Context) ([]*domain.StandardAllergen, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchStandard", ctx)
+	ret0, _ := ret[0].([]*domain.StandardAllergen)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchStandard indicates an expected call of FetchStandard.
+func (mr *MockAllergenRepositoryMockRecorder) FetchStandard(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchStandard", reflect.TypeOf((*MockAllergenRepository)(nil).FetchStandard), ctx)
+}
+
 // MockAllergenUsecase is a mock of AllergenUsecase interface.
 type MockAllergenUsecase struct {
 	ctrl     *gomock.Controller
@@ -93,6 +212,49 @@ func (m *MockAllergenUsecase) EXPECT() *MockAllergenUsecaseMockRecorder {
 	return m.recorder
 }
 
+// AttachToDish mocks base method.
+func (m *MockAllergenUsecase) AttachToDish(ctx context.Context, dishID string, allergens []*domain.Allergen) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AttachToDish", ctx, dishID, allergens)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AttachToDish indicates an expected call of AttachToDish.
+func (mr *MockAllergenUsecaseMockRecorder) AttachToDish(ctx, dishID, allergens any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttachToDish", reflect.TypeOf((*MockAllergenUsecase)(nil).AttachToDish), ctx, dishID, allergens)
+}
+
+// Create mocks base method.
+func (m *MockAllergenUsecase) Create(ctx context.Context, name string) (*domain.Allergen, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, name)
+	ret0, _ := ret[0].(*domain.Allergen)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockAllergenUsecaseMockRecorder) Create(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockAllergenUsecase)(nil).Create), ctx, name)
+}
+
+// DetachFromDish mocks base method.
+func (m *MockAllergenUsecase) DetachFromDish(ctx context.Context, dishID string, allergenID int32, category domain.AllergenCategory) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DetachFromDish", ctx, dishID, allergenID, category)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DetachFromDish indicates an expected call of DetachFromDish.
+func (mr *MockAllergenUsecaseMockRecorder) DetachFromDish(ctx, dishID, allergenID, category any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetachFromDish", reflect.TypeOf((*MockAllergenUsecase)(nil).DetachFromDish), ctx, dishID, allergenID, category)
+}
+
 // FetchByDishID mocks base method.
 func (m *MockAllergenUsecase) FetchByDishID(ctx context.Context, dishID string) ([]*domain.Allergen, error) {
 	m.ctrl.T.Helper()
@@ -108,6 +270,21 @@ func (mr *MockAllergenUsecaseMockRecorder) FetchByDishID(ctx, dishID any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchByDishID", reflect.TypeOf((*MockAllergenUsecase)(nil).FetchByDishID), ctx, dishID)
 }
 
+// FetchByDishIDs mocks base method.
+func (m *MockAllergenUsecase) FetchByDishIDs(ctx context.Context, dishIDs []string) (map[string][]*domain.Allergen, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchByDishIDs", ctx, dishIDs)
+	ret0, _ := ret[0].(map[string][]*domain.Allergen)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchByDishIDs indicates an expected call of FetchByDishIDs.
+func (mr *MockAllergenUsecaseMockRecorder) FetchByDishIDs(ctx, dishIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchByDishIDs", reflect.TypeOf((*MockAllergenUsecase)(nil).FetchByDishIDs), ctx, dishIDs)
+}
+
 // FetchByMenuID mocks base method.
 func (m *MockAllergenUsecase) FetchByMenuID(ctx context.Context, menuID string) ([]*domain.Allergen, error) {
 	m.ctrl.T.Helper()
@@ -123,6 +300,21 @@ func (mr *MockAllergenUsecaseMockRecorder) FetchByMenuID(ctx, menuID any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchByMenuID", reflect.TypeOf((*MockAllergenUsecase)(nil).FetchByMenuID), ctx, menuID)
 }
 
+// FetchStandard mocks base method.
+func (m *MockAllergenUsecase) FetchStandard(ctx context.Context) ([]*domain.StandardAllergen, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchStandard", ctx)
+	ret0, _ := ret[0].([]*domain.StandardAllergen)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchStandard indicates an expected call of FetchStandard.
+func (mr *MockAllergenUsecaseMockRecorder) FetchStandard(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchStandard", reflect.TypeOf((*MockAllergenUsecase)(nil).FetchStandard), ctx)
+}
+
 // MockAllergenController is a mock of AllergenController interface.
 type MockAllergenController struct {
 	ctrl     *gomock.Controller
@@ -173,3 +365,17 @@ func (mr *MockAllergenControllerMockRecorder) FetchByMenuID(c any) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchByMenuID", reflect.TypeOf((*MockAllergenController)(nil).FetchByMenuID), c)
 }
+
+// FetchStandard mocks base method.
+func (m *MockAllergenController) FetchStandard(c echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchStandard", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FetchStandard indicates an expected call of FetchStandard.
+func (mr *MockAllergenControllerMockRecorder) FetchStandard(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchStandard", reflect.TypeOf((*MockAllergenController)(nil).FetchStandard), c)
+}
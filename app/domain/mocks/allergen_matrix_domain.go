@@ -0,0 +1,162 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: domain/allergen_matrix_domain.go
+//
+// Generated by this command:
+//
+//	mockgen -source domain/allergen_matrix_domain.go -destination domain/mocks/allergen_matrix_domain.go -package mocks
+//
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	io "io"
+	reflect "reflect"
+	time "time"
+
+	echo "github.com/labstack/echo/v4"
+	domain "github.com/ogurilab/school-lunch-api/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAllergenMatrixRenderer is a mock of AllergenMatrixRenderer interface.
+type MockAllergenMatrixRenderer struct {
+	ctrl     *gomock.Controller
+	recorder *MockAllergenMatrixRendererMockRecorder
+}
+
+// MockAllergenMatrixRendererMockRecorder is the mock recorder for MockAllergenMatrixRenderer.
+type MockAllergenMatrixRendererMockRecorder struct {
+	mock *MockAllergenMatrixRenderer
+}
+
+// NewMockAllergenMatrixRenderer creates a new mock instance.
+func NewMockAllergenMatrixRenderer(ctrl *gomock.Controller) *MockAllergenMatrixRenderer {
+	mock := &MockAllergenMatrixRenderer{ctrl: ctrl}
+	mock.recorder = &MockAllergenMatrixRendererMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAllergenMatrixRenderer) EXPECT() *MockAllergenMatrixRendererMockRecorder {
+	return m.recorder
+}
+
+// Render mocks base method.
+func (m *MockAllergenMatrixRenderer) Render(w io.Writer, matrix *domain.AllergenMatrix) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Render", w, matrix)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Render indicates an expected call of Render.
+func (mr *MockAllergenMatrixRendererMockRecorder) Render(w, matrix any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Render", reflect.TypeOf((*MockAllergenMatrixRenderer)(nil).Render), w, matrix)
+}
+
+// MockAllergenMatrixUsecase is a mock of AllergenMatrixUsecase interface.
+type MockAllergenMatrixUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockAllergenMatrixUsecaseMockRecorder
+}
+
+// MockAllergenMatrixUsecaseMockRecorder is the mock recorder for MockAllergenMatrixUsecase.
+type MockAllergenMatrixUsecaseMockRecorder struct {
+	mock *MockAllergenMatrixUsecase
+}
+
+// NewMockAllergenMatrixUsecase creates a new mock instance.
+func NewMockAllergenMatrixUsecase(ctrl *gomock.Controller) *MockAllergenMatrixUsecase {
+	mock := &MockAllergenMatrixUsecase{ctrl: ctrl}
+	mock.recorder = &MockAllergenMatrixUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAllergenMatrixUsecase) EXPECT() *MockAllergenMatrixUsecaseMockRecorder {
+	return m.recorder
+}
+
+// ExportCSVByCity mocks base method.
+func (m *MockAllergenMatrixUsecase) ExportCSVByCity(ctx context.Context, month time.Time, city int32) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportCSVByCity", ctx, month, city)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportCSVByCity indicates an expected call of ExportCSVByCity.
+func (mr *MockAllergenMatrixUsecaseMockRecorder) ExportCSVByCity(ctx, month, city any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportCSVByCity", reflect.TypeOf((*MockAllergenMatrixUsecase)(nil).ExportCSVByCity), ctx, month, city)
+}
+
+// ExportPDFByCity mocks base method.
+func (m *MockAllergenMatrixUsecase) ExportPDFByCity(ctx context.Context, month time.Time, city int32) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportPDFByCity", ctx, month, city)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportPDFByCity indicates an expected call of ExportPDFByCity.
+func (mr *MockAllergenMatrixUsecaseMockRecorder) ExportPDFByCity(ctx, month, city any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportPDFByCity", reflect.TypeOf((*MockAllergenMatrixUsecase)(nil).ExportPDFByCity), ctx, month, city)
+}
+
+// FetchByCity mocks base method.
+func (m *MockAllergenMatrixUsecase) FetchByCity(ctx context.Context, month time.Time, city int32) (*domain.AllergenMatrix, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchByCity", ctx, month, city)
+	ret0, _ := ret[0].(*domain.AllergenMatrix)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchByCity indicates an expected call of FetchByCity.
+func (mr *MockAllergenMatrixUsecaseMockRecorder) FetchByCity(ctx, month, city any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchByCity", reflect.TypeOf((*MockAllergenMatrixUsecase)(nil).FetchByCity), ctx, month, city)
+}
+
+// MockAllergenMatrixController is a mock of AllergenMatrixController interface.
+type MockAllergenMatrixController struct {
+	ctrl     *gomock.Controller
+	recorder *MockAllergenMatrixControllerMockRecorder
+}
+
+// MockAllergenMatrixControllerMockRecorder is the mock recorder for MockAllergenMatrixController.
+type MockAllergenMatrixControllerMockRecorder struct {
+	mock *MockAllergenMatrixController
+}
+
+// NewMockAllergenMatrixController creates a new mock instance.
+func NewMockAllergenMatrixController(ctrl *gomock.Controller) *MockAllergenMatrixController {
+	mock := &MockAllergenMatrixController{ctrl: ctrl}
+	mock.recorder = &MockAllergenMatrixControllerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAllergenMatrixController) EXPECT() *MockAllergenMatrixControllerMockRecorder {
+	return m.recorder
+}
+
+// FetchByCity mocks base method.
+func (m *MockAllergenMatrixController) FetchByCity(c echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchByCity", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FetchByCity indicates an expected call of FetchByCity.
+func (mr *MockAllergenMatrixControllerMockRecorder) FetchByCity(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchByCity", reflect.TypeOf((*MockAllergenMatrixController)(nil).FetchByCity), c)
+}
@@ -0,0 +1,159 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: domain/audit_log_domain.go
+//
+// Generated by this command:
+//
+//	mockgen -source domain/audit_log_domain.go -destination domain/mocks/audit_log_domain.go -package mocks
+//
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	echo "github.com/labstack/echo/v4"
+	domain "github.com/ogurilab/school-lunch-api/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAuditLogRepository is a mock of AuditLogRepository interface.
+type MockAuditLogRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuditLogRepositoryMockRecorder
+}
+
+// MockAuditLogRepositoryMockRecorder is the mock recorder for MockAuditLogRepository.
+type MockAuditLogRepositoryMockRecorder struct {
+	mock *MockAuditLogRepository
+}
+
+// NewMockAuditLogRepository creates a new mock instance.
+func NewMockAuditLogRepository(ctrl *gomock.Controller) *MockAuditLogRepository {
+	mock := &MockAuditLogRepository{ctrl: ctrl}
+	mock.recorder = &MockAuditLogRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuditLogRepository) EXPECT() *MockAuditLogRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockAuditLogRepository) Create(ctx context.Context, log *domain.AuditLog) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, log)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockAuditLogRepositoryMockRecorder) Create(ctx, log any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockAuditLogRepository)(nil).Create), ctx, log)
+}
+
+// Fetch mocks base method.
+func (m *MockAuditLogRepository) Fetch(ctx context.Context, filter *domain.AuditLogFilter) ([]*domain.AuditLog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Fetch", ctx, filter)
+	ret0, _ := ret[0].([]*domain.AuditLog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Fetch indicates an expected call of Fetch.
+func (mr *MockAuditLogRepositoryMockRecorder) Fetch(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Fetch", reflect.TypeOf((*MockAuditLogRepository)(nil).Fetch), ctx, filter)
+}
+
+// MockAuditLogUsecase is a mock of AuditLogUsecase interface.
+type MockAuditLogUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuditLogUsecaseMockRecorder
+}
+
+// MockAuditLogUsecaseMockRecorder is the mock recorder for MockAuditLogUsecase.
+type MockAuditLogUsecaseMockRecorder struct {
+	mock *MockAuditLogUsecase
+}
+
+// NewMockAuditLogUsecase creates a new mock instance.
+func NewMockAuditLogUsecase(ctrl *gomock.Controller) *MockAuditLogUsecase {
+	mock := &MockAuditLogUsecase{ctrl: ctrl}
+	mock.recorder = &MockAuditLogUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuditLogUsecase) EXPECT() *MockAuditLogUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Fetch mocks base method.
+func (m *MockAuditLogUsecase) Fetch(ctx context.Context, filter *domain.AuditLogFilter) ([]*domain.AuditLog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Fetch", ctx, filter)
+	ret0, _ := ret[0].([]*domain.AuditLog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Fetch indicates an expected call of Fetch.
+func (mr *MockAuditLogUsecaseMockRecorder) Fetch(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Fetch", reflect.TypeOf((*MockAuditLogUsecase)(nil).Fetch), ctx, filter)
+}
+
+// Record mocks base method.
+func (m *MockAuditLogUsecase) Record(ctx context.Context, log *domain.AuditLog) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Record", ctx, log)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Record indicates an expected call of Record.
+func (mr *MockAuditLogUsecaseMockRecorder) Record(ctx, log any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Record", reflect.TypeOf((*MockAuditLogUsecase)(nil).Record), ctx, log)
+}
+
+// MockAuditLogController is a mock of AuditLogController interface.
+type MockAuditLogController struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuditLogControllerMockRecorder
+}
+
+// MockAuditLogControllerMockRecorder is the mock recorder for MockAuditLogController.
+type MockAuditLogControllerMockRecorder struct {
+	mock *MockAuditLogController
+}
+
+// NewMockAuditLogController creates a new mock instance.
+func NewMockAuditLogController(ctrl *gomock.Controller) *MockAuditLogController {
+	mock := &MockAuditLogController{ctrl: ctrl}
+	mock.recorder = &MockAuditLogControllerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuditLogController) EXPECT() *MockAuditLogControllerMockRecorder {
+	return m.recorder
+}
+
+// Fetch mocks base method.
+func (m *MockAuditLogController) Fetch(c echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Fetch", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Fetch indicates an expected call of Fetch.
+func (mr *MockAuditLogControllerMockRecorder) Fetch(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Fetch", reflect.TypeOf((*MockAuditLogController)(nil).Fetch), c)
+}
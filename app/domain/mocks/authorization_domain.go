@@ -0,0 +1,82 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: domain/authorization_domain.go
+//
+// Generated by this command:
+//
+//	mockgen -source domain/authorization_domain.go -destination domain/mocks/authorization_domain.go -package mocks
+//
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/ogurilab/school-lunch-api/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAuthorizationUsecase is a mock of AuthorizationUsecase interface.
+type MockAuthorizationUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuthorizationUsecaseMockRecorder
+}
+
+// MockAuthorizationUsecaseMockRecorder is the mock recorder for MockAuthorizationUsecase.
+type MockAuthorizationUsecaseMockRecorder struct {
+	mock *MockAuthorizationUsecase
+}
+
+// NewMockAuthorizationUsecase creates a new mock instance.
+func NewMockAuthorizationUsecase(ctrl *gomock.Controller) *MockAuthorizationUsecase {
+	mock := &MockAuthorizationUsecase{ctrl: ctrl}
+	mock.recorder = &MockAuthorizationUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuthorizationUsecase) EXPECT() *MockAuthorizationUsecaseMockRecorder {
+	return m.recorder
+}
+
+// AuthorizeCity mocks base method.
+func (m *MockAuthorizationUsecase) AuthorizeCity(ctx context.Context, payload *domain.TokenPayload, cityCode int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthorizeCity", ctx, payload, cityCode)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AuthorizeCity indicates an expected call of AuthorizeCity.
+func (mr *MockAuthorizationUsecaseMockRecorder) AuthorizeCity(ctx, payload, cityCode any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthorizeCity", reflect.TypeOf((*MockAuthorizationUsecase)(nil).AuthorizeCity), ctx, payload, cityCode)
+}
+
+// AuthorizeDish mocks base method.
+func (m *MockAuthorizationUsecase) AuthorizeDish(ctx context.Context, payload *domain.TokenPayload, dishID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthorizeDish", ctx, payload, dishID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AuthorizeDish indicates an expected call of AuthorizeDish.
+func (mr *MockAuthorizationUsecaseMockRecorder) AuthorizeDish(ctx, payload, dishID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthorizeDish", reflect.TypeOf((*MockAuthorizationUsecase)(nil).AuthorizeDish), ctx, payload, dishID)
+}
+
+// AuthorizeMenu mocks base method.
+func (m *MockAuthorizationUsecase) AuthorizeMenu(ctx context.Context, payload *domain.TokenPayload, menuID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthorizeMenu", ctx, payload, menuID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AuthorizeMenu indicates an expected call of AuthorizeMenu.
+func (mr *MockAuthorizationUsecaseMockRecorder) AuthorizeMenu(ctx, payload, menuID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthorizeMenu", reflect.TypeOf((*MockAuthorizationUsecase)(nil).AuthorizeMenu), ctx, payload, menuID)
+}
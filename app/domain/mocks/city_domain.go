@@ -40,6 +40,20 @@ func (m *MockCityRepository) EXPECT() *MockCityRepositoryMockRecorder {
 	return m.recorder
 }
 
+// Create mocks base method.
+func (m *MockCityRepository) Create(ctx context.Context, city *domain.City) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, city)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockCityRepositoryMockRecorder) Create(ctx, city any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCityRepository)(nil).Create), ctx, city)
+}
+
 // Fetch mocks base method.
 func (m *MockCityRepository) Fetch(ctx context.Context, limit, offset int32) ([]*domain.City, error) {
 	m.ctrl.T.Helper()
@@ -100,6 +114,20 @@ func (mr *MockCityRepositoryMockRecorder) GetByCityCode(ctx, code any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByCityCode", reflect.TypeOf((*MockCityRepository)(nil).GetByCityCode), ctx, code)
 }
 
+// Update mocks base method.
+func (m *MockCityRepository) Update(ctx context.Context, city *domain.City) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, city)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockCityRepositoryMockRecorder) Update(ctx, city any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockCityRepository)(nil).Update), ctx, city)
+}
+
 // MockCityUsecase is a mock of CityUsecase interface.
 type MockCityUsecase struct {
 	ctrl     *gomock.Controller
@@ -123,6 +151,20 @@ func (m *MockCityUsecase) EXPECT() *MockCityUsecaseMockRecorder {
 	return m.recorder
 }
 
+// Create mocks base method.
+func (m *MockCityUsecase) Create(ctx context.Context, city *domain.City) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, city)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockCityUsecaseMockRecorder) Create(ctx, city any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCityUsecase)(nil).Create), ctx, city)
+}
+
 // Fetch mocks base method.
 func (m *MockCityUsecase) Fetch(ctx context.Context, limit, offset int32, search string) ([]*domain.City, error) {
 	m.ctrl.T.Helper()
@@ -168,6 +210,20 @@ func (mr *MockCityUsecaseMockRecorder) GetByCityCode(ctx, code any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByCityCode", reflect.TypeOf((*MockCityUsecase)(nil).GetByCityCode), ctx, code)
 }
 
+// Update mocks base method.
+func (m *MockCityUsecase) Update(ctx context.Context, city *domain.City) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, city)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockCityUsecaseMockRecorder) Update(ctx, city any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockCityUsecase)(nil).Update), ctx, city)
+}
+
 // MockCityController is a mock of CityController interface.
 type MockCityController struct {
 	ctrl     *gomock.Controller
@@ -41,11 +41,12 @@ func (m *MockDishRepository) EXPECT() *MockDishRepositoryMockRecorder {
 }
 
 // Create mocks base method.
-func (m *MockDishRepository) Create(ctx context.Context, dish *domain.Dish, menuID string) error {
+func (m *MockDishRepository) Create(ctx context.Context, dish *domain.Dish, menuID string) (*domain.CreatedDishes, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Create", ctx, dish, menuID)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].(*domain.CreatedDishes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // Create indicates an expected call of Create.
@@ -55,11 +56,12 @@ func (mr *MockDishRepositoryMockRecorder) Create(ctx, dish, menuID any) *gomock.
 }
 
 // CreateMany mocks base method.
-func (m *MockDishRepository) CreateMany(ctx context.Context, dishes []*domain.Dish, menuID string) error {
+func (m *MockDishRepository) CreateMany(ctx context.Context, dishes []*domain.Dish, menuID string) (*domain.CreatedDishes, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CreateMany", ctx, dishes, menuID)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].(*domain.CreatedDishes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // CreateMany indicates an expected call of CreateMany.
@@ -68,6 +70,35 @@ func (mr *MockDishRepositoryMockRecorder) CreateMany(ctx, dishes, menuID any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMany", reflect.TypeOf((*MockDishRepository)(nil).CreateMany), ctx, dishes, menuID)
 }
 
+// Delete mocks base method.
+func (m *MockDishRepository) Delete(ctx context.Context, id string, force bool) (*domain.DeletedDish, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id, force)
+	ret0, _ := ret[0].(*domain.DeletedDish)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockDishRepositoryMockRecorder) Delete(ctx, id, force any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockDishRepository)(nil).Delete), ctx, id, force)
+}
+
+// DetachFromMenu mocks base method.
+func (m *MockDishRepository) DetachFromMenu(ctx context.Context, id, menuID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DetachFromMenu", ctx, id, menuID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DetachFromMenu indicates an expected call of DetachFromMenu.
+func (mr *MockDishRepositoryMockRecorder) DetachFromMenu(ctx, id, menuID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetachFromMenu", reflect.TypeOf((*MockDishRepository)(nil).DetachFromMenu), ctx, id, menuID)
+}
+
 // Fetch mocks base method.
 func (m *MockDishRepository) Fetch(ctx context.Context, limit, offset int32) ([]*domain.Dish, error) {
 	m.ctrl.T.Helper()
@@ -83,6 +114,36 @@ func (mr *MockDishRepositoryMockRecorder) Fetch(ctx, limit, offset any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Fetch", reflect.TypeOf((*MockDishRepository)(nil).Fetch), ctx, limit, offset)
 }
 
+// FetchByAllergenID mocks base method.
+func (m *MockDishRepository) FetchByAllergenID(ctx context.Context, allergenID, limit, offset int32) ([]*domain.Dish, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchByAllergenID", ctx, allergenID, limit, offset)
+	ret0, _ := ret[0].([]*domain.Dish)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchByAllergenID indicates an expected call of FetchByAllergenID.
+func (mr *MockDishRepositoryMockRecorder) FetchByAllergenID(ctx, allergenID, limit, offset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchByAllergenID", reflect.TypeOf((*MockDishRepository)(nil).FetchByAllergenID), ctx, allergenID, limit, offset)
+}
+
+// FetchByAllergenIDInCity mocks base method.
+func (m *MockDishRepository) FetchByAllergenIDInCity(ctx context.Context, allergenID, limit, offset, city int32) ([]*domain.Dish, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchByAllergenIDInCity", ctx, allergenID, limit, offset, city)
+	ret0, _ := ret[0].([]*domain.Dish)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchByAllergenIDInCity indicates an expected call of FetchByAllergenIDInCity.
+func (mr *MockDishRepositoryMockRecorder) FetchByAllergenIDInCity(ctx, allergenID, limit, offset, city any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchByAllergenIDInCity", reflect.TypeOf((*MockDishRepository)(nil).FetchByAllergenIDInCity), ctx, allergenID, limit, offset, city)
+}
+
 // FetchByMenuID mocks base method.
 func (m *MockDishRepository) FetchByMenuID(ctx context.Context, menuID string) ([]*domain.Dish, error) {
 	m.ctrl.T.Helper()
@@ -113,6 +174,51 @@ func (mr *MockDishRepositoryMockRecorder) FetchByName(ctx, search, limit, offset
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchByName", reflect.TypeOf((*MockDishRepository)(nil).FetchByName), ctx, search, limit, offset)
 }
 
+// FetchByNameExcludingAllergens mocks base method.
+func (m *MockDishRepository) FetchByNameExcludingAllergens(ctx context.Context, search string, allergenIDs []int32, limit, offset int32) ([]*domain.Dish, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchByNameExcludingAllergens", ctx, search, allergenIDs, limit, offset)
+	ret0, _ := ret[0].([]*domain.Dish)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchByNameExcludingAllergens indicates an expected call of FetchByNameExcludingAllergens.
+func (mr *MockDishRepositoryMockRecorder) FetchByNameExcludingAllergens(ctx, search, allergenIDs, limit, offset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchByNameExcludingAllergens", reflect.TypeOf((*MockDishRepository)(nil).FetchByNameExcludingAllergens), ctx, search, allergenIDs, limit, offset)
+}
+
+// FetchCityCodes mocks base method.
+func (m *MockDishRepository) FetchCityCodes(ctx context.Context, id string) ([]int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchCityCodes", ctx, id)
+	ret0, _ := ret[0].([]int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchCityCodes indicates an expected call of FetchCityCodes.
+func (mr *MockDishRepositoryMockRecorder) FetchCityCodes(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchCityCodes", reflect.TypeOf((*MockDishRepository)(nil).FetchCityCodes), ctx, id)
+}
+
+// FindByID mocks base method.
+func (m *MockDishRepository) FindByID(ctx context.Context, id string) (*domain.Dish, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, id)
+	ret0, _ := ret[0].(*domain.Dish)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockDishRepositoryMockRecorder) FindByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockDishRepository)(nil).FindByID), ctx, id)
+}
+
 // GetByID mocks base method.
 func (m *MockDishRepository) GetByID(ctx context.Context, id string, limit, offset int32) (*domain.DishWithMenuIDs, error) {
 	m.ctrl.T.Helper()
@@ -143,6 +249,20 @@ func (mr *MockDishRepositoryMockRecorder) GetByIdInCity(ctx, id, limit, offset,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIdInCity", reflect.TypeOf((*MockDishRepository)(nil).GetByIdInCity), ctx, id, limit, offset, city)
 }
 
+// Update mocks base method.
+func (m *MockDishRepository) Update(ctx context.Context, dish *domain.Dish) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, dish)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockDishRepositoryMockRecorder) Update(ctx, dish any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockDishRepository)(nil).Update), ctx, dish)
+}
+
 // MockDishUsecase is a mock of DishUsecase interface.
 type MockDishUsecase struct {
 	ctrl     *gomock.Controller
@@ -167,11 +287,12 @@ func (m *MockDishUsecase) EXPECT() *MockDishUsecaseMockRecorder {
 }
 
 // Create mocks base method.
-func (m *MockDishUsecase) Create(ctx context.Context, dish *domain.Dish, menuID string) error {
+func (m *MockDishUsecase) Create(ctx context.Context, dish *domain.Dish, menuID string) (*domain.CreatedDishes, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Create", ctx, dish, menuID)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].(*domain.CreatedDishes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // Create indicates an expected call of Create.
@@ -181,11 +302,12 @@ func (mr *MockDishUsecaseMockRecorder) Create(ctx, dish, menuID any) *gomock.Cal
 }
 
 // CreateMany mocks base method.
-func (m *MockDishUsecase) CreateMany(ctx context.Context, dishes []*domain.Dish, menuID string) error {
+func (m *MockDishUsecase) CreateMany(ctx context.Context, dishes []*domain.Dish, menuID string) (*domain.CreatedDishes, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CreateMany", ctx, dishes, menuID)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].(*domain.CreatedDishes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // CreateMany indicates an expected call of CreateMany.
@@ -194,6 +316,35 @@ func (mr *MockDishUsecaseMockRecorder) CreateMany(ctx, dishes, menuID any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMany", reflect.TypeOf((*MockDishUsecase)(nil).CreateMany), ctx, dishes, menuID)
 }
 
+// Delete mocks base method.
+func (m *MockDishUsecase) Delete(ctx context.Context, id string, force bool) (*domain.DeletedDish, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id, force)
+	ret0, _ := ret[0].(*domain.DeletedDish)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockDishUsecaseMockRecorder) Delete(ctx, id, force any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockDishUsecase)(nil).Delete), ctx, id, force)
+}
+
+// DetachFromMenu mocks base method.
+func (m *MockDishUsecase) DetachFromMenu(ctx context.Context, id, menuID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DetachFromMenu", ctx, id, menuID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DetachFromMenu indicates an expected call of DetachFromMenu.
+func (mr *MockDishUsecaseMockRecorder) DetachFromMenu(ctx, id, menuID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetachFromMenu", reflect.TypeOf((*MockDishUsecase)(nil).DetachFromMenu), ctx, id, menuID)
+}
+
 // Fetch mocks base method.
 func (m *MockDishUsecase) Fetch(ctx context.Context, search string, limit, offset int32) ([]*domain.Dish, error) {
 	m.ctrl.T.Helper()
@@ -209,6 +360,36 @@ func (mr *MockDishUsecaseMockRecorder) Fetch(ctx, search, limit, offset any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Fetch", reflect.TypeOf((*MockDishUsecase)(nil).Fetch), ctx, search, limit, offset)
 }
 
+// FetchByAllergenID mocks base method.
+func (m *MockDishUsecase) FetchByAllergenID(ctx context.Context, allergenID, limit, offset int32) ([]*domain.Dish, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchByAllergenID", ctx, allergenID, limit, offset)
+	ret0, _ := ret[0].([]*domain.Dish)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchByAllergenID indicates an expected call of FetchByAllergenID.
+func (mr *MockDishUsecaseMockRecorder) FetchByAllergenID(ctx, allergenID, limit, offset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchByAllergenID", reflect.TypeOf((*MockDishUsecase)(nil).FetchByAllergenID), ctx, allergenID, limit, offset)
+}
+
+// FetchByAllergenIDInCity mocks base method.
+func (m *MockDishUsecase) FetchByAllergenIDInCity(ctx context.Context, allergenID, limit, offset, city int32) ([]*domain.Dish, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchByAllergenIDInCity", ctx, allergenID, limit, offset, city)
+	ret0, _ := ret[0].([]*domain.Dish)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchByAllergenIDInCity indicates an expected call of FetchByAllergenIDInCity.
+func (mr *MockDishUsecaseMockRecorder) FetchByAllergenIDInCity(ctx, allergenID, limit, offset, city any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchByAllergenIDInCity", reflect.TypeOf((*MockDishUsecase)(nil).FetchByAllergenIDInCity), ctx, allergenID, limit, offset, city)
+}
+
 // FetchByMenuID mocks base method.
 func (m *MockDishUsecase) FetchByMenuID(ctx context.Context, menuID string) ([]*domain.Dish, error) {
 	m.ctrl.T.Helper()
@@ -224,6 +405,21 @@ func (mr *MockDishUsecaseMockRecorder) FetchByMenuID(ctx, menuID any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchByMenuID", reflect.TypeOf((*MockDishUsecase)(nil).FetchByMenuID), ctx, menuID)
 }
 
+// FetchExcludingAllergens mocks base method.
+func (m *MockDishUsecase) FetchExcludingAllergens(ctx context.Context, search string, allergenIDs []int32, limit, offset int32) ([]*domain.Dish, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchExcludingAllergens", ctx, search, allergenIDs, limit, offset)
+	ret0, _ := ret[0].([]*domain.Dish)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchExcludingAllergens indicates an expected call of FetchExcludingAllergens.
+func (mr *MockDishUsecaseMockRecorder) FetchExcludingAllergens(ctx, search, allergenIDs, limit, offset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchExcludingAllergens", reflect.TypeOf((*MockDishUsecase)(nil).FetchExcludingAllergens), ctx, search, allergenIDs, limit, offset)
+}
+
 // GetByID mocks base method.
 func (m *MockDishUsecase) GetByID(ctx context.Context, id string, limit, offset int32) (*domain.DishWithMenuIDs, error) {
 	m.ctrl.T.Helper()
@@ -254,6 +450,20 @@ func (mr *MockDishUsecaseMockRecorder) GetByIdInCity(ctx, id, limit, offset, cit
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIdInCity", reflect.TypeOf((*MockDishUsecase)(nil).GetByIdInCity), ctx, id, limit, offset, city)
 }
 
+// Update mocks base method.
+func (m *MockDishUsecase) Update(ctx context.Context, dish *domain.Dish) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, dish)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockDishUsecaseMockRecorder) Update(ctx, dish any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockDishUsecase)(nil).Update), ctx, dish)
+}
+
 // MockDishController is a mock of DishController interface.
 type MockDishController struct {
 	ctrl     *gomock.Controller
@@ -291,6 +501,20 @@ func (mr *MockDishControllerMockRecorder) Fetch(c any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Fetch", reflect.TypeOf((*MockDishController)(nil).Fetch), c)
 }
 
+// FetchByAllergenID mocks base method.
+func (m *MockDishController) FetchByAllergenID(c echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchByAllergenID", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FetchByAllergenID indicates an expected call of FetchByAllergenID.
+func (mr *MockDishControllerMockRecorder) FetchByAllergenID(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchByAllergenID", reflect.TypeOf((*MockDishController)(nil).FetchByAllergenID), c)
+}
+
 // FetchByMenuID mocks base method.
 func (m *MockDishController) FetchByMenuID(c echo.Context) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,430 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: domain/external_data_source_domain.go
+//
+// Generated by this command:
+//
+//	mockgen -source domain/external_data_source_domain.go -destination domain/mocks/external_data_source_domain.go -package mocks
+//
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	sql "database/sql"
+	io "io"
+	reflect "reflect"
+
+	echo "github.com/labstack/echo/v4"
+	domain "github.com/ogurilab/school-lunch-api/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockExternalDataSourceRepository is a mock of ExternalDataSourceRepository interface.
+type MockExternalDataSourceRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockExternalDataSourceRepositoryMockRecorder
+}
+
+// MockExternalDataSourceRepositoryMockRecorder is the mock recorder for MockExternalDataSourceRepository.
+type MockExternalDataSourceRepositoryMockRecorder struct {
+	mock *MockExternalDataSourceRepository
+}
+
+// NewMockExternalDataSourceRepository creates a new mock instance.
+func NewMockExternalDataSourceRepository(ctrl *gomock.Controller) *MockExternalDataSourceRepository {
+	mock := &MockExternalDataSourceRepository{ctrl: ctrl}
+	mock.recorder = &MockExternalDataSourceRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExternalDataSourceRepository) EXPECT() *MockExternalDataSourceRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockExternalDataSourceRepository) Create(ctx context.Context, source *domain.ExternalDataSource) (*domain.ExternalDataSource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, source)
+	ret0, _ := ret[0].(*domain.ExternalDataSource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockExternalDataSourceRepositoryMockRecorder) Create(ctx, source any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockExternalDataSourceRepository)(nil).Create), ctx, source)
+}
+
+// Delete mocks base method.
+func (m *MockExternalDataSourceRepository) Delete(ctx context.Context, id int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockExternalDataSourceRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockExternalDataSourceRepository)(nil).Delete), ctx, id)
+}
+
+// Fetch mocks base method.
+func (m *MockExternalDataSourceRepository) Fetch(ctx context.Context, limit, offset int32) ([]*domain.ExternalDataSource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Fetch", ctx, limit, offset)
+	ret0, _ := ret[0].([]*domain.ExternalDataSource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Fetch indicates an expected call of Fetch.
+func (mr *MockExternalDataSourceRepositoryMockRecorder) Fetch(ctx, limit, offset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Fetch", reflect.TypeOf((*MockExternalDataSourceRepository)(nil).Fetch), ctx, limit, offset)
+}
+
+// FetchByStatus mocks base method.
+func (m *MockExternalDataSourceRepository) FetchByStatus(ctx context.Context, status string) ([]*domain.ExternalDataSource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchByStatus", ctx, status)
+	ret0, _ := ret[0].([]*domain.ExternalDataSource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchByStatus indicates an expected call of FetchByStatus.
+func (mr *MockExternalDataSourceRepositoryMockRecorder) FetchByStatus(ctx, status any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchByStatus", reflect.TypeOf((*MockExternalDataSourceRepository)(nil).FetchByStatus), ctx, status)
+}
+
+// GetByID mocks base method.
+func (m *MockExternalDataSourceRepository) GetByID(ctx context.Context, id int32) (*domain.ExternalDataSource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*domain.ExternalDataSource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockExternalDataSourceRepositoryMockRecorder) GetByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockExternalDataSourceRepository)(nil).GetByID), ctx, id)
+}
+
+// StartSync mocks base method.
+func (m *MockExternalDataSourceRepository) StartSync(ctx context.Context, id int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartSync", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StartSync indicates an expected call of StartSync.
+func (mr *MockExternalDataSourceRepositoryMockRecorder) StartSync(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartSync", reflect.TypeOf((*MockExternalDataSourceRepository)(nil).StartSync), ctx, id)
+}
+
+// Update mocks base method.
+func (m *MockExternalDataSourceRepository) Update(ctx context.Context, source *domain.ExternalDataSource) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, source)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockExternalDataSourceRepositoryMockRecorder) Update(ctx, source any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockExternalDataSourceRepository)(nil).Update), ctx, source)
+}
+
+// UpdateStatus mocks base method.
+func (m *MockExternalDataSourceRepository) UpdateStatus(ctx context.Context, id int32, status string, description sql.NullString) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStatus", ctx, id, status, description)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateStatus indicates an expected call of UpdateStatus.
+func (mr *MockExternalDataSourceRepositoryMockRecorder) UpdateStatus(ctx, id, status, description any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatus", reflect.TypeOf((*MockExternalDataSourceRepository)(nil).UpdateStatus), ctx, id, status, description)
+}
+
+// MockExternalDataSourceUsecase is a mock of ExternalDataSourceUsecase interface.
+type MockExternalDataSourceUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockExternalDataSourceUsecaseMockRecorder
+}
+
+// MockExternalDataSourceUsecaseMockRecorder is the mock recorder for MockExternalDataSourceUsecase.
+type MockExternalDataSourceUsecaseMockRecorder struct {
+	mock *MockExternalDataSourceUsecase
+}
+
+// NewMockExternalDataSourceUsecase creates a new mock instance.
+func NewMockExternalDataSourceUsecase(ctrl *gomock.Controller) *MockExternalDataSourceUsecase {
+	mock := &MockExternalDataSourceUsecase{ctrl: ctrl}
+	mock.recorder = &MockExternalDataSourceUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExternalDataSourceUsecase) EXPECT() *MockExternalDataSourceUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockExternalDataSourceUsecase) Create(ctx context.Context, source *domain.ExternalDataSource) (*domain.ExternalDataSource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, source)
+	ret0, _ := ret[0].(*domain.ExternalDataSource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockExternalDataSourceUsecaseMockRecorder) Create(ctx, source any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockExternalDataSourceUsecase)(nil).Create), ctx, source)
+}
+
+// Delete mocks base method.
+func (m *MockExternalDataSourceUsecase) Delete(ctx context.Context, id int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockExternalDataSourceUsecaseMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockExternalDataSourceUsecase)(nil).Delete), ctx, id)
+}
+
+// Fetch mocks base method.
+func (m *MockExternalDataSourceUsecase) Fetch(ctx context.Context, limit, offset int32) ([]*domain.ExternalDataSource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Fetch", ctx, limit, offset)
+	ret0, _ := ret[0].([]*domain.ExternalDataSource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Fetch indicates an expected call of Fetch.
+func (mr *MockExternalDataSourceUsecaseMockRecorder) Fetch(ctx, limit, offset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Fetch", reflect.TypeOf((*MockExternalDataSourceUsecase)(nil).Fetch), ctx, limit, offset)
+}
+
+// GetByID mocks base method.
+func (m *MockExternalDataSourceUsecase) GetByID(ctx context.Context, id int32) (*domain.ExternalDataSource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*domain.ExternalDataSource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockExternalDataSourceUsecaseMockRecorder) GetByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockExternalDataSourceUsecase)(nil).GetByID), ctx, id)
+}
+
+// Sync mocks base method.
+func (m *MockExternalDataSourceUsecase) Sync(ctx context.Context, id int32) (*domain.DataSourceSyncResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Sync", ctx, id)
+	ret0, _ := ret[0].(*domain.DataSourceSyncResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Sync indicates an expected call of Sync.
+func (mr *MockExternalDataSourceUsecaseMockRecorder) Sync(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sync", reflect.TypeOf((*MockExternalDataSourceUsecase)(nil).Sync), ctx, id)
+}
+
+// SyncActive mocks base method.
+func (m *MockExternalDataSourceUsecase) SyncActive(ctx context.Context) ([]*domain.DataSourceSyncResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SyncActive", ctx)
+	ret0, _ := ret[0].([]*domain.DataSourceSyncResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SyncActive indicates an expected call of SyncActive.
+func (mr *MockExternalDataSourceUsecaseMockRecorder) SyncActive(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncActive", reflect.TypeOf((*MockExternalDataSourceUsecase)(nil).SyncActive), ctx)
+}
+
+// Update mocks base method.
+func (m *MockExternalDataSourceUsecase) Update(ctx context.Context, source *domain.ExternalDataSource) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, source)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockExternalDataSourceUsecaseMockRecorder) Update(ctx, source any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockExternalDataSourceUsecase)(nil).Update), ctx, source)
+}
+
+// MockDatasetFetcher is a mock of DatasetFetcher interface.
+type MockDatasetFetcher struct {
+	ctrl     *gomock.Controller
+	recorder *MockDatasetFetcherMockRecorder
+}
+
+// MockDatasetFetcherMockRecorder is the mock recorder for MockDatasetFetcher.
+type MockDatasetFetcherMockRecorder struct {
+	mock *MockDatasetFetcher
+}
+
+// NewMockDatasetFetcher creates a new mock instance.
+func NewMockDatasetFetcher(ctrl *gomock.Controller) *MockDatasetFetcher {
+	mock := &MockDatasetFetcher{ctrl: ctrl}
+	mock.recorder = &MockDatasetFetcherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDatasetFetcher) EXPECT() *MockDatasetFetcherMockRecorder {
+	return m.recorder
+}
+
+// Fetch mocks base method.
+func (m *MockDatasetFetcher) Fetch(ctx context.Context, url string) (io.ReadCloser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Fetch", ctx, url)
+	ret0, _ := ret[0].(io.ReadCloser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Fetch indicates an expected call of Fetch.
+func (mr *MockDatasetFetcherMockRecorder) Fetch(ctx, url any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Fetch", reflect.TypeOf((*MockDatasetFetcher)(nil).Fetch), ctx, url)
+}
+
+// MockExternalDataSourceController is a mock of ExternalDataSourceController interface.
+type MockExternalDataSourceController struct {
+	ctrl     *gomock.Controller
+	recorder *MockExternalDataSourceControllerMockRecorder
+}
+
+// MockExternalDataSourceControllerMockRecorder is the mock recorder for MockExternalDataSourceController.
+type MockExternalDataSourceControllerMockRecorder struct {
+	mock *MockExternalDataSourceController
+}
+
+// NewMockExternalDataSourceController creates a new mock instance.
+func NewMockExternalDataSourceController(ctrl *gomock.Controller) *MockExternalDataSourceController {
+	mock := &MockExternalDataSourceController{ctrl: ctrl}
+	mock.recorder = &MockExternalDataSourceControllerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExternalDataSourceController) EXPECT() *MockExternalDataSourceControllerMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockExternalDataSourceController) Create(c echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockExternalDataSourceControllerMockRecorder) Create(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockExternalDataSourceController)(nil).Create), c)
+}
+
+// Delete mocks base method.
+func (m *MockExternalDataSourceController) Delete(c echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockExternalDataSourceControllerMockRecorder) Delete(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockExternalDataSourceController)(nil).Delete), c)
+}
+
+// Fetch mocks base method.
+func (m *MockExternalDataSourceController) Fetch(c echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Fetch", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Fetch indicates an expected call of Fetch.
+func (mr *MockExternalDataSourceControllerMockRecorder) Fetch(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Fetch", reflect.TypeOf((*MockExternalDataSourceController)(nil).Fetch), c)
+}
+
+// GetByID mocks base method.
+func (m *MockExternalDataSourceController) GetByID(c echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockExternalDataSourceControllerMockRecorder) GetByID(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockExternalDataSourceController)(nil).GetByID), c)
+}
+
+// Sync mocks base method.
+func (m *MockExternalDataSourceController) Sync(c echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Sync", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Sync indicates an expected call of Sync.
+func (mr *MockExternalDataSourceControllerMockRecorder) Sync(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sync", reflect.TypeOf((*MockExternalDataSourceController)(nil).Sync), c)
+}
+
+// Update mocks base method.
+func (m *MockExternalDataSourceController) Update(c echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockExternalDataSourceControllerMockRecorder) Update(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockExternalDataSourceController)(nil).Update), c)
+}
@@ -0,0 +1,490 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: domain/ingredient_domain.go
+//
+// Generated by this command:
+//
+//	mockgen -source domain/ingredient_domain.go -destination domain/mocks/ingredient_domain.go -package mocks
+//
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	echo "github.com/labstack/echo/v4"
+	domain "github.com/ogurilab/school-lunch-api/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIngredientRepository is a mock of IngredientRepository interface.
+type MockIngredientRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIngredientRepositoryMockRecorder
+}
+
+// MockIngredientRepositoryMockRecorder is the mock recorder for MockIngredientRepository.
+type MockIngredientRepositoryMockRecorder struct {
+	mock *MockIngredientRepository
+}
+
+// NewMockIngredientRepository creates a new mock instance.
+func NewMockIngredientRepository(ctrl *gomock.Controller) *MockIngredientRepository {
+	mock := &MockIngredientRepository{ctrl: ctrl}
+	mock.recorder = &MockIngredientRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIngredientRepository) EXPECT() *MockIngredientRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AttachToDish mocks base method.
+func (m *MockIngredientRepository) AttachToDish(ctx context.Context, dishID string, ingredients []*domain.DishIngredient) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AttachToDish", ctx, dishID, ingredients)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AttachToDish indicates an expected call of AttachToDish.
+func (mr *MockIngredientRepositoryMockRecorder) AttachToDish(ctx, dishID, ingredients any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttachToDish", reflect.TypeOf((*MockIngredientRepository)(nil).AttachToDish), ctx, dishID, ingredients)
+}
+
+// Create mocks base method.
+func (m *MockIngredientRepository) Create(ctx context.Context, name string) (*domain.Ingredient, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, name)
+	ret0, _ := ret[0].(*domain.Ingredient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockIngredientRepositoryMockRecorder) Create(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockIngredientRepository)(nil).Create), ctx, name)
+}
+
+// Delete mocks base method.
+func (m *MockIngredientRepository) Delete(ctx context.Context, id int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockIngredientRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockIngredientRepository)(nil).Delete), ctx, id)
+}
+
+// DetachFromDish mocks base method.
+func (m *MockIngredientRepository) DetachFromDish(ctx context.Context, dishID string, ingredientID int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DetachFromDish", ctx, dishID, ingredientID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DetachFromDish indicates an expected call of DetachFromDish.
+func (mr *MockIngredientRepositoryMockRecorder) DetachFromDish(ctx, dishID, ingredientID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetachFromDish", reflect.TypeOf((*MockIngredientRepository)(nil).DetachFromDish), ctx, dishID, ingredientID)
+}
+
+// Fetch mocks base method.
+func (m *MockIngredientRepository) Fetch(ctx context.Context, limit, offset int32) ([]*domain.Ingredient, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Fetch", ctx, limit, offset)
+	ret0, _ := ret[0].([]*domain.Ingredient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Fetch indicates an expected call of Fetch.
+func (mr *MockIngredientRepositoryMockRecorder) Fetch(ctx, limit, offset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Fetch", reflect.TypeOf((*MockIngredientRepository)(nil).Fetch), ctx, limit, offset)
+}
+
+// FetchByDishID mocks base method.
+func (m *MockIngredientRepository) FetchByDishID(ctx context.Context, dishID string) ([]*domain.DishIngredient, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchByDishID", ctx, dishID)
+	ret0, _ := ret[0].([]*domain.DishIngredient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchByDishID indicates an expected call of FetchByDishID.
+func (mr *MockIngredientRepositoryMockRecorder) FetchByDishID(ctx, dishID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchByDishID", reflect.TypeOf((*MockIngredientRepository)(nil).FetchByDishID), ctx, dishID)
+}
+
+// FetchByIDs mocks base method.
+func (m *MockIngredientRepository) FetchByIDs(ctx context.Context, ids []int32) ([]*domain.Ingredient, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchByIDs", ctx, ids)
+	ret0, _ := ret[0].([]*domain.Ingredient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchByIDs indicates an expected call of FetchByIDs.
+func (mr *MockIngredientRepositoryMockRecorder) FetchByIDs(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchByIDs", reflect.TypeOf((*MockIngredientRepository)(nil).FetchByIDs), ctx, ids)
+}
+
+// FetchInDish mocks base method.
+func (m *MockIngredientRepository) FetchInDish(ctx context.Context, dishIDs []string) ([]*domain.DishIngredient, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchInDish", ctx, dishIDs)
+	ret0, _ := ret[0].([]*domain.DishIngredient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchInDish indicates an expected call of FetchInDish.
+func (mr *MockIngredientRepositoryMockRecorder) FetchInDish(ctx, dishIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchInDish", reflect.TypeOf((*MockIngredientRepository)(nil).FetchInDish), ctx, dishIDs)
+}
+
+// GetByID mocks base method.
+func (m *MockIngredientRepository) GetByID(ctx context.Context, id int32) (*domain.Ingredient, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*domain.Ingredient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockIngredientRepositoryMockRecorder) GetByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockIngredientRepository)(nil).GetByID), ctx, id)
+}
+
+// Update mocks base method.
+func (m *MockIngredientRepository) Update(ctx context.Context, ingredient *domain.Ingredient) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, ingredient)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockIngredientRepositoryMockRecorder) Update(ctx, ingredient any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIngredientRepository)(nil).Update), ctx, ingredient)
+}
+
+// MockIngredientUsecase is a mock of IngredientUsecase interface.
+type MockIngredientUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockIngredientUsecaseMockRecorder
+}
+
+// MockIngredientUsecaseMockRecorder is the mock recorder for MockIngredientUsecase.
+type MockIngredientUsecaseMockRecorder struct {
+	mock *MockIngredientUsecase
+}
+
+// NewMockIngredientUsecase creates a new mock instance.
+func NewMockIngredientUsecase(ctrl *gomock.Controller) *MockIngredientUsecase {
+	mock := &MockIngredientUsecase{ctrl: ctrl}
+	mock.recorder = &MockIngredientUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIngredientUsecase) EXPECT() *MockIngredientUsecaseMockRecorder {
+	return m.recorder
+}
+
+// AttachToDish mocks base method.
+func (m *MockIngredientUsecase) AttachToDish(ctx context.Context, dishID string, ingredients []*domain.DishIngredient) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AttachToDish", ctx, dishID, ingredients)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AttachToDish indicates an expected call of AttachToDish.
+func (mr *MockIngredientUsecaseMockRecorder) AttachToDish(ctx, dishID, ingredients any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttachToDish", reflect.TypeOf((*MockIngredientUsecase)(nil).AttachToDish), ctx, dishID, ingredients)
+}
+
+// Create mocks base method.
+func (m *MockIngredientUsecase) Create(ctx context.Context, name string) (*domain.Ingredient, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, name)
+	ret0, _ := ret[0].(*domain.Ingredient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockIngredientUsecaseMockRecorder) Create(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockIngredientUsecase)(nil).Create), ctx, name)
+}
+
+// Delete mocks base method.
+func (m *MockIngredientUsecase) Delete(ctx context.Context, id int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockIngredientUsecaseMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockIngredientUsecase)(nil).Delete), ctx, id)
+}
+
+// DetachFromDish mocks base method.
+func (m *MockIngredientUsecase) DetachFromDish(ctx context.Context, dishID string, ingredientID int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DetachFromDish", ctx, dishID, ingredientID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DetachFromDish indicates an expected call of DetachFromDish.
+func (mr *MockIngredientUsecaseMockRecorder) DetachFromDish(ctx, dishID, ingredientID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetachFromDish", reflect.TypeOf((*MockIngredientUsecase)(nil).DetachFromDish), ctx, dishID, ingredientID)
+}
+
+// Fetch mocks base method.
+func (m *MockIngredientUsecase) Fetch(ctx context.Context, limit, offset int32) ([]*domain.Ingredient, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Fetch", ctx, limit, offset)
+	ret0, _ := ret[0].([]*domain.Ingredient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Fetch indicates an expected call of Fetch.
+func (mr *MockIngredientUsecaseMockRecorder) Fetch(ctx, limit, offset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Fetch", reflect.TypeOf((*MockIngredientUsecase)(nil).Fetch), ctx, limit, offset)
+}
+
+// FetchByDishID mocks base method.
+func (m *MockIngredientUsecase) FetchByDishID(ctx context.Context, dishID string) ([]*domain.DishIngredient, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchByDishID", ctx, dishID)
+	ret0, _ := ret[0].([]*domain.DishIngredient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchByDishID indicates an expected call of FetchByDishID.
+func (mr *MockIngredientUsecaseMockRecorder) FetchByDishID(ctx, dishID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchByDishID", reflect.TypeOf((*MockIngredientUsecase)(nil).FetchByDishID), ctx, dishID)
+}
+
+// FetchByMenuID mocks base method.
+func (m *MockIngredientUsecase) FetchByMenuID(ctx context.Context, menuID string) ([]*domain.DishIngredient, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchByMenuID", ctx, menuID)
+	ret0, _ := ret[0].([]*domain.DishIngredient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchByMenuID indicates an expected call of FetchByMenuID.
+func (mr *MockIngredientUsecaseMockRecorder) FetchByMenuID(ctx, menuID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchByMenuID", reflect.TypeOf((*MockIngredientUsecase)(nil).FetchByMenuID), ctx, menuID)
+}
+
+// GetByID mocks base method.
+func (m *MockIngredientUsecase) GetByID(ctx context.Context, id int32) (*domain.Ingredient, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*domain.Ingredient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockIngredientUsecaseMockRecorder) GetByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockIngredientUsecase)(nil).GetByID), ctx, id)
+}
+
+// Update mocks base method.
+func (m *MockIngredientUsecase) Update(ctx context.Context, ingredient *domain.Ingredient) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, ingredient)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockIngredientUsecaseMockRecorder) Update(ctx, ingredient any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIngredientUsecase)(nil).Update), ctx, ingredient)
+}
+
+// MockIngredientController is a mock of IngredientController interface.
+type MockIngredientController struct {
+	ctrl     *gomock.Controller
+	recorder *MockIngredientControllerMockRecorder
+}
+
+// MockIngredientControllerMockRecorder is the mock recorder for MockIngredientController.
+type MockIngredientControllerMockRecorder struct {
+	mock *MockIngredientController
+}
+
+// NewMockIngredientController creates a new mock instance.
+func NewMockIngredientController(ctrl *gomock.Controller) *MockIngredientController {
+	mock := &MockIngredientController{ctrl: ctrl}
+	mock.recorder = &MockIngredientControllerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIngredientController) EXPECT() *MockIngredientControllerMockRecorder {
+	return m.recorder
+}
+
+// AttachToDish mocks base method.
+func (m *MockIngredientController) AttachToDish(c echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AttachToDish", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AttachToDish indicates an expected call of AttachToDish.
+func (mr *MockIngredientControllerMockRecorder) AttachToDish(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttachToDish", reflect.TypeOf((*MockIngredientController)(nil).AttachToDish), c)
+}
+
+// Create mocks base method.
+func (m *MockIngredientController) Create(c echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockIngredientControllerMockRecorder) Create(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockIngredientController)(nil).Create), c)
+}
+
+// Delete mocks base method.
+func (m *MockIngredientController) Delete(c echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockIngredientControllerMockRecorder) Delete(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockIngredientController)(nil).Delete), c)
+}
+
+// DetachFromDish mocks base method.
+func (m *MockIngredientController) DetachFromDish(c echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DetachFromDish", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DetachFromDish indicates an expected call of DetachFromDish.
+func (mr *MockIngredientControllerMockRecorder) DetachFromDish(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetachFromDish", reflect.TypeOf((*MockIngredientController)(nil).DetachFromDish), c)
+}
+
+// Fetch mocks base method.
+func (m *MockIngredientController) Fetch(c echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Fetch", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Fetch indicates an expected call of Fetch.
+func (mr *MockIngredientControllerMockRecorder) Fetch(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Fetch", reflect.TypeOf((*MockIngredientController)(nil).Fetch), c)
+}
+
+// FetchByDishID mocks base method.
+func (m *MockIngredientController) FetchByDishID(c echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchByDishID", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FetchByDishID indicates an expected call of FetchByDishID.
+func (mr *MockIngredientControllerMockRecorder) FetchByDishID(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchByDishID", reflect.TypeOf((*MockIngredientController)(nil).FetchByDishID), c)
+}
+
+// FetchByMenuID mocks base method.
+func (m *MockIngredientController) FetchByMenuID(c echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchByMenuID", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FetchByMenuID indicates an expected call of FetchByMenuID.
+func (mr *MockIngredientControllerMockRecorder) FetchByMenuID(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchByMenuID", reflect.TypeOf((*MockIngredientController)(nil).FetchByMenuID), c)
+}
+
+// GetByID mocks base method.
+func (m *MockIngredientController) GetByID(c echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockIngredientControllerMockRecorder) GetByID(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockIngredientController)(nil).GetByID), c)
+}
+
+// Update mocks base method.
+func (m *MockIngredientController) Update(c echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockIngredientControllerMockRecorder) Update(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIngredientController)(nil).Update), c)
+}
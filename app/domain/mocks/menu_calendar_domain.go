@@ -0,0 +1,132 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: domain/menu_calendar_domain.go
+//
+// Generated by this command:
+//
+//	mockgen -source domain/menu_calendar_domain.go -destination domain/mocks/menu_calendar_domain.go -package mocks
+//
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	io "io"
+	reflect "reflect"
+	time "time"
+
+	echo "github.com/labstack/echo/v4"
+	domain "github.com/ogurilab/school-lunch-api/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockMenuCalendarEncoder is a mock of MenuCalendarEncoder interface.
+type MockMenuCalendarEncoder struct {
+	ctrl     *gomock.Controller
+	recorder *MockMenuCalendarEncoderMockRecorder
+}
+
+// MockMenuCalendarEncoderMockRecorder is the mock recorder for MockMenuCalendarEncoder.
+type MockMenuCalendarEncoderMockRecorder struct {
+	mock *MockMenuCalendarEncoder
+}
+
+// NewMockMenuCalendarEncoder creates a new mock instance.
+func NewMockMenuCalendarEncoder(ctrl *gomock.Controller) *MockMenuCalendarEncoder {
+	mock := &MockMenuCalendarEncoder{ctrl: ctrl}
+	mock.recorder = &MockMenuCalendarEncoderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMenuCalendarEncoder) EXPECT() *MockMenuCalendarEncoderMockRecorder {
+	return m.recorder
+}
+
+// Encode mocks base method.
+func (m *MockMenuCalendarEncoder) Encode(w io.Writer, calendar *domain.MenuCalendar) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Encode", w, calendar)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Encode indicates an expected call of Encode.
+func (mr *MockMenuCalendarEncoderMockRecorder) Encode(w, calendar any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Encode", reflect.TypeOf((*MockMenuCalendarEncoder)(nil).Encode), w, calendar)
+}
+
+// MockMenuCalendarUsecase is a mock of MenuCalendarUsecase interface.
+type MockMenuCalendarUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockMenuCalendarUsecaseMockRecorder
+}
+
+// MockMenuCalendarUsecaseMockRecorder is the mock recorder for MockMenuCalendarUsecase.
+type MockMenuCalendarUsecaseMockRecorder struct {
+	mock *MockMenuCalendarUsecase
+}
+
+// NewMockMenuCalendarUsecase creates a new mock instance.
+func NewMockMenuCalendarUsecase(ctrl *gomock.Controller) *MockMenuCalendarUsecase {
+	mock := &MockMenuCalendarUsecase{ctrl: ctrl}
+	mock.recorder = &MockMenuCalendarUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMenuCalendarUsecase) EXPECT() *MockMenuCalendarUsecaseMockRecorder {
+	return m.recorder
+}
+
+// ExportByCity mocks base method.
+func (m *MockMenuCalendarUsecase) ExportByCity(ctx context.Context, from, to time.Time, city int32, highlight []int32) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportByCity", ctx, from, to, city, highlight)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportByCity indicates an expected call of ExportByCity.
+func (mr *MockMenuCalendarUsecaseMockRecorder) ExportByCity(ctx, from, to, city, highlight any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportByCity", reflect.TypeOf((*MockMenuCalendarUsecase)(nil).ExportByCity), ctx, from, to, city, highlight)
+}
+
+// MockMenuCalendarController is a mock of MenuCalendarController interface.
+type MockMenuCalendarController struct {
+	ctrl     *gomock.Controller
+	recorder *MockMenuCalendarControllerMockRecorder
+}
+
+// MockMenuCalendarControllerMockRecorder is the mock recorder for MockMenuCalendarController.
+type MockMenuCalendarControllerMockRecorder struct {
+	mock *MockMenuCalendarController
+}
+
+// NewMockMenuCalendarController creates a new mock instance.
+func NewMockMenuCalendarController(ctrl *gomock.Controller) *MockMenuCalendarController {
+	mock := &MockMenuCalendarController{ctrl: ctrl}
+	mock.recorder = &MockMenuCalendarControllerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMenuCalendarController) EXPECT() *MockMenuCalendarControllerMockRecorder {
+	return m.recorder
+}
+
+// ExportByCity mocks base method.
+func (m *MockMenuCalendarController) ExportByCity(c echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportByCity", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExportByCity indicates an expected call of ExportByCity.
+func (mr *MockMenuCalendarControllerMockRecorder) ExportByCity(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportByCity", reflect.TypeOf((*MockMenuCalendarController)(nil).ExportByCity), c)
+}
@@ -10,6 +10,8 @@ package mocks
 
 import (
 	context "context"
+	sql "database/sql"
+	io "io"
 	reflect "reflect"
 	time "time"
 
@@ -55,6 +57,21 @@ func (mr *MockMenuRepositoryMockRecorder) Create(ctx, menu any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockMenuRepository)(nil).Create), ctx, menu)
 }
 
+// Delete mocks base method.
+func (m *MockMenuRepository) Delete(ctx context.Context, id string) (*domain.DeletedMenu, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(*domain.DeletedMenu)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockMenuRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockMenuRepository)(nil).Delete), ctx, id)
+}
+
 // Fetch mocks base method.
 func (m *MockMenuRepository) Fetch(ctx context.Context, limit, offset int32, offered time.Time) ([]*domain.Menu, error) {
 	m.ctrl.T.Helper()
@@ -85,6 +102,21 @@ func (mr *MockMenuRepositoryMockRecorder) FetchByCity(ctx, limit, offset, offere
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchByCity", reflect.TypeOf((*MockMenuRepository)(nil).FetchByCity), ctx, limit, offset, offered, city)
 }
 
+// FetchByCityExcludingAllergens mocks base method.
+func (m *MockMenuRepository) FetchByCityExcludingAllergens(ctx context.Context, limit, offset int32, offered time.Time, city int32, allergenIDs []int32) ([]*domain.Menu, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchByCityExcludingAllergens", ctx, limit, offset, offered, city, allergenIDs)
+	ret0, _ := ret[0].([]*domain.Menu)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchByCityExcludingAllergens indicates an expected call of FetchByCityExcludingAllergens.
+func (mr *MockMenuRepositoryMockRecorder) FetchByCityExcludingAllergens(ctx, limit, offset, offered, city, allergenIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchByCityExcludingAllergens", reflect.TypeOf((*MockMenuRepository)(nil).FetchByCityExcludingAllergens), ctx, limit, offset, offered, city, allergenIDs)
+}
+
 // FetchByIDs mocks base method.
 func (m *MockMenuRepository) FetchByIDs(ctx context.Context, Limit, Offset int32, offered time.Time, ids []string) ([]*domain.Menu, error) {
 	m.ctrl.T.Helper()
@@ -100,6 +132,36 @@ func (mr *MockMenuRepositoryMockRecorder) FetchByIDs(ctx, Limit, Offset, offered
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchByIDs", reflect.TypeOf((*MockMenuRepository)(nil).FetchByIDs), ctx, Limit, Offset, offered, ids)
 }
 
+// FetchOfferedAtByCity mocks base method.
+func (m *MockMenuRepository) FetchOfferedAtByCity(ctx context.Context, city int32, start, end time.Time) ([]time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchOfferedAtByCity", ctx, city, start, end)
+	ret0, _ := ret[0].([]time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchOfferedAtByCity indicates an expected call of FetchOfferedAtByCity.
+func (mr *MockMenuRepositoryMockRecorder) FetchOfferedAtByCity(ctx, city, start, end any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchOfferedAtByCity", reflect.TypeOf((*MockMenuRepository)(nil).FetchOfferedAtByCity), ctx, city, start, end)
+}
+
+// FindByID mocks base method.
+func (m *MockMenuRepository) FindByID(ctx context.Context, id string) (*domain.Menu, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, id)
+	ret0, _ := ret[0].(*domain.Menu)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockMenuRepositoryMockRecorder) FindByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockMenuRepository)(nil).FindByID), ctx, id)
+}
+
 // GetByID mocks base method.
 func (m *MockMenuRepository) GetByID(ctx context.Context, id string, city int32) (*domain.Menu, error) {
 	m.ctrl.T.Helper()
@@ -115,6 +177,49 @@ func (mr *MockMenuRepositoryMockRecorder) GetByID(ctx, id, city any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockMenuRepository)(nil).GetByID), ctx, id, city)
 }
 
+// Import mocks base method.
+func (m *MockMenuRepository) Import(ctx context.Context, cityCode int32, rows []*domain.ImportMenuRow) (*domain.ImportMenuReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Import", ctx, cityCode, rows)
+	ret0, _ := ret[0].(*domain.ImportMenuReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Import indicates an expected call of Import.
+func (mr *MockMenuRepositoryMockRecorder) Import(ctx, cityCode, rows any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Import", reflect.TypeOf((*MockMenuRepository)(nil).Import), ctx, cityCode, rows)
+}
+
+// Update mocks base method.
+func (m *MockMenuRepository) Update(ctx context.Context, menu *domain.Menu) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, menu)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockMenuRepositoryMockRecorder) Update(ctx, menu any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockMenuRepository)(nil).Update), ctx, menu)
+}
+
+// UpdatePhoto mocks base method.
+func (m *MockMenuRepository) UpdatePhoto(ctx context.Context, id string, photoUrl sql.NullString, photos *domain.MenuPhotos, credit *domain.PhotoCredit) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePhoto", ctx, id, photoUrl, photos, credit)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePhoto indicates an expected call of UpdatePhoto.
+func (mr *MockMenuRepositoryMockRecorder) UpdatePhoto(ctx, id, photoUrl, photos, credit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePhoto", reflect.TypeOf((*MockMenuRepository)(nil).UpdatePhoto), ctx, id, photoUrl, photos, credit)
+}
+
 // MockMenuUsecase is a mock of MenuUsecase interface.
 type MockMenuUsecase struct {
 	ctrl     *gomock.Controller
@@ -152,6 +257,21 @@ func (mr *MockMenuUsecaseMockRecorder) Create(ctx, menu any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockMenuUsecase)(nil).Create), ctx, menu)
 }
 
+// Delete mocks base method.
+func (m *MockMenuUsecase) Delete(ctx context.Context, id string) (*domain.DeletedMenu, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(*domain.DeletedMenu)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockMenuUsecaseMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockMenuUsecase)(nil).Delete), ctx, id)
+}
+
 // Fetch mocks base method.
 func (m *MockMenuUsecase) Fetch(ctx context.Context, limit, offset int32, offered time.Time, ids []string) ([]*domain.Menu, error) {
 	m.ctrl.T.Helper()
@@ -182,6 +302,36 @@ func (mr *MockMenuUsecaseMockRecorder) FetchByCity(ctx, limit, offset, offered,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchByCity", reflect.TypeOf((*MockMenuUsecase)(nil).FetchByCity), ctx, limit, offset, offered, city)
 }
 
+// FetchByCityExcludingAllergens mocks base method.
+func (m *MockMenuUsecase) FetchByCityExcludingAllergens(ctx context.Context, limit, offset int32, offered time.Time, city int32, allergenIDs []int32) ([]*domain.Menu, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchByCityExcludingAllergens", ctx, limit, offset, offered, city, allergenIDs)
+	ret0, _ := ret[0].([]*domain.Menu)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchByCityExcludingAllergens indicates an expected call of FetchByCityExcludingAllergens.
+func (mr *MockMenuUsecaseMockRecorder) FetchByCityExcludingAllergens(ctx, limit, offset, offered, city, allergenIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchByCityExcludingAllergens", reflect.TypeOf((*MockMenuUsecase)(nil).FetchByCityExcludingAllergens), ctx, limit, offset, offered, city, allergenIDs)
+}
+
+// FindByID mocks base method.
+func (m *MockMenuUsecase) FindByID(ctx context.Context, id string) (*domain.Menu, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, id)
+	ret0, _ := ret[0].(*domain.Menu)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockMenuUsecaseMockRecorder) FindByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockMenuUsecase)(nil).FindByID), ctx, id)
+}
+
 // GetByID mocks base method.
 func (m *MockMenuUsecase) GetByID(ctx context.Context, id string, city int32) (*domain.Menu, error) {
 	m.ctrl.T.Helper()
@@ -197,6 +347,35 @@ func (mr *MockMenuUsecaseMockRecorder) GetByID(ctx, id, city any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockMenuUsecase)(nil).GetByID), ctx, id, city)
 }
 
+// Import mocks base method.
+func (m *MockMenuUsecase) Import(ctx context.Context, cityCode int32, r io.Reader) (*domain.ImportMenuReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Import", ctx, cityCode, r)
+	ret0, _ := ret[0].(*domain.ImportMenuReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Import indicates an expected call of Import.
+func (mr *MockMenuUsecaseMockRecorder) Import(ctx, cityCode, r any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Import", reflect.TypeOf((*MockMenuUsecase)(nil).Import), ctx, cityCode, r)
+}
+
+// Update mocks base method.
+func (m *MockMenuUsecase) Update(ctx context.Context, menu *domain.Menu) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, menu)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockMenuUsecaseMockRecorder) Update(ctx, menu any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockMenuUsecase)(nil).Update), ctx, menu)
+}
+
 // MockMenuController is a mock of MenuController interface.
 type MockMenuController struct {
 	ctrl     *gomock.Controller
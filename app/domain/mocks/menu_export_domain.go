@@ -0,0 +1,197 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: domain/menu_export_domain.go
+//
+// Generated by this command:
+//
+//	mockgen -source domain/menu_export_domain.go -destination domain/mocks/menu_export_domain.go -package mocks
+//
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	io "io"
+	reflect "reflect"
+	time "time"
+
+	echo "github.com/labstack/echo/v4"
+	domain "github.com/ogurilab/school-lunch-api/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSpreadsheetWriter is a mock of SpreadsheetWriter interface.
+type MockSpreadsheetWriter struct {
+	ctrl     *gomock.Controller
+	recorder *MockSpreadsheetWriterMockRecorder
+}
+
+// MockSpreadsheetWriterMockRecorder is the mock recorder for MockSpreadsheetWriter.
+type MockSpreadsheetWriterMockRecorder struct {
+	mock *MockSpreadsheetWriter
+}
+
+// NewMockSpreadsheetWriter creates a new mock instance.
+func NewMockSpreadsheetWriter(ctrl *gomock.Controller) *MockSpreadsheetWriter {
+	mock := &MockSpreadsheetWriter{ctrl: ctrl}
+	mock.recorder = &MockSpreadsheetWriterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSpreadsheetWriter) EXPECT() *MockSpreadsheetWriterMockRecorder {
+	return m.recorder
+}
+
+// Close mocks base method.
+func (m *MockSpreadsheetWriter) Close() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockSpreadsheetWriterMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockSpreadsheetWriter)(nil).Close))
+}
+
+// Flush mocks base method.
+func (m *MockSpreadsheetWriter) Flush() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Flush")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Flush indicates an expected call of Flush.
+func (mr *MockSpreadsheetWriterMockRecorder) Flush() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Flush", reflect.TypeOf((*MockSpreadsheetWriter)(nil).Flush))
+}
+
+// WriteRow mocks base method.
+func (m *MockSpreadsheetWriter) WriteRow(cells []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WriteRow", cells)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WriteRow indicates an expected call of WriteRow.
+func (mr *MockSpreadsheetWriterMockRecorder) WriteRow(cells any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteRow", reflect.TypeOf((*MockSpreadsheetWriter)(nil).WriteRow), cells)
+}
+
+// MockSpreadsheetEncoder is a mock of SpreadsheetEncoder interface.
+type MockSpreadsheetEncoder struct {
+	ctrl     *gomock.Controller
+	recorder *MockSpreadsheetEncoderMockRecorder
+}
+
+// MockSpreadsheetEncoderMockRecorder is the mock recorder for MockSpreadsheetEncoder.
+type MockSpreadsheetEncoderMockRecorder struct {
+	mock *MockSpreadsheetEncoder
+}
+
+// NewMockSpreadsheetEncoder creates a new mock instance.
+func NewMockSpreadsheetEncoder(ctrl *gomock.Controller) *MockSpreadsheetEncoder {
+	mock := &MockSpreadsheetEncoder{ctrl: ctrl}
+	mock.recorder = &MockSpreadsheetEncoderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSpreadsheetEncoder) EXPECT() *MockSpreadsheetEncoderMockRecorder {
+	return m.recorder
+}
+
+// NewWriter mocks base method.
+func (m *MockSpreadsheetEncoder) NewWriter(w io.Writer, format domain.ExportFormat, sheetName string) (domain.SpreadsheetWriter, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewWriter", w, format, sheetName)
+	ret0, _ := ret[0].(domain.SpreadsheetWriter)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewWriter indicates an expected call of NewWriter.
+func (mr *MockSpreadsheetEncoderMockRecorder) NewWriter(w, format, sheetName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewWriter", reflect.TypeOf((*MockSpreadsheetEncoder)(nil).NewWriter), w, format, sheetName)
+}
+
+// MockMenuExportUsecase is a mock of MenuExportUsecase interface.
+type MockMenuExportUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockMenuExportUsecaseMockRecorder
+}
+
+// MockMenuExportUsecaseMockRecorder is the mock recorder for MockMenuExportUsecase.
+type MockMenuExportUsecaseMockRecorder struct {
+	mock *MockMenuExportUsecase
+}
+
+// NewMockMenuExportUsecase creates a new mock instance.
+func NewMockMenuExportUsecase(ctrl *gomock.Controller) *MockMenuExportUsecase {
+	mock := &MockMenuExportUsecase{ctrl: ctrl}
+	mock.recorder = &MockMenuExportUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMenuExportUsecase) EXPECT() *MockMenuExportUsecaseMockRecorder {
+	return m.recorder
+}
+
+// ExportByCity mocks base method.
+func (m *MockMenuExportUsecase) ExportByCity(ctx context.Context, w io.Writer, format domain.ExportFormat, month time.Time, city int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportByCity", ctx, w, format, month, city)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExportByCity indicates an expected call of ExportByCity.
+func (mr *MockMenuExportUsecaseMockRecorder) ExportByCity(ctx, w, format, month, city any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportByCity", reflect.TypeOf((*MockMenuExportUsecase)(nil).ExportByCity), ctx, w, format, month, city)
+}
+
+// MockMenuExportController is a mock of MenuExportController interface.
+type MockMenuExportController struct {
+	ctrl     *gomock.Controller
+	recorder *MockMenuExportControllerMockRecorder
+}
+
+// MockMenuExportControllerMockRecorder is the mock recorder for MockMenuExportController.
+type MockMenuExportControllerMockRecorder struct {
+	mock *MockMenuExportController
+}
+
+// NewMockMenuExportController creates a new mock instance.
+func NewMockMenuExportController(ctrl *gomock.Controller) *MockMenuExportController {
+	mock := &MockMenuExportController{ctrl: ctrl}
+	mock.recorder = &MockMenuExportControllerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMenuExportController) EXPECT() *MockMenuExportControllerMockRecorder {
+	return m.recorder
+}
+
+// ExportByCity mocks base method.
+func (m *MockMenuExportController) ExportByCity(c echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportByCity", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExportByCity indicates an expected call of ExportByCity.
+func (mr *MockMenuExportControllerMockRecorder) ExportByCity(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportByCity", reflect.TypeOf((*MockMenuExportController)(nil).ExportByCity), c)
+}
@@ -0,0 +1,145 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: domain/menu_feed_domain.go
+//
+// Generated by this command:
+//
+//	mockgen -source domain/menu_feed_domain.go -destination domain/mocks/menu_feed_domain.go -package mocks
+//
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	io "io"
+	reflect "reflect"
+
+	echo "github.com/labstack/echo/v4"
+	domain "github.com/ogurilab/school-lunch-api/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockMenuFeedEncoder is a mock of MenuFeedEncoder interface.
+type MockMenuFeedEncoder struct {
+	ctrl     *gomock.Controller
+	recorder *MockMenuFeedEncoderMockRecorder
+}
+
+// MockMenuFeedEncoderMockRecorder is the mock recorder for MockMenuFeedEncoder.
+type MockMenuFeedEncoderMockRecorder struct {
+	mock *MockMenuFeedEncoder
+}
+
+// NewMockMenuFeedEncoder creates a new mock instance.
+func NewMockMenuFeedEncoder(ctrl *gomock.Controller) *MockMenuFeedEncoder {
+	mock := &MockMenuFeedEncoder{ctrl: ctrl}
+	mock.recorder = &MockMenuFeedEncoderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMenuFeedEncoder) EXPECT() *MockMenuFeedEncoderMockRecorder {
+	return m.recorder
+}
+
+// Encode mocks base method.
+func (m *MockMenuFeedEncoder) Encode(w io.Writer, format domain.FeedFormat, feed *domain.MenuFeed) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Encode", w, format, feed)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Encode indicates an expected call of Encode.
+func (mr *MockMenuFeedEncoderMockRecorder) Encode(w, format, feed any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Encode", reflect.TypeOf((*MockMenuFeedEncoder)(nil).Encode), w, format, feed)
+}
+
+// MockMenuFeedUsecase is a mock of MenuFeedUsecase interface.
+type MockMenuFeedUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockMenuFeedUsecaseMockRecorder
+}
+
+// MockMenuFeedUsecaseMockRecorder is the mock recorder for MockMenuFeedUsecase.
+type MockMenuFeedUsecaseMockRecorder struct {
+	mock *MockMenuFeedUsecase
+}
+
+// NewMockMenuFeedUsecase creates a new mock instance.
+func NewMockMenuFeedUsecase(ctrl *gomock.Controller) *MockMenuFeedUsecase {
+	mock := &MockMenuFeedUsecase{ctrl: ctrl}
+	mock.recorder = &MockMenuFeedUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMenuFeedUsecase) EXPECT() *MockMenuFeedUsecaseMockRecorder {
+	return m.recorder
+}
+
+// ExportByCity mocks base method.
+func (m *MockMenuFeedUsecase) ExportByCity(ctx context.Context, format domain.FeedFormat, limit, city int32, baseURL string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportByCity", ctx, format, limit, city, baseURL)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportByCity indicates an expected call of ExportByCity.
+func (mr *MockMenuFeedUsecaseMockRecorder) ExportByCity(ctx, format, limit, city, baseURL any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportByCity", reflect.TypeOf((*MockMenuFeedUsecase)(nil).ExportByCity), ctx, format, limit, city, baseURL)
+}
+
+// MockMenuFeedController is a mock of MenuFeedController interface.
+type MockMenuFeedController struct {
+	ctrl     *gomock.Controller
+	recorder *MockMenuFeedControllerMockRecorder
+}
+
+// MockMenuFeedControllerMockRecorder is the mock recorder for MockMenuFeedController.
+type MockMenuFeedControllerMockRecorder struct {
+	mock *MockMenuFeedController
+}
+
+// NewMockMenuFeedController creates a new mock instance.
+func NewMockMenuFeedController(ctrl *gomock.Controller) *MockMenuFeedController {
+	mock := &MockMenuFeedController{ctrl: ctrl}
+	mock.recorder = &MockMenuFeedControllerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMenuFeedController) EXPECT() *MockMenuFeedControllerMockRecorder {
+	return m.recorder
+}
+
+// FetchAtomByCity mocks base method.
+func (m *MockMenuFeedController) FetchAtomByCity(c echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchAtomByCity", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FetchAtomByCity indicates an expected call of FetchAtomByCity.
+func (mr *MockMenuFeedControllerMockRecorder) FetchAtomByCity(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchAtomByCity", reflect.TypeOf((*MockMenuFeedController)(nil).FetchAtomByCity), c)
+}
+
+// FetchRSSByCity mocks base method.
+func (m *MockMenuFeedController) FetchRSSByCity(c echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchRSSByCity", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FetchRSSByCity indicates an expected call of FetchRSSByCity.
+func (mr *MockMenuFeedControllerMockRecorder) FetchRSSByCity(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchRSSByCity", reflect.TypeOf((*MockMenuFeedController)(nil).FetchRSSByCity), c)
+}
@@ -0,0 +1,132 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: domain/menu_sheet_domain.go
+//
+// Generated by this command:
+//
+//	mockgen -source domain/menu_sheet_domain.go -destination domain/mocks/menu_sheet_domain.go -package mocks
+//
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	io "io"
+	reflect "reflect"
+	time "time"
+
+	echo "github.com/labstack/echo/v4"
+	domain "github.com/ogurilab/school-lunch-api/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockMenuSheetRenderer is a mock of MenuSheetRenderer interface.
+type MockMenuSheetRenderer struct {
+	ctrl     *gomock.Controller
+	recorder *MockMenuSheetRendererMockRecorder
+}
+
+// MockMenuSheetRendererMockRecorder is the mock recorder for MockMenuSheetRenderer.
+type MockMenuSheetRendererMockRecorder struct {
+	mock *MockMenuSheetRenderer
+}
+
+// NewMockMenuSheetRenderer creates a new mock instance.
+func NewMockMenuSheetRenderer(ctrl *gomock.Controller) *MockMenuSheetRenderer {
+	mock := &MockMenuSheetRenderer{ctrl: ctrl}
+	mock.recorder = &MockMenuSheetRendererMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMenuSheetRenderer) EXPECT() *MockMenuSheetRendererMockRecorder {
+	return m.recorder
+}
+
+// Render mocks base method.
+func (m *MockMenuSheetRenderer) Render(w io.Writer, sheet *domain.MenuSheet) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Render", w, sheet)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Render indicates an expected call of Render.
+func (mr *MockMenuSheetRendererMockRecorder) Render(w, sheet any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Render", reflect.TypeOf((*MockMenuSheetRenderer)(nil).Render), w, sheet)
+}
+
+// MockMenuSheetUsecase is a mock of MenuSheetUsecase interface.
+type MockMenuSheetUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockMenuSheetUsecaseMockRecorder
+}
+
+// MockMenuSheetUsecaseMockRecorder is the mock recorder for MockMenuSheetUsecase.
+type MockMenuSheetUsecaseMockRecorder struct {
+	mock *MockMenuSheetUsecase
+}
+
+// NewMockMenuSheetUsecase creates a new mock instance.
+func NewMockMenuSheetUsecase(ctrl *gomock.Controller) *MockMenuSheetUsecase {
+	mock := &MockMenuSheetUsecase{ctrl: ctrl}
+	mock.recorder = &MockMenuSheetUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMenuSheetUsecase) EXPECT() *MockMenuSheetUsecaseMockRecorder {
+	return m.recorder
+}
+
+// ExportByCity mocks base method.
+func (m *MockMenuSheetUsecase) ExportByCity(ctx context.Context, month time.Time, city int32) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportByCity", ctx, month, city)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportByCity indicates an expected call of ExportByCity.
+func (mr *MockMenuSheetUsecaseMockRecorder) ExportByCity(ctx, month, city any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportByCity", reflect.TypeOf((*MockMenuSheetUsecase)(nil).ExportByCity), ctx, month, city)
+}
+
+// MockMenuSheetController is a mock of MenuSheetController interface.
+type MockMenuSheetController struct {
+	ctrl     *gomock.Controller
+	recorder *MockMenuSheetControllerMockRecorder
+}
+
+// MockMenuSheetControllerMockRecorder is the mock recorder for MockMenuSheetController.
+type MockMenuSheetControllerMockRecorder struct {
+	mock *MockMenuSheetController
+}
+
+// NewMockMenuSheetController creates a new mock instance.
+func NewMockMenuSheetController(ctrl *gomock.Controller) *MockMenuSheetController {
+	mock := &MockMenuSheetController{ctrl: ctrl}
+	mock.recorder = &MockMenuSheetControllerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMenuSheetController) EXPECT() *MockMenuSheetControllerMockRecorder {
+	return m.recorder
+}
+
+// ExportByCity mocks base method.
+func (m *MockMenuSheetController) ExportByCity(c echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportByCity", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExportByCity indicates an expected call of ExportByCity.
+func (mr *MockMenuSheetControllerMockRecorder) ExportByCity(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportByCity", reflect.TypeOf((*MockMenuSheetController)(nil).ExportByCity), c)
+}
@@ -71,6 +71,51 @@ func (mr *MockMenuWithDishesRepositoryMockRecorder) FetchByCity(ctx, limit, offs
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchByCity", reflect.TypeOf((*MockMenuWithDishesRepository)(nil).FetchByCity), ctx, limit, offset, offered, city)
 }
 
+// FetchByCityBetween mocks base method.
+func (m *MockMenuWithDishesRepository) FetchByCityBetween(ctx context.Context, from, to time.Time, city int32) ([]*domain.MenuWithDishes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchByCityBetween", ctx, from, to, city)
+	ret0, _ := ret[0].([]*domain.MenuWithDishes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchByCityBetween indicates an expected call of FetchByCityBetween.
+func (mr *MockMenuWithDishesRepositoryMockRecorder) FetchByCityBetween(ctx, from, to, city any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchByCityBetween", reflect.TypeOf((*MockMenuWithDishesRepository)(nil).FetchByCityBetween), ctx, from, to, city)
+}
+
+// FetchByCityExcludingAllergens mocks base method.
+func (m *MockMenuWithDishesRepository) FetchByCityExcludingAllergens(ctx context.Context, limit, offset int32, offered time.Time, city int32, allergenIDs []int32) ([]*domain.MenuWithDishes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchByCityExcludingAllergens", ctx, limit, offset, offered, city, allergenIDs)
+	ret0, _ := ret[0].([]*domain.MenuWithDishes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchByCityExcludingAllergens indicates an expected call of FetchByCityExcludingAllergens.
+func (mr *MockMenuWithDishesRepositoryMockRecorder) FetchByCityExcludingAllergens(ctx, limit, offset, offered, city, allergenIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchByCityExcludingAllergens", reflect.TypeOf((*MockMenuWithDishesRepository)(nil).FetchByCityExcludingAllergens), ctx, limit, offset, offered, city, allergenIDs)
+}
+
+// FetchLatestByCity mocks base method.
+func (m *MockMenuWithDishesRepository) FetchLatestByCity(ctx context.Context, limit, city int32) ([]*domain.MenuWithDishes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchLatestByCity", ctx, limit, city)
+	ret0, _ := ret[0].([]*domain.MenuWithDishes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchLatestByCity indicates an expected call of FetchLatestByCity.
+func (mr *MockMenuWithDishesRepositoryMockRecorder) FetchLatestByCity(ctx, limit, city any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchLatestByCity", reflect.TypeOf((*MockMenuWithDishesRepository)(nil).FetchLatestByCity), ctx, limit, city)
+}
+
 // GetByID mocks base method.
 func (m *MockMenuWithDishesRepository) GetByID(ctx context.Context, id string, city int32) (*domain.MenuWithDishes, error) {
 	m.ctrl.T.Helper()
@@ -124,6 +169,21 @@ func (mr *MockMenuWithDishesUsecaseMockRecorder) Fetch(ctx, limit, offset, offer
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Fetch", reflect.TypeOf((*MockMenuWithDishesUsecase)(nil).Fetch), ctx, limit, offset, offered)
 }
 
+// FetchAllergenRisksByCity mocks base method.
+func (m *MockMenuWithDishesUsecase) FetchAllergenRisksByCity(ctx context.Context, limit, offset int32, offered time.Time, city int32, allergenIDs []int32) ([]*domain.MenuAllergenRisk, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchAllergenRisksByCity", ctx, limit, offset, offered, city, allergenIDs)
+	ret0, _ := ret[0].([]*domain.MenuAllergenRisk)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchAllergenRisksByCity indicates an expected call of FetchAllergenRisksByCity.
+func (mr *MockMenuWithDishesUsecaseMockRecorder) FetchAllergenRisksByCity(ctx, limit, offset, offered, city, allergenIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchAllergenRisksByCity", reflect.TypeOf((*MockMenuWithDishesUsecase)(nil).FetchAllergenRisksByCity), ctx, limit, offset, offered, city, allergenIDs)
+}
+
 // FetchByCity mocks base method.
 func (m *MockMenuWithDishesUsecase) FetchByCity(ctx context.Context, limit, offset int32, offered time.Time, city int32) ([]*domain.MenuWithDishes, error) {
 	m.ctrl.T.Helper()
@@ -139,6 +199,21 @@ func (mr *MockMenuWithDishesUsecaseMockRecorder) FetchByCity(ctx, limit, offset,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchByCity", reflect.TypeOf((*MockMenuWithDishesUsecase)(nil).FetchByCity), ctx, limit, offset, offered, city)
 }
 
+// FetchByCityExcludingAllergens mocks base method.
+func (m *MockMenuWithDishesUsecase) FetchByCityExcludingAllergens(ctx context.Context, limit, offset int32, offered time.Time, city int32, allergenIDs []int32) ([]*domain.MenuWithDishes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchByCityExcludingAllergens", ctx, limit, offset, offered, city, allergenIDs)
+	ret0, _ := ret[0].([]*domain.MenuWithDishes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchByCityExcludingAllergens indicates an expected call of FetchByCityExcludingAllergens.
+func (mr *MockMenuWithDishesUsecaseMockRecorder) FetchByCityExcludingAllergens(ctx, limit, offset, offered, city, allergenIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchByCityExcludingAllergens", reflect.TypeOf((*MockMenuWithDishesUsecase)(nil).FetchByCityExcludingAllergens), ctx, limit, offset, offered, city, allergenIDs)
+}
+
 // GetByID mocks base method.
 func (m *MockMenuWithDishesUsecase) GetByID(ctx context.Context, id string, city int32) (*domain.MenuWithDishes, error) {
 	m.ctrl.T.Helper()
@@ -191,6 +266,20 @@ func (mr *MockMenuWithDishesControllerMockRecorder) Fetch(c any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Fetch", reflect.TypeOf((*MockMenuWithDishesController)(nil).Fetch), c)
 }
 
+// FetchAllergenRisksByCity mocks base method.
+func (m *MockMenuWithDishesController) FetchAllergenRisksByCity(c echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchAllergenRisksByCity", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FetchAllergenRisksByCity indicates an expected call of FetchAllergenRisksByCity.
+func (mr *MockMenuWithDishesControllerMockRecorder) FetchAllergenRisksByCity(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchAllergenRisksByCity", reflect.TypeOf((*MockMenuWithDishesController)(nil).FetchAllergenRisksByCity), c)
+}
+
 // FetchByCity mocks base method.
 func (m *MockMenuWithDishesController) FetchByCity(c echo.Context) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,187 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: domain/nutrition_domain.go
+//
+// Generated by this command:
+//
+//	mockgen -source domain/nutrition_domain.go -destination domain/mocks/nutrition_domain.go -package mocks
+//
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	echo "github.com/labstack/echo/v4"
+	domain "github.com/ogurilab/school-lunch-api/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockNutritionRepository is a mock of NutritionRepository interface.
+type MockNutritionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockNutritionRepositoryMockRecorder
+}
+
+// MockNutritionRepositoryMockRecorder is the mock recorder for MockNutritionRepository.
+type MockNutritionRepositoryMockRecorder struct {
+	mock *MockNutritionRepository
+}
+
+// NewMockNutritionRepository creates a new mock instance.
+func NewMockNutritionRepository(ctrl *gomock.Controller) *MockNutritionRepository {
+	mock := &MockNutritionRepository{ctrl: ctrl}
+	mock.recorder = &MockNutritionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNutritionRepository) EXPECT() *MockNutritionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockNutritionRepository) Delete(ctx context.Context, menuID string, level domain.SchoolLevel) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, menuID, level)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockNutritionRepositoryMockRecorder) Delete(ctx, menuID, level any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockNutritionRepository)(nil).Delete), ctx, menuID, level)
+}
+
+// FetchByMenuIDs mocks base method.
+func (m *MockNutritionRepository) FetchByMenuIDs(ctx context.Context, menuIDs []string) (map[string]*domain.MenuNutrition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchByMenuIDs", ctx, menuIDs)
+	ret0, _ := ret[0].(map[string]*domain.MenuNutrition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchByMenuIDs indicates an expected call of FetchByMenuIDs.
+func (mr *MockNutritionRepositoryMockRecorder) FetchByMenuIDs(ctx, menuIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchByMenuIDs", reflect.TypeOf((*MockNutritionRepository)(nil).FetchByMenuIDs), ctx, menuIDs)
+}
+
+// Upsert mocks base method.
+func (m *MockNutritionRepository) Upsert(ctx context.Context, menuID string, level domain.SchoolLevel, facts *domain.NutritionFacts) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upsert", ctx, menuID, level, facts)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Upsert indicates an expected call of Upsert.
+func (mr *MockNutritionRepositoryMockRecorder) Upsert(ctx, menuID, level, facts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MockNutritionRepository)(nil).Upsert), ctx, menuID, level, facts)
+}
+
+// MockNutritionUsecase is a mock of NutritionUsecase interface.
+type MockNutritionUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockNutritionUsecaseMockRecorder
+}
+
+// MockNutritionUsecaseMockRecorder is the mock recorder for MockNutritionUsecase.
+type MockNutritionUsecaseMockRecorder struct {
+	mock *MockNutritionUsecase
+}
+
+// NewMockNutritionUsecase creates a new mock instance.
+func NewMockNutritionUsecase(ctrl *gomock.Controller) *MockNutritionUsecase {
+	mock := &MockNutritionUsecase{ctrl: ctrl}
+	mock.recorder = &MockNutritionUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNutritionUsecase) EXPECT() *MockNutritionUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockNutritionUsecase) Delete(ctx context.Context, menuID string, level domain.SchoolLevel) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, menuID, level)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockNutritionUsecaseMockRecorder) Delete(ctx, menuID, level any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockNutritionUsecase)(nil).Delete), ctx, menuID, level)
+}
+
+// Upsert mocks base method.
+func (m *MockNutritionUsecase) Upsert(ctx context.Context, menuID string, level domain.SchoolLevel, facts *domain.NutritionFacts) (*domain.MenuNutrition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upsert", ctx, menuID, level, facts)
+	ret0, _ := ret[0].(*domain.MenuNutrition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Upsert indicates an expected call of Upsert.
+func (mr *MockNutritionUsecaseMockRecorder) Upsert(ctx, menuID, level, facts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MockNutritionUsecase)(nil).Upsert), ctx, menuID, level, facts)
+}
+
+// MockNutritionController is a mock of NutritionController interface.
+type MockNutritionController struct {
+	ctrl     *gomock.Controller
+	recorder *MockNutritionControllerMockRecorder
+}
+
+// MockNutritionControllerMockRecorder is the mock recorder for MockNutritionController.
+type MockNutritionControllerMockRecorder struct {
+	mock *MockNutritionController
+}
+
+// NewMockNutritionController creates a new mock instance.
+func NewMockNutritionController(ctrl *gomock.Controller) *MockNutritionController {
+	mock := &MockNutritionController{ctrl: ctrl}
+	mock.recorder = &MockNutritionControllerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNutritionController) EXPECT() *MockNutritionControllerMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockNutritionController) Delete(c echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockNutritionControllerMockRecorder) Delete(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockNutritionController)(nil).Delete), c)
+}
+
+// Upsert mocks base method.
+func (m *MockNutritionController) Upsert(c echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upsert", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Upsert indicates an expected call of Upsert.
+func (mr *MockNutritionControllerMockRecorder) Upsert(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MockNutritionController)(nil).Upsert), c)
+}
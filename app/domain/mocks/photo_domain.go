@@ -0,0 +1,251 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: domain/photo_domain.go
+//
+// Generated by this command:
+//
+//	mockgen -source domain/photo_domain.go -destination domain/mocks/photo_domain.go -package mocks
+//
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	echo "github.com/labstack/echo/v4"
+	domain "github.com/ogurilab/school-lunch-api/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockStorage is a mock of Storage interface.
+type MockStorage struct {
+	ctrl     *gomock.Controller
+	recorder *MockStorageMockRecorder
+}
+
+// MockStorageMockRecorder is the mock recorder for MockStorage.
+type MockStorageMockRecorder struct {
+	mock *MockStorage
+}
+
+// NewMockStorage creates a new mock instance.
+func NewMockStorage(ctrl *gomock.Controller) *MockStorage {
+	mock := &MockStorage{ctrl: ctrl}
+	mock.recorder = &MockStorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStorage) EXPECT() *MockStorageMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockStorage) Delete(ctx context.Context, key string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockStorageMockRecorder) Delete(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockStorage)(nil).Delete), ctx, key)
+}
+
+// Get mocks base method.
+func (m *MockStorage) Get(ctx context.Context, key string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, key)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockStorageMockRecorder) Get(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockStorage)(nil).Get), ctx, key)
+}
+
+// Key mocks base method.
+func (m *MockStorage) Key(url string) (string, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Key", url)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// Key indicates an expected call of Key.
+func (mr *MockStorageMockRecorder) Key(url any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Key", reflect.TypeOf((*MockStorage)(nil).Key), url)
+}
+
+// Put mocks base method.
+func (m *MockStorage) Put(ctx context.Context, key string, body []byte, contentType string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Put", ctx, key, body, contentType)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Put indicates an expected call of Put.
+func (mr *MockStorageMockRecorder) Put(ctx, key, body, contentType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockStorage)(nil).Put), ctx, key, body, contentType)
+}
+
+// MockPhotoResizer is a mock of PhotoResizer interface.
+type MockPhotoResizer struct {
+	ctrl     *gomock.Controller
+	recorder *MockPhotoResizerMockRecorder
+}
+
+// MockPhotoResizerMockRecorder is the mock recorder for MockPhotoResizer.
+type MockPhotoResizerMockRecorder struct {
+	mock *MockPhotoResizer
+}
+
+// NewMockPhotoResizer creates a new mock instance.
+func NewMockPhotoResizer(ctrl *gomock.Controller) *MockPhotoResizer {
+	mock := &MockPhotoResizer{ctrl: ctrl}
+	mock.recorder = &MockPhotoResizerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPhotoResizer) EXPECT() *MockPhotoResizerMockRecorder {
+	return m.recorder
+}
+
+// Resize mocks base method.
+func (m *MockPhotoResizer) Resize(body []byte, widths []int) ([][]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Resize", body, widths)
+	ret0, _ := ret[0].([][]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Resize indicates an expected call of Resize.
+func (mr *MockPhotoResizerMockRecorder) Resize(body, widths any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resize", reflect.TypeOf((*MockPhotoResizer)(nil).Resize), body, widths)
+}
+
+// MockPhotoPublisher is a mock of PhotoPublisher interface.
+type MockPhotoPublisher struct {
+	ctrl     *gomock.Controller
+	recorder *MockPhotoPublisherMockRecorder
+}
+
+// MockPhotoPublisherMockRecorder is the mock recorder for MockPhotoPublisher.
+type MockPhotoPublisherMockRecorder struct {
+	mock *MockPhotoPublisher
+}
+
+// NewMockPhotoPublisher creates a new mock instance.
+func NewMockPhotoPublisher(ctrl *gomock.Controller) *MockPhotoPublisher {
+	mock := &MockPhotoPublisher{ctrl: ctrl}
+	mock.recorder = &MockPhotoPublisherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPhotoPublisher) EXPECT() *MockPhotoPublisherMockRecorder {
+	return m.recorder
+}
+
+// Publish mocks base method.
+func (m *MockPhotoPublisher) Publish(ctx context.Context, photo *domain.PublishPhoto) (*domain.PhotoCredit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Publish", ctx, photo)
+	ret0, _ := ret[0].(*domain.PhotoCredit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Publish indicates an expected call of Publish.
+func (mr *MockPhotoPublisherMockRecorder) Publish(ctx, photo any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*MockPhotoPublisher)(nil).Publish), ctx, photo)
+}
+
+// MockPhotoUsecase is a mock of PhotoUsecase interface.
+type MockPhotoUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockPhotoUsecaseMockRecorder
+}
+
+// MockPhotoUsecaseMockRecorder is the mock recorder for MockPhotoUsecase.
+type MockPhotoUsecaseMockRecorder struct {
+	mock *MockPhotoUsecase
+}
+
+// NewMockPhotoUsecase creates a new mock instance.
+func NewMockPhotoUsecase(ctrl *gomock.Controller) *MockPhotoUsecase {
+	mock := &MockPhotoUsecase{ctrl: ctrl}
+	mock.recorder = &MockPhotoUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPhotoUsecase) EXPECT() *MockPhotoUsecaseMockRecorder {
+	return m.recorder
+}
+
+// UploadMenuPhoto mocks base method.
+func (m *MockPhotoUsecase) UploadMenuPhoto(ctx context.Context, upload *domain.MenuPhotoUpload) (*domain.Menu, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadMenuPhoto", ctx, upload)
+	ret0, _ := ret[0].(*domain.Menu)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadMenuPhoto indicates an expected call of UploadMenuPhoto.
+func (mr *MockPhotoUsecaseMockRecorder) UploadMenuPhoto(ctx, upload any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadMenuPhoto", reflect.TypeOf((*MockPhotoUsecase)(nil).UploadMenuPhoto), ctx, upload)
+}
+
+// MockPhotoController is a mock of PhotoController interface.
+type MockPhotoController struct {
+	ctrl     *gomock.Controller
+	recorder *MockPhotoControllerMockRecorder
+}
+
+// MockPhotoControllerMockRecorder is the mock recorder for MockPhotoController.
+type MockPhotoControllerMockRecorder struct {
+	mock *MockPhotoController
+}
+
+// NewMockPhotoController creates a new mock instance.
+func NewMockPhotoController(ctrl *gomock.Controller) *MockPhotoController {
+	mock := &MockPhotoController{ctrl: ctrl}
+	mock.recorder = &MockPhotoControllerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPhotoController) EXPECT() *MockPhotoControllerMockRecorder {
+	return m.recorder
+}
+
+// UploadMenuPhoto mocks base method.
+func (m *MockPhotoController) UploadMenuPhoto(c echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadMenuPhoto", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UploadMenuPhoto indicates an expected call of UploadMenuPhoto.
+func (mr *MockPhotoControllerMockRecorder) UploadMenuPhoto(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadMenuPhoto", reflect.TypeOf((*MockPhotoController)(nil).UploadMenuPhoto), c)
+}
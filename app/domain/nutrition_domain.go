@@ -0,0 +1,63 @@
+package domain
+
+import (
+	"context"
+
+	"github.com/labstack/echo/v4"
+)
+
+// SchoolLevel は栄養価を記載する学校の種別
+type SchoolLevel string
+
+const (
+	SchoolLevelElementary SchoolLevel = "elementary"
+	SchoolLevelJuniorHigh SchoolLevel = "junior_high"
+)
+
+// NutritionFacts は1人1回あたりの栄養価
+// 献立表に記載されていない項目は nil にする
+type NutritionFacts struct {
+	Protein      *float64 `json:"protein"`      // たんぱく質 (g)
+	Fat          *float64 `json:"fat"`          // 脂質 (g)
+	Carbohydrate *float64 `json:"carbohydrate"` // 炭水化物 (g)
+	Salt         *float64 `json:"salt"`         // 食塩相当量 (g)
+	Calcium      *float64 `json:"calcium"`      // カルシウム (mg)
+	Iron         *float64 `json:"iron"`         // 鉄 (mg)
+	VitaminA     *float64 `json:"vitamin_a"`    // ビタミンA (µgRAE)
+	VitaminB1    *float64 `json:"vitamin_b1"`   // ビタミンB1 (mg)
+	VitaminB2    *float64 `json:"vitamin_b2"`   // ビタミンB2 (mg)
+	VitaminC     *float64 `json:"vitamin_c"`    // ビタミンC (mg)
+}
+
+// MenuNutrition は献立の学校種別ごとの栄養価
+// 登録されていない学校種別は nil になる
+type MenuNutrition struct {
+	Elementary *NutritionFacts `json:"elementary"`
+	JuniorHigh *NutritionFacts `json:"junior_high"`
+}
+
+// Set は学校種別に対応する栄養価を設定する
+func (n *MenuNutrition) Set(level SchoolLevel, facts *NutritionFacts) {
+	switch level {
+	case SchoolLevelElementary:
+		n.Elementary = facts
+	case SchoolLevelJuniorHigh:
+		n.JuniorHigh = facts
+	}
+}
+
+type NutritionRepository interface {
+	Upsert(ctx context.Context, menuID string, level SchoolLevel, facts *NutritionFacts) error
+	Delete(ctx context.Context, menuID string, level SchoolLevel) error
+	FetchByMenuIDs(ctx context.Context, menuIDs []string) (map[string]*MenuNutrition, error)
+}
+
+type NutritionUsecase interface {
+	Upsert(ctx context.Context, menuID string, level SchoolLevel, facts *NutritionFacts) (*MenuNutrition, error)
+	Delete(ctx context.Context, menuID string, level SchoolLevel) error
+}
+
+type NutritionController interface {
+	Upsert(c echo.Context) error
+	Delete(c echo.Context) error
+}
@@ -0,0 +1,111 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+// MaxPhotoSize はアップロードできる写真の最大サイズ
+const MaxPhotoSize = 10 << 20
+
+var (
+	ErrUnsupportedPhotoType = errors.New("photo must be jpeg, png or webp")
+	ErrPhotoTooLarge        = errors.New("photo is too large")
+	ErrPhotoPublishDisabled = errors.New("publishing photos to wikimedia commons is not configured")
+	ErrPhotoPublishFailed   = errors.New("failed to publish photo to wikimedia commons")
+	ErrInvalidPhoto         = errors.New("photo could not be decoded")
+)
+
+func IsPhotoPublishFailed(err error) bool {
+	return errors.Is(err, ErrPhotoPublishFailed)
+}
+
+func IsInvalidPhoto(err error) bool {
+	return errors.Is(err, ErrInvalidPhoto)
+}
+
+// PhotoContentTypes はアップロードできる写真の Content-Type と拡張子
+var PhotoContentTypes = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+	"image/webp": ".webp",
+}
+
+// Storage は写真などのファイルを保存する
+// Put は保存したファイルの公開URLを返す
+// Get は MaxPhotoSize を超えるファイルに ErrPhotoTooLarge を返す
+// Key は Put が返した公開URLをキーに戻し、このストレージのURLでない場合は false を返す
+type Storage interface {
+	Put(ctx context.Context, key string, body []byte, contentType string) (string, error)
+	Get(ctx context.Context, key string) ([]byte, error)
+	Delete(ctx context.Context, key string) error
+	Key(url string) (string, bool)
+}
+
+// サムネイルの幅(px)
+// 元の写真より大きいサイズは拡大せず、元の写真の幅で作成する
+const (
+	SmallPhotoWidth  = 240
+	MediumPhotoWidth = 640
+	LargePhotoWidth  = 1280
+)
+
+// ThumbnailContentType はサムネイルの Content-Type
+const ThumbnailContentType = "image/jpeg"
+
+// MenuPhotos は献立の写真のサムネイルの URL
+// 写真をアップロードした場合のみ作成され、photo_url を直接指定した写真には存在しない
+type MenuPhotos struct {
+	Small  string `json:"small"`
+	Medium string `json:"medium"`
+	Large  string `json:"large"`
+}
+
+// PhotoResizer は写真を縮小したサムネイルを作成する
+// Resize は widths の順に ThumbnailContentType のサムネイルを返す
+type PhotoResizer interface {
+	Resize(body []byte, widths []int) ([][]byte, error)
+}
+
+// PhotoCredit は Wikimedia Commons に公開した写真のファイルページとクレジット表記
+// 写真を表示する際は Attribution を併記する
+type PhotoCredit struct {
+	FilePage    string `json:"file_page"`
+	License     string `json:"license"`
+	Attribution string `json:"attribution"`
+}
+
+// PublishPhoto は Wikimedia Commons に公開する写真
+type PublishPhoto struct {
+	FileName    string
+	Description string
+	Author      string
+	Date        time.Time
+	ContentType string
+	Body        []byte
+}
+
+// PhotoPublisher は写真をオープンなライセンスで公開する
+type PhotoPublisher interface {
+	Publish(ctx context.Context, photo *PublishPhoto) (*PhotoCredit, error)
+}
+
+// MenuPhotoUpload は献立の写真のアップロード
+// Publish が true の場合は Wikimedia Commons にも公開し、Author を撮影者として記載する
+type MenuPhotoUpload struct {
+	MenuID  string
+	Body    []byte
+	Publish bool
+	Author  string
+}
+
+type PhotoUsecase interface {
+	UploadMenuPhoto(ctx context.Context, upload *MenuPhotoUpload) (*Menu, error)
+}
+
+type PhotoController interface {
+	UploadMenuPhoto(c echo.Context) error
+}
@@ -0,0 +1,137 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+var (
+	ErrInvalidCredentials = errors.New("invalid username or password")
+	ErrInvalidToken       = errors.New("token is invalid")
+	ErrExpiredToken       = errors.New("token has expired")
+	ErrSessionRevoked     = errors.New("session has been revoked")
+)
+
+const (
+	UserRoleAdmin        = "admin"
+	UserRoleMunicipality = "municipality"
+	UserRoleGuest        = "guest"
+)
+
+// AuthPayloadKey は認証済みのトークンの内容を echo.Context に保存する際のキー
+const AuthPayloadKey = "auth_payload"
+
+type User struct {
+	ID             int32     `json:"id"`
+	Username       string    `json:"username"`
+	HashedPassword string    `json:"-"`
+	Email          string    `json:"email"`
+	Role           string    `json:"role"`
+	CityCode       int32     `json:"city_code"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+// Session は発行したトークンを個別に無効化するために保存する
+type Session struct {
+	ID        string    `json:"id"`
+	UserID    int32     `json:"user_id"`
+	Revoked   bool      `json:"revoked"`
+	ExpiresAt time.Time `json:"expires_at"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// TokenPayload はトークンに含まれる情報
+// ID はセッションのIDと同じ値になる
+type TokenPayload struct {
+	ID        string    `json:"id"`
+	UserID    int32     `json:"user_id"`
+	Username  string    `json:"username"`
+	Role      string    `json:"role"`
+	CityCode  int32     `json:"city_code"`
+	IssuedAt  time.Time `json:"issued_at"`
+	ExpiredAt time.Time `json:"expired_at"`
+}
+
+type LoginResult struct {
+	AccessToken          string    `json:"access_token"`
+	AccessTokenExpiresAt time.Time `json:"access_token_expires_at"`
+	User                 *User     `json:"user"`
+}
+
+type UserRepository interface {
+	Create(ctx context.Context, user *User) (*User, error)
+	GetByID(ctx context.Context, id int32) (*User, error)
+	GetByUsername(ctx context.Context, username string) (*User, error)
+	Fetch(ctx context.Context, limit int32, offset int32) ([]*User, error)
+	Delete(ctx context.Context, id int32) error
+	CreateSession(ctx context.Context, session *Session) error
+	GetSession(ctx context.Context, id string) (*Session, error)
+	RevokeSessions(ctx context.Context, userID int32) error
+}
+
+type UserUsecase interface {
+	Create(ctx context.Context, user *User, password string) (*User, error)
+	GetByID(ctx context.Context, id int32) (*User, error)
+	Fetch(ctx context.Context, limit int32, offset int32) ([]*User, error)
+	Delete(ctx context.Context, id int32) error
+	RevokeSessions(ctx context.Context, id int32) error
+	Login(ctx context.Context, username string, password string) (*LoginResult, error)
+	Authenticate(ctx context.Context, token string) (*TokenPayload, error)
+}
+
+// TokenMaker は署名付きのアクセストークンを発行・検証する
+type TokenMaker interface {
+	CreateToken(user *User, duration time.Duration) (string, *TokenPayload, error)
+	VerifyToken(token string) (*TokenPayload, error)
+}
+
+type UserController interface {
+	Login(c echo.Context) error
+	Create(c echo.Context) error
+	GetByID(c echo.Context) error
+	Fetch(c echo.Context) error
+	Delete(c echo.Context) error
+	RevokeSessions(c echo.Context) error
+}
+
+func NewUser(
+	username string,
+	email string,
+	role string,
+	cityCode int32,
+) *User {
+	return &User{
+		Username: username,
+		Email:    email,
+		Role:     role,
+		CityCode: cityCode,
+	}
+}
+
+func ReNewUser(
+	id int32,
+	username string,
+	hashedPassword string,
+	email string,
+	role string,
+	cityCode int32,
+	createdAt time.Time,
+) *User {
+	user := NewUser(username, email, role, cityCode)
+	user.ID = id
+	user.HashedPassword = hashedPassword
+	user.CreatedAt = createdAt
+
+	return user
+}
+
+func NewSession(id string, userID int32, expiresAt time.Time) *Session {
+	return &Session{
+		ID:        id,
+		UserID:    userID,
+		ExpiresAt: expiresAt,
+	}
+}
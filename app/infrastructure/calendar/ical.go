@@ -0,0 +1,160 @@
+package calendar
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/ogurilab/school-lunch-api/domain"
+)
+
+const (
+	prodID = "-//ogurilab//school-lunch-api//JA"
+
+	// RFC 5545 では1行を75オクテット以内に折り返す
+	maxLineOctets = 75
+)
+
+type icalEncoder struct{}
+
+// NewICalendarEncoder は献立の1日を終日の VEVENT として書き出す MenuCalendarEncoder を返す
+func NewICalendarEncoder() domain.MenuCalendarEncoder {
+	return &icalEncoder{}
+}
+
+func (e *icalEncoder) Encode(w io.Writer, calendar *domain.MenuCalendar) error {
+	bw := bufio.NewWriter(w)
+
+	writeLine(bw, "BEGIN:VCALENDAR")
+	writeLine(bw, "VERSION:2.0")
+	writeLine(bw, "PRODID:"+prodID)
+	writeLine(bw, "CALSCALE:GREGORIAN")
+	writeLine(bw, "METHOD:PUBLISH")
+	writeLine(bw, "X-WR-CALNAME:"+escapeText(fmt.Sprintf("給食の献立 (%d)", calendar.CityCode)))
+
+	for _, day := range calendar.Days {
+		menu := day.Menu
+		start := menu.OfferedAt.Format("20060102")
+		end := menu.OfferedAt.AddDate(0, 0, 1).Format("20060102")
+
+		writeLine(bw, "BEGIN:VEVENT")
+		writeLine(bw, "UID:"+menu.ID+"@school-lunch-api")
+		// 取得するたびに内容が変わらないよう、DTSTAMP は提供日の 00:00 (UTC) にする
+		writeLine(bw, "DTSTAMP:"+start+"T000000Z")
+		writeLine(bw, "DTSTART;VALUE=DATE:"+start)
+		writeLine(bw, "DTEND;VALUE=DATE:"+end)
+		writeLine(bw, "SUMMARY:"+escapeText(summary(day)))
+		writeLine(bw, "DESCRIPTION:"+escapeText(description(day)))
+		writeLine(bw, "TRANSP:TRANSPARENT")
+		writeLine(bw, "END:VEVENT")
+	}
+
+	writeLine(bw, "END:VCALENDAR")
+
+	return bw.Flush()
+}
+
+// summary は料理名を並べ、注意するアレルゲンを含む日は先頭に【注意】を付ける
+func summary(day *domain.MenuCalendarDay) string {
+	names := make([]string, 0, len(day.Menu.Dishes))
+
+	for _, dish := range day.Menu.Dishes {
+		names = append(names, dish.Name)
+	}
+
+	s := strings.Join(names, "、")
+
+	if len(day.Highlighted) > 0 {
+		s = "【注意】" + s
+	}
+
+	return s
+}
+
+// description はエネルギーと料理ごとのアレルゲンを書き出す
+func description(day *domain.MenuCalendarDay) string {
+	menu := day.Menu
+	lines := []string{
+		fmt.Sprintf("エネルギー: 小学校 %dkcal / 中学校 %dkcal", menu.ElementarySchoolCalories, menu.JuniorHighSchoolCalories),
+	}
+
+	if len(day.Highlighted) > 0 {
+		lines = append(lines, "注意するアレルゲン: "+joinAllergens(day.Highlighted))
+	}
+
+	allergenLines := make([]string, 0, len(menu.Dishes))
+
+	for _, dish := range menu.Dishes {
+		allergens := day.Allergens[dish.ID]
+
+		if len(allergens) == 0 {
+			continue
+		}
+
+		allergenLines = append(allergenLines, fmt.Sprintf("・%s: %s", dish.Name, joinAllergens(allergens)))
+	}
+
+	if len(allergenLines) == 0 {
+		lines = append(lines, "アレルゲン: なし")
+	} else {
+		lines = append(lines, "アレルゲン:")
+		lines = append(lines, allergenLines...)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// joinAllergens は混入の可能性があるアレルゲンにのみ区分を併記する
+func joinAllergens(allergens []*domain.Allergen) string {
+	names := make([]string, 0, len(allergens))
+
+	for _, a := range allergens {
+		if a.Category == domain.AllergenCategoryMayContain {
+			names = append(names, fmt.Sprintf("%s(%s)", a.Name, a.CategoryLabel))
+			continue
+		}
+
+		names = append(names, a.Name)
+	}
+
+	return strings.Join(names, "、")
+}
+
+// escapeText は TEXT 型の値に含まれる特殊文字をエスケープする
+func escapeText(s string) string {
+	r := strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+	)
+
+	return r.Replace(s)
+}
+
+// writeLine は1行を75オクテットごとに折り返して CRLF で書き出す
+// マルチバイト文字の途中では折り返さない
+func writeLine(w *bufio.Writer, line string) {
+	limit := maxLineOctets
+
+	for len(line) > limit {
+		cut := limit
+
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+
+		w.WriteString(line[:cut])
+		w.WriteString("\r\n ")
+		line = line[cut:]
+
+		// 継続行は先頭の空白を含めて75オクテットにする
+		limit = maxLineOctets - 1
+	}
+
+	w.WriteString(line)
+	w.WriteString("\r\n")
+}
@@ -0,0 +1,161 @@
+package calendar
+
+import (
+	"bytes"
+	"database/sql"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/ogurilab/school-lunch-api/domain"
+	"github.com/ogurilab/school-lunch-api/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncode(t *testing.T) {
+	menu := randomMenuWithDishes(t, "ごはん", "牛乳", "えびフライ, タルタルソース")
+	milk := domain.ReNewAllergen(1, "乳", domain.AllergenKindSpecified, domain.AllergenCategoryContains)
+	shrimp := domain.ReNewAllergen(2, "えび", domain.AllergenKindSpecified, domain.AllergenCategoryMayContain)
+
+	testCases := []struct {
+		name     string
+		calendar *domain.MenuCalendar
+		check    func(t *testing.T, body string)
+	}{
+		{
+			name: "OK",
+			calendar: &domain.MenuCalendar{
+				CityCode: 131016,
+				Days: []*domain.MenuCalendarDay{
+					{
+						Menu: menu,
+						Allergens: map[string][]*domain.Allergen{
+							menu.Dishes[1].ID: {milk},
+							menu.Dishes[2].ID: {shrimp},
+						},
+						Highlighted: []*domain.Allergen{},
+					},
+				},
+			},
+			check: func(t *testing.T, body string) {
+				lines := unfold(body)
+
+				require.Equal(t, "BEGIN:VCALENDAR", lines[0])
+				require.Equal(t, "END:VCALENDAR", lines[len(lines)-1])
+				require.Contains(t, lines, "UID:"+menu.ID+"@school-lunch-api")
+				require.Contains(t, lines, "DTSTART;VALUE=DATE:20240410")
+				require.Contains(t, lines, "DTEND;VALUE=DATE:20240411")
+				require.Contains(t, lines, `SUMMARY:ごはん、牛乳、えびフライ\, タルタルソース`)
+				require.Contains(t, lines, `DESCRIPTION:エネルギー: 小学校 620kcal / 中学校 830kcal\nアレルゲン:\n・牛乳: 乳\n・えびフライ\, タルタルソース: えび(混入の可能性あり)`)
+			},
+		},
+		{
+			name: "OK - Highlighted",
+			calendar: &domain.MenuCalendar{
+				CityCode: 131016,
+				Days: []*domain.MenuCalendarDay{
+					{
+						Menu: menu,
+						Allergens: map[string][]*domain.Allergen{
+							menu.Dishes[1].ID: {milk},
+						},
+						Highlighted: []*domain.Allergen{milk},
+					},
+				},
+			},
+			check: func(t *testing.T, body string) {
+				lines := unfold(body)
+
+				require.Contains(t, lines, `SUMMARY:【注意】ごはん、牛乳、えびフライ\, タルタルソース`)
+				require.Contains(t, lines, `DESCRIPTION:エネルギー: 小学校 620kcal / 中学校 830kcal\n注意するアレルゲン: 乳\nアレルゲン:\n・牛乳: 乳`)
+			},
+		},
+		{
+			name: "OK - Without Allergens",
+			calendar: &domain.MenuCalendar{
+				CityCode: 131016,
+				Days: []*domain.MenuCalendarDay{
+					{
+						Menu:        menu,
+						Allergens:   map[string][]*domain.Allergen{},
+						Highlighted: []*domain.Allergen{},
+					},
+				},
+			},
+			check: func(t *testing.T, body string) {
+				require.Contains(t, unfold(body), `DESCRIPTION:エネルギー: 小学校 620kcal / 中学校 830kcal\nアレルゲン: なし`)
+			},
+		},
+		{
+			name: "OK - Empty",
+			calendar: &domain.MenuCalendar{
+				CityCode: 131016,
+				Days:     []*domain.MenuCalendarDay{},
+			},
+			check: func(t *testing.T, body string) {
+				require.NotContains(t, body, "BEGIN:VEVENT")
+				require.Contains(t, body, "BEGIN:VCALENDAR\r\n")
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var buf bytes.Buffer
+
+			err := NewICalendarEncoder().Encode(&buf, tc.calendar)
+
+			require.NoError(t, err)
+
+			// 全ての行が CRLF で終わり、75オクテット以内に折り返されている
+			body := buf.String()
+			require.True(t, strings.HasSuffix(body, "\r\n"))
+
+			for _, line := range strings.Split(strings.TrimSuffix(body, "\r\n"), "\r\n") {
+				require.LessOrEqual(t, len(line), maxLineOctets)
+				require.True(t, utf8.ValidString(line))
+			}
+
+			tc.check(t, body)
+		})
+	}
+}
+
+func TestEncodeIsStable(t *testing.T) {
+	menu := randomMenuWithDishes(t, "ごはん")
+	calendar := &domain.MenuCalendar{
+		CityCode: 131016,
+		Days:     []*domain.MenuCalendarDay{{Menu: menu}},
+	}
+
+	var first, second bytes.Buffer
+
+	require.NoError(t, NewICalendarEncoder().Encode(&first, calendar))
+	require.NoError(t, NewICalendarEncoder().Encode(&second, calendar))
+
+	require.Equal(t, first.Bytes(), second.Bytes())
+}
+
+func randomMenuWithDishes(t *testing.T, names ...string) *domain.MenuWithDishes {
+	dishes := make([]*domain.Dish, 0, len(names))
+
+	for _, name := range names {
+		dish, err := domain.ReNewDish(util.NewUlid(), name)
+		require.NoError(t, err)
+
+		dishes = append(dishes, dish)
+	}
+
+	offeredAt := time.Date(2024, 4, 10, 0, 0, 0, 0, time.UTC)
+
+	menu, err := domain.ReNewMenuWithDishes(util.NewUlid(), offeredAt, sql.NullString{}, 620, 830, 131016, dishes)
+	require.NoError(t, err)
+
+	return menu
+}
+
+// unfold は折り返された行を元に戻す
+func unfold(body string) []string {
+	return strings.Split(strings.TrimSuffix(strings.ReplaceAll(body, "\r\n ", ""), "\r\n"), "\r\n")
+}
@@ -0,0 +1,379 @@
+package commons
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/cookiejar"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/ogurilab/school-lunch-api/domain"
+	"github.com/ogurilab/school-lunch-api/util"
+)
+
+const (
+	DefaultAPIURL  = "https://commons.wikimedia.org/w/api.php"
+	DefaultLicense = "cc-by-sa-4.0"
+
+	defaultRequestTimeout = 60 * time.Second
+
+	// Wikimedia の User-Agent ポリシーに従い、連絡先のわかる User-Agent を送る
+	userAgent = "school-lunch-api (https://github.com/ogurilab/school-lunch-api)"
+)
+
+// licenseLabels は Commons のライセンステンプレートとクレジットに記載する名称
+var licenseLabels = map[string]string{
+	"cc-by-4.0":    "CC BY 4.0",
+	"cc-by-sa-4.0": "CC BY-SA 4.0",
+	"cc-zero":      "CC0 1.0",
+}
+
+// MediaWikiConfig は MediaWiki API への接続情報
+// Password には Special:BotPasswords で発行したボットパスワードを使用する
+type MediaWikiConfig struct {
+	APIURL   string
+	Username string
+	Password string
+	// License は Commons のライセンステンプレート名
+	License string
+	// Author は撮影者が指定されなかった場合にクレジットに記載する名前
+	Author string
+}
+
+type mediaWikiPublisher struct {
+	client *http.Client
+	config MediaWikiConfig
+}
+
+// NewMediaWikiPublisher は MediaWiki API で写真を Wikimedia Commons にアップロードする PhotoPublisher を返す
+// client が nil の場合はタイムアウトを設定したクライアントを使用する
+func NewMediaWikiPublisher(client *http.Client, config MediaWikiConfig) domain.PhotoPublisher {
+	if client == nil {
+		client = &http.Client{Timeout: defaultRequestTimeout}
+	}
+
+	if config.APIURL == "" {
+		config.APIURL = DefaultAPIURL
+	}
+
+	if config.License == "" {
+		config.License = DefaultLicense
+	}
+
+	if config.Author == "" {
+		config.Author = config.Username
+	}
+
+	return &mediaWikiPublisher{
+		client: client,
+		config: config,
+	}
+}
+
+type apiError struct {
+	Code string `json:"code"`
+	Info string `json:"info"`
+}
+
+type tokensResponse struct {
+	Query struct {
+		Tokens struct {
+			LoginToken string `json:"logintoken"`
+			CsrfToken  string `json:"csrftoken"`
+		} `json:"tokens"`
+	} `json:"query"`
+	Error *apiError `json:"error"`
+}
+
+type loginResponse struct {
+	Login struct {
+		Result string `json:"result"`
+		Reason string `json:"reason"`
+	} `json:"login"`
+	Error *apiError `json:"error"`
+}
+
+type uploadResponse struct {
+	Upload struct {
+		Result    string            `json:"result"`
+		Filename  string            `json:"filename"`
+		Warnings  map[string]any    `json:"warnings"`
+		ImageInfo map[string]string `json:"imageinfo"`
+	} `json:"upload"`
+	Error *apiError `json:"error"`
+}
+
+// Publish はログインしてから写真をアップロードする
+// セッションは Publish ごとに作成し、アップロード後は破棄する
+func (p *mediaWikiPublisher) Publish(ctx context.Context, photo *domain.PublishPhoto) (*domain.PhotoCredit, error) {
+	jar, err := cookiejar.New(nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	client := &http.Client{
+		Transport: p.client.Transport,
+		Timeout:   p.client.Timeout,
+		Jar:       jar,
+	}
+
+	if err := p.login(ctx, client); err != nil {
+		return nil, err
+	}
+
+	var tokens tokensResponse
+
+	if err := p.get(ctx, client, url.Values{"action": {"query"}, "meta": {"tokens"}}, &tokens); err != nil {
+		return nil, err
+	}
+
+	if tokens.Query.Tokens.CsrfToken == "" {
+		return nil, fmt.Errorf("mediawiki: csrf token is empty")
+	}
+
+	author := photo.Author
+
+	if author == "" {
+		author = p.config.Author
+	}
+
+	res, err := p.upload(ctx, client, photo, author, tokens.Query.Tokens.CsrfToken)
+
+	if err != nil {
+		return nil, err
+	}
+
+	label, ok := licenseLabels[p.config.License]
+
+	if !ok {
+		label = p.config.License
+	}
+
+	filePage := res.Upload.ImageInfo["descriptionurl"]
+
+	if filePage == "" {
+		filePage = p.filePageURL(res.Upload.Filename)
+	}
+
+	return &domain.PhotoCredit{
+		FilePage:    filePage,
+		License:     label,
+		Attribution: fmt.Sprintf("%s, %s, via Wikimedia Commons", author, label),
+	}, nil
+}
+
+func (p *mediaWikiPublisher) login(ctx context.Context, client *http.Client) error {
+	var tokens tokensResponse
+
+	if err := p.get(ctx, client, url.Values{"action": {"query"}, "meta": {"tokens"}, "type": {"login"}}, &tokens); err != nil {
+		return err
+	}
+
+	form := url.Values{
+		"action":     {"login"},
+		"format":     {"json"},
+		"lgname":     {p.config.Username},
+		"lgpassword": {p.config.Password},
+		"lgtoken":    {tokens.Query.Tokens.LoginToken},
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.config.APIURL, strings.NewReader(form.Encode()))
+
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	var res loginResponse
+
+	if err := do(client, req, &res); err != nil {
+		return err
+	}
+
+	if res.Error != nil {
+		return fmt.Errorf("mediawiki: %s: %s", res.Error.Code, res.Error.Info)
+	}
+
+	if res.Login.Result != "Success" {
+		return fmt.Errorf("mediawiki: login %s: %s", res.Login.Result, res.Login.Reason)
+	}
+
+	return nil
+}
+
+func (p *mediaWikiPublisher) upload(ctx context.Context, client *http.Client, photo *domain.PublishPhoto, author string, token string) (*uploadResponse, error) {
+	body := new(bytes.Buffer)
+	writer := multipart.NewWriter(body)
+
+	fields := [][2]string{
+		{"action", "upload"},
+		{"format", "json"},
+		{"filename", photo.FileName},
+		{"comment", "Uploaded via school-lunch-api"},
+		{"text", p.pageText(photo, author)},
+		{"token", token},
+	}
+
+	for _, field := range fields {
+		if err := writer.WriteField(field[0], field[1]); err != nil {
+			return nil, err
+		}
+	}
+
+	part, err := writer.CreateFormFile("file", photo.FileName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := part.Write(photo.Body); err != nil {
+		return nil, err
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.config.APIURL, body)
+
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+
+	var res uploadResponse
+
+	if err := do(client, req, &res); err != nil {
+		return nil, err
+	}
+
+	if res.Error != nil {
+		return nil, fmt.Errorf("mediawiki: %s: %s", res.Error.Code, res.Error.Info)
+	}
+
+	// 重複などの警告がある場合はアップロードされないため、エラーにする
+	if res.Upload.Result != "Success" {
+		warnings := make([]string, 0, len(res.Upload.Warnings))
+
+		for warning := range res.Upload.Warnings {
+			warnings = append(warnings, warning)
+		}
+
+		return nil, fmt.Errorf("mediawiki: upload %s: %s", res.Upload.Result, strings.Join(warnings, ","))
+	}
+
+	return &res, nil
+}
+
+// pageText はファイルページの説明文を作成する
+// {{own}} と {{self}} はアップロードしたアカウントの本人が撮影した写真にのみ使い、
+// それ以外は提供を受けた写真として、撮影者をクレジットしたライセンステンプレートを使う
+func (p *mediaWikiPublisher) pageText(photo *domain.PublishPhoto, author string) string {
+	source := "{{own}}"
+	license := fmt.Sprintf("{{self|%s}}", p.config.License)
+
+	if !p.isOwner(author) {
+		source = fmt.Sprintf("Provided by the author to [[User:%s]]", escapeWikiText(p.accountName()))
+		license = fmt.Sprintf("{{%s|1=%s}}", p.config.License, escapeWikiText(author))
+	}
+
+	return fmt.Sprintf(`=={{int:filedesc}}==
+{{Information
+|description={{ja|1=%s}}
+|date=%s
+|source=%s
+|author=%s
+}}
+
+=={{int:license-header}}==
+%s
+
+[[Category:School lunches in Japan]]
+`,
+		escapeWikiText(photo.Description),
+		util.FormatDate(photo.Date),
+		source,
+		escapeWikiText(author),
+		license,
+	)
+}
+
+// isOwner は author がアップロードするアカウントの本人かどうかを返す
+func (p *mediaWikiPublisher) isOwner(author string) bool {
+	return author == p.config.Author || author == p.accountName()
+}
+
+// accountName はボットパスワードのユーザー名 (アカウント名@ボット名) からアカウント名を返す
+func (p *mediaWikiPublisher) accountName() string {
+	name, _, _ := strings.Cut(p.config.Username, "@")
+
+	return name
+}
+
+// filePageURL は API の URL からファイルページの URL を組み立てる
+func (p *mediaWikiPublisher) filePageURL(filename string) string {
+	base := strings.TrimSuffix(p.config.APIURL, "/w/api.php")
+	title := strings.ReplaceAll(filename, " ", "_")
+
+	return base + "/wiki/File:" + url.PathEscape(title)
+}
+
+func (p *mediaWikiPublisher) get(ctx context.Context, client *http.Client, query url.Values, v *tokensResponse) error {
+	query.Set("format", "json")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.config.APIURL+"?"+query.Encode(), nil)
+
+	if err != nil {
+		return err
+	}
+
+	if err := do(client, req, v); err != nil {
+		return err
+	}
+
+	if v.Error != nil {
+		return fmt.Errorf("mediawiki: %s: %s", v.Error.Code, v.Error.Info)
+	}
+
+	return nil
+}
+
+func do(client *http.Client, req *http.Request, v any) error {
+	req.Header.Set("User-Agent", userAgent)
+
+	res, err := client.Do(req)
+
+	if err != nil {
+		return err
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		b, _ := io.ReadAll(io.LimitReader(res.Body, 1024))
+
+		return fmt.Errorf("mediawiki: %s %s", res.Status, strings.TrimSpace(string(b)))
+	}
+
+	return json.NewDecoder(res.Body).Decode(v)
+}
+
+// escapeWikiText はテンプレートの引数を壊す記号を文字参照に置き換える
+var escapeWikiText = strings.NewReplacer(
+	"|", "&#124;",
+	"{", "&#123;",
+	"}", "&#125;",
+	"[", "&#91;",
+	"]", "&#93;",
+	"<", "&lt;",
+	">", "&gt;",
+).Replace
@@ -0,0 +1,208 @@
+package commons
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ogurilab/school-lunch-api/domain"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testLoginToken = "login-token+\\"
+	testCsrfToken  = "csrf-token+\\"
+	testSession    = "session-id"
+)
+
+// standIn は MediaWiki API のログインとアップロードのみを再現する
+type standIn struct {
+	t        *testing.T
+	password string
+	// uploadResult が Success 以外の場合は警告を返す
+	uploadResult string
+	uploaded     map[string]string
+	file         []byte
+}
+
+func (s *standIn) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	require.Equal(s.t, "/w/api.php", r.URL.Path)
+
+	loggedIn := false
+
+	if cookie, err := r.Cookie("session"); err == nil && cookie.Value == testSession {
+		loggedIn = true
+	}
+
+	write := func(v any) {
+		w.Header().Set("Content-Type", "application/json")
+		require.NoError(s.t, json.NewEncoder(w).Encode(v))
+	}
+
+	if r.Method == http.MethodGet {
+		require.Equal(s.t, "json", r.URL.Query().Get("format"))
+		require.Equal(s.t, "tokens", r.URL.Query().Get("meta"))
+
+		if r.URL.Query().Get("type") == "login" {
+			write(map[string]any{"query": map[string]any{"tokens": map[string]string{"logintoken": testLoginToken}}})
+			return
+		}
+
+		require.True(s.t, loggedIn)
+		write(map[string]any{"query": map[string]any{"tokens": map[string]string{"csrftoken": testCsrfToken}}})
+
+		return
+	}
+
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "application/x-www-form-urlencoded") {
+		require.NoError(s.t, r.ParseForm())
+		require.Equal(s.t, "login", r.PostForm.Get("action"))
+		require.Equal(s.t, testLoginToken, r.PostForm.Get("lgtoken"))
+
+		if r.PostForm.Get("lgname") != "bot@school-lunch" || r.PostForm.Get("lgpassword") != s.password {
+			write(map[string]any{"login": map[string]string{"result": "Failed", "reason": "Incorrect username or password entered."}})
+			return
+		}
+
+		http.SetCookie(w, &http.Cookie{Name: "session", Value: testSession, Path: "/"})
+		write(map[string]any{"login": map[string]string{"result": "Success"}})
+
+		return
+	}
+
+	require.True(s.t, loggedIn)
+	require.NoError(s.t, r.ParseMultipartForm(1<<20))
+	require.Equal(s.t, "upload", r.FormValue("action"))
+	require.Equal(s.t, testCsrfToken, r.FormValue("token"))
+
+	file, _, err := r.FormFile("file")
+	require.NoError(s.t, err)
+
+	s.file, err = io.ReadAll(file)
+	require.NoError(s.t, err)
+
+	s.uploaded = map[string]string{
+		"filename": r.FormValue("filename"),
+		"text":     r.FormValue("text"),
+	}
+
+	if s.uploadResult != "Success" {
+		write(map[string]any{"upload": map[string]any{"result": s.uploadResult, "warnings": map[string]string{"duplicate": "existing.jpg"}}})
+		return
+	}
+
+	filename := strings.ReplaceAll(r.FormValue("filename"), " ", "_")
+
+	write(map[string]any{"upload": map[string]any{
+		"result":    "Success",
+		"filename":  filename,
+		"imageinfo": map[string]string{"descriptionurl": "http://" + r.Host + "/wiki/File:" + filename},
+	}})
+}
+
+func TestPublish(t *testing.T) {
+	photo := &domain.PublishPhoto{
+		FileName:    "School lunch 23205 2024-04-08 01HV.jpg",
+		Description: "2024-04-08 の学校給食",
+		Author:      "半田市|給食センター",
+		Date:        time.Date(2024, 4, 8, 0, 0, 0, 0, time.UTC),
+		ContentType: "image/jpeg",
+		Body:        []byte("photo"),
+	}
+
+	testCases := []struct {
+		name         string
+		password     string
+		uploadResult string
+		author       string
+		check        func(t *testing.T, server *httptest.Server, s *standIn, credit *domain.PhotoCredit, err error)
+	}{
+		{
+			name:         "OK",
+			password:     "secret",
+			uploadResult: "Success",
+			author:       photo.Author,
+			check: func(t *testing.T, server *httptest.Server, s *standIn, credit *domain.PhotoCredit, err error) {
+				require.NoError(t, err)
+				require.Equal(t, server.URL+"/wiki/File:School_lunch_23205_2024-04-08_01HV.jpg", credit.FilePage)
+				require.Equal(t, "CC BY-SA 4.0", credit.License)
+				require.Equal(t, "半田市|給食センター, CC BY-SA 4.0, via Wikimedia Commons", credit.Attribution)
+
+				require.Equal(t, photo.FileName, s.uploaded["filename"])
+				require.Equal(t, photo.Body, s.file)
+				require.Contains(t, s.uploaded["text"], "|date=2024-04-08")
+				require.Contains(t, s.uploaded["text"], "|author=半田市&#124;給食センター")
+
+				// アカウントの本人以外が撮影した写真は {{own}} と {{self}} を使わない
+				require.Contains(t, s.uploaded["text"], "|source=Provided by the author to [[User:bot]]")
+				require.Contains(t, s.uploaded["text"], "{{cc-by-sa-4.0|1=半田市&#124;給食センター}}")
+				require.NotContains(t, s.uploaded["text"], "{{own}}")
+				require.NotContains(t, s.uploaded["text"], "{{self|")
+			},
+		},
+		{
+			name:         "OK - Default Author",
+			password:     "secret",
+			uploadResult: "Success",
+			check: func(t *testing.T, server *httptest.Server, s *standIn, credit *domain.PhotoCredit, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "bot, CC BY-SA 4.0, via Wikimedia Commons", credit.Attribution)
+				require.Contains(t, s.uploaded["text"], "|source={{own}}")
+				require.Contains(t, s.uploaded["text"], "{{self|cc-by-sa-4.0}}")
+			},
+		},
+		{
+			name:         "Login Failed",
+			password:     "wrong",
+			uploadResult: "Success",
+			check: func(t *testing.T, server *httptest.Server, s *standIn, credit *domain.PhotoCredit, err error) {
+				require.Error(t, err)
+				require.Nil(t, credit)
+				require.Nil(t, s.uploaded)
+			},
+		},
+		{
+			name:         "Upload Warning",
+			password:     "secret",
+			uploadResult: "Warning",
+			check: func(t *testing.T, server *httptest.Server, s *standIn, credit *domain.PhotoCredit, err error) {
+				require.ErrorContains(t, err, "duplicate")
+				require.Nil(t, credit)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			s := &standIn{t: t, password: "secret", uploadResult: tc.uploadResult}
+
+			server := httptest.NewServer(s)
+			defer server.Close()
+
+			publisher := NewMediaWikiPublisher(server.Client(), MediaWikiConfig{
+				APIURL:   server.URL + "/w/api.php",
+				Username: "bot@school-lunch",
+				Password: tc.password,
+				Author:   "bot",
+			})
+
+			p := *photo
+			p.Author = tc.author
+
+			credit, err := publisher.Publish(context.Background(), &p)
+
+			tc.check(t, server, s, credit, err)
+		})
+	}
+}
+
+func TestFilePageURL(t *testing.T) {
+	p := &mediaWikiPublisher{config: MediaWikiConfig{APIURL: DefaultAPIURL}}
+
+	require.Equal(t, "https://commons.wikimedia.org/wiki/File:School_lunch_1.jpg", p.filePageURL("School lunch 1.jpg"))
+}
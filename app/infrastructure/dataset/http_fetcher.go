@@ -0,0 +1,53 @@
+package dataset
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/ogurilab/school-lunch-api/domain"
+)
+
+const defaultFetchTimeout = 30 * time.Second
+
+type httpFetcher struct {
+	client *http.Client
+}
+
+// NewHTTPFetcher はHTTPでデータセットを取得する
+// client が nil の場合はタイムアウトを設定したクライアントを使用する
+func NewHTTPFetcher(client *http.Client) domain.DatasetFetcher {
+	if client == nil {
+		client = &http.Client{Timeout: defaultFetchTimeout}
+	}
+
+	return &httpFetcher{
+		client: client,
+	}
+}
+
+func (f *httpFetcher) Fetch(ctx context.Context, url string) (io.ReadCloser, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Accept", "text/csv")
+
+	res, err := f.client.Do(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode != http.StatusOK {
+		res.Body.Close()
+
+		return nil, fmt.Errorf("failed to fetch dataset %s: %s", url, res.Status)
+	}
+
+	return res.Body, nil
+}
@@ -0,0 +1,57 @@
+package dataset
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFetch(t *testing.T) {
+	server := httptest.NewServer(http.FileServer(http.Dir("testdata")))
+	defer server.Close()
+
+	fixture, err := os.ReadFile("testdata/menus.csv")
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name  string
+		path  string
+		check func(t *testing.T, body io.ReadCloser, err error)
+	}{
+		{
+			name: "OK",
+			path: "/menus.csv",
+			check: func(t *testing.T, body io.ReadCloser, err error) {
+				require.NoError(t, err)
+				defer body.Close()
+
+				b, err := io.ReadAll(body)
+				require.NoError(t, err)
+				require.Equal(t, fixture, b)
+			},
+		},
+		{
+			name: "Not Found",
+			path: "/not-found.csv",
+			check: func(t *testing.T, body io.ReadCloser, err error) {
+				require.Error(t, err)
+				require.Nil(t, body)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fetcher := NewHTTPFetcher(nil)
+
+			body, err := fetcher.Fetch(context.Background(), server.URL+tc.path)
+
+			tc.check(t, body, err)
+		})
+	}
+}
@@ -0,0 +1,7 @@
+ALTER TABLE `users` MODIFY `city_code` SMALLINT NOT NULL DEFAULT 0;
+
+ALTER TABLE `cities` MODIFY `prefecture_code` SMALLINT NOT NULL;
+
+ALTER TABLE `cities` MODIFY `city_code` SMALLINT;
+
+ALTER TABLE `menus` MODIFY `city_code` SMALLINT NOT NULL;
@@ -0,0 +1,7 @@
+ALTER TABLE `menus` MODIFY `city_code` INT NOT NULL;
+
+ALTER TABLE `cities` MODIFY `city_code` INT;
+
+ALTER TABLE `cities` MODIFY `prefecture_code` INT NOT NULL;
+
+ALTER TABLE `users` MODIFY `city_code` INT NOT NULL DEFAULT 0;
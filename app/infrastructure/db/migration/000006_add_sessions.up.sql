@@ -0,0 +1,9 @@
+CREATE TABLE `sessions` (
+  `id` varchar(255) PRIMARY KEY COMMENT 'トークンのID(jti)',
+  `user_id` INT NOT NULL,
+  `revoked` boolean NOT NULL DEFAULT FALSE COMMENT 'トークンが無効化されているかどうか',
+  `expires_at` TIMESTAMP NOT NULL,
+  `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4;
+
+CREATE INDEX `idx_sessions_user_id` ON `sessions` (`user_id`);
@@ -0,0 +1,13 @@
+CREATE TABLE `api_keys` (
+  `id` INT PRIMARY KEY AUTO_INCREMENT,
+  `name` varchar(100) NOT NULL COMMENT '利用者の名前',
+  `prefix` varchar(16) NOT NULL COMMENT 'キーを識別するための先頭の文字列',
+  `hashed_key` char(64) NOT NULL COMMENT 'SHA-256でハッシュ化したキー',
+  `rate_limit` INT NOT NULL COMMENT '1分あたりのリクエスト数',
+  `burst` INT NOT NULL COMMENT '連続して受け付けるリクエスト数',
+  `daily_quota` INT NOT NULL COMMENT '1日あたりのリクエスト数',
+  `revoked` boolean NOT NULL DEFAULT FALSE COMMENT 'キーが無効化されているかどうか',
+  `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4;
+
+CREATE UNIQUE INDEX `idx_api_keys_hashed_key` ON `api_keys` (`hashed_key`);
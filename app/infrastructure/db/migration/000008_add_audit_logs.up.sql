@@ -0,0 +1,19 @@
+CREATE TABLE `audit_logs` (
+  `id` BIGINT PRIMARY KEY AUTO_INCREMENT,
+  `actor_id` INT NOT NULL COMMENT '操作したユーザーのID',
+  `actor_username` varchar(50) NOT NULL COMMENT 'ユーザーが削除されても追えるように保存する',
+  `action` varchar(50) NOT NULL,
+  `entity_type` varchar(50) NOT NULL,
+  `entity_id` varchar(255) NOT NULL,
+  `city_code` INT COMMENT '操作した対象の市区町村コード',
+  `before_data` JSON COMMENT '変更前の状態',
+  `after_data` JSON COMMENT '変更後の状態',
+  `request_id` varchar(64) NOT NULL,
+  `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4;
+
+CREATE INDEX `idx_audit_logs_created_at` ON `audit_logs` (`created_at`);
+
+CREATE INDEX `idx_audit_logs_city_code_created_at` ON `audit_logs` (`city_code`, `created_at`);
+
+CREATE INDEX `idx_audit_logs_entity` ON `audit_logs` (`entity_type`, `entity_id`, `created_at`);
@@ -0,0 +1,4 @@
+ALTER TABLE `menus`
+DROP COLUMN `photo_attribution`,
+DROP COLUMN `photo_license`,
+DROP COLUMN `photo_file_page`;
@@ -0,0 +1,4 @@
+ALTER TABLE `menus`
+ADD COLUMN `photo_file_page` varchar(255) COMMENT 'Wikimedia Commons のファイルページ',
+ADD COLUMN `photo_license` varchar(64) COMMENT '写真のライセンス',
+ADD COLUMN `photo_attribution` varchar(512) COMMENT '写真のクレジット表記';
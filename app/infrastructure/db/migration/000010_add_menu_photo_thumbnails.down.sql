@@ -0,0 +1,4 @@
+ALTER TABLE `menus`
+DROP COLUMN `photo_large_url`,
+DROP COLUMN `photo_medium_url`,
+DROP COLUMN `photo_small_url`;
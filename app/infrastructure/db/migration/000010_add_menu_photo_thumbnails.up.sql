@@ -0,0 +1,4 @@
+ALTER TABLE `menus`
+ADD COLUMN `photo_small_url` varchar(255) COMMENT '幅 240px のサムネイル',
+ADD COLUMN `photo_medium_url` varchar(255) COMMENT '幅 640px のサムネイル',
+ADD COLUMN `photo_large_url` varchar(255) COMMENT '幅 1280px のサムネイル';
@@ -0,0 +1,15 @@
+CREATE TABLE `menu_nutritions` (
+  `menu_id` varchar(255) NOT NULL,
+  `school_level` varchar(16) NOT NULL COMMENT 'elementary または junior_high',
+  `protein` DOUBLE COMMENT 'たんぱく質 (g)',
+  `fat` DOUBLE COMMENT '脂質 (g)',
+  `carbohydrate` DOUBLE COMMENT '炭水化物 (g)',
+  `salt` DOUBLE COMMENT '食塩相当量 (g)',
+  `calcium` DOUBLE COMMENT 'カルシウム (mg)',
+  `iron` DOUBLE COMMENT '鉄 (mg)',
+  `vitamin_a` DOUBLE COMMENT 'ビタミンA (µgRAE)',
+  `vitamin_b1` DOUBLE COMMENT 'ビタミンB1 (mg)',
+  `vitamin_b2` DOUBLE COMMENT 'ビタミンB2 (mg)',
+  `vitamin_c` DOUBLE COMMENT 'ビタミンC (mg)',
+  PRIMARY KEY (`menu_id`, `school_level`)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4;
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS `dishes_ingredients`;
+
+DROP TABLE IF EXISTS `ingredients`;
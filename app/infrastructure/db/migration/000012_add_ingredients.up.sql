@@ -0,0 +1,15 @@
+CREATE TABLE `ingredients` (
+  `id` INT PRIMARY KEY AUTO_INCREMENT,
+  `name` varchar(255) UNIQUE NOT NULL,
+  `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4;
+
+CREATE TABLE `dishes_ingredients` (
+  `dish_id` varchar(255) NOT NULL,
+  `ingredient_id` INT NOT NULL,
+  `quantity` DOUBLE COMMENT '1人分の使用量 (g)',
+  `origin_prefecture_code` INT COMMENT '産地の都道府県コード',
+  PRIMARY KEY (`dish_id`, `ingredient_id`)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4;
+
+CREATE INDEX `idx_dishes_ingredients_ingredient_id` ON `dishes_ingredients` (`ingredient_id`);
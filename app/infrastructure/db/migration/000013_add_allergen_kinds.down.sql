@@ -0,0 +1,6 @@
+ALTER TABLE `dishes_allergens`
+MODIFY COLUMN `category` TINYINT NOT NULL;
+
+-- 登録した品目は既存のアレルゲンと区別できないため削除せず、コードと種別だけを取り除く
+ALTER TABLE `allergens` DROP COLUMN `kind`,
+  DROP COLUMN `code`;
@@ -0,0 +1,38 @@
+ALTER TABLE `allergens`
+ADD COLUMN `code` varchar(50) UNIQUE COMMENT '食品表示基準の品目を表す固定のコード',
+ADD COLUMN `kind` varchar(20) NOT NULL DEFAULT 'other' COMMENT 'specified (特定原材料) or recommended (特定原材料に準ずるもの) or other';
+
+ALTER TABLE `dishes_allergens`
+MODIFY COLUMN `category` TINYINT NOT NULL DEFAULT 0 COMMENT '0: 含む, 1: 製造工程で混入する可能性がある';
+
+INSERT INTO `allergens` (`name`, `code`, `kind`)
+VALUES ('えび', 'shrimp', 'specified'),
+  ('かに', 'crab', 'specified'),
+  ('くるみ', 'walnut', 'specified'),
+  ('小麦', 'wheat', 'specified'),
+  ('そば', 'buckwheat', 'specified'),
+  ('卵', 'egg', 'specified'),
+  ('乳', 'milk', 'specified'),
+  ('落花生', 'peanut', 'specified'),
+  ('アーモンド', 'almond', 'recommended'),
+  ('あわび', 'abalone', 'recommended'),
+  ('いか', 'squid', 'recommended'),
+  ('いくら', 'salmon_roe', 'recommended'),
+  ('オレンジ', 'orange', 'recommended'),
+  ('カシューナッツ', 'cashew_nut', 'recommended'),
+  ('キウイフルーツ', 'kiwifruit', 'recommended'),
+  ('牛肉', 'beef', 'recommended'),
+  ('ごま', 'sesame', 'recommended'),
+  ('さけ', 'salmon', 'recommended'),
+  ('さば', 'mackerel', 'recommended'),
+  ('大豆', 'soybean', 'recommended'),
+  ('鶏肉', 'chicken', 'recommended'),
+  ('バナナ', 'banana', 'recommended'),
+  ('豚肉', 'pork', 'recommended'),
+  ('マカダミアナッツ', 'macadamia_nut', 'recommended'),
+  ('もも', 'peach', 'recommended'),
+  ('やまいも', 'yam', 'recommended'),
+  ('りんご', 'apple', 'recommended'),
+  ('ゼラチン', 'gelatin', 'recommended') ON DUPLICATE KEY
+UPDATE `code` = VALUES(`code`),
+  `kind` = VALUES(`kind`);
@@ -3,25 +3,85 @@ INSERT INTO allergens (name)
 VALUES (sqlc.arg(name));
 
 -- name: GetAllergenByName :one
-SELECT id,
-  name
+SELECT *
 FROM allergens
 WHERE name = sqlc.arg(name);
 
 -- name: ListAllergenByDishID :many
 SELECT DISTINCT allergens.id,
   allergens.name,
+  allergens.kind,
   dishes_allergens.category
 FROM allergens
   JOIN dishes_allergens ON allergens.id = dishes_allergens.allergen_id
 WHERE dishes_allergens.dish_id = sqlc.arg(dish_id)
 ORDER BY allergens.name;
 
+-- name: ListAllergenByDishIDs :many
+SELECT dishes_allergens.dish_id,
+  allergens.id,
+  allergens.name,
+  allergens.kind,
+  dishes_allergens.category
+FROM allergens
+  JOIN dishes_allergens ON allergens.id = dishes_allergens.allergen_id
+WHERE dishes_allergens.dish_id IN (sqlc.slice(dish_ids))
+ORDER BY allergens.name;
+
 -- name: ListAllergenInDish :many
 SELECT DISTINCT allergens.id,
   allergens.name,
+  allergens.kind,
   dishes_allergens.category
 FROM allergens
   JOIN dishes_allergens ON allergens.id = dishes_allergens.allergen_id
 WHERE dishes_allergens.dish_id IN (sqlc.slice(dish_ids))
-ORDER BY allergens.name;
\ No newline at end of file
+ORDER BY allergens.name;
+
+-- name: ListAllergenInDishByAllergenIDs :many
+SELECT dishes_allergens.dish_id,
+  allergens.id,
+  allergens.name,
+  allergens.kind,
+  dishes_allergens.category
+FROM allergens
+  JOIN dishes_allergens ON allergens.id = dishes_allergens.allergen_id
+WHERE dishes_allergens.dish_id IN (sqlc.slice(dish_ids))
+  AND dishes_allergens.allergen_id IN (sqlc.slice(allergen_ids))
+ORDER BY allergens.name;
+
+-- name: ListAllergenMatrixByCity :many
+SELECT m.id AS menu_id,
+  m.offered_at,
+  d.id AS dish_id,
+  d.name AS dish_name,
+  allergens.id AS allergen_id,
+  allergens.name AS allergen_name,
+  allergens.kind AS allergen_kind,
+  dishes_allergens.category
+FROM menus AS m
+  INNER JOIN menu_dishes AS md ON m.id = md.menu_id
+  INNER JOIN dishes AS d ON md.dish_id = d.id
+  LEFT JOIN dishes_allergens ON d.id = dishes_allergens.dish_id
+  LEFT JOIN allergens ON dishes_allergens.allergen_id = allergens.id
+WHERE m.city_code = sqlc.arg(city_code)
+  AND m.offered_at BETWEEN sqlc.arg(start_at) AND sqlc.arg(end_at)
+ORDER BY m.offered_at,
+  m.id,
+  d.id,
+  allergens.id,
+  dishes_allergens.category;
+
+-- name: ListAllergensByIDs :many
+SELECT id,
+  name,
+  kind
+FROM allergens
+WHERE id IN (sqlc.slice(ids));
+
+-- name: ListStandardAllergens :many
+SELECT *
+FROM allergens
+WHERE code IS NOT NULL
+ORDER BY FIELD(kind, 'specified', 'recommended', 'other'),
+  code;
@@ -0,0 +1,40 @@
+-- name: CreateApiKey :execlastid
+INSERT INTO api_keys (
+    name,
+    prefix,
+    hashed_key,
+    rate_limit,
+    burst,
+    daily_quota
+  )
+VALUES (
+    sqlc.arg(name),
+    sqlc.arg(prefix),
+    sqlc.arg(hashed_key),
+    sqlc.arg(rate_limit),
+    sqlc.arg(burst),
+    sqlc.arg(daily_quota)
+  );
+
+-- name: GetApiKey :one
+SELECT *
+FROM api_keys
+WHERE id = sqlc.arg(id)
+LIMIT 1;
+
+-- name: GetApiKeyByHashedKey :one
+SELECT *
+FROM api_keys
+WHERE hashed_key = sqlc.arg(hashed_key)
+LIMIT 1;
+
+-- name: ListApiKeys :many
+SELECT *
+FROM api_keys
+ORDER BY id
+LIMIT ? OFFSET ?;
+
+-- name: RevokeApiKey :execrows
+UPDATE api_keys
+SET revoked = TRUE
+WHERE id = sqlc.arg(id);
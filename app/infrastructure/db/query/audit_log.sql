@@ -0,0 +1,50 @@
+-- name: CreateAuditLog :exec
+INSERT INTO audit_logs (
+    actor_id,
+    actor_username,
+    action,
+    entity_type,
+    entity_id,
+    city_code,
+    before_data,
+    after_data,
+    request_id
+  )
+VALUES (
+    sqlc.arg(actor_id),
+    sqlc.arg(actor_username),
+    sqlc.arg(action),
+    sqlc.arg(entity_type),
+    sqlc.arg(entity_id),
+    sqlc.arg(city_code),
+    sqlc.arg(before_data),
+    sqlc.arg(after_data),
+    sqlc.arg(request_id)
+  );
+
+-- name: ListAuditLogs :many
+SELECT *
+FROM audit_logs
+WHERE (
+    sqlc.narg(city_code) IS NULL
+    OR city_code = sqlc.narg(city_code)
+  )
+  AND (
+    sqlc.narg(entity_type) IS NULL
+    OR entity_type = sqlc.narg(entity_type)
+  )
+  AND (
+    sqlc.narg(entity_id) IS NULL
+    OR entity_id = sqlc.narg(entity_id)
+  )
+  AND (
+    sqlc.narg(created_from) IS NULL
+    OR created_at >= sqlc.narg(created_from)
+  )
+  AND (
+    sqlc.narg(created_to) IS NULL
+    OR created_at < sqlc.narg(created_to)
+  )
+ORDER BY created_at DESC,
+  id DESC
+LIMIT ? OFFSET ?;
@@ -41,4 +41,12 @@ SELECT *
 FROM cities
 WHERE prefecture_code = ?
 ORDER BY city_code
-LIMIT ? OFFSET ?;
\ No newline at end of file
+LIMIT ? OFFSET ?;
+
+-- name: UpdateCity :exec
+UPDATE cities
+SET city_name = sqlc.arg(city_name),
+  prefecture_code = sqlc.arg(prefecture_code),
+  prefecture_name = sqlc.arg(prefecture_name),
+  school_lunch_info_available = sqlc.arg(school_lunch_info_available)
+WHERE city_code = sqlc.arg(city_code);
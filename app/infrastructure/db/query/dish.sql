@@ -48,4 +48,26 @@ SELECT dishes.id,
   dishes.name
 FROM dishes
 ORDER BY id
-LIMIT ? OFFSET ?;
\ No newline at end of file
+LIMIT ? OFFSET ?;
+
+-- name: GetDishByID :one
+SELECT dishes.id,
+  dishes.name
+FROM dishes
+WHERE id = sqlc.arg(id);
+
+-- name: UpdateDishName :exec
+UPDATE dishes
+SET name = sqlc.arg(name)
+WHERE id = sqlc.arg(id);
+
+-- name: DeleteDish :execrows
+DELETE FROM dishes
+WHERE id = sqlc.arg(id);
+
+-- name: ListDishInNames :many
+SELECT dishes.id,
+  dishes.name
+FROM dishes
+WHERE name IN (sqlc.slice(names))
+ORDER BY id;
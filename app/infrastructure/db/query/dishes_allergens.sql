@@ -4,4 +4,49 @@ VALUES (
     sqlc.arg(dish_id),
     sqlc.arg(allergen_id),
     sqlc.arg(category)
-  );
\ No newline at end of file
+  );
+
+-- name: DeleteDishesAllergens :execrows
+DELETE FROM dishes_allergens
+WHERE dish_id = sqlc.arg(dish_id)
+  AND allergen_id = sqlc.arg(allergen_id)
+  AND category = sqlc.arg(category);
+
+-- name: DeleteDishesAllergensByDishID :execrows
+DELETE FROM dishes_allergens
+WHERE dish_id = sqlc.arg(dish_id);
+
+-- name: ListDishByAllergenID :many
+SELECT DISTINCT dishes.id,
+  dishes.name
+FROM dishes
+  INNER JOIN dishes_allergens ON dishes.id = dishes_allergens.dish_id
+WHERE dishes_allergens.allergen_id = sqlc.arg(allergen_id)
+ORDER BY dishes.id
+LIMIT ? OFFSET ?;
+
+-- name: ListDishByAllergenIDInCity :many
+SELECT DISTINCT dishes.id,
+  dishes.name
+FROM dishes
+  INNER JOIN dishes_allergens ON dishes.id = dishes_allergens.dish_id
+  INNER JOIN menu_dishes ON dishes.id = menu_dishes.dish_id
+  INNER JOIN menus ON menu_dishes.menu_id = menus.id
+WHERE dishes_allergens.allergen_id = sqlc.arg(allergen_id)
+  AND menus.city_code = sqlc.arg(city_code)
+ORDER BY dishes.id
+LIMIT ? OFFSET ?;
+
+-- name: ListDishByNameExcludingAllergens :many
+SELECT dishes.id,
+  dishes.name
+FROM dishes
+WHERE dishes.name LIKE sqlc.arg(name)
+  AND NOT EXISTS (
+    SELECT 1
+    FROM dishes_allergens
+    WHERE dishes_allergens.dish_id = dishes.id
+      AND dishes_allergens.allergen_id IN (sqlc.slice(allergen_ids))
+  )
+ORDER BY dishes.id
+LIMIT ? OFFSET ?;
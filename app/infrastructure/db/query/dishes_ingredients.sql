@@ -0,0 +1,28 @@
+-- name: UpsertDishesIngredients :exec
+INSERT INTO dishes_ingredients (
+    dish_id,
+    ingredient_id,
+    quantity,
+    origin_prefecture_code
+  )
+VALUES (
+    sqlc.arg(dish_id),
+    sqlc.arg(ingredient_id),
+    sqlc.narg(quantity),
+    sqlc.narg(origin_prefecture_code)
+  ) ON DUPLICATE KEY
+UPDATE quantity = VALUES(quantity),
+  origin_prefecture_code = VALUES(origin_prefecture_code);
+
+-- name: DeleteDishesIngredients :execrows
+DELETE FROM dishes_ingredients
+WHERE dish_id = sqlc.arg(dish_id)
+  AND ingredient_id = sqlc.arg(ingredient_id);
+
+-- name: DeleteDishesIngredientsByDishID :execrows
+DELETE FROM dishes_ingredients
+WHERE dish_id = sqlc.arg(dish_id);
+
+-- name: DeleteDishesIngredientsByIngredientID :execrows
+DELETE FROM dishes_ingredients
+WHERE ingredient_id = sqlc.arg(ingredient_id);
@@ -0,0 +1,67 @@
+-- name: CreateExternalDataSource :execlastid
+INSERT INTO external_data_sources (
+    city_code,
+    dataset_id,
+    year,
+    status,
+    category,
+    description
+  )
+VALUES (
+    sqlc.arg(city_code),
+    sqlc.arg(dataset_id),
+    sqlc.arg(year),
+    sqlc.arg(status),
+    sqlc.arg(category),
+    sqlc.arg(description)
+  );
+
+-- name: DeleteExternalDataSource :execrows
+DELETE FROM external_data_sources
+WHERE source_id = sqlc.arg(source_id);
+
+-- name: GetExternalDataSource :one
+SELECT *
+FROM external_data_sources
+WHERE source_id = sqlc.arg(source_id)
+LIMIT 1;
+
+-- name: ListExternalDataSources :many
+SELECT *
+FROM external_data_sources
+ORDER BY source_id
+LIMIT ? OFFSET ?;
+
+-- name: ListExternalDataSourcesByStatus :many
+SELECT *
+FROM external_data_sources
+WHERE status = sqlc.arg(status)
+ORDER BY source_id;
+
+-- name: StartExternalDataSourceSync :execrows
+UPDATE external_data_sources
+SET status = 'Updating',
+  updated_at = CURRENT_TIMESTAMP
+WHERE source_id = sqlc.arg(source_id)
+  AND (
+    status <> 'Updating'
+    OR updated_at < NOW() - INTERVAL 1 HOUR
+  );
+
+-- name: UpdateExternalDataSource :exec
+UPDATE external_data_sources
+SET city_code = sqlc.arg(city_code),
+  dataset_id = sqlc.arg(dataset_id),
+  year = sqlc.arg(year),
+  status = sqlc.arg(status),
+  category = sqlc.arg(category),
+  description = sqlc.arg(description),
+  updated_at = CURRENT_TIMESTAMP
+WHERE source_id = sqlc.arg(source_id);
+
+-- name: UpdateExternalDataSourceStatus :exec
+UPDATE external_data_sources
+SET status = sqlc.arg(status),
+  description = sqlc.arg(description),
+  updated_at = CURRENT_TIMESTAMP
+WHERE source_id = sqlc.arg(source_id);
@@ -0,0 +1,54 @@
+-- name: CreateIngredient :execlastid
+INSERT INTO ingredients (name)
+VALUES (sqlc.arg(name));
+
+-- name: GetIngredient :one
+SELECT id,
+  name
+FROM ingredients
+WHERE id = sqlc.arg(id);
+
+-- name: ListIngredients :many
+SELECT id,
+  name
+FROM ingredients
+ORDER BY name
+LIMIT ? OFFSET ?;
+
+-- name: ListIngredientsByIDs :many
+SELECT id,
+  name
+FROM ingredients
+WHERE id IN (sqlc.slice(ids));
+
+-- name: UpdateIngredient :exec
+UPDATE ingredients
+SET name = sqlc.arg(name)
+WHERE id = sqlc.arg(id);
+
+-- name: DeleteIngredient :execrows
+DELETE FROM ingredients
+WHERE id = sqlc.arg(id);
+
+-- name: ListIngredientByDishID :many
+SELECT ingredients.id,
+  ingredients.name,
+  dishes_ingredients.dish_id,
+  dishes_ingredients.quantity,
+  dishes_ingredients.origin_prefecture_code
+FROM ingredients
+  JOIN dishes_ingredients ON ingredients.id = dishes_ingredients.ingredient_id
+WHERE dishes_ingredients.dish_id = sqlc.arg(dish_id)
+ORDER BY ingredients.name;
+
+-- name: ListIngredientInDish :many
+SELECT ingredients.id,
+  ingredients.name,
+  dishes_ingredients.dish_id,
+  dishes_ingredients.quantity,
+  dishes_ingredients.origin_prefecture_code
+FROM ingredients
+  JOIN dishes_ingredients ON ingredients.id = dishes_ingredients.ingredient_id
+WHERE dishes_ingredients.dish_id IN (sqlc.slice(dish_ids))
+ORDER BY dishes_ingredients.dish_id,
+  ingredients.name;
@@ -30,6 +30,21 @@ WHERE city_code = sqlc.arg(city_code)
 ORDER BY offered_at DESC
 LIMIT ? OFFSET ?;
 
+-- name: ListMenuByCityExcludingAllergens :many
+SELECT *
+FROM menus AS m
+WHERE city_code = sqlc.arg(city_code)
+  AND offered_at <= sqlc.arg(offered_at)
+  AND NOT EXISTS (
+    SELECT 1
+    FROM menu_dishes AS md
+      INNER JOIN dishes_allergens AS da ON md.dish_id = da.dish_id
+    WHERE md.menu_id = m.id
+      AND da.allergen_id IN (sqlc.slice(allergen_ids))
+  )
+ORDER BY offered_at DESC
+LIMIT ? OFFSET ?;
+
 -- name: ListMenuInIds :many
 SELECT *
 FROM menus
@@ -43,4 +58,48 @@ SELECT *
 FROM menus
 WHERE offered_at <= sqlc.arg(offered_at)
 ORDER BY offered_at DESC
-LIMIT ? OFFSET ?;
\ No newline at end of file
+LIMIT ? OFFSET ?;
+
+-- name: GetMenuByID :one
+SELECT *
+FROM menus
+WHERE id = sqlc.arg(id);
+
+-- name: UpdateMenu :exec
+-- 写真が変更された場合はクレジットとサムネイルを削除する
+-- 代入は左から順に評価されるため、photo_url より先にクレジットとサムネイルを更新する
+UPDATE menus
+SET photo_file_page = IF(photo_url <=> sqlc.arg(photo_url), photo_file_page, NULL),
+  photo_license = IF(photo_url <=> sqlc.arg(photo_url), photo_license, NULL),
+  photo_attribution = IF(photo_url <=> sqlc.arg(photo_url), photo_attribution, NULL),
+  photo_small_url = IF(photo_url <=> sqlc.arg(photo_url), photo_small_url, NULL),
+  photo_medium_url = IF(photo_url <=> sqlc.arg(photo_url), photo_medium_url, NULL),
+  photo_large_url = IF(photo_url <=> sqlc.arg(photo_url), photo_large_url, NULL),
+  offered_at = sqlc.arg(offered_at),
+  photo_url = sqlc.arg(photo_url),
+  elementary_school_calories = sqlc.arg(elementary_school_calories),
+  junior_high_school_calories = sqlc.arg(junior_high_school_calories),
+  city_code = sqlc.arg(city_code)
+WHERE id = sqlc.arg(id);
+
+-- name: DeleteMenu :execrows
+DELETE FROM menus
+WHERE id = sqlc.arg(id);
+
+-- name: ListMenuOfferedAtByCity :many
+SELECT offered_at
+FROM menus
+WHERE city_code = sqlc.arg(city_code)
+  AND offered_at BETWEEN sqlc.arg(start_at) AND sqlc.arg(end_at)
+ORDER BY offered_at;
+
+-- name: UpdateMenuPhoto :exec
+UPDATE menus
+SET photo_url = sqlc.arg(photo_url),
+  photo_file_page = sqlc.narg(photo_file_page),
+  photo_license = sqlc.narg(photo_license),
+  photo_attribution = sqlc.narg(photo_attribution),
+  photo_small_url = sqlc.narg(photo_small_url),
+  photo_medium_url = sqlc.narg(photo_medium_url),
+  photo_large_url = sqlc.narg(photo_large_url)
+WHERE id = sqlc.arg(id);
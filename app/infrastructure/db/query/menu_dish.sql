@@ -1,3 +1,29 @@
 -- name: CreateMenuDish :exec
 INSERT INTO menu_dishes (menu_id, dish_id)
-VALUES (sqlc.arg("menu_id"), sqlc.arg("dish_id"));
\ No newline at end of file
+VALUES (sqlc.arg("menu_id"), sqlc.arg("dish_id"));
+
+-- name: DeleteMenuDishesByMenuID :execrows
+DELETE FROM menu_dishes
+WHERE menu_id = sqlc.arg(menu_id);
+
+-- name: ListMenuIDByDishID :many
+SELECT menu_id
+FROM menu_dishes
+WHERE dish_id = sqlc.arg(dish_id)
+ORDER BY menu_id;
+
+-- name: DeleteMenuDish :execrows
+DELETE FROM menu_dishes
+WHERE menu_id = sqlc.arg(menu_id)
+  AND dish_id = sqlc.arg(dish_id);
+
+-- name: DeleteMenuDishesByDishID :execrows
+DELETE FROM menu_dishes
+WHERE dish_id = sqlc.arg(dish_id);
+
+-- name: ListCityCodeByDishID :many
+SELECT DISTINCT m.city_code
+FROM menu_dishes AS md
+  INNER JOIN menus AS m ON md.menu_id = m.id
+WHERE md.dish_id = sqlc.arg(dish_id)
+ORDER BY m.city_code;
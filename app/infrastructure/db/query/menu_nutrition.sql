@@ -0,0 +1,55 @@
+-- name: UpsertMenuNutrition :exec
+INSERT INTO menu_nutritions (
+    menu_id,
+    school_level,
+    protein,
+    fat,
+    carbohydrate,
+    salt,
+    calcium,
+    iron,
+    vitamin_a,
+    vitamin_b1,
+    vitamin_b2,
+    vitamin_c
+  )
+VALUES (
+    sqlc.arg(menu_id),
+    sqlc.arg(school_level),
+    sqlc.narg(protein),
+    sqlc.narg(fat),
+    sqlc.narg(carbohydrate),
+    sqlc.narg(salt),
+    sqlc.narg(calcium),
+    sqlc.narg(iron),
+    sqlc.narg(vitamin_a),
+    sqlc.narg(vitamin_b1),
+    sqlc.narg(vitamin_b2),
+    sqlc.narg(vitamin_c)
+  ) ON DUPLICATE KEY
+UPDATE protein = VALUES(protein),
+  fat = VALUES(fat),
+  carbohydrate = VALUES(carbohydrate),
+  salt = VALUES(salt),
+  calcium = VALUES(calcium),
+  iron = VALUES(iron),
+  vitamin_a = VALUES(vitamin_a),
+  vitamin_b1 = VALUES(vitamin_b1),
+  vitamin_b2 = VALUES(vitamin_b2),
+  vitamin_c = VALUES(vitamin_c);
+
+-- name: ListMenuNutritionsByMenuIDs :many
+SELECT *
+FROM menu_nutritions
+WHERE menu_id IN (sqlc.slice(menu_ids))
+ORDER BY menu_id,
+  school_level;
+
+-- name: DeleteMenuNutrition :execrows
+DELETE FROM menu_nutritions
+WHERE menu_id = sqlc.arg(menu_id)
+  AND school_level = sqlc.arg(school_level);
+
+-- name: DeleteMenuNutritionsByMenuID :exec
+DELETE FROM menu_nutritions
+WHERE menu_id = sqlc.arg(menu_id);
@@ -27,6 +27,56 @@ FROM (
   INNER JOIN menu_dishes md ON m.id = md.menu_id
   INNER JOIN dishes d ON md.dish_id = d.id;
 
+-- name: ListMenuWithDishesByCityBetween :many
+SELECT m.*,
+  d.id AS dish_id,
+  d.name AS dish_name
+FROM (
+    SELECT *
+    FROM menus AS m
+    WHERE city_code = sqlc.arg(city_code)
+      AND offered_at BETWEEN sqlc.arg(start_at) AND sqlc.arg(end_at)
+  ) AS m
+  INNER JOIN menu_dishes md ON m.id = md.menu_id
+  INNER JOIN dishes d ON md.dish_id = d.id;
+
+-- name: ListMenuWithDishesByCityExcludingAllergens :many
+SELECT m.*,
+  d.id AS dish_id,
+  d.name AS dish_name
+FROM (
+    SELECT *
+    FROM menus AS m
+    WHERE city_code = sqlc.arg(city_code)
+      AND offered_at <= sqlc.arg(offered_at)
+      AND NOT EXISTS (
+        SELECT 1
+        FROM menu_dishes AS emd
+          INNER JOIN dishes_allergens AS da ON emd.dish_id = da.dish_id
+        WHERE emd.menu_id = m.id
+          AND da.allergen_id IN (sqlc.slice(allergen_ids))
+      )
+    ORDER BY offered_at DESC
+    LIMIT ? OFFSET ?
+  ) AS m
+  INNER JOIN menu_dishes md ON m.id = md.menu_id
+  INNER JOIN dishes d ON md.dish_id = d.id;
+
+-- name: ListLatestMenuWithDishesByCity :many
+SELECT m.*,
+  d.id AS dish_id,
+  d.name AS dish_name
+FROM (
+    SELECT *
+    FROM menus AS m
+    WHERE city_code = sqlc.arg(city_code)
+    ORDER BY created_at DESC,
+      id DESC
+    LIMIT ?
+  ) AS m
+  INNER JOIN menu_dishes md ON m.id = md.menu_id
+  INNER JOIN dishes d ON md.dish_id = d.id;
+
 -- name: ListMenuWithDishes :many
 SELECT m.*,
   d.id AS dish_id,
@@ -0,0 +1,27 @@
+-- name: CreateSession :exec
+INSERT INTO sessions (
+    id,
+    user_id,
+    expires_at
+  )
+VALUES (
+    sqlc.arg(id),
+    sqlc.arg(user_id),
+    sqlc.arg(expires_at)
+  );
+
+-- name: DeleteSessionsByUser :exec
+DELETE FROM sessions
+WHERE user_id = sqlc.arg(user_id);
+
+-- name: GetSession :one
+SELECT *
+FROM sessions
+WHERE id = sqlc.arg(id)
+LIMIT 1;
+
+-- name: RevokeSessionsByUser :execrows
+UPDATE sessions
+SET revoked = true
+WHERE user_id = sqlc.arg(user_id)
+  AND revoked = false;
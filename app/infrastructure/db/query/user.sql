@@ -0,0 +1,37 @@
+-- name: CreateUser :execlastid
+INSERT INTO users (
+    username,
+    hashed_password,
+    email,
+    role,
+    city_code
+  )
+VALUES (
+    sqlc.arg(username),
+    sqlc.arg(hashed_password),
+    sqlc.arg(email),
+    sqlc.arg(role),
+    sqlc.arg(city_code)
+  );
+
+-- name: DeleteUser :execrows
+DELETE FROM users
+WHERE id = sqlc.arg(id);
+
+-- name: GetUser :one
+SELECT *
+FROM users
+WHERE id = sqlc.arg(id)
+LIMIT 1;
+
+-- name: GetUserByUsername :one
+SELECT *
+FROM users
+WHERE username = sqlc.arg(username)
+LIMIT 1;
+
+-- name: ListUsers :many
+SELECT *
+FROM users
+ORDER BY id
+LIMIT ? OFFSET ?;
@@ -7,7 +7,9 @@ package db
 
 import (
 	"context"
+	"database/sql"
 	"strings"
+	"time"
 )
 
 const createAllergen = `-- name: CreateAllergen :exec
@@ -21,8 +23,7 @@ func (q *Queries) CreateAllergen(ctx context.Context, name string) error {
 }
 
 const getAllergenByName = `-- name: GetAllergenByName :one
-SELECT id,
-  name
+SELECT id, name, code, kind
 FROM allergens
 WHERE name = ?
 `
@@ -30,13 +31,19 @@ WHERE name = ?
 func (q *Queries) GetAllergenByName(ctx context.Context, name string) (Allergen, error) {
 	row := q.db.QueryRowContext(ctx, getAllergenByName, name)
 	var i Allergen
-	err := row.Scan(&i.ID, &i.Name)
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Code,
+		&i.Kind,
+	)
 	return i, err
 }
 
 const listAllergenByDishID = `-- name: ListAllergenByDishID :many
 SELECT DISTINCT allergens.id,
   allergens.name,
+  allergens.kind,
   dishes_allergens.category
 FROM allergens
   JOIN dishes_allergens ON allergens.id = dishes_allergens.allergen_id
@@ -47,6 +54,7 @@ ORDER BY allergens.name
 type ListAllergenByDishIDRow struct {
 	ID       int32  `json:"id"`
 	Name     string `json:"name"`
+	Kind     string `json:"kind"`
 	Category int32  `json:"category"`
 }
 
@@ -59,7 +67,71 @@ func (q *Queries) ListAllergenByDishID(ctx context.Context, dishID string) ([]Li
 	items := []ListAllergenByDishIDRow{}
 	for rows.Next() {
 		var i ListAllergenByDishIDRow
-		if err := rows.Scan(&i.ID, &i.Name, &i.Category); err != nil {
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Kind,
+			&i.Category,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAllergenByDishIDs = `-- name: ListAllergenByDishIDs :many
+SELECT dishes_allergens.dish_id,
+  allergens.id,
+  allergens.name,
+  allergens.kind,
+  dishes_allergens.category
+FROM allergens
+  JOIN dishes_allergens ON allergens.id = dishes_allergens.allergen_id
+WHERE dishes_allergens.dish_id IN (/*SLICE:dish_ids*/?)
+ORDER BY allergens.name
+`
+
+type ListAllergenByDishIDsRow struct {
+	DishID   string `json:"dish_id"`
+	ID       int32  `json:"id"`
+	Name     string `json:"name"`
+	Kind     string `json:"kind"`
+	Category int32  `json:"category"`
+}
+
+func (q *Queries) ListAllergenByDishIDs(ctx context.Context, dishIds []string) ([]ListAllergenByDishIDsRow, error) {
+	query := listAllergenByDishIDs
+	var queryParams []interface{}
+	if len(dishIds) > 0 {
+		for _, v := range dishIds {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:dish_ids*/?", strings.Repeat(",?", len(dishIds))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:dish_ids*/?", "NULL", 1)
+	}
+	rows, err := q.db.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListAllergenByDishIDsRow{}
+	for rows.Next() {
+		var i ListAllergenByDishIDsRow
+		if err := rows.Scan(
+			&i.DishID,
+			&i.ID,
+			&i.Name,
+			&i.Kind,
+			&i.Category,
+		); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -76,6 +148,7 @@ func (q *Queries) ListAllergenByDishID(ctx context.Context, dishID string) ([]Li
 const listAllergenInDish = `-- name: ListAllergenInDish :many
 SELECT DISTINCT allergens.id,
   allergens.name,
+  allergens.kind,
   dishes_allergens.category
 FROM allergens
   JOIN dishes_allergens ON allergens.id = dishes_allergens.allergen_id
@@ -86,6 +159,7 @@ ORDER BY allergens.name
 type ListAllergenInDishRow struct {
 	ID       int32  `json:"id"`
 	Name     string `json:"name"`
+	Kind     string `json:"kind"`
 	Category int32  `json:"category"`
 }
 
@@ -108,7 +182,240 @@ func (q *Queries) ListAllergenInDish(ctx context.Context, dishIds []string) ([]L
 	items := []ListAllergenInDishRow{}
 	for rows.Next() {
 		var i ListAllergenInDishRow
-		if err := rows.Scan(&i.ID, &i.Name, &i.Category); err != nil {
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Kind,
+			&i.Category,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAllergenInDishByAllergenIDs = `-- name: ListAllergenInDishByAllergenIDs :many
+SELECT dishes_allergens.dish_id,
+  allergens.id,
+  allergens.name,
+  allergens.kind,
+  dishes_allergens.category
+FROM allergens
+  JOIN dishes_allergens ON allergens.id = dishes_allergens.allergen_id
+WHERE dishes_allergens.dish_id IN (/*SLICE:dish_ids*/?)
+  AND dishes_allergens.allergen_id IN (/*SLICE:allergen_ids*/?)
+ORDER BY allergens.name
+`
+
+type ListAllergenInDishByAllergenIDsParams struct {
+	DishIds     []string `json:"dish_ids"`
+	AllergenIds []int32  `json:"allergen_ids"`
+}
+
+type ListAllergenInDishByAllergenIDsRow struct {
+	DishID   string `json:"dish_id"`
+	ID       int32  `json:"id"`
+	Name     string `json:"name"`
+	Kind     string `json:"kind"`
+	Category int32  `json:"category"`
+}
+
+func (q *Queries) ListAllergenInDishByAllergenIDs(ctx context.Context, arg ListAllergenInDishByAllergenIDsParams) ([]ListAllergenInDishByAllergenIDsRow, error) {
+	query := listAllergenInDishByAllergenIDs
+	var queryParams []interface{}
+	if len(arg.DishIds) > 0 {
+		for _, v := range arg.DishIds {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:dish_ids*/?", strings.Repeat(",?", len(arg.DishIds))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:dish_ids*/?", "NULL", 1)
+	}
+	if len(arg.AllergenIds) > 0 {
+		for _, v := range arg.AllergenIds {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:allergen_ids*/?", strings.Repeat(",?", len(arg.AllergenIds))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:allergen_ids*/?", "NULL", 1)
+	}
+	rows, err := q.db.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListAllergenInDishByAllergenIDsRow{}
+	for rows.Next() {
+		var i ListAllergenInDishByAllergenIDsRow
+		if err := rows.Scan(
+			&i.DishID,
+			&i.ID,
+			&i.Name,
+			&i.Kind,
+			&i.Category,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAllergenMatrixByCity = `-- name: ListAllergenMatrixByCity :many
+SELECT m.id AS menu_id,
+  m.offered_at,
+  d.id AS dish_id,
+  d.name AS dish_name,
+  allergens.id AS allergen_id,
+  allergens.name AS allergen_name,
+  allergens.kind AS allergen_kind,
+  dishes_allergens.category
+FROM menus AS m
+  INNER JOIN menu_dishes AS md ON m.id = md.menu_id
+  INNER JOIN dishes AS d ON md.dish_id = d.id
+  LEFT JOIN dishes_allergens ON d.id = dishes_allergens.dish_id
+  LEFT JOIN allergens ON dishes_allergens.allergen_id = allergens.id
+WHERE m.city_code = ?
+  AND m.offered_at BETWEEN ? AND ?
+ORDER BY m.offered_at,
+  m.id,
+  d.id,
+  allergens.id,
+  dishes_allergens.category
+`
+
+type ListAllergenMatrixByCityParams struct {
+	CityCode int32     `json:"city_code"`
+	StartAt  time.Time `json:"start_at"`
+	EndAt    time.Time `json:"end_at"`
+}
+
+type ListAllergenMatrixByCityRow struct {
+	MenuID       string         `json:"menu_id"`
+	OfferedAt    time.Time      `json:"offered_at"`
+	DishID       string         `json:"dish_id"`
+	DishName     string         `json:"dish_name"`
+	AllergenID   sql.NullInt32  `json:"allergen_id"`
+	AllergenName sql.NullString `json:"allergen_name"`
+	AllergenKind sql.NullString `json:"allergen_kind"`
+	Category     sql.NullInt32  `json:"category"`
+}
+
+func (q *Queries) ListAllergenMatrixByCity(ctx context.Context, arg ListAllergenMatrixByCityParams) ([]ListAllergenMatrixByCityRow, error) {
+	rows, err := q.db.QueryContext(ctx, listAllergenMatrixByCity, arg.CityCode, arg.StartAt, arg.EndAt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListAllergenMatrixByCityRow{}
+	for rows.Next() {
+		var i ListAllergenMatrixByCityRow
+		if err := rows.Scan(
+			&i.MenuID,
+			&i.OfferedAt,
+			&i.DishID,
+			&i.DishName,
+			&i.AllergenID,
+			&i.AllergenName,
+			&i.AllergenKind,
+			&i.Category,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAllergensByIDs = `-- name: ListAllergensByIDs :many
+SELECT id,
+  name,
+  kind
+FROM allergens
+WHERE id IN (/*SLICE:ids*/?)
+`
+
+type ListAllergensByIDsRow struct {
+	ID   int32  `json:"id"`
+	Name string `json:"name"`
+	Kind string `json:"kind"`
+}
+
+func (q *Queries) ListAllergensByIDs(ctx context.Context, ids []int32) ([]ListAllergensByIDsRow, error) {
+	query := listAllergensByIDs
+	var queryParams []interface{}
+	if len(ids) > 0 {
+		for _, v := range ids {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:ids*/?", strings.Repeat(",?", len(ids))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:ids*/?", "NULL", 1)
+	}
+	rows, err := q.db.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListAllergensByIDsRow{}
+	for rows.Next() {
+		var i ListAllergensByIDsRow
+		if err := rows.Scan(&i.ID, &i.Name, &i.Kind); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listStandardAllergens = `-- name: ListStandardAllergens :many
+SELECT id, name, code, kind
+FROM allergens
+WHERE code IS NOT NULL
+ORDER BY FIELD(kind, 'specified', 'recommended', 'other'),
+  code
+`
+
+func (q *Queries) ListStandardAllergens(ctx context.Context) ([]Allergen, error) {
+	rows, err := q.db.QueryContext(ctx, listStandardAllergens)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Allergen{}
+	for rows.Next() {
+		var i Allergen
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Code,
+			&i.Kind,
+		); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
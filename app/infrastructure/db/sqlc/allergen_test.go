@@ -102,6 +102,151 @@ func TestListAllergenInDish(t *testing.T) {
 	require.ElementsMatch(t, allergensNames, resNames)
 }
 
+func TestListAllergenByDishIDs(t *testing.T) {
+	cityCode := util.RandomCityCode()
+	menu := createRandomMenu(t, cityCode)
+	dish := createRandomDish(t, menu.ID)
+	other := createRandomDish(t, menu.ID)
+
+	allergens := createRandomAllergens(t, 3)
+
+	for _, allergen := range allergens[:2] {
+		createRandomDishesAllergens(t, dish.ID, allergen.ID, int32(allergen.Category))
+	}
+
+	createRandomDishesAllergens(t, other.ID, allergens[2].ID, int32(allergens[2].Category))
+
+	res, err := testQuery.ListAllergenByDishIDs(context.Background(), []string{dish.ID, other.ID})
+
+	require.NoError(t, err)
+	require.Len(t, res, 3)
+
+	for _, row := range res {
+		if row.ID == allergens[2].ID {
+			require.Equal(t, other.ID, row.DishID)
+		} else {
+			require.Equal(t, dish.ID, row.DishID)
+		}
+	}
+}
+
+func TestListAllergenInDishByAllergenIDs(t *testing.T) {
+	cityCode := util.RandomCityCode()
+	menu := createRandomMenu(t, cityCode)
+	dish := createRandomDish(t, menu.ID)
+	other := createRandomDish(t, menu.ID)
+
+	allergens := createRandomAllergens(t, 3)
+
+	for _, allergen := range allergens {
+		createRandomDishesAllergens(t, dish.ID, allergen.ID, int32(allergen.Category))
+	}
+
+	createRandomDishesAllergens(t, other.ID, allergens[2].ID, int32(allergens[2].Category))
+
+	arg := ListAllergenInDishByAllergenIDsParams{
+		DishIds:     []string{dish.ID, other.ID},
+		AllergenIds: []int32{allergens[0].ID, allergens[1].ID},
+	}
+
+	res, err := testQuery.ListAllergenInDishByAllergenIDs(context.Background(), arg)
+
+	require.NoError(t, err)
+	require.Len(t, res, 2)
+
+	for _, row := range res {
+		require.Equal(t, dish.ID, row.DishID)
+		require.Contains(t, arg.AllergenIds, row.ID)
+	}
+}
+
+func TestListAllergenMatrixByCity(t *testing.T) {
+	cityCode := util.RandomCityCode()
+	menu := createRandomMenu(t, cityCode)
+	dish := createRandomDish(t, menu.ID)
+	other := createRandomDish(t, menu.ID)
+
+	allergens := createRandomAllergens(t, 2)
+
+	createRandomDishesAllergens(t, dish.ID, allergens[0].ID, 0)
+	createRandomDishesAllergens(t, dish.ID, allergens[1].ID, 1)
+
+	arg := ListAllergenMatrixByCityParams{
+		CityCode: cityCode,
+		StartAt:  menu.OfferedAt,
+		EndAt:    menu.OfferedAt,
+	}
+
+	res, err := testQuery.ListAllergenMatrixByCity(context.Background(), arg)
+
+	require.NoError(t, err)
+	require.Len(t, res, 3)
+
+	for _, row := range res {
+		require.Equal(t, menu.ID, row.MenuID)
+
+		// アレルゲンのない料理も行として返す
+		if row.DishID == other.ID {
+			require.False(t, row.AllergenID.Valid)
+			require.False(t, row.Category.Valid)
+			continue
+		}
+
+		require.Equal(t, dish.ID, row.DishID)
+		require.True(t, row.AllergenID.Valid)
+
+		if row.AllergenID.Int32 == allergens[0].ID {
+			require.Equal(t, int32(0), row.Category.Int32)
+		} else {
+			require.Equal(t, allergens[1].ID, row.AllergenID.Int32)
+			require.Equal(t, int32(1), row.Category.Int32)
+		}
+	}
+
+	arg.StartAt = menu.OfferedAt.AddDate(0, 0, 1)
+	arg.EndAt = menu.OfferedAt.AddDate(0, 0, 1)
+
+	res, err = testQuery.ListAllergenMatrixByCity(context.Background(), arg)
+
+	require.NoError(t, err)
+	require.Empty(t, res)
+}
+
+func TestListAllergensByIDs(t *testing.T) {
+	allergens := createRandomAllergens(t, 2)
+
+	ids := []int32{allergens[0].ID, allergens[1].ID, -1}
+
+	res, err := testQuery.ListAllergensByIDs(context.Background(), ids)
+
+	require.NoError(t, err)
+	require.Len(t, res, 2)
+
+	for _, row := range res {
+		require.Contains(t, ids[:2], row.ID)
+		require.NotEmpty(t, row.Name)
+	}
+}
+
+func TestListStandardAllergens(t *testing.T) {
+	res, err := testQuery.ListStandardAllergens(context.Background())
+
+	require.NoError(t, err)
+
+	// 特定原材料8品目と特定原材料に準ずるもの20品目
+	require.Len(t, res, 28)
+
+	for i, allergen := range res {
+		require.True(t, allergen.Code.Valid)
+
+		if i < 8 {
+			require.Equal(t, string(domain.AllergenKindSpecified), allergen.Kind)
+		} else {
+			require.Equal(t, string(domain.AllergenKindRecommended), allergen.Kind)
+		}
+	}
+}
+
 func createRandomAllergen(t *testing.T, name string, category int32) *domain.Allergen {
 
 	ctx := context.Background()
@@ -118,7 +263,7 @@ func createRandomAllergen(t *testing.T, name string, category int32) *domain.All
 
 	require.Equal(t, name, res.Name)
 
-	return domain.ReNewAllergen(res.ID, res.Name, category)
+	return domain.ReNewAllergen(res.ID, res.Name, domain.AllergenKind(res.Kind), domain.AllergenCategory(category))
 }
 
 func createRandomAllergens(t *testing.T, length int) []*domain.Allergen {
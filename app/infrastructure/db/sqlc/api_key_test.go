@@ -0,0 +1,66 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ogurilab/school-lunch-api/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateApiKey(t *testing.T) {
+	createRandomApiKey(t)
+}
+
+func TestGetApiKeyByHashedKey(t *testing.T) {
+	key := createRandomApiKey(t)
+
+	key2, err := testQuery.GetApiKeyByHashedKey(context.Background(), key.HashedKey)
+
+	require.NoError(t, err)
+	require.Equal(t, key.ID, key2.ID)
+	require.Equal(t, key.Prefix, key2.Prefix)
+}
+
+func TestRevokeApiKey(t *testing.T) {
+	key := createRandomApiKey(t)
+
+	affected, err := testQuery.RevokeApiKey(context.Background(), key.ID)
+
+	require.NoError(t, err)
+	require.Equal(t, int64(1), affected)
+
+	key2, err := testQuery.GetApiKey(context.Background(), key.ID)
+
+	require.NoError(t, err)
+	require.True(t, key2.Revoked)
+}
+
+func createRandomApiKey(t *testing.T) ApiKey {
+	arg := CreateApiKeyParams{
+		Name:       util.RandomString(10),
+		Prefix:     "slk_" + util.RandomString(8),
+		HashedKey:  util.HashAPIKey(util.RandomString(32)),
+		RateLimit:  60,
+		Burst:      10,
+		DailyQuota: 1000,
+	}
+
+	id, err := testQuery.CreateApiKey(context.Background(), arg)
+
+	require.NoError(t, err)
+
+	key, err := testQuery.GetApiKey(context.Background(), int32(id))
+
+	require.NoError(t, err)
+	require.Equal(t, arg.Name, key.Name)
+	require.Equal(t, arg.Prefix, key.Prefix)
+	require.Equal(t, arg.HashedKey, key.HashedKey)
+	require.Equal(t, arg.RateLimit, key.RateLimit)
+	require.Equal(t, arg.Burst, key.Burst)
+	require.Equal(t, arg.DailyQuota, key.DailyQuota)
+	require.False(t, key.Revoked)
+	require.NotZero(t, key.CreatedAt)
+
+	return key
+}
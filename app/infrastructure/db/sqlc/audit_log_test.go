@@ -0,0 +1,80 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/ogurilab/school-lunch-api/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateAuditLog(t *testing.T) {
+	createRandomAuditLog(t, util.RandomCityCode())
+}
+
+func TestListAuditLogs(t *testing.T) {
+	cityCode := util.RandomCityCode()
+	entityID := util.RandomUlid()
+
+	for i := 0; i < 3; i++ {
+		arg := randomCreateAuditLogParams(cityCode)
+		arg.EntityID = entityID
+
+		err := testQuery.CreateAuditLog(context.Background(), arg)
+		require.NoError(t, err)
+	}
+
+	arg := ListAuditLogsParams{
+		CityCode:    sql.NullInt32{Int32: cityCode, Valid: true},
+		EntityType:  sql.NullString{String: "menu", Valid: true},
+		EntityID:    sql.NullString{String: entityID, Valid: true},
+		CreatedFrom: sql.NullTime{Time: time.Now().Add(-time.Hour), Valid: true},
+		Limit:       10,
+		Offset:      0,
+	}
+
+	logs, err := testQuery.ListAuditLogs(context.Background(), arg)
+
+	require.NoError(t, err)
+	require.Len(t, logs, 3)
+
+	for _, log := range logs {
+		require.Equal(t, entityID, log.EntityID)
+		require.Equal(t, cityCode, log.CityCode.Int32)
+		require.JSONEq(t, `{"name": "after"}`, string(log.AfterData))
+	}
+
+	// 範囲外の日時を指定した場合は取得しない
+	arg.CreatedFrom = sql.NullTime{}
+	arg.CreatedTo = sql.NullTime{Time: time.Now().Add(-time.Hour), Valid: true}
+
+	logs, err = testQuery.ListAuditLogs(context.Background(), arg)
+
+	require.NoError(t, err)
+	require.Empty(t, logs)
+}
+
+func createRandomAuditLog(t *testing.T, cityCode int32) {
+	arg := randomCreateAuditLogParams(cityCode)
+
+	err := testQuery.CreateAuditLog(context.Background(), arg)
+
+	require.NoError(t, err)
+}
+
+func randomCreateAuditLogParams(cityCode int32) CreateAuditLogParams {
+	return CreateAuditLogParams{
+		ActorID:       util.RandomInt32(),
+		ActorUsername: util.RandomString(10),
+		Action:        "update",
+		EntityType:    "menu",
+		EntityID:      util.RandomUlid(),
+		CityCode:      sql.NullInt32{Int32: cityCode, Valid: true},
+		BeforeData:    json.RawMessage(`{"name": "before"}`),
+		AfterData:     json.RawMessage(`{"name": "after"}`),
+		RequestID:     util.RandomString(20),
+	}
+}
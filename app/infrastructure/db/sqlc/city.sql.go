@@ -198,3 +198,31 @@ func (q *Queries) UpdateAvailable(ctx context.Context, cityCode int32) error {
 	_, err := q.db.ExecContext(ctx, updateAvailable, cityCode)
 	return err
 }
+
+const updateCity = `-- name: UpdateCity :exec
+UPDATE cities
+SET city_name = ?,
+  prefecture_code = ?,
+  prefecture_name = ?,
+  school_lunch_info_available = ?
+WHERE city_code = ?
+`
+
+type UpdateCityParams struct {
+	CityName                 string `json:"city_name"`
+	PrefectureCode           int32  `json:"prefecture_code"`
+	PrefectureName           string `json:"prefecture_name"`
+	SchoolLunchInfoAvailable bool   `json:"school_lunch_info_available"`
+	CityCode                 int32  `json:"city_code"`
+}
+
+func (q *Queries) UpdateCity(ctx context.Context, arg UpdateCityParams) error {
+	_, err := q.db.ExecContext(ctx, updateCity,
+		arg.CityName,
+		arg.PrefectureCode,
+		arg.PrefectureName,
+		arg.SchoolLunchInfoAvailable,
+		arg.CityCode,
+	)
+	return err
+}
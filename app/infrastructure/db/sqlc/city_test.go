@@ -32,6 +32,30 @@ func TestUpdateAvailable(t *testing.T) {
 	require.Equal(t, city2.SchoolLunchInfoAvailable, true)
 }
 
+func TestUpdateCity(t *testing.T) {
+	city := createRandomCity(t)
+
+	arg := UpdateCityParams{
+		CityName:                 util.RandomString(10),
+		PrefectureCode:           util.RandomInt32(),
+		PrefectureName:           util.RandomString(10),
+		SchoolLunchInfoAvailable: true,
+		CityCode:                 city.CityCode,
+	}
+
+	err := testQuery.UpdateCity(context.Background(), arg)
+
+	require.NoError(t, err)
+
+	city2, err := testQuery.GetCity(context.Background(), city.CityCode)
+
+	require.NoError(t, err)
+	require.Equal(t, arg.CityName, city2.CityName)
+	require.Equal(t, arg.PrefectureCode, city2.PrefectureCode)
+	require.Equal(t, arg.PrefectureName, city2.PrefectureName)
+	require.True(t, city2.SchoolLunchInfoAvailable)
+}
+
 func TestGetCity(t *testing.T) {
 	city := createRandomCity(t)
 	city2, err := testQuery.GetCity(context.Background(), city.CityCode)
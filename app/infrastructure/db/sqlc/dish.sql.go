@@ -7,6 +7,7 @@ package db
 
 import (
 	"context"
+	"strings"
 )
 
 const createDish = `-- name: CreateDish :exec
@@ -24,6 +25,19 @@ func (q *Queries) CreateDish(ctx context.Context, arg CreateDishParams) error {
 	return err
 }
 
+const deleteDish = `-- name: DeleteDish :execrows
+DELETE FROM dishes
+WHERE id = ?
+`
+
+func (q *Queries) DeleteDish(ctx context.Context, id string) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteDish, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const getDish = `-- name: GetDish :many
 SELECT dishes.id,
   dishes.name,
@@ -70,6 +84,25 @@ func (q *Queries) GetDish(ctx context.Context, arg GetDishParams) ([]GetDishRow,
 	return items, nil
 }
 
+const getDishByID = `-- name: GetDishByID :one
+SELECT dishes.id,
+  dishes.name
+FROM dishes
+WHERE id = ?
+`
+
+type GetDishByIDRow struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+func (q *Queries) GetDishByID(ctx context.Context, id string) (GetDishByIDRow, error) {
+	row := q.db.QueryRowContext(ctx, getDishByID, id)
+	var i GetDishByIDRow
+	err := row.Scan(&i.ID, &i.Name)
+	return i, err
+}
+
 const getDishInCity = `-- name: GetDishInCity :many
 SELECT dishes.id,
   dishes.name,
@@ -247,3 +280,65 @@ func (q *Queries) ListDishByName(ctx context.Context, arg ListDishByNameParams)
 	}
 	return items, nil
 }
+
+const listDishInNames = `-- name: ListDishInNames :many
+SELECT dishes.id,
+  dishes.name
+FROM dishes
+WHERE name IN (/*SLICE:names*/?)
+ORDER BY id
+`
+
+type ListDishInNamesRow struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+func (q *Queries) ListDishInNames(ctx context.Context, names []string) ([]ListDishInNamesRow, error) {
+	query := listDishInNames
+	var queryParams []interface{}
+	if len(names) > 0 {
+		for _, v := range names {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:names*/?", strings.Repeat(",?", len(names))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:names*/?", "NULL", 1)
+	}
+	rows, err := q.db.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListDishInNamesRow{}
+	for rows.Next() {
+		var i ListDishInNamesRow
+		if err := rows.Scan(&i.ID, &i.Name); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateDishName = `-- name: UpdateDishName :exec
+UPDATE dishes
+SET name = ?
+WHERE id = ?
+`
+
+type UpdateDishNameParams struct {
+	Name string `json:"name"`
+	ID   string `json:"id"`
+}
+
+func (q *Queries) UpdateDishName(ctx context.Context, arg UpdateDishNameParams) error {
+	_, err := q.db.ExecContext(ctx, updateDishName, arg.Name, arg.ID)
+	return err
+}
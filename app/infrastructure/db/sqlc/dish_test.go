@@ -2,6 +2,8 @@ package db
 
 import (
 	"context"
+	"database/sql"
+	"slices"
 	"testing"
 
 	"github.com/ogurilab/school-lunch-api/domain"
@@ -158,6 +160,64 @@ func TestFetchDishes(t *testing.T) {
 	}
 }
 
+func TestUpdateDishName(t *testing.T) {
+	cityCode := util.RandomCityCode()
+	menu := createRandomMenu(t, cityCode)
+	dish := createRandomDish(t, menu.ID)
+
+	arg := UpdateDishNameParams{
+		Name: util.RandomString(10),
+		ID:   dish.ID,
+	}
+
+	err := testQuery.UpdateDishName(context.Background(), arg)
+
+	require.NoError(t, err)
+
+	res, err := testQuery.GetDishByID(context.Background(), dish.ID)
+
+	require.NoError(t, err)
+	require.Equal(t, dish.ID, res.ID)
+	require.Equal(t, arg.Name, res.Name)
+}
+
+func TestDeleteDish(t *testing.T) {
+	dish := createRandomDish(t, util.RandomUlid())
+
+	affected, err := testQuery.DeleteDish(context.Background(), dish.ID)
+
+	require.NoError(t, err)
+	require.Equal(t, int64(1), affected)
+
+	_, err = testQuery.GetDishByID(context.Background(), dish.ID)
+
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestListCityCodeByDishID(t *testing.T) {
+	dish := createRandomDish(t, util.RandomUlid())
+
+	cityCodes := []int32{util.RandomCityCode(), util.RandomCityCode()}
+
+	for _, cityCode := range cityCodes {
+		createMenuDishesByDishID(t, dish.ID, cityCode, 2)
+	}
+
+	results, err := testQuery.ListCityCodeByDishID(context.Background(), dish.ID)
+
+	require.NoError(t, err)
+
+	expected := make([]int32, 0, len(cityCodes))
+
+	for _, cityCode := range cityCodes {
+		if !slices.Contains(expected, cityCode) {
+			expected = append(expected, cityCode)
+		}
+	}
+
+	require.ElementsMatch(t, expected, results)
+}
+
 func createMenuDishesByDishID(t *testing.T, dishID string, cityCode int32, length int) []string {
 
 	menus := make([]*domain.Menu, 0, length)
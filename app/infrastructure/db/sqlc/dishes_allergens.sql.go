@@ -7,6 +7,7 @@ package db
 
 import (
 	"context"
+	"strings"
 )
 
 const createDishesAllergens = `-- name: CreateDishesAllergens :exec
@@ -28,3 +29,197 @@ func (q *Queries) CreateDishesAllergens(ctx context.Context, arg CreateDishesAll
 	_, err := q.db.ExecContext(ctx, createDishesAllergens, arg.DishID, arg.AllergenID, arg.Category)
 	return err
 }
+
+const deleteDishesAllergens = `-- name: DeleteDishesAllergens :execrows
+DELETE FROM dishes_allergens
+WHERE dish_id = ?
+  AND allergen_id = ?
+  AND category = ?
+`
+
+type DeleteDishesAllergensParams struct {
+	DishID     string `json:"dish_id"`
+	AllergenID int32  `json:"allergen_id"`
+	Category   int32  `json:"category"`
+}
+
+func (q *Queries) DeleteDishesAllergens(ctx context.Context, arg DeleteDishesAllergensParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteDishesAllergens, arg.DishID, arg.AllergenID, arg.Category)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteDishesAllergensByDishID = `-- name: DeleteDishesAllergensByDishID :execrows
+DELETE FROM dishes_allergens
+WHERE dish_id = ?
+`
+
+func (q *Queries) DeleteDishesAllergensByDishID(ctx context.Context, dishID string) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteDishesAllergensByDishID, dishID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const listDishByAllergenID = `-- name: ListDishByAllergenID :many
+SELECT DISTINCT dishes.id,
+  dishes.name
+FROM dishes
+  INNER JOIN dishes_allergens ON dishes.id = dishes_allergens.dish_id
+WHERE dishes_allergens.allergen_id = ?
+ORDER BY dishes.id
+LIMIT ? OFFSET ?
+`
+
+type ListDishByAllergenIDParams struct {
+	AllergenID int32 `json:"allergen_id"`
+	Limit      int32 `json:"limit"`
+	Offset     int32 `json:"offset"`
+}
+
+type ListDishByAllergenIDRow struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+func (q *Queries) ListDishByAllergenID(ctx context.Context, arg ListDishByAllergenIDParams) ([]ListDishByAllergenIDRow, error) {
+	rows, err := q.db.QueryContext(ctx, listDishByAllergenID, arg.AllergenID, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListDishByAllergenIDRow{}
+	for rows.Next() {
+		var i ListDishByAllergenIDRow
+		if err := rows.Scan(&i.ID, &i.Name); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listDishByAllergenIDInCity = `-- name: ListDishByAllergenIDInCity :many
+SELECT DISTINCT dishes.id,
+  dishes.name
+FROM dishes
+  INNER JOIN dishes_allergens ON dishes.id = dishes_allergens.dish_id
+  INNER JOIN menu_dishes ON dishes.id = menu_dishes.dish_id
+  INNER JOIN menus ON menu_dishes.menu_id = menus.id
+WHERE dishes_allergens.allergen_id = ?
+  AND menus.city_code = ?
+ORDER BY dishes.id
+LIMIT ? OFFSET ?
+`
+
+type ListDishByAllergenIDInCityParams struct {
+	AllergenID int32 `json:"allergen_id"`
+	CityCode   int32 `json:"city_code"`
+	Limit      int32 `json:"limit"`
+	Offset     int32 `json:"offset"`
+}
+
+type ListDishByAllergenIDInCityRow struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+func (q *Queries) ListDishByAllergenIDInCity(ctx context.Context, arg ListDishByAllergenIDInCityParams) ([]ListDishByAllergenIDInCityRow, error) {
+	rows, err := q.db.QueryContext(ctx, listDishByAllergenIDInCity,
+		arg.AllergenID,
+		arg.CityCode,
+		arg.Limit,
+		arg.Offset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListDishByAllergenIDInCityRow{}
+	for rows.Next() {
+		var i ListDishByAllergenIDInCityRow
+		if err := rows.Scan(&i.ID, &i.Name); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listDishByNameExcludingAllergens = `-- name: ListDishByNameExcludingAllergens :many
+SELECT dishes.id,
+  dishes.name
+FROM dishes
+WHERE dishes.name LIKE ?
+  AND NOT EXISTS (
+    SELECT 1
+    FROM dishes_allergens
+    WHERE dishes_allergens.dish_id = dishes.id
+      AND dishes_allergens.allergen_id IN (/*SLICE:allergen_ids*/?)
+  )
+ORDER BY dishes.id
+LIMIT ? OFFSET ?
+`
+
+type ListDishByNameExcludingAllergensParams struct {
+	Name        string  `json:"name"`
+	AllergenIds []int32 `json:"allergen_ids"`
+	Limit       int32   `json:"limit"`
+	Offset      int32   `json:"offset"`
+}
+
+type ListDishByNameExcludingAllergensRow struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+func (q *Queries) ListDishByNameExcludingAllergens(ctx context.Context, arg ListDishByNameExcludingAllergensParams) ([]ListDishByNameExcludingAllergensRow, error) {
+	query := listDishByNameExcludingAllergens
+	var queryParams []interface{}
+	queryParams = append(queryParams, arg.Name)
+	if len(arg.AllergenIds) > 0 {
+		for _, v := range arg.AllergenIds {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:allergen_ids*/?", strings.Repeat(",?", len(arg.AllergenIds))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:allergen_ids*/?", "NULL", 1)
+	}
+	queryParams = append(queryParams, arg.Limit)
+	queryParams = append(queryParams, arg.Offset)
+	rows, err := q.db.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListDishByNameExcludingAllergensRow{}
+	for rows.Next() {
+		var i ListDishByNameExcludingAllergensRow
+		if err := rows.Scan(&i.ID, &i.Name); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -2,8 +2,10 @@ package db
 
 import (
 	"context"
+	"database/sql"
 	"testing"
 
+	"github.com/ogurilab/school-lunch-api/domain"
 	"github.com/ogurilab/school-lunch-api/util"
 	"github.com/stretchr/testify/require"
 )
@@ -19,6 +21,138 @@ func TestCreateDishesAllergens(t *testing.T) {
 	createRandomDishesAllergens(t, dish.ID, allergen.ID, category)
 }
 
+func TestDeleteDishesAllergens(t *testing.T) {
+	city := createRandomCity(t)
+	menu := createRandomMenu(t, city.CityCode)
+	dish := createRandomDish(t, menu.ID)
+	name := util.RandomString(50)
+	category := util.RandomInt32()
+	allergen := createRandomAllergen(t, name, category)
+
+	createRandomDishesAllergens(t, dish.ID, allergen.ID, category)
+
+	arg := DeleteDishesAllergensParams{
+		DishID:     dish.ID,
+		AllergenID: allergen.ID,
+		Category:   category,
+	}
+
+	affected, err := testQuery.DeleteDishesAllergens(context.Background(), arg)
+
+	require.NoError(t, err)
+	require.Equal(t, int64(1), affected)
+
+	_, err = testQuery.getDishesAllergens(dish.ID, allergen.ID, category)
+
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	// 既に削除済みの場合は影響行数が0になる
+	affected, err = testQuery.DeleteDishesAllergens(context.Background(), arg)
+
+	require.NoError(t, err)
+	require.Equal(t, int64(0), affected)
+}
+
+func TestListDishByAllergenID(t *testing.T) {
+	city := createRandomCity(t)
+	menu := createRandomMenu(t, city.CityCode)
+	allergen := createRandomAllergen(t, util.RandomString(50), 0)
+
+	n := 3
+	for i := 0; i < n; i++ {
+		dish := createRandomDish(t, menu.ID)
+		createRandomDishesAllergens(t, dish.ID, allergen.ID, int32(i%2))
+	}
+
+	// アレルゲンを含まない料理は結果に含まれない
+	createRandomDish(t, menu.ID)
+
+	arg := ListDishByAllergenIDParams{
+		AllergenID: allergen.ID,
+		Limit:      10,
+		Offset:     0,
+	}
+
+	dishes, err := testQuery.ListDishByAllergenID(context.Background(), arg)
+
+	require.NoError(t, err)
+	require.Len(t, dishes, n)
+}
+
+func TestListDishByAllergenIDInCity(t *testing.T) {
+	city := createRandomCity(t)
+	otherCity := createRandomCity(t)
+	menu := createRandomMenu(t, city.CityCode)
+	otherMenu := createRandomMenu(t, otherCity.CityCode)
+	allergen := createRandomAllergen(t, util.RandomString(50), 0)
+
+	dish := createRandomDish(t, menu.ID)
+	createRandomDishesAllergens(t, dish.ID, allergen.ID, 0)
+
+	otherDish := createRandomDish(t, otherMenu.ID)
+	createRandomDishesAllergens(t, otherDish.ID, allergen.ID, 0)
+
+	arg := ListDishByAllergenIDInCityParams{
+		AllergenID: allergen.ID,
+		CityCode:   city.CityCode,
+		Limit:      10,
+		Offset:     0,
+	}
+
+	dishes, err := testQuery.ListDishByAllergenIDInCity(context.Background(), arg)
+
+	require.NoError(t, err)
+	require.Len(t, dishes, 1)
+	require.Equal(t, dish.ID, dishes[0].ID)
+	require.Equal(t, dish.Name, dishes[0].Name)
+}
+
+func TestListDishByNameExcludingAllergens(t *testing.T) {
+	city := createRandomCity(t)
+	menu := createRandomMenu(t, city.CityCode)
+	allergens := createRandomAllergens(t, 2)
+
+	safe := createRandomDish(t, menu.ID)
+	contains := createRandomDish(t, menu.ID)
+	mayContain := createRandomDish(t, menu.ID)
+
+	createRandomDishesAllergens(t, contains.ID, allergens[0].ID, 0)
+	createRandomDishesAllergens(t, mayContain.ID, allergens[1].ID, 1)
+
+	allergenIDs := []int32{allergens[0].ID, allergens[1].ID}
+
+	testCases := []struct {
+		dish     *domain.Dish
+		excluded bool
+	}{
+		{dish: safe, excluded: false},
+		{dish: contains, excluded: true},
+		{dish: mayContain, excluded: true},
+	}
+
+	for _, tc := range testCases {
+		arg := ListDishByNameExcludingAllergensParams{
+			Name:        "%" + tc.dish.Name + "%",
+			AllergenIds: allergenIDs,
+			Limit:       10,
+			Offset:      0,
+		}
+
+		dishes, err := testQuery.ListDishByNameExcludingAllergens(context.Background(), arg)
+
+		require.NoError(t, err)
+
+		found := false
+		for _, d := range dishes {
+			if d.ID == tc.dish.ID {
+				found = true
+			}
+		}
+
+		require.Equal(t, !tc.excluded, found)
+	}
+}
+
 func createRandomDishesAllergens(t *testing.T, dishID string, allergenID int32, category int32) error {
 	ctx := context.Background()
 
@@ -0,0 +1,139 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/ogurilab/school-lunch-api/domain"
+	"github.com/ogurilab/school-lunch-api/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateExternalDataSource(t *testing.T) {
+	createRandomExternalDataSource(t, domain.DataSourceStatusInactive)
+}
+
+func TestUpdateExternalDataSource(t *testing.T) {
+	source := createRandomExternalDataSource(t, domain.DataSourceStatusInactive)
+
+	arg := UpdateExternalDataSourceParams{
+		CityCode:    util.RandomCityCode(),
+		DatasetID:   util.RandomURL(),
+		Year:        source.Year + 1,
+		Status:      domain.DataSourceStatusActive,
+		Category:    domain.DataSourceCategoryMenu,
+		Description: sql.NullString{String: util.RandomString(10), Valid: true},
+		SourceID:    source.SourceID,
+	}
+
+	err := testQuery.UpdateExternalDataSource(context.Background(), arg)
+
+	require.NoError(t, err)
+
+	source2, err := testQuery.GetExternalDataSource(context.Background(), source.SourceID)
+
+	require.NoError(t, err)
+	require.Equal(t, arg.CityCode, source2.CityCode)
+	require.Equal(t, arg.DatasetID, source2.DatasetID)
+	require.Equal(t, arg.Year, source2.Year)
+	require.Equal(t, arg.Status, source2.Status)
+	require.Equal(t, arg.Description, source2.Description)
+}
+
+func TestStartExternalDataSourceSync(t *testing.T) {
+	source := createRandomExternalDataSource(t, domain.DataSourceStatusActive)
+
+	affected, err := testQuery.StartExternalDataSourceSync(context.Background(), source.SourceID)
+
+	require.NoError(t, err)
+	require.Equal(t, int64(1), affected)
+
+	// 同期中のデータソースは再度開始できない
+	affected, err = testQuery.StartExternalDataSourceSync(context.Background(), source.SourceID)
+
+	require.NoError(t, err)
+	require.Zero(t, affected)
+
+	// 中断されて Updating のまま残ったデータソースは開始し直せる
+	err = testQuery.expireExternalDataSourceSync(source.SourceID)
+
+	require.NoError(t, err)
+
+	affected, err = testQuery.StartExternalDataSourceSync(context.Background(), source.SourceID)
+
+	require.NoError(t, err)
+	require.Equal(t, int64(1), affected)
+
+	arg := UpdateExternalDataSourceStatusParams{
+		Status:      domain.DataSourceStatusActive,
+		Description: sql.NullString{String: "imported 1 menus", Valid: true},
+		SourceID:    source.SourceID,
+	}
+
+	err = testQuery.UpdateExternalDataSourceStatus(context.Background(), arg)
+
+	require.NoError(t, err)
+
+	source2, err := testQuery.GetExternalDataSource(context.Background(), source.SourceID)
+
+	require.NoError(t, err)
+	require.Equal(t, arg.Status, source2.Status)
+	require.Equal(t, arg.Description, source2.Description)
+}
+
+func TestListExternalDataSourcesByStatus(t *testing.T) {
+	for i := 0; i < 3; i++ {
+		createRandomExternalDataSource(t, domain.DataSourceStatusError)
+	}
+
+	sources, err := testQuery.ListExternalDataSourcesByStatus(context.Background(), domain.DataSourceStatusError)
+
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, len(sources), 3)
+
+	for _, source := range sources {
+		require.Equal(t, domain.DataSourceStatusError, source.Status)
+	}
+}
+
+func TestDeleteExternalDataSource(t *testing.T) {
+	source := createRandomExternalDataSource(t, domain.DataSourceStatusInactive)
+
+	affected, err := testQuery.DeleteExternalDataSource(context.Background(), source.SourceID)
+
+	require.NoError(t, err)
+	require.Equal(t, int64(1), affected)
+
+	_, err = testQuery.GetExternalDataSource(context.Background(), source.SourceID)
+
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func createRandomExternalDataSource(t *testing.T, status string) ExternalDataSource {
+	arg := CreateExternalDataSourceParams{
+		CityCode:  util.RandomCityCode(),
+		DatasetID: util.RandomURL(),
+		Year:      int32(util.RandomInt(2000, 2100)),
+		Status:    status,
+		Category:  domain.DataSourceCategoryMenu,
+	}
+
+	id, err := testQuery.CreateExternalDataSource(context.Background(), arg)
+
+	require.NoError(t, err)
+	require.NotZero(t, id)
+
+	source, err := testQuery.GetExternalDataSource(context.Background(), int32(id))
+
+	require.NoError(t, err)
+	require.Equal(t, arg.CityCode, source.CityCode)
+	require.Equal(t, arg.DatasetID, source.DatasetID)
+	require.Equal(t, arg.Year, source.Year)
+	require.Equal(t, arg.Status, source.Status)
+	require.Equal(t, arg.Category, source.Category)
+	require.False(t, source.Description.Valid)
+	require.NotZero(t, source.UpdatedAt)
+
+	return source
+}
@@ -0,0 +1,176 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/ogurilab/school-lunch-api/domain"
+	"github.com/ogurilab/school-lunch-api/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateIngredient(t *testing.T) {
+	createRandomIngredient(t)
+}
+
+func TestUpdateIngredient(t *testing.T) {
+	ingredient := createRandomIngredient(t)
+
+	arg := UpdateIngredientParams{
+		Name: util.RandomString(50),
+		ID:   ingredient.ID,
+	}
+
+	err := testQuery.UpdateIngredient(context.Background(), arg)
+
+	require.NoError(t, err)
+
+	res, err := testQuery.GetIngredient(context.Background(), ingredient.ID)
+
+	require.NoError(t, err)
+	require.Equal(t, arg.Name, res.Name)
+}
+
+func TestListIngredientsByIDs(t *testing.T) {
+	ingredients := createRandomIngredients(t, 3)
+
+	ids := make([]int32, 0, len(ingredients))
+
+	for _, ingredient := range ingredients {
+		ids = append(ids, ingredient.ID)
+	}
+
+	res, err := testQuery.ListIngredientsByIDs(context.Background(), ids)
+
+	require.NoError(t, err)
+	require.Len(t, res, len(ingredients))
+}
+
+func TestListIngredientByDishID(t *testing.T) {
+	cityCode := util.RandomCityCode()
+	menu := createRandomMenu(t, cityCode)
+	dish := createRandomDish(t, menu.ID)
+
+	ingredient := createRandomIngredient(t)
+
+	quantity := 42.5
+	origin := int32(23)
+
+	arg := UpsertDishesIngredientsParams{
+		DishID:               dish.ID,
+		IngredientID:         ingredient.ID,
+		Quantity:             util.PointerToNullFloat64(&quantity),
+		OriginPrefectureCode: util.PointerToNullInt32(&origin),
+	}
+
+	err := testQuery.UpsertDishesIngredients(context.Background(), arg)
+	require.NoError(t, err)
+
+	res, err := testQuery.ListIngredientByDishID(context.Background(), dish.ID)
+
+	require.NoError(t, err)
+	require.Len(t, res, 1)
+	require.Equal(t, ingredient.Name, res[0].Name)
+	require.Equal(t, arg.Quantity, res[0].Quantity)
+	require.Equal(t, arg.OriginPrefectureCode, res[0].OriginPrefectureCode)
+
+	// 既に紐付いている場合は使用量と産地を上書きする
+	arg.Quantity = sql.NullFloat64{}
+
+	err = testQuery.UpsertDishesIngredients(context.Background(), arg)
+	require.NoError(t, err)
+
+	res, err = testQuery.ListIngredientByDishID(context.Background(), dish.ID)
+
+	require.NoError(t, err)
+	require.Len(t, res, 1)
+	require.False(t, res[0].Quantity.Valid)
+}
+
+func TestListIngredientInDish(t *testing.T) {
+	cityCode := util.RandomCityCode()
+	menu := createRandomMenu(t, cityCode)
+
+	dishLength := 3
+	ingredientsLength := 4
+	dishIDs := make([]string, 0, dishLength)
+
+	for i := 0; i < dishLength; i++ {
+		dish := createRandomDish(t, menu.ID)
+		dishIDs = append(dishIDs, dish.ID)
+
+		ingredients := createRandomIngredients(t, ingredientsLength)
+
+		err := testQuery.UpsertDishesIngredientsTx(context.Background(), dish.ID, toDishIngredients(dish.ID, ingredients))
+		require.NoError(t, err)
+	}
+
+	res, err := testQuery.ListIngredientInDish(context.Background(), dishIDs)
+
+	require.NoError(t, err)
+	require.Len(t, res, dishLength*ingredientsLength)
+}
+
+func TestDeleteIngredientTx(t *testing.T) {
+	cityCode := util.RandomCityCode()
+	menu := createRandomMenu(t, cityCode)
+	dish := createRandomDish(t, menu.ID)
+
+	ingredient := createRandomIngredient(t)
+
+	err := testQuery.UpsertDishesIngredientsTx(context.Background(), dish.ID, toDishIngredients(dish.ID, []*domain.Ingredient{ingredient}))
+	require.NoError(t, err)
+
+	err = testQuery.DeleteIngredientTx(context.Background(), ingredient.ID)
+	require.NoError(t, err)
+
+	_, err = testQuery.GetIngredient(context.Background(), ingredient.ID)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	res, err := testQuery.ListIngredientByDishID(context.Background(), dish.ID)
+	require.NoError(t, err)
+	require.Empty(t, res)
+
+	err = testQuery.DeleteIngredientTx(context.Background(), ingredient.ID)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func createRandomIngredient(t *testing.T) *domain.Ingredient {
+	ctx := context.Background()
+	name := util.RandomString(50)
+
+	id, err := testQuery.CreateIngredient(ctx, name)
+
+	require.NoError(t, err)
+
+	res, err := testQuery.GetIngredient(ctx, int32(id))
+
+	require.NoError(t, err)
+	require.Equal(t, name, res.Name)
+
+	return domain.ReNewIngredient(res.ID, res.Name)
+}
+
+func createRandomIngredients(t *testing.T, length int) []*domain.Ingredient {
+	ingredients := make([]*domain.Ingredient, 0, length)
+
+	for i := 0; i < length; i++ {
+		ingredients = append(ingredients, createRandomIngredient(t))
+	}
+
+	return ingredients
+}
+
+func toDishIngredients(dishID string, ingredients []*domain.Ingredient) []*domain.DishIngredient {
+	result := make([]*domain.DishIngredient, 0, len(ingredients))
+
+	for _, ingredient := range ingredients {
+		quantity := float64(util.RandomInt(1, 100))
+		origin := int32(util.RandomInt(1, 47))
+
+		result = append(result, domain.ReNewDishIngredient(ingredient.ID, ingredient.Name, dishID, &quantity, &origin))
+	}
+
+	return result
+}
@@ -17,6 +17,7 @@ type TestQuery interface {
 	getMenuDishes(menuID string, dishID string) (MenuDish, error)
 	getMenuDishesAllCount(menuID string) (int, error)
 	getDishesAllergens(dishID string, allergenID int32, category int32) (DishesAllergen, error)
+	expireExternalDataSourceSync(sourceID int32) error
 }
 
 type query struct {
@@ -97,6 +98,13 @@ func (q *query) getDishesAllergens(dishID string, allergenID int32, category int
 	return dishesAllergen, err
 }
 
+// expireExternalDataSourceSync は同期の開始時刻を2時間前にする
+func (q *query) expireExternalDataSourceSync(sourceID int32) error {
+	_, err := q.db.Exec("UPDATE external_data_sources SET updated_at = NOW() - INTERVAL 2 HOUR WHERE source_id = ?", sourceID)
+
+	return err
+}
+
 func TestMain(m *testing.M) {
 	os.Setenv("DB_SOURCE", TEST_DB_URL)
 	app := bootstrap.NewApp("../../../")
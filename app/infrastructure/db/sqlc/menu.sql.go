@@ -52,8 +52,21 @@ func (q *Queries) CreateMenu(ctx context.Context, arg CreateMenuParams) error {
 	return err
 }
 
+const deleteMenu = `-- name: DeleteMenu :execrows
+DELETE FROM menus
+WHERE id = ?
+`
+
+func (q *Queries) DeleteMenu(ctx context.Context, id string) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteMenu, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const getMenu = `-- name: GetMenu :one
-SELECT id, offered_at, photo_url, created_at, elementary_school_calories, junior_high_school_calories, city_code
+SELECT id, offered_at, photo_url, created_at, elementary_school_calories, junior_high_school_calories, city_code, photo_file_page, photo_license, photo_attribution, photo_small_url, photo_medium_url, photo_large_url
 FROM menus
 WHERE id = ?
   AND city_code = ?
@@ -75,12 +88,45 @@ func (q *Queries) GetMenu(ctx context.Context, arg GetMenuParams) (Menu, error)
 		&i.ElementarySchoolCalories,
 		&i.JuniorHighSchoolCalories,
 		&i.CityCode,
+		&i.PhotoFilePage,
+		&i.PhotoLicense,
+		&i.PhotoAttribution,
+		&i.PhotoSmallUrl,
+		&i.PhotoMediumUrl,
+		&i.PhotoLargeUrl,
+	)
+	return i, err
+}
+
+const getMenuByID = `-- name: GetMenuByID :one
+SELECT id, offered_at, photo_url, created_at, elementary_school_calories, junior_high_school_calories, city_code, photo_file_page, photo_license, photo_attribution, photo_small_url, photo_medium_url, photo_large_url
+FROM menus
+WHERE id = ?
+`
+
+func (q *Queries) GetMenuByID(ctx context.Context, id string) (Menu, error) {
+	row := q.db.QueryRowContext(ctx, getMenuByID, id)
+	var i Menu
+	err := row.Scan(
+		&i.ID,
+		&i.OfferedAt,
+		&i.PhotoUrl,
+		&i.CreatedAt,
+		&i.ElementarySchoolCalories,
+		&i.JuniorHighSchoolCalories,
+		&i.CityCode,
+		&i.PhotoFilePage,
+		&i.PhotoLicense,
+		&i.PhotoAttribution,
+		&i.PhotoSmallUrl,
+		&i.PhotoMediumUrl,
+		&i.PhotoLargeUrl,
 	)
 	return i, err
 }
 
 const listMenu = `-- name: ListMenu :many
-SELECT id, offered_at, photo_url, created_at, elementary_school_calories, junior_high_school_calories, city_code
+SELECT id, offered_at, photo_url, created_at, elementary_school_calories, junior_high_school_calories, city_code, photo_file_page, photo_license, photo_attribution, photo_small_url, photo_medium_url, photo_large_url
 FROM menus
 WHERE offered_at <= ?
 ORDER BY offered_at DESC
@@ -110,6 +156,12 @@ func (q *Queries) ListMenu(ctx context.Context, arg ListMenuParams) ([]Menu, err
 			&i.ElementarySchoolCalories,
 			&i.JuniorHighSchoolCalories,
 			&i.CityCode,
+			&i.PhotoFilePage,
+			&i.PhotoLicense,
+			&i.PhotoAttribution,
+			&i.PhotoSmallUrl,
+			&i.PhotoMediumUrl,
+			&i.PhotoLargeUrl,
 		); err != nil {
 			return nil, err
 		}
@@ -125,7 +177,7 @@ func (q *Queries) ListMenu(ctx context.Context, arg ListMenuParams) ([]Menu, err
 }
 
 const listMenuByCity = `-- name: ListMenuByCity :many
-SELECT id, offered_at, photo_url, created_at, elementary_school_calories, junior_high_school_calories, city_code
+SELECT id, offered_at, photo_url, created_at, elementary_school_calories, junior_high_school_calories, city_code, photo_file_page, photo_license, photo_attribution, photo_small_url, photo_medium_url, photo_large_url
 FROM menus AS m
 WHERE city_code = ?
   AND offered_at <= ?
@@ -162,6 +214,87 @@ func (q *Queries) ListMenuByCity(ctx context.Context, arg ListMenuByCityParams)
 			&i.ElementarySchoolCalories,
 			&i.JuniorHighSchoolCalories,
 			&i.CityCode,
+			&i.PhotoFilePage,
+			&i.PhotoLicense,
+			&i.PhotoAttribution,
+			&i.PhotoSmallUrl,
+			&i.PhotoMediumUrl,
+			&i.PhotoLargeUrl,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listMenuByCityExcludingAllergens = `-- name: ListMenuByCityExcludingAllergens :many
+SELECT id, offered_at, photo_url, created_at, elementary_school_calories, junior_high_school_calories, city_code, photo_file_page, photo_license, photo_attribution, photo_small_url, photo_medium_url, photo_large_url
+FROM menus AS m
+WHERE city_code = ?
+  AND offered_at <= ?
+  AND NOT EXISTS (
+    SELECT 1
+    FROM menu_dishes AS md
+      INNER JOIN dishes_allergens AS da ON md.dish_id = da.dish_id
+    WHERE md.menu_id = m.id
+      AND da.allergen_id IN (/*SLICE:allergen_ids*/?)
+  )
+ORDER BY offered_at DESC
+LIMIT ? OFFSET ?
+`
+
+type ListMenuByCityExcludingAllergensParams struct {
+	CityCode    int32     `json:"city_code"`
+	OfferedAt   time.Time `json:"offered_at"`
+	AllergenIds []int32   `json:"allergen_ids"`
+	Limit       int32     `json:"limit"`
+	Offset      int32     `json:"offset"`
+}
+
+func (q *Queries) ListMenuByCityExcludingAllergens(ctx context.Context, arg ListMenuByCityExcludingAllergensParams) ([]Menu, error) {
+	query := listMenuByCityExcludingAllergens
+	var queryParams []interface{}
+	queryParams = append(queryParams, arg.CityCode)
+	queryParams = append(queryParams, arg.OfferedAt)
+	if len(arg.AllergenIds) > 0 {
+		for _, v := range arg.AllergenIds {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:allergen_ids*/?", strings.Repeat(",?", len(arg.AllergenIds))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:allergen_ids*/?", "NULL", 1)
+	}
+	queryParams = append(queryParams, arg.Limit)
+	queryParams = append(queryParams, arg.Offset)
+	rows, err := q.db.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Menu{}
+	for rows.Next() {
+		var i Menu
+		if err := rows.Scan(
+			&i.ID,
+			&i.OfferedAt,
+			&i.PhotoUrl,
+			&i.CreatedAt,
+			&i.ElementarySchoolCalories,
+			&i.JuniorHighSchoolCalories,
+			&i.CityCode,
+			&i.PhotoFilePage,
+			&i.PhotoLicense,
+			&i.PhotoAttribution,
+			&i.PhotoSmallUrl,
+			&i.PhotoMediumUrl,
+			&i.PhotoLargeUrl,
 		); err != nil {
 			return nil, err
 		}
@@ -177,7 +310,7 @@ func (q *Queries) ListMenuByCity(ctx context.Context, arg ListMenuByCityParams)
 }
 
 const listMenuInIds = `-- name: ListMenuInIds :many
-SELECT id, offered_at, photo_url, created_at, elementary_school_calories, junior_high_school_calories, city_code
+SELECT id, offered_at, photo_url, created_at, elementary_school_calories, junior_high_school_calories, city_code, photo_file_page, photo_license, photo_attribution, photo_small_url, photo_medium_url, photo_large_url
 FROM menus
 WHERE id IN (/*SLICE:ids*/?)
   AND offered_at <= ?
@@ -222,6 +355,12 @@ func (q *Queries) ListMenuInIds(ctx context.Context, arg ListMenuInIdsParams) ([
 			&i.ElementarySchoolCalories,
 			&i.JuniorHighSchoolCalories,
 			&i.CityCode,
+			&i.PhotoFilePage,
+			&i.PhotoLicense,
+			&i.PhotoAttribution,
+			&i.PhotoSmallUrl,
+			&i.PhotoMediumUrl,
+			&i.PhotoLargeUrl,
 		); err != nil {
 			return nil, err
 		}
@@ -235,3 +374,122 @@ func (q *Queries) ListMenuInIds(ctx context.Context, arg ListMenuInIdsParams) ([
 	}
 	return items, nil
 }
+
+const listMenuOfferedAtByCity = `-- name: ListMenuOfferedAtByCity :many
+SELECT offered_at
+FROM menus
+WHERE city_code = ?
+  AND offered_at BETWEEN ? AND ?
+ORDER BY offered_at
+`
+
+type ListMenuOfferedAtByCityParams struct {
+	CityCode int32     `json:"city_code"`
+	StartAt  time.Time `json:"start_at"`
+	EndAt    time.Time `json:"end_at"`
+}
+
+func (q *Queries) ListMenuOfferedAtByCity(ctx context.Context, arg ListMenuOfferedAtByCityParams) ([]time.Time, error) {
+	rows, err := q.db.QueryContext(ctx, listMenuOfferedAtByCity, arg.CityCode, arg.StartAt, arg.EndAt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []time.Time{}
+	for rows.Next() {
+		var offered_at time.Time
+		if err := rows.Scan(&offered_at); err != nil {
+			return nil, err
+		}
+		items = append(items, offered_at)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateMenu = `-- name: UpdateMenu :exec
+UPDATE menus
+SET photo_file_page = IF(photo_url <=> ?, photo_file_page, NULL),
+  photo_license = IF(photo_url <=> ?, photo_license, NULL),
+  photo_attribution = IF(photo_url <=> ?, photo_attribution, NULL),
+  photo_small_url = IF(photo_url <=> ?, photo_small_url, NULL),
+  photo_medium_url = IF(photo_url <=> ?, photo_medium_url, NULL),
+  photo_large_url = IF(photo_url <=> ?, photo_large_url, NULL),
+  offered_at = ?,
+  photo_url = ?,
+  elementary_school_calories = ?,
+  junior_high_school_calories = ?,
+  city_code = ?
+WHERE id = ?
+`
+
+type UpdateMenuParams struct {
+	PhotoUrl                 sql.NullString `json:"photo_url"`
+	OfferedAt                time.Time      `json:"offered_at"`
+	ElementarySchoolCalories int32          `json:"elementary_school_calories"`
+	JuniorHighSchoolCalories int32          `json:"junior_high_school_calories"`
+	CityCode                 int32          `json:"city_code"`
+	ID                       string         `json:"id"`
+}
+
+// 写真が変更された場合はクレジットとサムネイルを削除する
+// 代入は左から順に評価されるため、photo_url より先にクレジットとサムネイルを更新する
+func (q *Queries) UpdateMenu(ctx context.Context, arg UpdateMenuParams) error {
+	_, err := q.db.ExecContext(ctx, updateMenu,
+		arg.PhotoUrl,
+		arg.PhotoUrl,
+		arg.PhotoUrl,
+		arg.PhotoUrl,
+		arg.PhotoUrl,
+		arg.PhotoUrl,
+		arg.OfferedAt,
+		arg.PhotoUrl,
+		arg.ElementarySchoolCalories,
+		arg.JuniorHighSchoolCalories,
+		arg.CityCode,
+		arg.ID,
+	)
+	return err
+}
+
+const updateMenuPhoto = `-- name: UpdateMenuPhoto :exec
+UPDATE menus
+SET photo_url = ?,
+  photo_file_page = ?,
+  photo_license = ?,
+  photo_attribution = ?,
+  photo_small_url = ?,
+  photo_medium_url = ?,
+  photo_large_url = ?
+WHERE id = ?
+`
+
+type UpdateMenuPhotoParams struct {
+	PhotoUrl         sql.NullString `json:"photo_url"`
+	PhotoFilePage    sql.NullString `json:"photo_file_page"`
+	PhotoLicense     sql.NullString `json:"photo_license"`
+	PhotoAttribution sql.NullString `json:"photo_attribution"`
+	PhotoSmallUrl    sql.NullString `json:"photo_small_url"`
+	PhotoMediumUrl   sql.NullString `json:"photo_medium_url"`
+	PhotoLargeUrl    sql.NullString `json:"photo_large_url"`
+	ID               string         `json:"id"`
+}
+
+func (q *Queries) UpdateMenuPhoto(ctx context.Context, arg UpdateMenuPhotoParams) error {
+	_, err := q.db.ExecContext(ctx, updateMenuPhoto,
+		arg.PhotoUrl,
+		arg.PhotoFilePage,
+		arg.PhotoLicense,
+		arg.PhotoAttribution,
+		arg.PhotoSmallUrl,
+		arg.PhotoMediumUrl,
+		arg.PhotoLargeUrl,
+		arg.ID,
+	)
+	return err
+}
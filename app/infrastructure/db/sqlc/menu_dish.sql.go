@@ -23,3 +23,109 @@ func (q *Queries) CreateMenuDish(ctx context.Context, arg CreateMenuDishParams)
 	_, err := q.db.ExecContext(ctx, createMenuDish, arg.MenuID, arg.DishID)
 	return err
 }
+
+const deleteMenuDish = `-- name: DeleteMenuDish :execrows
+DELETE FROM menu_dishes
+WHERE menu_id = ?
+  AND dish_id = ?
+`
+
+type DeleteMenuDishParams struct {
+	MenuID string `json:"menu_id"`
+	DishID string `json:"dish_id"`
+}
+
+func (q *Queries) DeleteMenuDish(ctx context.Context, arg DeleteMenuDishParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteMenuDish, arg.MenuID, arg.DishID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteMenuDishesByDishID = `-- name: DeleteMenuDishesByDishID :execrows
+DELETE FROM menu_dishes
+WHERE dish_id = ?
+`
+
+func (q *Queries) DeleteMenuDishesByDishID(ctx context.Context, dishID string) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteMenuDishesByDishID, dishID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteMenuDishesByMenuID = `-- name: DeleteMenuDishesByMenuID :execrows
+DELETE FROM menu_dishes
+WHERE menu_id = ?
+`
+
+func (q *Queries) DeleteMenuDishesByMenuID(ctx context.Context, menuID string) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteMenuDishesByMenuID, menuID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const listCityCodeByDishID = `-- name: ListCityCodeByDishID :many
+SELECT DISTINCT m.city_code
+FROM menu_dishes AS md
+  INNER JOIN menus AS m ON md.menu_id = m.id
+WHERE md.dish_id = ?
+ORDER BY m.city_code
+`
+
+func (q *Queries) ListCityCodeByDishID(ctx context.Context, dishID string) ([]int32, error) {
+	rows, err := q.db.QueryContext(ctx, listCityCodeByDishID, dishID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []int32{}
+	for rows.Next() {
+		var city_code int32
+		if err := rows.Scan(&city_code); err != nil {
+			return nil, err
+		}
+		items = append(items, city_code)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listMenuIDByDishID = `-- name: ListMenuIDByDishID :many
+SELECT menu_id
+FROM menu_dishes
+WHERE dish_id = ?
+ORDER BY menu_id
+`
+
+func (q *Queries) ListMenuIDByDishID(ctx context.Context, dishID string) ([]string, error) {
+	rows, err := q.db.QueryContext(ctx, listMenuIDByDishID, dishID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []string{}
+	for rows.Next() {
+		var menu_id string
+		if err := rows.Scan(&menu_id); err != nil {
+			return nil, err
+		}
+		items = append(items, menu_id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
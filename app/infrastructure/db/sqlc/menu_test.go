@@ -2,6 +2,7 @@ package db
 
 import (
 	"context"
+	"database/sql"
 	"testing"
 	"time"
 
@@ -58,6 +59,73 @@ func TestFetchMenusByCity(t *testing.T) {
 	require.Len(t, menus, 5)
 }
 
+func TestListMenuByCityExcludingAllergens(t *testing.T) {
+	cityCode := util.RandomCityCode()
+	start := time.Now()
+	allergen := createRandomAllergen(t, util.RandomString(50), 0)
+
+	flagged := make(map[string]bool)
+
+	for i := 0; i < 10; i++ {
+		menu := createRandomMenuFromStart(t, start, cityCode)
+		dish := createRandomDish(t, menu.ID)
+
+		if i%2 == 0 {
+			createRandomDishesAllergens(t, dish.ID, allergen.ID, util.RandomInt32())
+			flagged[menu.ID] = true
+		}
+	}
+
+	arg := ListMenuByCityExcludingAllergensParams{
+		Limit:       10,
+		Offset:      0,
+		CityCode:    cityCode,
+		OfferedAt:   start,
+		AllergenIds: []int32{allergen.ID},
+	}
+
+	menus, err := testQuery.ListMenuByCityExcludingAllergens(context.Background(), arg)
+
+	require.NoError(t, err)
+	require.Len(t, menus, 5)
+
+	for _, menu := range menus {
+		require.False(t, flagged[menu.ID])
+	}
+}
+
+func TestListMenuOfferedAtByCity(t *testing.T) {
+	cityCode := util.RandomCityCode()
+	start := time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
+
+	for i := 0; i < 5; i++ {
+		args := CreateMenuParams{
+			ID:                       util.RandomUlid(),
+			OfferedAt:                start.AddDate(0, 0, i),
+			PhotoUrl:                 util.RandomNullURL(),
+			ElementarySchoolCalories: util.RandomInt32(),
+			JuniorHighSchoolCalories: util.RandomInt32(),
+			CityCode:                 cityCode,
+		}
+
+		err := testQuery.CreateMenu(context.Background(), args)
+
+		require.NoError(t, err)
+	}
+
+	arg := ListMenuOfferedAtByCityParams{
+		CityCode: cityCode,
+		StartAt:  start.AddDate(0, 0, 1),
+		EndAt:    start.AddDate(0, 0, 3),
+	}
+
+	offered, err := testQuery.ListMenuOfferedAtByCity(context.Background(), arg)
+
+	require.NoError(t, err)
+	require.Len(t, offered, 3)
+	require.True(t, offered[0].Equal(arg.StartAt))
+}
+
 func TestFetchMenus(t *testing.T) {
 	cityCode := util.RandomCityCode()
 	start := time.Now()
@@ -115,6 +183,58 @@ func TestFetchMenusInId(t *testing.T) {
 
 }
 
+func TestGetMenuByID(t *testing.T) {
+	cityCode := util.RandomCityCode()
+	menu1 := createRandomMenu(t, cityCode)
+
+	menu2, err := testQuery.GetMenuByID(context.Background(), menu1.ID)
+
+	require.NoError(t, err)
+	require.Equal(t, menu1.ID, menu2.ID)
+	require.Equal(t, menu1.CityCode, menu2.CityCode)
+	require.Equal(t, menu1.OfferedAt, menu2.OfferedAt)
+}
+
+func TestUpdateMenu(t *testing.T) {
+	cityCode := util.RandomCityCode()
+	menu1 := createRandomMenu(t, cityCode)
+
+	arg := UpdateMenuParams{
+		OfferedAt:                util.RandomDate(),
+		PhotoUrl:                 util.RandomNullURL(),
+		ElementarySchoolCalories: util.RandomInt32(),
+		JuniorHighSchoolCalories: util.RandomInt32(),
+		CityCode:                 cityCode,
+		ID:                       menu1.ID,
+	}
+
+	err := testQuery.UpdateMenu(context.Background(), arg)
+
+	require.NoError(t, err)
+
+	menu2, err := testQuery.GetMenuByID(context.Background(), menu1.ID)
+
+	require.NoError(t, err)
+	require.Equal(t, arg.OfferedAt, menu2.OfferedAt)
+	require.Equal(t, arg.PhotoUrl, menu2.PhotoUrl)
+	require.Equal(t, arg.ElementarySchoolCalories, menu2.ElementarySchoolCalories)
+	require.Equal(t, arg.JuniorHighSchoolCalories, menu2.JuniorHighSchoolCalories)
+}
+
+func TestDeleteMenu(t *testing.T) {
+	cityCode := util.RandomCityCode()
+	menu := createRandomMenu(t, cityCode)
+
+	affected, err := testQuery.DeleteMenu(context.Background(), menu.ID)
+
+	require.NoError(t, err)
+	require.Equal(t, int64(1), affected)
+
+	_, err = testQuery.GetMenuByID(context.Background(), menu.ID)
+
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
 func createRandomMenu(t *testing.T, cityCode int32) *domain.Menu {
 	id := util.RandomUlid()
 
@@ -8,15 +8,16 @@ package db
 import (
 	"context"
 	"database/sql"
+	"strings"
 	"time"
 )
 
 const getMenuWithDishes = `-- name: GetMenuWithDishes :many
-SELECT m.id, m.offered_at, m.photo_url, m.created_at, m.elementary_school_calories, m.junior_high_school_calories, m.city_code,
+SELECT m.id, m.offered_at, m.photo_url, m.created_at, m.elementary_school_calories, m.junior_high_school_calories, m.city_code, m.photo_file_page, m.photo_license, m.photo_attribution, m.photo_small_url, m.photo_medium_url, m.photo_large_url,
   d.id AS dish_id,
   d.name AS dish_name
 FROM (
-    SELECT id, offered_at, photo_url, created_at, elementary_school_calories, junior_high_school_calories, city_code
+    SELECT id, offered_at, photo_url, created_at, elementary_school_calories, junior_high_school_calories, city_code, photo_file_page, photo_license, photo_attribution, photo_small_url, photo_medium_url, photo_large_url
     FROM menus
     WHERE menus.id = ?
       AND city_code = ?
@@ -39,6 +40,12 @@ type GetMenuWithDishesRow struct {
 	ElementarySchoolCalories int32          `json:"elementary_school_calories"`
 	JuniorHighSchoolCalories int32          `json:"junior_high_school_calories"`
 	CityCode                 int32          `json:"city_code"`
+	PhotoFilePage            sql.NullString `json:"photo_file_page"`
+	PhotoLicense             sql.NullString `json:"photo_license"`
+	PhotoAttribution         sql.NullString `json:"photo_attribution"`
+	PhotoSmallUrl            sql.NullString `json:"photo_small_url"`
+	PhotoMediumUrl           sql.NullString `json:"photo_medium_url"`
+	PhotoLargeUrl            sql.NullString `json:"photo_large_url"`
 	DishID                   string         `json:"dish_id"`
 	DishName                 string         `json:"dish_name"`
 }
@@ -60,6 +67,90 @@ func (q *Queries) GetMenuWithDishes(ctx context.Context, arg GetMenuWithDishesPa
 			&i.ElementarySchoolCalories,
 			&i.JuniorHighSchoolCalories,
 			&i.CityCode,
+			&i.PhotoFilePage,
+			&i.PhotoLicense,
+			&i.PhotoAttribution,
+			&i.PhotoSmallUrl,
+			&i.PhotoMediumUrl,
+			&i.PhotoLargeUrl,
+			&i.DishID,
+			&i.DishName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listLatestMenuWithDishesByCity = `-- name: ListLatestMenuWithDishesByCity :many
+SELECT m.id, m.offered_at, m.photo_url, m.created_at, m.elementary_school_calories, m.junior_high_school_calories, m.city_code, m.photo_file_page, m.photo_license, m.photo_attribution, m.photo_small_url, m.photo_medium_url, m.photo_large_url,
+  d.id AS dish_id,
+  d.name AS dish_name
+FROM (
+    SELECT id, offered_at, photo_url, created_at, elementary_school_calories, junior_high_school_calories, city_code, photo_file_page, photo_license, photo_attribution, photo_small_url, photo_medium_url, photo_large_url
+    FROM menus AS m
+    WHERE city_code = ?
+    ORDER BY created_at DESC,
+      id DESC
+    LIMIT ?
+  ) AS m
+  INNER JOIN menu_dishes md ON m.id = md.menu_id
+  INNER JOIN dishes d ON md.dish_id = d.id
+`
+
+type ListLatestMenuWithDishesByCityParams struct {
+	CityCode int32 `json:"city_code"`
+	Limit    int32 `json:"limit"`
+}
+
+type ListLatestMenuWithDishesByCityRow struct {
+	ID                       string         `json:"id"`
+	OfferedAt                time.Time      `json:"offered_at"`
+	PhotoUrl                 sql.NullString `json:"photo_url"`
+	CreatedAt                time.Time      `json:"created_at"`
+	ElementarySchoolCalories int32          `json:"elementary_school_calories"`
+	JuniorHighSchoolCalories int32          `json:"junior_high_school_calories"`
+	CityCode                 int32          `json:"city_code"`
+	PhotoFilePage            sql.NullString `json:"photo_file_page"`
+	PhotoLicense             sql.NullString `json:"photo_license"`
+	PhotoAttribution         sql.NullString `json:"photo_attribution"`
+	PhotoSmallUrl            sql.NullString `json:"photo_small_url"`
+	PhotoMediumUrl           sql.NullString `json:"photo_medium_url"`
+	PhotoLargeUrl            sql.NullString `json:"photo_large_url"`
+	DishID                   string         `json:"dish_id"`
+	DishName                 string         `json:"dish_name"`
+}
+
+func (q *Queries) ListLatestMenuWithDishesByCity(ctx context.Context, arg ListLatestMenuWithDishesByCityParams) ([]ListLatestMenuWithDishesByCityRow, error) {
+	rows, err := q.db.QueryContext(ctx, listLatestMenuWithDishesByCity, arg.CityCode, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListLatestMenuWithDishesByCityRow{}
+	for rows.Next() {
+		var i ListLatestMenuWithDishesByCityRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.OfferedAt,
+			&i.PhotoUrl,
+			&i.CreatedAt,
+			&i.ElementarySchoolCalories,
+			&i.JuniorHighSchoolCalories,
+			&i.CityCode,
+			&i.PhotoFilePage,
+			&i.PhotoLicense,
+			&i.PhotoAttribution,
+			&i.PhotoSmallUrl,
+			&i.PhotoMediumUrl,
+			&i.PhotoLargeUrl,
 			&i.DishID,
 			&i.DishName,
 		); err != nil {
@@ -77,11 +168,11 @@ func (q *Queries) GetMenuWithDishes(ctx context.Context, arg GetMenuWithDishesPa
 }
 
 const listMenuWithDishes = `-- name: ListMenuWithDishes :many
-SELECT m.id, m.offered_at, m.photo_url, m.created_at, m.elementary_school_calories, m.junior_high_school_calories, m.city_code,
+SELECT m.id, m.offered_at, m.photo_url, m.created_at, m.elementary_school_calories, m.junior_high_school_calories, m.city_code, m.photo_file_page, m.photo_license, m.photo_attribution, m.photo_small_url, m.photo_medium_url, m.photo_large_url,
   d.id AS dish_id,
   d.name AS dish_name
 FROM (
-    SELECT id, offered_at, photo_url, created_at, elementary_school_calories, junior_high_school_calories, city_code
+    SELECT id, offered_at, photo_url, created_at, elementary_school_calories, junior_high_school_calories, city_code, photo_file_page, photo_license, photo_attribution, photo_small_url, photo_medium_url, photo_large_url
     FROM menus AS m
     WHERE offered_at <= ?
     ORDER BY offered_at DESC
@@ -105,6 +196,12 @@ type ListMenuWithDishesRow struct {
 	ElementarySchoolCalories int32          `json:"elementary_school_calories"`
 	JuniorHighSchoolCalories int32          `json:"junior_high_school_calories"`
 	CityCode                 int32          `json:"city_code"`
+	PhotoFilePage            sql.NullString `json:"photo_file_page"`
+	PhotoLicense             sql.NullString `json:"photo_license"`
+	PhotoAttribution         sql.NullString `json:"photo_attribution"`
+	PhotoSmallUrl            sql.NullString `json:"photo_small_url"`
+	PhotoMediumUrl           sql.NullString `json:"photo_medium_url"`
+	PhotoLargeUrl            sql.NullString `json:"photo_large_url"`
 	DishID                   string         `json:"dish_id"`
 	DishName                 string         `json:"dish_name"`
 }
@@ -126,6 +223,12 @@ func (q *Queries) ListMenuWithDishes(ctx context.Context, arg ListMenuWithDishes
 			&i.ElementarySchoolCalories,
 			&i.JuniorHighSchoolCalories,
 			&i.CityCode,
+			&i.PhotoFilePage,
+			&i.PhotoLicense,
+			&i.PhotoAttribution,
+			&i.PhotoSmallUrl,
+			&i.PhotoMediumUrl,
+			&i.PhotoLargeUrl,
 			&i.DishID,
 			&i.DishName,
 		); err != nil {
@@ -143,11 +246,11 @@ func (q *Queries) ListMenuWithDishes(ctx context.Context, arg ListMenuWithDishes
 }
 
 const listMenuWithDishesByCity = `-- name: ListMenuWithDishesByCity :many
-SELECT m.id, m.offered_at, m.photo_url, m.created_at, m.elementary_school_calories, m.junior_high_school_calories, m.city_code,
+SELECT m.id, m.offered_at, m.photo_url, m.created_at, m.elementary_school_calories, m.junior_high_school_calories, m.city_code, m.photo_file_page, m.photo_license, m.photo_attribution, m.photo_small_url, m.photo_medium_url, m.photo_large_url,
   d.id AS dish_id,
   d.name AS dish_name
 FROM (
-    SELECT id, offered_at, photo_url, created_at, elementary_school_calories, junior_high_school_calories, city_code
+    SELECT id, offered_at, photo_url, created_at, elementary_school_calories, junior_high_school_calories, city_code, photo_file_page, photo_license, photo_attribution, photo_small_url, photo_medium_url, photo_large_url
     FROM menus AS m
     WHERE city_code = ?
       AND offered_at <= ?
@@ -173,6 +276,12 @@ type ListMenuWithDishesByCityRow struct {
 	ElementarySchoolCalories int32          `json:"elementary_school_calories"`
 	JuniorHighSchoolCalories int32          `json:"junior_high_school_calories"`
 	CityCode                 int32          `json:"city_code"`
+	PhotoFilePage            sql.NullString `json:"photo_file_page"`
+	PhotoLicense             sql.NullString `json:"photo_license"`
+	PhotoAttribution         sql.NullString `json:"photo_attribution"`
+	PhotoSmallUrl            sql.NullString `json:"photo_small_url"`
+	PhotoMediumUrl           sql.NullString `json:"photo_medium_url"`
+	PhotoLargeUrl            sql.NullString `json:"photo_large_url"`
 	DishID                   string         `json:"dish_id"`
 	DishName                 string         `json:"dish_name"`
 }
@@ -199,6 +308,191 @@ func (q *Queries) ListMenuWithDishesByCity(ctx context.Context, arg ListMenuWith
 			&i.ElementarySchoolCalories,
 			&i.JuniorHighSchoolCalories,
 			&i.CityCode,
+			&i.PhotoFilePage,
+			&i.PhotoLicense,
+			&i.PhotoAttribution,
+			&i.PhotoSmallUrl,
+			&i.PhotoMediumUrl,
+			&i.PhotoLargeUrl,
+			&i.DishID,
+			&i.DishName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listMenuWithDishesByCityBetween = `-- name: ListMenuWithDishesByCityBetween :many
+SELECT m.id, m.offered_at, m.photo_url, m.created_at, m.elementary_school_calories, m.junior_high_school_calories, m.city_code, m.photo_file_page, m.photo_license, m.photo_attribution, m.photo_small_url, m.photo_medium_url, m.photo_large_url,
+  d.id AS dish_id,
+  d.name AS dish_name
+FROM (
+    SELECT id, offered_at, photo_url, created_at, elementary_school_calories, junior_high_school_calories, city_code, photo_file_page, photo_license, photo_attribution, photo_small_url, photo_medium_url, photo_large_url
+    FROM menus AS m
+    WHERE city_code = ?
+      AND offered_at BETWEEN ? AND ?
+  ) AS m
+  INNER JOIN menu_dishes md ON m.id = md.menu_id
+  INNER JOIN dishes d ON md.dish_id = d.id
+`
+
+type ListMenuWithDishesByCityBetweenParams struct {
+	CityCode int32     `json:"city_code"`
+	StartAt  time.Time `json:"start_at"`
+	EndAt    time.Time `json:"end_at"`
+}
+
+type ListMenuWithDishesByCityBetweenRow struct {
+	ID                       string         `json:"id"`
+	OfferedAt                time.Time      `json:"offered_at"`
+	PhotoUrl                 sql.NullString `json:"photo_url"`
+	CreatedAt                time.Time      `json:"created_at"`
+	ElementarySchoolCalories int32          `json:"elementary_school_calories"`
+	JuniorHighSchoolCalories int32          `json:"junior_high_school_calories"`
+	CityCode                 int32          `json:"city_code"`
+	PhotoFilePage            sql.NullString `json:"photo_file_page"`
+	PhotoLicense             sql.NullString `json:"photo_license"`
+	PhotoAttribution         sql.NullString `json:"photo_attribution"`
+	PhotoSmallUrl            sql.NullString `json:"photo_small_url"`
+	PhotoMediumUrl           sql.NullString `json:"photo_medium_url"`
+	PhotoLargeUrl            sql.NullString `json:"photo_large_url"`
+	DishID                   string         `json:"dish_id"`
+	DishName                 string         `json:"dish_name"`
+}
+
+func (q *Queries) ListMenuWithDishesByCityBetween(ctx context.Context, arg ListMenuWithDishesByCityBetweenParams) ([]ListMenuWithDishesByCityBetweenRow, error) {
+	rows, err := q.db.QueryContext(ctx, listMenuWithDishesByCityBetween, arg.CityCode, arg.StartAt, arg.EndAt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListMenuWithDishesByCityBetweenRow{}
+	for rows.Next() {
+		var i ListMenuWithDishesByCityBetweenRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.OfferedAt,
+			&i.PhotoUrl,
+			&i.CreatedAt,
+			&i.ElementarySchoolCalories,
+			&i.JuniorHighSchoolCalories,
+			&i.CityCode,
+			&i.PhotoFilePage,
+			&i.PhotoLicense,
+			&i.PhotoAttribution,
+			&i.PhotoSmallUrl,
+			&i.PhotoMediumUrl,
+			&i.PhotoLargeUrl,
+			&i.DishID,
+			&i.DishName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listMenuWithDishesByCityExcludingAllergens = `-- name: ListMenuWithDishesByCityExcludingAllergens :many
+SELECT m.id, m.offered_at, m.photo_url, m.created_at, m.elementary_school_calories, m.junior_high_school_calories, m.city_code, m.photo_file_page, m.photo_license, m.photo_attribution, m.photo_small_url, m.photo_medium_url, m.photo_large_url,
+  d.id AS dish_id,
+  d.name AS dish_name
+FROM (
+    SELECT id, offered_at, photo_url, created_at, elementary_school_calories, junior_high_school_calories, city_code, photo_file_page, photo_license, photo_attribution, photo_small_url, photo_medium_url, photo_large_url
+    FROM menus AS m
+    WHERE city_code = ?
+      AND offered_at <= ?
+      AND NOT EXISTS (
+        SELECT 1
+        FROM menu_dishes AS emd
+          INNER JOIN dishes_allergens AS da ON emd.dish_id = da.dish_id
+        WHERE emd.menu_id = m.id
+          AND da.allergen_id IN (/*SLICE:allergen_ids*/?)
+      )
+    ORDER BY offered_at DESC
+    LIMIT ? OFFSET ?
+  ) AS m
+  INNER JOIN menu_dishes md ON m.id = md.menu_id
+  INNER JOIN dishes d ON md.dish_id = d.id
+`
+
+type ListMenuWithDishesByCityExcludingAllergensParams struct {
+	CityCode    int32     `json:"city_code"`
+	OfferedAt   time.Time `json:"offered_at"`
+	AllergenIds []int32   `json:"allergen_ids"`
+	Limit       int32     `json:"limit"`
+	Offset      int32     `json:"offset"`
+}
+
+type ListMenuWithDishesByCityExcludingAllergensRow struct {
+	ID                       string         `json:"id"`
+	OfferedAt                time.Time      `json:"offered_at"`
+	PhotoUrl                 sql.NullString `json:"photo_url"`
+	CreatedAt                time.Time      `json:"created_at"`
+	ElementarySchoolCalories int32          `json:"elementary_school_calories"`
+	JuniorHighSchoolCalories int32          `json:"junior_high_school_calories"`
+	CityCode                 int32          `json:"city_code"`
+	PhotoFilePage            sql.NullString `json:"photo_file_page"`
+	PhotoLicense             sql.NullString `json:"photo_license"`
+	PhotoAttribution         sql.NullString `json:"photo_attribution"`
+	PhotoSmallUrl            sql.NullString `json:"photo_small_url"`
+	PhotoMediumUrl           sql.NullString `json:"photo_medium_url"`
+	PhotoLargeUrl            sql.NullString `json:"photo_large_url"`
+	DishID                   string         `json:"dish_id"`
+	DishName                 string         `json:"dish_name"`
+}
+
+func (q *Queries) ListMenuWithDishesByCityExcludingAllergens(ctx context.Context, arg ListMenuWithDishesByCityExcludingAllergensParams) ([]ListMenuWithDishesByCityExcludingAllergensRow, error) {
+	query := listMenuWithDishesByCityExcludingAllergens
+	var queryParams []interface{}
+	queryParams = append(queryParams, arg.CityCode)
+	queryParams = append(queryParams, arg.OfferedAt)
+	if len(arg.AllergenIds) > 0 {
+		for _, v := range arg.AllergenIds {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:allergen_ids*/?", strings.Repeat(",?", len(arg.AllergenIds))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:allergen_ids*/?", "NULL", 1)
+	}
+	queryParams = append(queryParams, arg.Limit)
+	queryParams = append(queryParams, arg.Offset)
+	rows, err := q.db.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListMenuWithDishesByCityExcludingAllergensRow{}
+	for rows.Next() {
+		var i ListMenuWithDishesByCityExcludingAllergensRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.OfferedAt,
+			&i.PhotoUrl,
+			&i.CreatedAt,
+			&i.ElementarySchoolCalories,
+			&i.JuniorHighSchoolCalories,
+			&i.CityCode,
+			&i.PhotoFilePage,
+			&i.PhotoLicense,
+			&i.PhotoAttribution,
+			&i.PhotoSmallUrl,
+			&i.PhotoMediumUrl,
+			&i.PhotoLargeUrl,
 			&i.DishID,
 			&i.DishName,
 		); err != nil {
@@ -3,6 +3,7 @@ package db
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/ogurilab/school-lunch-api/domain"
 	"github.com/ogurilab/school-lunch-api/util"
@@ -125,6 +126,116 @@ func TestFetchMenuWithDishesByCity(t *testing.T) {
 	}
 }
 
+func TestListMenuWithDishesByCityExcludingAllergens(t *testing.T) {
+	cityCode := util.RandomCityCode()
+	start := util.RandomDate()
+	allergen := createRandomAllergen(t, util.RandomString(50), 0)
+
+	flagged := make(map[string]bool)
+
+	for i := 0; i < 10; i++ {
+		menu := createRandomMenuFromStart(t, start, cityCode)
+
+		for j := 0; j < 5; j++ {
+			dish := createRandomDish(t, menu.ID)
+
+			if i%2 == 0 && j == 0 {
+				createRandomDishesAllergens(t, dish.ID, allergen.ID, util.RandomInt32())
+				flagged[menu.ID] = true
+			}
+		}
+	}
+
+	arg := ListMenuWithDishesByCityExcludingAllergensParams{
+		Limit:       10,
+		Offset:      0,
+		CityCode:    cityCode,
+		OfferedAt:   start,
+		AllergenIds: []int32{allergen.ID},
+	}
+
+	results, err := testQuery.ListMenuWithDishesByCityExcludingAllergens(context.Background(), arg)
+
+	require.NoError(t, err)
+	require.Len(t, results, 25)
+
+	for _, result := range results {
+		require.False(t, flagged[result.ID])
+	}
+}
+
+func TestListMenuWithDishesByCityBetween(t *testing.T) {
+	city := createRandomCity(t)
+	start := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+
+	for i := 0; i < 10; i++ {
+		args := CreateMenuParams{
+			ID:                       util.RandomUlid(),
+			OfferedAt:                start.AddDate(0, 0, i),
+			PhotoUrl:                 util.RandomNullURL(),
+			ElementarySchoolCalories: util.RandomInt32(),
+			JuniorHighSchoolCalories: util.RandomInt32(),
+			CityCode:                 city.CityCode,
+		}
+
+		err := testQuery.CreateMenu(context.Background(), args)
+		require.NoError(t, err)
+
+		for j := 0; j < 2; j++ {
+			createRandomDish(t, args.ID)
+		}
+	}
+
+	arg := ListMenuWithDishesByCityBetweenParams{
+		CityCode: city.CityCode,
+		StartAt:  start.AddDate(0, 0, 2),
+		EndAt:    start.AddDate(0, 0, 8),
+	}
+
+	results, err := testQuery.ListMenuWithDishesByCityBetween(context.Background(), arg)
+
+	require.NoError(t, err)
+	// 件数の上限はなく、期間内の7日分の献立と料理を全て返す
+	require.Len(t, results, 14)
+
+	for _, result := range results {
+		require.False(t, result.OfferedAt.Before(arg.StartAt))
+		require.False(t, result.OfferedAt.After(arg.EndAt))
+	}
+}
+
+func TestListLatestMenuWithDishesByCity(t *testing.T) {
+	city := createRandomCity(t)
+
+	menus := make([]*domain.Menu, 0, 3)
+
+	for i := 0; i < 3; i++ {
+		menu := createRandomMenu(t, city.CityCode)
+
+		for j := 0; j < 2; j++ {
+			createRandomDish(t, menu.ID)
+		}
+
+		menus = append(menus, menu)
+	}
+
+	arg := ListLatestMenuWithDishesByCityParams{
+		CityCode: city.CityCode,
+		Limit:    2,
+	}
+
+	results, err := testQuery.ListLatestMenuWithDishesByCity(context.Background(), arg)
+
+	require.NoError(t, err)
+	require.Len(t, results, 4)
+
+	// 同じ時刻に登録された場合は ID (ULID) の降順になるため、最初の献立は含まれない
+	for _, result := range results {
+		require.NotEqual(t, menus[0].ID, result.ID)
+		require.False(t, result.CreatedAt.IsZero())
+	}
+}
+
 func TestFetchMenuWithDishes(t *testing.T) {
 	err := testQuery.truncateMenusTable()
 	require.NoError(t, err)
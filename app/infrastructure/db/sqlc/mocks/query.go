@@ -11,6 +11,7 @@ package mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	domain "github.com/ogurilab/school-lunch-api/domain"
 	db "github.com/ogurilab/school-lunch-api/infrastructure/db/sqlc"
@@ -54,6 +55,35 @@ func (mr *MockQueryMockRecorder) CreateAllergen(ctx, name any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAllergen", reflect.TypeOf((*MockQuery)(nil).CreateAllergen), ctx, name)
 }
 
+// CreateApiKey mocks base method.
+func (m *MockQuery) CreateApiKey(ctx context.Context, arg db.CreateApiKeyParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateApiKey", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateApiKey indicates an expected call of CreateApiKey.
+func (mr *MockQueryMockRecorder) CreateApiKey(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateApiKey", reflect.TypeOf((*MockQuery)(nil).CreateApiKey), ctx, arg)
+}
+
+// CreateAuditLog mocks base method.
+func (m *MockQuery) CreateAuditLog(ctx context.Context, arg db.CreateAuditLogParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAuditLog", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAuditLog indicates an expected call of CreateAuditLog.
+func (mr *MockQueryMockRecorder) CreateAuditLog(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAuditLog", reflect.TypeOf((*MockQuery)(nil).CreateAuditLog), ctx, arg)
+}
+
 // CreateCity mocks base method.
 func (m *MockQuery) CreateCity(ctx context.Context, arg db.CreateCityParams) error {
 	m.ctrl.T.Helper()
@@ -83,11 +113,12 @@ func (mr *MockQueryMockRecorder) CreateDish(ctx, arg any) *gomock.Call {
 }
 
 // CreateDishTx mocks base method.
-func (m *MockQuery) CreateDishTx(ctx context.Context, dish *domain.Dish, menuID string) error {
+func (m *MockQuery) CreateDishTx(ctx context.Context, dish *domain.Dish, menuID string) (db.CreateDishesTxResult, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CreateDishTx", ctx, dish, menuID)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].(db.CreateDishesTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // CreateDishTx indicates an expected call of CreateDishTx.
@@ -110,20 +141,65 @@ func (mr *MockQueryMockRecorder) CreateDishesAllergens(ctx, arg any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDishesAllergens", reflect.TypeOf((*MockQuery)(nil).CreateDishesAllergens), ctx, arg)
 }
 
-// CreateDishesTx mocks base method.
-func (m *MockQuery) CreateDishesTx(ctx context.Context, dishes []*domain.Dish, menuID string) error {
+// CreateDishesAllergensTx mocks base method.
+func (m *MockQuery) CreateDishesAllergensTx(ctx context.Context, dishID string, allergens []*domain.Allergen) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateDishesTx", ctx, dishes, menuID)
+	ret := m.ctrl.Call(m, "CreateDishesAllergensTx", ctx, dishID, allergens)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
+// CreateDishesAllergensTx indicates an expected call of CreateDishesAllergensTx.
+func (mr *MockQueryMockRecorder) CreateDishesAllergensTx(ctx, dishID, allergens any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDishesAllergensTx", reflect.TypeOf((*MockQuery)(nil).CreateDishesAllergensTx), ctx, dishID, allergens)
+}
+
+// CreateDishesTx mocks base method.
+func (m *MockQuery) CreateDishesTx(ctx context.Context, dishes []*domain.Dish, menuID string) (db.CreateDishesTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDishesTx", ctx, dishes, menuID)
+	ret0, _ := ret[0].(db.CreateDishesTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
 // CreateDishesTx indicates an expected call of CreateDishesTx.
 func (mr *MockQueryMockRecorder) CreateDishesTx(ctx, dishes, menuID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDishesTx", reflect.TypeOf((*MockQuery)(nil).CreateDishesTx), ctx, dishes, menuID)
 }
 
+// CreateExternalDataSource mocks base method.
+func (m *MockQuery) CreateExternalDataSource(ctx context.Context, arg db.CreateExternalDataSourceParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateExternalDataSource", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateExternalDataSource indicates an expected call of CreateExternalDataSource.
+func (mr *MockQueryMockRecorder) CreateExternalDataSource(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateExternalDataSource", reflect.TypeOf((*MockQuery)(nil).CreateExternalDataSource), ctx, arg)
+}
+
+// CreateIngredient mocks base method.
+func (m *MockQuery) CreateIngredient(ctx context.Context, name string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateIngredient", ctx, name)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateIngredient indicates an expected call of CreateIngredient.
+func (mr *MockQueryMockRecorder) CreateIngredient(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIngredient", reflect.TypeOf((*MockQuery)(nil).CreateIngredient), ctx, name)
+}
+
 // CreateMenu mocks base method.
 func (m *MockQuery) CreateMenu(ctx context.Context, arg db.CreateMenuParams) error {
 	m.ctrl.T.Helper()
@@ -152,301 +228,1379 @@ func (mr *MockQueryMockRecorder) CreateMenuDish(ctx, arg any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMenuDish", reflect.TypeOf((*MockQuery)(nil).CreateMenuDish), ctx, arg)
 }
 
-// GetAllergenByName mocks base method.
-func (m *MockQuery) GetAllergenByName(ctx context.Context, name string) (db.Allergen, error) {
+// CreateMenuTx mocks base method.
+func (m *MockQuery) CreateMenuTx(ctx context.Context, arg db.CreateMenuParams) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAllergenByName", ctx, name)
-	ret0, _ := ret[0].(db.Allergen)
+	ret := m.ctrl.Call(m, "CreateMenuTx", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateMenuTx indicates an expected call of CreateMenuTx.
+func (mr *MockQueryMockRecorder) CreateMenuTx(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMenuTx", reflect.TypeOf((*MockQuery)(nil).CreateMenuTx), ctx, arg)
+}
+
+// CreateSession mocks base method.
+func (m *MockQuery) CreateSession(ctx context.Context, arg db.CreateSessionParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSession", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateSession indicates an expected call of CreateSession.
+func (mr *MockQueryMockRecorder) CreateSession(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSession", reflect.TypeOf((*MockQuery)(nil).CreateSession), ctx, arg)
+}
+
+// CreateUser mocks base method.
+func (m *MockQuery) CreateUser(ctx context.Context, arg db.CreateUserParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUser", ctx, arg)
+	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetAllergenByName indicates an expected call of GetAllergenByName.
-func (mr *MockQueryMockRecorder) GetAllergenByName(ctx, name any) *gomock.Call {
+// CreateUser indicates an expected call of CreateUser.
+func (mr *MockQueryMockRecorder) CreateUser(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllergenByName", reflect.TypeOf((*MockQuery)(nil).GetAllergenByName), ctx, name)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockQuery)(nil).CreateUser), ctx, arg)
 }
 
-// GetCity mocks base method.
-func (m *MockQuery) GetCity(ctx context.Context, cityCode int32) (db.City, error) {
+// DeleteDish mocks base method.
+func (m *MockQuery) DeleteDish(ctx context.Context, id string) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCity", ctx, cityCode)
-	ret0, _ := ret[0].(db.City)
+	ret := m.ctrl.Call(m, "DeleteDish", ctx, id)
+	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetCity indicates an expected call of GetCity.
-func (mr *MockQueryMockRecorder) GetCity(ctx, cityCode any) *gomock.Call {
+// DeleteDish indicates an expected call of DeleteDish.
+func (mr *MockQueryMockRecorder) DeleteDish(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCity", reflect.TypeOf((*MockQuery)(nil).GetCity), ctx, cityCode)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDish", reflect.TypeOf((*MockQuery)(nil).DeleteDish), ctx, id)
 }
 
-// GetDish mocks base method.
-func (m *MockQuery) GetDish(ctx context.Context, arg db.GetDishParams) ([]db.GetDishRow, error) {
+// DeleteDishTx mocks base method.
+func (m *MockQuery) DeleteDishTx(ctx context.Context, dishID string, force bool) (db.DeleteDishTxResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetDish", ctx, arg)
-	ret0, _ := ret[0].([]db.GetDishRow)
+	ret := m.ctrl.Call(m, "DeleteDishTx", ctx, dishID, force)
+	ret0, _ := ret[0].(db.DeleteDishTxResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetDish indicates an expected call of GetDish.
-func (mr *MockQueryMockRecorder) GetDish(ctx, arg any) *gomock.Call {
+// DeleteDishTx indicates an expected call of DeleteDishTx.
+func (mr *MockQueryMockRecorder) DeleteDishTx(ctx, dishID, force any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDish", reflect.TypeOf((*MockQuery)(nil).GetDish), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDishTx", reflect.TypeOf((*MockQuery)(nil).DeleteDishTx), ctx, dishID, force)
 }
 
-// GetDishInCity mocks base method.
-func (m *MockQuery) GetDishInCity(ctx context.Context, arg db.GetDishInCityParams) ([]db.GetDishInCityRow, error) {
+// DeleteDishesAllergens mocks base method.
+func (m *MockQuery) DeleteDishesAllergens(ctx context.Context, arg db.DeleteDishesAllergensParams) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetDishInCity", ctx, arg)
-	ret0, _ := ret[0].([]db.GetDishInCityRow)
+	ret := m.ctrl.Call(m, "DeleteDishesAllergens", ctx, arg)
+	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetDishInCity indicates an expected call of GetDishInCity.
-func (mr *MockQueryMockRecorder) GetDishInCity(ctx, arg any) *gomock.Call {
+// DeleteDishesAllergens indicates an expected call of DeleteDishesAllergens.
+func (mr *MockQueryMockRecorder) DeleteDishesAllergens(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDishInCity", reflect.TypeOf((*MockQuery)(nil).GetDishInCity), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDishesAllergens", reflect.TypeOf((*MockQuery)(nil).DeleteDishesAllergens), ctx, arg)
 }
 
-// GetMenu mocks base method.
-func (m *MockQuery) GetMenu(ctx context.Context, arg db.GetMenuParams) (db.Menu, error) {
+// DeleteDishesAllergensByDishID mocks base method.
+func (m *MockQuery) DeleteDishesAllergensByDishID(ctx context.Context, dishID string) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetMenu", ctx, arg)
-	ret0, _ := ret[0].(db.Menu)
+	ret := m.ctrl.Call(m, "DeleteDishesAllergensByDishID", ctx, dishID)
+	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetMenu indicates an expected call of GetMenu.
-func (mr *MockQueryMockRecorder) GetMenu(ctx, arg any) *gomock.Call {
+// DeleteDishesAllergensByDishID indicates an expected call of DeleteDishesAllergensByDishID.
+func (mr *MockQueryMockRecorder) DeleteDishesAllergensByDishID(ctx, dishID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMenu", reflect.TypeOf((*MockQuery)(nil).GetMenu), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDishesAllergensByDishID", reflect.TypeOf((*MockQuery)(nil).DeleteDishesAllergensByDishID), ctx, dishID)
 }
 
-// GetMenuWithDishes mocks base method.
-func (m *MockQuery) GetMenuWithDishes(ctx context.Context, arg db.GetMenuWithDishesParams) ([]db.GetMenuWithDishesRow, error) {
+// DeleteDishesIngredients mocks base method.
+func (m *MockQuery) DeleteDishesIngredients(ctx context.Context, arg db.DeleteDishesIngredientsParams) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetMenuWithDishes", ctx, arg)
-	ret0, _ := ret[0].([]db.GetMenuWithDishesRow)
+	ret := m.ctrl.Call(m, "DeleteDishesIngredients", ctx, arg)
+	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetMenuWithDishes indicates an expected call of GetMenuWithDishes.
-func (mr *MockQueryMockRecorder) GetMenuWithDishes(ctx, arg any) *gomock.Call {
+// DeleteDishesIngredients indicates an expected call of DeleteDishesIngredients.
+func (mr *MockQueryMockRecorder) DeleteDishesIngredients(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMenuWithDishes", reflect.TypeOf((*MockQuery)(nil).GetMenuWithDishes), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDishesIngredients", reflect.TypeOf((*MockQuery)(nil).DeleteDishesIngredients), ctx, arg)
 }
 
-// ListAllergenByDishID mocks base method.
-func (m *MockQuery) ListAllergenByDishID(ctx context.Context, dishID string) ([]db.ListAllergenByDishIDRow, error) {
+// DeleteDishesIngredientsByDishID mocks base method.
+func (m *MockQuery) DeleteDishesIngredientsByDishID(ctx context.Context, dishID string) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListAllergenByDishID", ctx, dishID)
-	ret0, _ := ret[0].([]db.ListAllergenByDishIDRow)
+	ret := m.ctrl.Call(m, "DeleteDishesIngredientsByDishID", ctx, dishID)
+	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListAllergenByDishID indicates an expected call of ListAllergenByDishID.
-func (mr *MockQueryMockRecorder) ListAllergenByDishID(ctx, dishID any) *gomock.Call {
+// DeleteDishesIngredientsByDishID indicates an expected call of DeleteDishesIngredientsByDishID.
+func (mr *MockQueryMockRecorder) DeleteDishesIngredientsByDishID(ctx, dishID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAllergenByDishID", reflect.TypeOf((*MockQuery)(nil).ListAllergenByDishID), ctx, dishID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDishesIngredientsByDishID", reflect.TypeOf((*MockQuery)(nil).DeleteDishesIngredientsByDishID), ctx, dishID)
 }
 
-// ListAllergenInDish mocks base method.
-func (m *MockQuery) ListAllergenInDish(ctx context.Context, dishIds []string) ([]db.ListAllergenInDishRow, error) {
+// DeleteDishesIngredientsByIngredientID mocks base method.
+func (m *MockQuery) DeleteDishesIngredientsByIngredientID(ctx context.Context, ingredientID int32) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListAllergenInDish", ctx, dishIds)
-	ret0, _ := ret[0].([]db.ListAllergenInDishRow)
+	ret := m.ctrl.Call(m, "DeleteDishesIngredientsByIngredientID", ctx, ingredientID)
+	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListAllergenInDish indicates an expected call of ListAllergenInDish.
-func (mr *MockQueryMockRecorder) ListAllergenInDish(ctx, dishIds any) *gomock.Call {
+// DeleteDishesIngredientsByIngredientID indicates an expected call of DeleteDishesIngredientsByIngredientID.
+func (mr *MockQueryMockRecorder) DeleteDishesIngredientsByIngredientID(ctx, ingredientID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAllergenInDish", reflect.TypeOf((*MockQuery)(nil).ListAllergenInDish), ctx, dishIds)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDishesIngredientsByIngredientID", reflect.TypeOf((*MockQuery)(nil).DeleteDishesIngredientsByIngredientID), ctx, ingredientID)
 }
 
-// ListCities mocks base method.
-func (m *MockQuery) ListCities(ctx context.Context, arg db.ListCitiesParams) ([]db.City, error) {
+// DeleteExternalDataSource mocks base method.
+func (m *MockQuery) DeleteExternalDataSource(ctx context.Context, sourceID int32) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListCities", ctx, arg)
-	ret0, _ := ret[0].([]db.City)
+	ret := m.ctrl.Call(m, "DeleteExternalDataSource", ctx, sourceID)
+	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListCities indicates an expected call of ListCities.
-func (mr *MockQueryMockRecorder) ListCities(ctx, arg any) *gomock.Call {
+// DeleteExternalDataSource indicates an expected call of DeleteExternalDataSource.
+func (mr *MockQueryMockRecorder) DeleteExternalDataSource(ctx, sourceID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCities", reflect.TypeOf((*MockQuery)(nil).ListCities), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExternalDataSource", reflect.TypeOf((*MockQuery)(nil).DeleteExternalDataSource), ctx, sourceID)
 }
 
-// ListCitiesByName mocks base method.
-func (m *MockQuery) ListCitiesByName(ctx context.Context, arg db.ListCitiesByNameParams) ([]db.City, error) {
+// DeleteIngredient mocks base method.
+func (m *MockQuery) DeleteIngredient(ctx context.Context, id int32) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListCitiesByName", ctx, arg)
-	ret0, _ := ret[0].([]db.City)
+	ret := m.ctrl.Call(m, "DeleteIngredient", ctx, id)
+	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListCitiesByName indicates an expected call of ListCitiesByName.
-func (mr *MockQueryMockRecorder) ListCitiesByName(ctx, arg any) *gomock.Call {
+// DeleteIngredient indicates an expected call of DeleteIngredient.
+func (mr *MockQueryMockRecorder) DeleteIngredient(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCitiesByName", reflect.TypeOf((*MockQuery)(nil).ListCitiesByName), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteIngredient", reflect.TypeOf((*MockQuery)(nil).DeleteIngredient), ctx, id)
 }
 
-// ListCitiesByPrefecture mocks base method.
-func (m *MockQuery) ListCitiesByPrefecture(ctx context.Context, arg db.ListCitiesByPrefectureParams) ([]db.City, error) {
+// DeleteIngredientTx mocks base method.
+func (m *MockQuery) DeleteIngredientTx(ctx context.Context, ingredientID int32) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListCitiesByPrefecture", ctx, arg)
-	ret0, _ := ret[0].([]db.City)
+	ret := m.ctrl.Call(m, "DeleteIngredientTx", ctx, ingredientID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteIngredientTx indicates an expected call of DeleteIngredientTx.
+func (mr *MockQueryMockRecorder) DeleteIngredientTx(ctx, ingredientID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteIngredientTx", reflect.TypeOf((*MockQuery)(nil).DeleteIngredientTx), ctx, ingredientID)
+}
+
+// DeleteMenu mocks base method.
+func (m *MockQuery) DeleteMenu(ctx context.Context, id string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMenu", ctx, id)
+	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListCitiesByPrefecture indicates an expected call of ListCitiesByPrefecture.
-func (mr *MockQueryMockRecorder) ListCitiesByPrefecture(ctx, arg any) *gomock.Call {
+// DeleteMenu indicates an expected call of DeleteMenu.
+func (mr *MockQueryMockRecorder) DeleteMenu(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCitiesByPrefecture", reflect.TypeOf((*MockQuery)(nil).ListCitiesByPrefecture), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMenu", reflect.TypeOf((*MockQuery)(nil).DeleteMenu), ctx, id)
 }
 
-// ListDish mocks base method.
-func (m *MockQuery) ListDish(ctx context.Context, arg db.ListDishParams) ([]db.ListDishRow, error) {
+// DeleteMenuDish mocks base method.
+func (m *MockQuery) DeleteMenuDish(ctx context.Context, arg db.DeleteMenuDishParams) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListDish", ctx, arg)
-	ret0, _ := ret[0].([]db.ListDishRow)
+	ret := m.ctrl.Call(m, "DeleteMenuDish", ctx, arg)
+	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListDish indicates an expected call of ListDish.
-func (mr *MockQueryMockRecorder) ListDish(ctx, arg any) *gomock.Call {
+// DeleteMenuDish indicates an expected call of DeleteMenuDish.
+func (mr *MockQueryMockRecorder) DeleteMenuDish(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDish", reflect.TypeOf((*MockQuery)(nil).ListDish), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMenuDish", reflect.TypeOf((*MockQuery)(nil).DeleteMenuDish), ctx, arg)
 }
 
-// ListDishByMenuID mocks base method.
-func (m *MockQuery) ListDishByMenuID(ctx context.Context, menuID string) ([]db.ListDishByMenuIDRow, error) {
+// DeleteMenuDishesByDishID mocks base method.
+func (m *MockQuery) DeleteMenuDishesByDishID(ctx context.Context, dishID string) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListDishByMenuID", ctx, menuID)
-	ret0, _ := ret[0].([]db.ListDishByMenuIDRow)
+	ret := m.ctrl.Call(m, "DeleteMenuDishesByDishID", ctx, dishID)
+	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListDishByMenuID indicates an expected call of ListDishByMenuID.
-func (mr *MockQueryMockRecorder) ListDishByMenuID(ctx, menuID any) *gomock.Call {
+// DeleteMenuDishesByDishID indicates an expected call of DeleteMenuDishesByDishID.
+func (mr *MockQueryMockRecorder) DeleteMenuDishesByDishID(ctx, dishID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDishByMenuID", reflect.TypeOf((*MockQuery)(nil).ListDishByMenuID), ctx, menuID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMenuDishesByDishID", reflect.TypeOf((*MockQuery)(nil).DeleteMenuDishesByDishID), ctx, dishID)
 }
 
-// ListDishByName mocks base method.
-func (m *MockQuery) ListDishByName(ctx context.Context, arg db.ListDishByNameParams) ([]db.ListDishByNameRow, error) {
+// DeleteMenuDishesByMenuID mocks base method.
+func (m *MockQuery) DeleteMenuDishesByMenuID(ctx context.Context, menuID string) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListDishByName", ctx, arg)
-	ret0, _ := ret[0].([]db.ListDishByNameRow)
+	ret := m.ctrl.Call(m, "DeleteMenuDishesByMenuID", ctx, menuID)
+	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListDishByName indicates an expected call of ListDishByName.
-func (mr *MockQueryMockRecorder) ListDishByName(ctx, arg any) *gomock.Call {
+// DeleteMenuDishesByMenuID indicates an expected call of DeleteMenuDishesByMenuID.
+func (mr *MockQueryMockRecorder) DeleteMenuDishesByMenuID(ctx, menuID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDishByName", reflect.TypeOf((*MockQuery)(nil).ListDishByName), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMenuDishesByMenuID", reflect.TypeOf((*MockQuery)(nil).DeleteMenuDishesByMenuID), ctx, menuID)
 }
 
-// ListMenu mocks base method.
-func (m *MockQuery) ListMenu(ctx context.Context, arg db.ListMenuParams) ([]db.Menu, error) {
+// DeleteMenuNutrition mocks base method.
+func (m *MockQuery) DeleteMenuNutrition(ctx context.Context, arg db.DeleteMenuNutritionParams) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListMenu", ctx, arg)
-	ret0, _ := ret[0].([]db.Menu)
+	ret := m.ctrl.Call(m, "DeleteMenuNutrition", ctx, arg)
+	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListMenu indicates an expected call of ListMenu.
-func (mr *MockQueryMockRecorder) ListMenu(ctx, arg any) *gomock.Call {
+// DeleteMenuNutrition indicates an expected call of DeleteMenuNutrition.
+func (mr *MockQueryMockRecorder) DeleteMenuNutrition(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMenu", reflect.TypeOf((*MockQuery)(nil).ListMenu), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMenuNutrition", reflect.TypeOf((*MockQuery)(nil).DeleteMenuNutrition), ctx, arg)
 }
 
-// ListMenuByCity mocks base method.
-func (m *MockQuery) ListMenuByCity(ctx context.Context, arg db.ListMenuByCityParams) ([]db.Menu, error) {
+// DeleteMenuNutritionsByMenuID mocks base method.
+func (m *MockQuery) DeleteMenuNutritionsByMenuID(ctx context.Context, menuID string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListMenuByCity", ctx, arg)
-	ret0, _ := ret[0].([]db.Menu)
+	ret := m.ctrl.Call(m, "DeleteMenuNutritionsByMenuID", ctx, menuID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMenuNutritionsByMenuID indicates an expected call of DeleteMenuNutritionsByMenuID.
+func (mr *MockQueryMockRecorder) DeleteMenuNutritionsByMenuID(ctx, menuID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMenuNutritionsByMenuID", reflect.TypeOf((*MockQuery)(nil).DeleteMenuNutritionsByMenuID), ctx, menuID)
+}
+
+// DeleteMenuTx mocks base method.
+func (m *MockQuery) DeleteMenuTx(ctx context.Context, menuID string) (db.DeleteMenuTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMenuTx", ctx, menuID)
+	ret0, _ := ret[0].(db.DeleteMenuTxResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListMenuByCity indicates an expected call of ListMenuByCity.
-func (mr *MockQueryMockRecorder) ListMenuByCity(ctx, arg any) *gomock.Call {
+// DeleteMenuTx indicates an expected call of DeleteMenuTx.
+func (mr *MockQueryMockRecorder) DeleteMenuTx(ctx, menuID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMenuByCity", reflect.TypeOf((*MockQuery)(nil).ListMenuByCity), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMenuTx", reflect.TypeOf((*MockQuery)(nil).DeleteMenuTx), ctx, menuID)
 }
 
-// ListMenuInIds mocks base method.
-func (m *MockQuery) ListMenuInIds(ctx context.Context, arg db.ListMenuInIdsParams) ([]db.Menu, error) {
+// DeleteSessionsByUser mocks base method.
+func (m *MockQuery) DeleteSessionsByUser(ctx context.Context, userID int32) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListMenuInIds", ctx, arg)
-	ret0, _ := ret[0].([]db.Menu)
+	ret := m.ctrl.Call(m, "DeleteSessionsByUser", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSessionsByUser indicates an expected call of DeleteSessionsByUser.
+func (mr *MockQueryMockRecorder) DeleteSessionsByUser(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSessionsByUser", reflect.TypeOf((*MockQuery)(nil).DeleteSessionsByUser), ctx, userID)
+}
+
+// DeleteUser mocks base method.
+func (m *MockQuery) DeleteUser(ctx context.Context, id int32) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUser", ctx, id)
+	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListMenuInIds indicates an expected call of ListMenuInIds.
-func (mr *MockQueryMockRecorder) ListMenuInIds(ctx, arg any) *gomock.Call {
+// DeleteUser indicates an expected call of DeleteUser.
+func (mr *MockQueryMockRecorder) DeleteUser(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMenuInIds", reflect.TypeOf((*MockQuery)(nil).ListMenuInIds), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUser", reflect.TypeOf((*MockQuery)(nil).DeleteUser), ctx, id)
 }
 
-// ListMenuWithDishes mocks base method.
-func (m *MockQuery) ListMenuWithDishes(ctx context.Context, arg db.ListMenuWithDishesParams) ([]db.ListMenuWithDishesRow, error) {
+// DeleteUserTx mocks base method.
+func (m *MockQuery) DeleteUserTx(ctx context.Context, userID int32) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListMenuWithDishes", ctx, arg)
-	ret0, _ := ret[0].([]db.ListMenuWithDishesRow)
+	ret := m.ctrl.Call(m, "DeleteUserTx", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUserTx indicates an expected call of DeleteUserTx.
+func (mr *MockQueryMockRecorder) DeleteUserTx(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserTx", reflect.TypeOf((*MockQuery)(nil).DeleteUserTx), ctx, userID)
+}
+
+// GetAllergenByName mocks base method.
+func (m *MockQuery) GetAllergenByName(ctx context.Context, name string) (db.Allergen, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllergenByName", ctx, name)
+	ret0, _ := ret[0].(db.Allergen)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListMenuWithDishes indicates an expected call of ListMenuWithDishes.
-func (mr *MockQueryMockRecorder) ListMenuWithDishes(ctx, arg any) *gomock.Call {
+// GetAllergenByName indicates an expected call of GetAllergenByName.
+func (mr *MockQueryMockRecorder) GetAllergenByName(ctx, name any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMenuWithDishes", reflect.TypeOf((*MockQuery)(nil).ListMenuWithDishes), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllergenByName", reflect.TypeOf((*MockQuery)(nil).GetAllergenByName), ctx, name)
 }
 
-// ListMenuWithDishesByCity mocks base method.
-func (m *MockQuery) ListMenuWithDishesByCity(ctx context.Context, arg db.ListMenuWithDishesByCityParams) ([]db.ListMenuWithDishesByCityRow, error) {
+// GetApiKey mocks base method.
+func (m *MockQuery) GetApiKey(ctx context.Context, id int32) (db.ApiKey, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListMenuWithDishesByCity", ctx, arg)
-	ret0, _ := ret[0].([]db.ListMenuWithDishesByCityRow)
+	ret := m.ctrl.Call(m, "GetApiKey", ctx, id)
+	ret0, _ := ret[0].(db.ApiKey)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListMenuWithDishesByCity indicates an expected call of ListMenuWithDishesByCity.
-func (mr *MockQueryMockRecorder) ListMenuWithDishesByCity(ctx, arg any) *gomock.Call {
+// GetApiKey indicates an expected call of GetApiKey.
+func (mr *MockQueryMockRecorder) GetApiKey(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMenuWithDishesByCity", reflect.TypeOf((*MockQuery)(nil).ListMenuWithDishesByCity), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApiKey", reflect.TypeOf((*MockQuery)(nil).GetApiKey), ctx, id)
 }
 
-// UpdateAvailable mocks base method.
-func (m *MockQuery) UpdateAvailable(ctx context.Context, cityCode int32) error {
+// GetApiKeyByHashedKey mocks base method.
+func (m *MockQuery) GetApiKeyByHashedKey(ctx context.Context, hashedKey string) (db.ApiKey, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateAvailable", ctx, cityCode)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "GetApiKeyByHashedKey", ctx, hashedKey)
+	ret0, _ := ret[0].(db.ApiKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// UpdateAvailable indicates an expected call of UpdateAvailable.
-func (mr *MockQueryMockRecorder) UpdateAvailable(ctx, cityCode any) *gomock.Call {
+// GetApiKeyByHashedKey indicates an expected call of GetApiKeyByHashedKey.
+func (mr *MockQueryMockRecorder) GetApiKeyByHashedKey(ctx, hashedKey any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAvailable", reflect.TypeOf((*MockQuery)(nil).UpdateAvailable), ctx, cityCode)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApiKeyByHashedKey", reflect.TypeOf((*MockQuery)(nil).GetApiKeyByHashedKey), ctx, hashedKey)
+}
+
+// GetCity mocks base method.
+func (m *MockQuery) GetCity(ctx context.Context, cityCode int32) (db.City, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCity", ctx, cityCode)
+	ret0, _ := ret[0].(db.City)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCity indicates an expected call of GetCity.
+func (mr *MockQueryMockRecorder) GetCity(ctx, cityCode any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCity", reflect.TypeOf((*MockQuery)(nil).GetCity), ctx, cityCode)
+}
+
+// GetDish mocks base method.
+func (m *MockQuery) GetDish(ctx context.Context, arg db.GetDishParams) ([]db.GetDishRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDish", ctx, arg)
+	ret0, _ := ret[0].([]db.GetDishRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDish indicates an expected call of GetDish.
+func (mr *MockQueryMockRecorder) GetDish(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDish", reflect.TypeOf((*MockQuery)(nil).GetDish), ctx, arg)
+}
+
+// GetDishByID mocks base method.
+func (m *MockQuery) GetDishByID(ctx context.Context, id string) (db.GetDishByIDRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDishByID", ctx, id)
+	ret0, _ := ret[0].(db.GetDishByIDRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDishByID indicates an expected call of GetDishByID.
+func (mr *MockQueryMockRecorder) GetDishByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDishByID", reflect.TypeOf((*MockQuery)(nil).GetDishByID), ctx, id)
+}
+
+// GetDishInCity mocks base method.
+func (m *MockQuery) GetDishInCity(ctx context.Context, arg db.GetDishInCityParams) ([]db.GetDishInCityRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDishInCity", ctx, arg)
+	ret0, _ := ret[0].([]db.GetDishInCityRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDishInCity indicates an expected call of GetDishInCity.
+func (mr *MockQueryMockRecorder) GetDishInCity(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDishInCity", reflect.TypeOf((*MockQuery)(nil).GetDishInCity), ctx, arg)
+}
+
+// GetExternalDataSource mocks base method.
+func (m *MockQuery) GetExternalDataSource(ctx context.Context, sourceID int32) (db.ExternalDataSource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExternalDataSource", ctx, sourceID)
+	ret0, _ := ret[0].(db.ExternalDataSource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExternalDataSource indicates an expected call of GetExternalDataSource.
+func (mr *MockQueryMockRecorder) GetExternalDataSource(ctx, sourceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExternalDataSource", reflect.TypeOf((*MockQuery)(nil).GetExternalDataSource), ctx, sourceID)
+}
+
+// GetIngredient mocks base method.
+func (m *MockQuery) GetIngredient(ctx context.Context, id int32) (db.GetIngredientRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIngredient", ctx, id)
+	ret0, _ := ret[0].(db.GetIngredientRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIngredient indicates an expected call of GetIngredient.
+func (mr *MockQueryMockRecorder) GetIngredient(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIngredient", reflect.TypeOf((*MockQuery)(nil).GetIngredient), ctx, id)
+}
+
+// GetMenu mocks base method.
+func (m *MockQuery) GetMenu(ctx context.Context, arg db.GetMenuParams) (db.Menu, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMenu", ctx, arg)
+	ret0, _ := ret[0].(db.Menu)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMenu indicates an expected call of GetMenu.
+func (mr *MockQueryMockRecorder) GetMenu(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMenu", reflect.TypeOf((*MockQuery)(nil).GetMenu), ctx, arg)
+}
+
+// GetMenuByID mocks base method.
+func (m *MockQuery) GetMenuByID(ctx context.Context, id string) (db.Menu, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMenuByID", ctx, id)
+	ret0, _ := ret[0].(db.Menu)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMenuByID indicates an expected call of GetMenuByID.
+func (mr *MockQueryMockRecorder) GetMenuByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMenuByID", reflect.TypeOf((*MockQuery)(nil).GetMenuByID), ctx, id)
+}
+
+// GetMenuWithDishes mocks base method.
+func (m *MockQuery) GetMenuWithDishes(ctx context.Context, arg db.GetMenuWithDishesParams) ([]db.GetMenuWithDishesRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMenuWithDishes", ctx, arg)
+	ret0, _ := ret[0].([]db.GetMenuWithDishesRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMenuWithDishes indicates an expected call of GetMenuWithDishes.
+func (mr *MockQueryMockRecorder) GetMenuWithDishes(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMenuWithDishes", reflect.TypeOf((*MockQuery)(nil).GetMenuWithDishes), ctx, arg)
+}
+
+// GetSession mocks base method.
+func (m *MockQuery) GetSession(ctx context.Context, id string) (db.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSession", ctx, id)
+	ret0, _ := ret[0].(db.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSession indicates an expected call of GetSession.
+func (mr *MockQueryMockRecorder) GetSession(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSession", reflect.TypeOf((*MockQuery)(nil).GetSession), ctx, id)
+}
+
+// GetUser mocks base method.
+func (m *MockQuery) GetUser(ctx context.Context, id int32) (db.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUser", ctx, id)
+	ret0, _ := ret[0].(db.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUser indicates an expected call of GetUser.
+func (mr *MockQueryMockRecorder) GetUser(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockQuery)(nil).GetUser), ctx, id)
+}
+
+// GetUserByUsername mocks base method.
+func (m *MockQuery) GetUserByUsername(ctx context.Context, username string) (db.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserByUsername", ctx, username)
+	ret0, _ := ret[0].(db.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserByUsername indicates an expected call of GetUserByUsername.
+func (mr *MockQueryMockRecorder) GetUserByUsername(ctx, username any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByUsername", reflect.TypeOf((*MockQuery)(nil).GetUserByUsername), ctx, username)
+}
+
+// ImportMenusTx mocks base method.
+func (m *MockQuery) ImportMenusTx(ctx context.Context, cityCode int32, rows []*domain.ImportMenuRow) (db.ImportMenusTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportMenusTx", ctx, cityCode, rows)
+	ret0, _ := ret[0].(db.ImportMenusTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportMenusTx indicates an expected call of ImportMenusTx.
+func (mr *MockQueryMockRecorder) ImportMenusTx(ctx, cityCode, rows any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportMenusTx", reflect.TypeOf((*MockQuery)(nil).ImportMenusTx), ctx, cityCode, rows)
+}
+
+// ListAllergenByDishID mocks base method.
+func (m *MockQuery) ListAllergenByDishID(ctx context.Context, dishID string) ([]db.ListAllergenByDishIDRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAllergenByDishID", ctx, dishID)
+	ret0, _ := ret[0].([]db.ListAllergenByDishIDRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAllergenByDishID indicates an expected call of ListAllergenByDishID.
+func (mr *MockQueryMockRecorder) ListAllergenByDishID(ctx, dishID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAllergenByDishID", reflect.TypeOf((*MockQuery)(nil).ListAllergenByDishID), ctx, dishID)
+}
+
+// ListAllergenByDishIDs mocks base method.
+func (m *MockQuery) ListAllergenByDishIDs(ctx context.Context, dishIds []string) ([]db.ListAllergenByDishIDsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAllergenByDishIDs", ctx, dishIds)
+	ret0, _ := ret[0].([]db.ListAllergenByDishIDsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAllergenByDishIDs indicates an expected call of ListAllergenByDishIDs.
+func (mr *MockQueryMockRecorder) ListAllergenByDishIDs(ctx, dishIds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAllergenByDishIDs", reflect.TypeOf((*MockQuery)(nil).ListAllergenByDishIDs), ctx, dishIds)
+}
+
+// ListAllergenInDish mocks base method.
+func (m *MockQuery) ListAllergenInDish(ctx context.Context, dishIds []string) ([]db.ListAllergenInDishRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAllergenInDish", ctx, dishIds)
+	ret0, _ := ret[0].([]db.ListAllergenInDishRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAllergenInDish indicates an expected call of ListAllergenInDish.
+func (mr *MockQueryMockRecorder) ListAllergenInDish(ctx, dishIds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAllergenInDish", reflect.TypeOf((*MockQuery)(nil).ListAllergenInDish), ctx, dishIds)
+}
+
+// ListAllergenInDishByAllergenIDs mocks base method.
+func (m *MockQuery) ListAllergenInDishByAllergenIDs(ctx context.Context, arg db.ListAllergenInDishByAllergenIDsParams) ([]db.ListAllergenInDishByAllergenIDsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAllergenInDishByAllergenIDs", ctx, arg)
+	ret0, _ := ret[0].([]db.ListAllergenInDishByAllergenIDsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAllergenInDishByAllergenIDs indicates an expected call of ListAllergenInDishByAllergenIDs.
+func (mr *MockQueryMockRecorder) ListAllergenInDishByAllergenIDs(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAllergenInDishByAllergenIDs", reflect.TypeOf((*MockQuery)(nil).ListAllergenInDishByAllergenIDs), ctx, arg)
+}
+
+// ListAllergenMatrixByCity mocks base method.
+func (m *MockQuery) ListAllergenMatrixByCity(ctx context.Context, arg db.ListAllergenMatrixByCityParams) ([]db.ListAllergenMatrixByCityRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAllergenMatrixByCity", ctx, arg)
+	ret0, _ := ret[0].([]db.ListAllergenMatrixByCityRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAllergenMatrixByCity indicates an expected call of ListAllergenMatrixByCity.
+func (mr *MockQueryMockRecorder) ListAllergenMatrixByCity(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAllergenMatrixByCity", reflect.TypeOf((*MockQuery)(nil).ListAllergenMatrixByCity), ctx, arg)
+}
+
+// ListAllergensByIDs mocks base method.
+func (m *MockQuery) ListAllergensByIDs(ctx context.Context, ids []int32) ([]db.ListAllergensByIDsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAllergensByIDs", ctx, ids)
+	ret0, _ := ret[0].([]db.ListAllergensByIDsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAllergensByIDs indicates an expected call of ListAllergensByIDs.
+func (mr *MockQueryMockRecorder) ListAllergensByIDs(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAllergensByIDs", reflect.TypeOf((*MockQuery)(nil).ListAllergensByIDs), ctx, ids)
+}
+
+// ListApiKeys mocks base method.
+func (m *MockQuery) ListApiKeys(ctx context.Context, arg db.ListApiKeysParams) ([]db.ApiKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListApiKeys", ctx, arg)
+	ret0, _ := ret[0].([]db.ApiKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListApiKeys indicates an expected call of ListApiKeys.
+func (mr *MockQueryMockRecorder) ListApiKeys(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListApiKeys", reflect.TypeOf((*MockQuery)(nil).ListApiKeys), ctx, arg)
+}
+
+// ListAuditLogs mocks base method.
+func (m *MockQuery) ListAuditLogs(ctx context.Context, arg db.ListAuditLogsParams) ([]db.AuditLog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAuditLogs", ctx, arg)
+	ret0, _ := ret[0].([]db.AuditLog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAuditLogs indicates an expected call of ListAuditLogs.
+func (mr *MockQueryMockRecorder) ListAuditLogs(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAuditLogs", reflect.TypeOf((*MockQuery)(nil).ListAuditLogs), ctx, arg)
+}
+
+// ListCities mocks base method.
+func (m *MockQuery) ListCities(ctx context.Context, arg db.ListCitiesParams) ([]db.City, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCities", ctx, arg)
+	ret0, _ := ret[0].([]db.City)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCities indicates an expected call of ListCities.
+func (mr *MockQueryMockRecorder) ListCities(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCities", reflect.TypeOf((*MockQuery)(nil).ListCities), ctx, arg)
+}
+
+// ListCitiesByName mocks base method.
+func (m *MockQuery) ListCitiesByName(ctx context.Context, arg db.ListCitiesByNameParams) ([]db.City, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCitiesByName", ctx, arg)
+	ret0, _ := ret[0].([]db.City)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCitiesByName indicates an expected call of ListCitiesByName.
+func (mr *MockQueryMockRecorder) ListCitiesByName(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCitiesByName", reflect.TypeOf((*MockQuery)(nil).ListCitiesByName), ctx, arg)
+}
+
+// ListCitiesByPrefecture mocks base method.
+func (m *MockQuery) ListCitiesByPrefecture(ctx context.Context, arg db.ListCitiesByPrefectureParams) ([]db.City, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCitiesByPrefecture", ctx, arg)
+	ret0, _ := ret[0].([]db.City)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCitiesByPrefecture indicates an expected call of ListCitiesByPrefecture.
+func (mr *MockQueryMockRecorder) ListCitiesByPrefecture(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCitiesByPrefecture", reflect.TypeOf((*MockQuery)(nil).ListCitiesByPrefecture), ctx, arg)
+}
+
+// ListCityCodeByDishID mocks base method.
+func (m *MockQuery) ListCityCodeByDishID(ctx context.Context, dishID string) ([]int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCityCodeByDishID", ctx, dishID)
+	ret0, _ := ret[0].([]int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCityCodeByDishID indicates an expected call of ListCityCodeByDishID.
+func (mr *MockQueryMockRecorder) ListCityCodeByDishID(ctx, dishID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCityCodeByDishID", reflect.TypeOf((*MockQuery)(nil).ListCityCodeByDishID), ctx, dishID)
+}
+
+// ListDish mocks base method.
+func (m *MockQuery) ListDish(ctx context.Context, arg db.ListDishParams) ([]db.ListDishRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDish", ctx, arg)
+	ret0, _ := ret[0].([]db.ListDishRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDish indicates an expected call of ListDish.
+func (mr *MockQueryMockRecorder) ListDish(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDish", reflect.TypeOf((*MockQuery)(nil).ListDish), ctx, arg)
+}
+
+// ListDishByAllergenID mocks base method.
+func (m *MockQuery) ListDishByAllergenID(ctx context.Context, arg db.ListDishByAllergenIDParams) ([]db.ListDishByAllergenIDRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDishByAllergenID", ctx, arg)
+	ret0, _ := ret[0].([]db.ListDishByAllergenIDRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDishByAllergenID indicates an expected call of ListDishByAllergenID.
+func (mr *MockQueryMockRecorder) ListDishByAllergenID(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDishByAllergenID", reflect.TypeOf((*MockQuery)(nil).ListDishByAllergenID), ctx, arg)
+}
+
+// ListDishByAllergenIDInCity mocks base method.
+func (m *MockQuery) ListDishByAllergenIDInCity(ctx context.Context, arg db.ListDishByAllergenIDInCityParams) ([]db.ListDishByAllergenIDInCityRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDishByAllergenIDInCity", ctx, arg)
+	ret0, _ := ret[0].([]db.ListDishByAllergenIDInCityRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDishByAllergenIDInCity indicates an expected call of ListDishByAllergenIDInCity.
+func (mr *MockQueryMockRecorder) ListDishByAllergenIDInCity(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDishByAllergenIDInCity", reflect.TypeOf((*MockQuery)(nil).ListDishByAllergenIDInCity), ctx, arg)
+}
+
+// ListDishByMenuID mocks base method.
+func (m *MockQuery) ListDishByMenuID(ctx context.Context, menuID string) ([]db.ListDishByMenuIDRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDishByMenuID", ctx, menuID)
+	ret0, _ := ret[0].([]db.ListDishByMenuIDRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDishByMenuID indicates an expected call of ListDishByMenuID.
+func (mr *MockQueryMockRecorder) ListDishByMenuID(ctx, menuID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDishByMenuID", reflect.TypeOf((*MockQuery)(nil).ListDishByMenuID), ctx, menuID)
+}
+
+// ListDishByName mocks base method.
+func (m *MockQuery) ListDishByName(ctx context.Context, arg db.ListDishByNameParams) ([]db.ListDishByNameRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDishByName", ctx, arg)
+	ret0, _ := ret[0].([]db.ListDishByNameRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDishByName indicates an expected call of ListDishByName.
+func (mr *MockQueryMockRecorder) ListDishByName(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDishByName", reflect.TypeOf((*MockQuery)(nil).ListDishByName), ctx, arg)
+}
+
+// ListDishByNameExcludingAllergens mocks base method.
+func (m *MockQuery) ListDishByNameExcludingAllergens(ctx context.Context, arg db.ListDishByNameExcludingAllergensParams) ([]db.ListDishByNameExcludingAllergensRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDishByNameExcludingAllergens", ctx, arg)
+	ret0, _ := ret[0].([]db.ListDishByNameExcludingAllergensRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDishByNameExcludingAllergens indicates an expected call of ListDishByNameExcludingAllergens.
+func (mr *MockQueryMockRecorder) ListDishByNameExcludingAllergens(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDishByNameExcludingAllergens", reflect.TypeOf((*MockQuery)(nil).ListDishByNameExcludingAllergens), ctx, arg)
+}
+
+// ListDishInNames mocks base method.
+func (m *MockQuery) ListDishInNames(ctx context.Context, names []string) ([]db.ListDishInNamesRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDishInNames", ctx, names)
+	ret0, _ := ret[0].([]db.ListDishInNamesRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDishInNames indicates an expected call of ListDishInNames.
+func (mr *MockQueryMockRecorder) ListDishInNames(ctx, names any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDishInNames", reflect.TypeOf((*MockQuery)(nil).ListDishInNames), ctx, names)
+}
+
+// ListExternalDataSources mocks base method.
+func (m *MockQuery) ListExternalDataSources(ctx context.Context, arg db.ListExternalDataSourcesParams) ([]db.ExternalDataSource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListExternalDataSources", ctx, arg)
+	ret0, _ := ret[0].([]db.ExternalDataSource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListExternalDataSources indicates an expected call of ListExternalDataSources.
+func (mr *MockQueryMockRecorder) ListExternalDataSources(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListExternalDataSources", reflect.TypeOf((*MockQuery)(nil).ListExternalDataSources), ctx, arg)
+}
+
+// ListExternalDataSourcesByStatus mocks base method.
+func (m *MockQuery) ListExternalDataSourcesByStatus(ctx context.Context, status string) ([]db.ExternalDataSource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListExternalDataSourcesByStatus", ctx, status)
+	ret0, _ := ret[0].([]db.ExternalDataSource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListExternalDataSourcesByStatus indicates an expected call of ListExternalDataSourcesByStatus.
+func (mr *MockQueryMockRecorder) ListExternalDataSourcesByStatus(ctx, status any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListExternalDataSourcesByStatus", reflect.TypeOf((*MockQuery)(nil).ListExternalDataSourcesByStatus), ctx, status)
+}
+
+// ListIngredientByDishID mocks base method.
+func (m *MockQuery) ListIngredientByDishID(ctx context.Context, dishID string) ([]db.ListIngredientByDishIDRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListIngredientByDishID", ctx, dishID)
+	ret0, _ := ret[0].([]db.ListIngredientByDishIDRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListIngredientByDishID indicates an expected call of ListIngredientByDishID.
+func (mr *MockQueryMockRecorder) ListIngredientByDishID(ctx, dishID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIngredientByDishID", reflect.TypeOf((*MockQuery)(nil).ListIngredientByDishID), ctx, dishID)
+}
+
+// ListIngredientInDish mocks base method.
+func (m *MockQuery) ListIngredientInDish(ctx context.Context, dishIds []string) ([]db.ListIngredientInDishRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListIngredientInDish", ctx, dishIds)
+	ret0, _ := ret[0].([]db.ListIngredientInDishRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListIngredientInDish indicates an expected call of ListIngredientInDish.
+func (mr *MockQueryMockRecorder) ListIngredientInDish(ctx, dishIds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIngredientInDish", reflect.TypeOf((*MockQuery)(nil).ListIngredientInDish), ctx, dishIds)
+}
+
+// ListIngredients mocks base method.
+func (m *MockQuery) ListIngredients(ctx context.Context, arg db.ListIngredientsParams) ([]db.ListIngredientsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListIngredients", ctx, arg)
+	ret0, _ := ret[0].([]db.ListIngredientsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListIngredients indicates an expected call of ListIngredients.
+func (mr *MockQueryMockRecorder) ListIngredients(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIngredients", reflect.TypeOf((*MockQuery)(nil).ListIngredients), ctx, arg)
+}
+
+// ListIngredientsByIDs mocks base method.
+func (m *MockQuery) ListIngredientsByIDs(ctx context.Context, ids []int32) ([]db.ListIngredientsByIDsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListIngredientsByIDs", ctx, ids)
+	ret0, _ := ret[0].([]db.ListIngredientsByIDsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListIngredientsByIDs indicates an expected call of ListIngredientsByIDs.
+func (mr *MockQueryMockRecorder) ListIngredientsByIDs(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIngredientsByIDs", reflect.TypeOf((*MockQuery)(nil).ListIngredientsByIDs), ctx, ids)
+}
+
+// ListLatestMenuWithDishesByCity mocks base method.
+func (m *MockQuery) ListLatestMenuWithDishesByCity(ctx context.Context, arg db.ListLatestMenuWithDishesByCityParams) ([]db.ListLatestMenuWithDishesByCityRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLatestMenuWithDishesByCity", ctx, arg)
+	ret0, _ := ret[0].([]db.ListLatestMenuWithDishesByCityRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLatestMenuWithDishesByCity indicates an expected call of ListLatestMenuWithDishesByCity.
+func (mr *MockQueryMockRecorder) ListLatestMenuWithDishesByCity(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLatestMenuWithDishesByCity", reflect.TypeOf((*MockQuery)(nil).ListLatestMenuWithDishesByCity), ctx, arg)
+}
+
+// ListMenu mocks base method.
+func (m *MockQuery) ListMenu(ctx context.Context, arg db.ListMenuParams) ([]db.Menu, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMenu", ctx, arg)
+	ret0, _ := ret[0].([]db.Menu)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMenu indicates an expected call of ListMenu.
+func (mr *MockQueryMockRecorder) ListMenu(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMenu", reflect.TypeOf((*MockQuery)(nil).ListMenu), ctx, arg)
+}
+
+// ListMenuByCity mocks base method.
+func (m *MockQuery) ListMenuByCity(ctx context.Context, arg db.ListMenuByCityParams) ([]db.Menu, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMenuByCity", ctx, arg)
+	ret0, _ := ret[0].([]db.Menu)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMenuByCity indicates an expected call of ListMenuByCity.
+func (mr *MockQueryMockRecorder) ListMenuByCity(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMenuByCity", reflect.TypeOf((*MockQuery)(nil).ListMenuByCity), ctx, arg)
+}
+
+// ListMenuByCityExcludingAllergens mocks base method.
+func (m *MockQuery) ListMenuByCityExcludingAllergens(ctx context.Context, arg db.ListMenuByCityExcludingAllergensParams) ([]db.Menu, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMenuByCityExcludingAllergens", ctx, arg)
+	ret0, _ := ret[0].([]db.Menu)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMenuByCityExcludingAllergens indicates an expected call of ListMenuByCityExcludingAllergens.
+func (mr *MockQueryMockRecorder) ListMenuByCityExcludingAllergens(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMenuByCityExcludingAllergens", reflect.TypeOf((*MockQuery)(nil).ListMenuByCityExcludingAllergens), ctx, arg)
+}
+
+// ListMenuIDByDishID mocks base method.
+func (m *MockQuery) ListMenuIDByDishID(ctx context.Context, dishID string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMenuIDByDishID", ctx, dishID)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMenuIDByDishID indicates an expected call of ListMenuIDByDishID.
+func (mr *MockQueryMockRecorder) ListMenuIDByDishID(ctx, dishID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMenuIDByDishID", reflect.TypeOf((*MockQuery)(nil).ListMenuIDByDishID), ctx, dishID)
+}
+
+// ListMenuInIds mocks base method.
+func (m *MockQuery) ListMenuInIds(ctx context.Context, arg db.ListMenuInIdsParams) ([]db.Menu, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMenuInIds", ctx, arg)
+	ret0, _ := ret[0].([]db.Menu)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMenuInIds indicates an expected call of ListMenuInIds.
+func (mr *MockQueryMockRecorder) ListMenuInIds(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMenuInIds", reflect.TypeOf((*MockQuery)(nil).ListMenuInIds), ctx, arg)
+}
+
+// ListMenuNutritionsByMenuIDs mocks base method.
+func (m *MockQuery) ListMenuNutritionsByMenuIDs(ctx context.Context, menuIds []string) ([]db.MenuNutrition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMenuNutritionsByMenuIDs", ctx, menuIds)
+	ret0, _ := ret[0].([]db.MenuNutrition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMenuNutritionsByMenuIDs indicates an expected call of ListMenuNutritionsByMenuIDs.
+func (mr *MockQueryMockRecorder) ListMenuNutritionsByMenuIDs(ctx, menuIds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMenuNutritionsByMenuIDs", reflect.TypeOf((*MockQuery)(nil).ListMenuNutritionsByMenuIDs), ctx, menuIds)
+}
+
+// ListMenuOfferedAtByCity mocks base method.
+func (m *MockQuery) ListMenuOfferedAtByCity(ctx context.Context, arg db.ListMenuOfferedAtByCityParams) ([]time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMenuOfferedAtByCity", ctx, arg)
+	ret0, _ := ret[0].([]time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMenuOfferedAtByCity indicates an expected call of ListMenuOfferedAtByCity.
+func (mr *MockQueryMockRecorder) ListMenuOfferedAtByCity(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMenuOfferedAtByCity", reflect.TypeOf((*MockQuery)(nil).ListMenuOfferedAtByCity), ctx, arg)
+}
+
+// ListMenuWithDishes mocks base method.
+func (m *MockQuery) ListMenuWithDishes(ctx context.Context, arg db.ListMenuWithDishesParams) ([]db.ListMenuWithDishesRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMenuWithDishes", ctx, arg)
+	ret0, _ := ret[0].([]db.ListMenuWithDishesRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMenuWithDishes indicates an expected call of ListMenuWithDishes.
+func (mr *MockQueryMockRecorder) ListMenuWithDishes(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMenuWithDishes", reflect.TypeOf((*MockQuery)(nil).ListMenuWithDishes), ctx, arg)
+}
+
+// ListMenuWithDishesByCity mocks base method.
+func (m *MockQuery) ListMenuWithDishesByCity(ctx context.Context, arg db.ListMenuWithDishesByCityParams) ([]db.ListMenuWithDishesByCityRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMenuWithDishesByCity", ctx, arg)
+	ret0, _ := ret[0].([]db.ListMenuWithDishesByCityRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMenuWithDishesByCity indicates an expected call of ListMenuWithDishesByCity.
+func (mr *MockQueryMockRecorder) ListMenuWithDishesByCity(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMenuWithDishesByCity", reflect.TypeOf((*MockQuery)(nil).ListMenuWithDishesByCity), ctx, arg)
+}
+
+// ListMenuWithDishesByCityBetween mocks base method.
+func (m *MockQuery) ListMenuWithDishesByCityBetween(ctx context.Context, arg db.ListMenuWithDishesByCityBetweenParams) ([]db.ListMenuWithDishesByCityBetweenRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMenuWithDishesByCityBetween", ctx, arg)
+	ret0, _ := ret[0].([]db.ListMenuWithDishesByCityBetweenRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMenuWithDishesByCityBetween indicates an expected call of ListMenuWithDishesByCityBetween.
+func (mr *MockQueryMockRecorder) ListMenuWithDishesByCityBetween(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMenuWithDishesByCityBetween", reflect.TypeOf((*MockQuery)(nil).ListMenuWithDishesByCityBetween), ctx, arg)
+}
+
+// ListMenuWithDishesByCityExcludingAllergens mocks base method.
+func (m *MockQuery) ListMenuWithDishesByCityExcludingAllergens(ctx context.Context, arg db.ListMenuWithDishesByCityExcludingAllergensParams) ([]db.ListMenuWithDishesByCityExcludingAllergensRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMenuWithDishesByCityExcludingAllergens", ctx, arg)
+	ret0, _ := ret[0].([]db.ListMenuWithDishesByCityExcludingAllergensRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMenuWithDishesByCityExcludingAllergens indicates an expected call of ListMenuWithDishesByCityExcludingAllergens.
+func (mr *MockQueryMockRecorder) ListMenuWithDishesByCityExcludingAllergens(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMenuWithDishesByCityExcludingAllergens", reflect.TypeOf((*MockQuery)(nil).ListMenuWithDishesByCityExcludingAllergens), ctx, arg)
+}
+
+// ListStandardAllergens mocks base method.
+func (m *MockQuery) ListStandardAllergens(ctx context.Context) ([]db.Allergen, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListStandardAllergens", ctx)
+	ret0, _ := ret[0].([]db.Allergen)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStandardAllergens indicates an expected call of ListStandardAllergens.
+func (mr *MockQueryMockRecorder) ListStandardAllergens(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStandardAllergens", reflect.TypeOf((*MockQuery)(nil).ListStandardAllergens), ctx)
+}
+
+// ListUsers mocks base method.
+func (m *MockQuery) ListUsers(ctx context.Context, arg db.ListUsersParams) ([]db.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUsers", ctx, arg)
+	ret0, _ := ret[0].([]db.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUsers indicates an expected call of ListUsers.
+func (mr *MockQueryMockRecorder) ListUsers(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsers", reflect.TypeOf((*MockQuery)(nil).ListUsers), ctx, arg)
+}
+
+// RevokeApiKey mocks base method.
+func (m *MockQuery) RevokeApiKey(ctx context.Context, id int32) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeApiKey", ctx, id)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeApiKey indicates an expected call of RevokeApiKey.
+func (mr *MockQueryMockRecorder) RevokeApiKey(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeApiKey", reflect.TypeOf((*MockQuery)(nil).RevokeApiKey), ctx, id)
+}
+
+// RevokeSessionsByUser mocks base method.
+func (m *MockQuery) RevokeSessionsByUser(ctx context.Context, userID int32) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeSessionsByUser", ctx, userID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeSessionsByUser indicates an expected call of RevokeSessionsByUser.
+func (mr *MockQueryMockRecorder) RevokeSessionsByUser(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeSessionsByUser", reflect.TypeOf((*MockQuery)(nil).RevokeSessionsByUser), ctx, userID)
+}
+
+// StartExternalDataSourceSync mocks base method.
+func (m *MockQuery) StartExternalDataSourceSync(ctx context.Context, sourceID int32) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartExternalDataSourceSync", ctx, sourceID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartExternalDataSourceSync indicates an expected call of StartExternalDataSourceSync.
+func (mr *MockQueryMockRecorder) StartExternalDataSourceSync(ctx, sourceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartExternalDataSourceSync", reflect.TypeOf((*MockQuery)(nil).StartExternalDataSourceSync), ctx, sourceID)
+}
+
+// UpdateAvailable mocks base method.
+func (m *MockQuery) UpdateAvailable(ctx context.Context, cityCode int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAvailable", ctx, cityCode)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAvailable indicates an expected call of UpdateAvailable.
+func (mr *MockQueryMockRecorder) UpdateAvailable(ctx, cityCode any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAvailable", reflect.TypeOf((*MockQuery)(nil).UpdateAvailable), ctx, cityCode)
+}
+
+// UpdateCity mocks base method.
+func (m *MockQuery) UpdateCity(ctx context.Context, arg db.UpdateCityParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCity", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateCity indicates an expected call of UpdateCity.
+func (mr *MockQueryMockRecorder) UpdateCity(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCity", reflect.TypeOf((*MockQuery)(nil).UpdateCity), ctx, arg)
+}
+
+// UpdateDishName mocks base method.
+func (m *MockQuery) UpdateDishName(ctx context.Context, arg db.UpdateDishNameParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDishName", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateDishName indicates an expected call of UpdateDishName.
+func (mr *MockQueryMockRecorder) UpdateDishName(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDishName", reflect.TypeOf((*MockQuery)(nil).UpdateDishName), ctx, arg)
+}
+
+// UpdateExternalDataSource mocks base method.
+func (m *MockQuery) UpdateExternalDataSource(ctx context.Context, arg db.UpdateExternalDataSourceParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateExternalDataSource", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateExternalDataSource indicates an expected call of UpdateExternalDataSource.
+func (mr *MockQueryMockRecorder) UpdateExternalDataSource(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateExternalDataSource", reflect.TypeOf((*MockQuery)(nil).UpdateExternalDataSource), ctx, arg)
+}
+
+// UpdateExternalDataSourceStatus mocks base method.
+func (m *MockQuery) UpdateExternalDataSourceStatus(ctx context.Context, arg db.UpdateExternalDataSourceStatusParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateExternalDataSourceStatus", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateExternalDataSourceStatus indicates an expected call of UpdateExternalDataSourceStatus.
+func (mr *MockQueryMockRecorder) UpdateExternalDataSourceStatus(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateExternalDataSourceStatus", reflect.TypeOf((*MockQuery)(nil).UpdateExternalDataSourceStatus), ctx, arg)
+}
+
+// UpdateIngredient mocks base method.
+func (m *MockQuery) UpdateIngredient(ctx context.Context, arg db.UpdateIngredientParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateIngredient", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateIngredient indicates an expected call of UpdateIngredient.
+func (mr *MockQueryMockRecorder) UpdateIngredient(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateIngredient", reflect.TypeOf((*MockQuery)(nil).UpdateIngredient), ctx, arg)
+}
+
+// UpdateMenu mocks base method.
+func (m *MockQuery) UpdateMenu(ctx context.Context, arg db.UpdateMenuParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMenu", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateMenu indicates an expected call of UpdateMenu.
+func (mr *MockQueryMockRecorder) UpdateMenu(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMenu", reflect.TypeOf((*MockQuery)(nil).UpdateMenu), ctx, arg)
+}
+
+// UpdateMenuPhoto mocks base method.
+func (m *MockQuery) UpdateMenuPhoto(ctx context.Context, arg db.UpdateMenuPhotoParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMenuPhoto", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateMenuPhoto indicates an expected call of UpdateMenuPhoto.
+func (mr *MockQueryMockRecorder) UpdateMenuPhoto(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMenuPhoto", reflect.TypeOf((*MockQuery)(nil).UpdateMenuPhoto), ctx, arg)
+}
+
+// UpdateMenuTx mocks base method.
+func (m *MockQuery) UpdateMenuTx(ctx context.Context, arg db.UpdateMenuParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMenuTx", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateMenuTx indicates an expected call of UpdateMenuTx.
+func (mr *MockQueryMockRecorder) UpdateMenuTx(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMenuTx", reflect.TypeOf((*MockQuery)(nil).UpdateMenuTx), ctx, arg)
+}
+
+// UpsertDishesIngredients mocks base method.
+func (m *MockQuery) UpsertDishesIngredients(ctx context.Context, arg db.UpsertDishesIngredientsParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertDishesIngredients", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertDishesIngredients indicates an expected call of UpsertDishesIngredients.
+func (mr *MockQueryMockRecorder) UpsertDishesIngredients(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertDishesIngredients", reflect.TypeOf((*MockQuery)(nil).UpsertDishesIngredients), ctx, arg)
+}
+
+// UpsertDishesIngredientsTx mocks base method.
+func (m *MockQuery) UpsertDishesIngredientsTx(ctx context.Context, dishID string, ingredients []*domain.DishIngredient) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertDishesIngredientsTx", ctx, dishID, ingredients)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertDishesIngredientsTx indicates an expected call of UpsertDishesIngredientsTx.
+func (mr *MockQueryMockRecorder) UpsertDishesIngredientsTx(ctx, dishID, ingredients any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertDishesIngredientsTx", reflect.TypeOf((*MockQuery)(nil).UpsertDishesIngredientsTx), ctx, dishID, ingredients)
+}
+
+// UpsertMenuNutrition mocks base method.
+func (m *MockQuery) UpsertMenuNutrition(ctx context.Context, arg db.UpsertMenuNutritionParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertMenuNutrition", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertMenuNutrition indicates an expected call of UpsertMenuNutrition.
+func (mr *MockQueryMockRecorder) UpsertMenuNutrition(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertMenuNutrition", reflect.TypeOf((*MockQuery)(nil).UpsertMenuNutrition), ctx, arg)
 }
@@ -6,12 +6,55 @@ package db
 
 import (
 	"database/sql"
+	"encoding/json"
 	"time"
 )
 
 type Allergen struct {
 	ID   int32  `json:"id"`
 	Name string `json:"name"`
+	// 食品表示基準の品目を表す固定のコード
+	Code sql.NullString `json:"code"`
+	// specified (特定原材料) or recommended (特定原材料に準ずるもの) or other
+	Kind string `json:"kind"`
+}
+
+type ApiKey struct {
+	ID int32 `json:"id"`
+	// 利用者の名前
+	Name string `json:"name"`
+	// キーを識別するための先頭の文字列
+	Prefix string `json:"prefix"`
+	// SHA-256でハッシュ化したキー
+	HashedKey string `json:"hashed_key"`
+	// 1分あたりのリクエスト数
+	RateLimit int32 `json:"rate_limit"`
+	// 連続して受け付けるリクエスト数
+	Burst int32 `json:"burst"`
+	// 1日あたりのリクエスト数
+	DailyQuota int32 `json:"daily_quota"`
+	// キーが無効化されているかどうか
+	Revoked   bool      `json:"revoked"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type AuditLog struct {
+	ID int64 `json:"id"`
+	// 操作したユーザーのID
+	ActorID int32 `json:"actor_id"`
+	// ユーザーが削除されても追えるように保存する
+	ActorUsername string `json:"actor_username"`
+	Action        string `json:"action"`
+	EntityType    string `json:"entity_type"`
+	EntityID      string `json:"entity_id"`
+	// 操作した対象の市区町村コード
+	CityCode sql.NullInt32 `json:"city_code"`
+	// 変更前の状態
+	BeforeData json.RawMessage `json:"before_data"`
+	// 変更後の状態
+	AfterData json.RawMessage `json:"after_data"`
+	RequestID string          `json:"request_id"`
+	CreatedAt time.Time       `json:"created_at"`
 }
 
 type City struct {
@@ -32,7 +75,17 @@ type Dish struct {
 type DishesAllergen struct {
 	AllergenID int32  `json:"allergen_id"`
 	DishID     string `json:"dish_id"`
-	Category   int32  `json:"category"`
+	// 0: 含む, 1: 製造工程で混入する可能性がある
+	Category int32 `json:"category"`
+}
+
+type DishesIngredient struct {
+	DishID       string `json:"dish_id"`
+	IngredientID int32  `json:"ingredient_id"`
+	// 1人分の使用量 (g)
+	Quantity sql.NullFloat64 `json:"quantity"`
+	// 産地の都道府県コード
+	OriginPrefectureCode sql.NullInt32 `json:"origin_prefecture_code"`
 }
 
 type ExternalDataSource struct {
@@ -49,6 +102,12 @@ type ExternalDataSource struct {
 	Description sql.NullString `json:"description"`
 }
 
+type Ingredient struct {
+	ID        int32     `json:"id"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
 type Menu struct {
 	ID string `json:"id"`
 	// 給食の提供日
@@ -58,6 +117,18 @@ type Menu struct {
 	ElementarySchoolCalories int32          `json:"elementary_school_calories"`
 	JuniorHighSchoolCalories int32          `json:"junior_high_school_calories"`
 	CityCode                 int32          `json:"city_code"`
+	// Wikimedia Commons のファイルページ
+	PhotoFilePage sql.NullString `json:"photo_file_page"`
+	// 写真のライセンス
+	PhotoLicense sql.NullString `json:"photo_license"`
+	// 写真のクレジット表記
+	PhotoAttribution sql.NullString `json:"photo_attribution"`
+	// 幅 240px のサムネイル
+	PhotoSmallUrl sql.NullString `json:"photo_small_url"`
+	// 幅 640px のサムネイル
+	PhotoMediumUrl sql.NullString `json:"photo_medium_url"`
+	// 幅 1280px のサムネイル
+	PhotoLargeUrl sql.NullString `json:"photo_large_url"`
 }
 
 type MenuDish struct {
@@ -65,6 +136,42 @@ type MenuDish struct {
 	DishID string `json:"dish_id"`
 }
 
+type MenuNutrition struct {
+	MenuID string `json:"menu_id"`
+	// elementary または junior_high
+	SchoolLevel string `json:"school_level"`
+	// たんぱく質 (g)
+	Protein sql.NullFloat64 `json:"protein"`
+	// 脂質 (g)
+	Fat sql.NullFloat64 `json:"fat"`
+	// 炭水化物 (g)
+	Carbohydrate sql.NullFloat64 `json:"carbohydrate"`
+	// 食塩相当量 (g)
+	Salt sql.NullFloat64 `json:"salt"`
+	// カルシウム (mg)
+	Calcium sql.NullFloat64 `json:"calcium"`
+	// 鉄 (mg)
+	Iron sql.NullFloat64 `json:"iron"`
+	// ビタミンA (µgRAE)
+	VitaminA sql.NullFloat64 `json:"vitamin_a"`
+	// ビタミンB1 (mg)
+	VitaminB1 sql.NullFloat64 `json:"vitamin_b1"`
+	// ビタミンB2 (mg)
+	VitaminB2 sql.NullFloat64 `json:"vitamin_b2"`
+	// ビタミンC (mg)
+	VitaminC sql.NullFloat64 `json:"vitamin_c"`
+}
+
+type Session struct {
+	// トークンのID(jti)
+	ID     string `json:"id"`
+	UserID int32  `json:"user_id"`
+	// トークンが無効化されているかどうか
+	Revoked   bool      `json:"revoked"`
+	ExpiresAt time.Time `json:"expires_at"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
 type User struct {
 	ID             int32  `json:"id"`
 	Username       string `json:"username"`
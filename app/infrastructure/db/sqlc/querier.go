@@ -6,35 +6,107 @@ package db
 
 import (
 	"context"
+	"time"
 )
 
 type Querier interface {
 	CreateAllergen(ctx context.Context, name string) error
+	CreateApiKey(ctx context.Context, arg CreateApiKeyParams) (int64, error)
+	CreateAuditLog(ctx context.Context, arg CreateAuditLogParams) error
 	CreateCity(ctx context.Context, arg CreateCityParams) error
 	CreateDish(ctx context.Context, arg CreateDishParams) error
 	CreateDishesAllergens(ctx context.Context, arg CreateDishesAllergensParams) error
+	CreateExternalDataSource(ctx context.Context, arg CreateExternalDataSourceParams) (int64, error)
+	CreateIngredient(ctx context.Context, name string) (int64, error)
 	CreateMenu(ctx context.Context, arg CreateMenuParams) error
 	CreateMenuDish(ctx context.Context, arg CreateMenuDishParams) error
+	CreateSession(ctx context.Context, arg CreateSessionParams) error
+	CreateUser(ctx context.Context, arg CreateUserParams) (int64, error)
+	DeleteDish(ctx context.Context, id string) (int64, error)
+	DeleteDishesAllergens(ctx context.Context, arg DeleteDishesAllergensParams) (int64, error)
+	DeleteDishesAllergensByDishID(ctx context.Context, dishID string) (int64, error)
+	DeleteDishesIngredients(ctx context.Context, arg DeleteDishesIngredientsParams) (int64, error)
+	DeleteDishesIngredientsByDishID(ctx context.Context, dishID string) (int64, error)
+	DeleteDishesIngredientsByIngredientID(ctx context.Context, ingredientID int32) (int64, error)
+	DeleteExternalDataSource(ctx context.Context, sourceID int32) (int64, error)
+	DeleteIngredient(ctx context.Context, id int32) (int64, error)
+	DeleteMenu(ctx context.Context, id string) (int64, error)
+	DeleteMenuDish(ctx context.Context, arg DeleteMenuDishParams) (int64, error)
+	DeleteMenuDishesByDishID(ctx context.Context, dishID string) (int64, error)
+	DeleteMenuDishesByMenuID(ctx context.Context, menuID string) (int64, error)
+	DeleteMenuNutrition(ctx context.Context, arg DeleteMenuNutritionParams) (int64, error)
+	DeleteMenuNutritionsByMenuID(ctx context.Context, menuID string) error
+	DeleteSessionsByUser(ctx context.Context, userID int32) error
+	DeleteUser(ctx context.Context, id int32) (int64, error)
 	GetAllergenByName(ctx context.Context, name string) (Allergen, error)
+	GetApiKey(ctx context.Context, id int32) (ApiKey, error)
+	GetApiKeyByHashedKey(ctx context.Context, hashedKey string) (ApiKey, error)
 	GetCity(ctx context.Context, cityCode int32) (City, error)
 	GetDish(ctx context.Context, arg GetDishParams) ([]GetDishRow, error)
+	GetDishByID(ctx context.Context, id string) (GetDishByIDRow, error)
 	GetDishInCity(ctx context.Context, arg GetDishInCityParams) ([]GetDishInCityRow, error)
+	GetExternalDataSource(ctx context.Context, sourceID int32) (ExternalDataSource, error)
+	GetIngredient(ctx context.Context, id int32) (GetIngredientRow, error)
 	GetMenu(ctx context.Context, arg GetMenuParams) (Menu, error)
+	GetMenuByID(ctx context.Context, id string) (Menu, error)
 	GetMenuWithDishes(ctx context.Context, arg GetMenuWithDishesParams) ([]GetMenuWithDishesRow, error)
+	GetSession(ctx context.Context, id string) (Session, error)
+	GetUser(ctx context.Context, id int32) (User, error)
+	GetUserByUsername(ctx context.Context, username string) (User, error)
 	ListAllergenByDishID(ctx context.Context, dishID string) ([]ListAllergenByDishIDRow, error)
+	ListAllergenByDishIDs(ctx context.Context, dishIds []string) ([]ListAllergenByDishIDsRow, error)
 	ListAllergenInDish(ctx context.Context, dishIds []string) ([]ListAllergenInDishRow, error)
+	ListAllergenInDishByAllergenIDs(ctx context.Context, arg ListAllergenInDishByAllergenIDsParams) ([]ListAllergenInDishByAllergenIDsRow, error)
+	ListAllergenMatrixByCity(ctx context.Context, arg ListAllergenMatrixByCityParams) ([]ListAllergenMatrixByCityRow, error)
+	ListAllergensByIDs(ctx context.Context, ids []int32) ([]ListAllergensByIDsRow, error)
+	ListApiKeys(ctx context.Context, arg ListApiKeysParams) ([]ApiKey, error)
+	ListAuditLogs(ctx context.Context, arg ListAuditLogsParams) ([]AuditLog, error)
 	ListCities(ctx context.Context, arg ListCitiesParams) ([]City, error)
 	ListCitiesByName(ctx context.Context, arg ListCitiesByNameParams) ([]City, error)
 	ListCitiesByPrefecture(ctx context.Context, arg ListCitiesByPrefectureParams) ([]City, error)
+	ListCityCodeByDishID(ctx context.Context, dishID string) ([]int32, error)
 	ListDish(ctx context.Context, arg ListDishParams) ([]ListDishRow, error)
+	ListDishByAllergenID(ctx context.Context, arg ListDishByAllergenIDParams) ([]ListDishByAllergenIDRow, error)
+	ListDishByAllergenIDInCity(ctx context.Context, arg ListDishByAllergenIDInCityParams) ([]ListDishByAllergenIDInCityRow, error)
 	ListDishByMenuID(ctx context.Context, menuID string) ([]ListDishByMenuIDRow, error)
 	ListDishByName(ctx context.Context, arg ListDishByNameParams) ([]ListDishByNameRow, error)
+	ListDishByNameExcludingAllergens(ctx context.Context, arg ListDishByNameExcludingAllergensParams) ([]ListDishByNameExcludingAllergensRow, error)
+	ListDishInNames(ctx context.Context, names []string) ([]ListDishInNamesRow, error)
+	ListExternalDataSources(ctx context.Context, arg ListExternalDataSourcesParams) ([]ExternalDataSource, error)
+	ListExternalDataSourcesByStatus(ctx context.Context, status string) ([]ExternalDataSource, error)
+	ListIngredientByDishID(ctx context.Context, dishID string) ([]ListIngredientByDishIDRow, error)
+	ListIngredientInDish(ctx context.Context, dishIds []string) ([]ListIngredientInDishRow, error)
+	ListIngredients(ctx context.Context, arg ListIngredientsParams) ([]ListIngredientsRow, error)
+	ListIngredientsByIDs(ctx context.Context, ids []int32) ([]ListIngredientsByIDsRow, error)
+	ListLatestMenuWithDishesByCity(ctx context.Context, arg ListLatestMenuWithDishesByCityParams) ([]ListLatestMenuWithDishesByCityRow, error)
 	ListMenu(ctx context.Context, arg ListMenuParams) ([]Menu, error)
 	ListMenuByCity(ctx context.Context, arg ListMenuByCityParams) ([]Menu, error)
+	ListMenuByCityExcludingAllergens(ctx context.Context, arg ListMenuByCityExcludingAllergensParams) ([]Menu, error)
+	ListMenuIDByDishID(ctx context.Context, dishID string) ([]string, error)
 	ListMenuInIds(ctx context.Context, arg ListMenuInIdsParams) ([]Menu, error)
+	ListMenuNutritionsByMenuIDs(ctx context.Context, menuIds []string) ([]MenuNutrition, error)
+	ListMenuOfferedAtByCity(ctx context.Context, arg ListMenuOfferedAtByCityParams) ([]time.Time, error)
 	ListMenuWithDishes(ctx context.Context, arg ListMenuWithDishesParams) ([]ListMenuWithDishesRow, error)
 	ListMenuWithDishesByCity(ctx context.Context, arg ListMenuWithDishesByCityParams) ([]ListMenuWithDishesByCityRow, error)
+	ListMenuWithDishesByCityBetween(ctx context.Context, arg ListMenuWithDishesByCityBetweenParams) ([]ListMenuWithDishesByCityBetweenRow, error)
+	ListMenuWithDishesByCityExcludingAllergens(ctx context.Context, arg ListMenuWithDishesByCityExcludingAllergensParams) ([]ListMenuWithDishesByCityExcludingAllergensRow, error)
+	ListStandardAllergens(ctx context.Context) ([]Allergen, error)
+	ListUsers(ctx context.Context, arg ListUsersParams) ([]User, error)
+	RevokeApiKey(ctx context.Context, id int32) (int64, error)
+	RevokeSessionsByUser(ctx context.Context, userID int32) (int64, error)
+	StartExternalDataSourceSync(ctx context.Context, sourceID int32) (int64, error)
 	UpdateAvailable(ctx context.Context, cityCode int32) error
+	UpdateCity(ctx context.Context, arg UpdateCityParams) error
+	UpdateDishName(ctx context.Context, arg UpdateDishNameParams) error
+	UpdateExternalDataSource(ctx context.Context, arg UpdateExternalDataSourceParams) error
+	UpdateExternalDataSourceStatus(ctx context.Context, arg UpdateExternalDataSourceStatusParams) error
+	UpdateIngredient(ctx context.Context, arg UpdateIngredientParams) error
+	// 写真が変更された場合はクレジットとサムネイルを削除する
+	// 代入は左から順に評価されるため、photo_url より先にクレジットとサムネイルを更新する
+	UpdateMenu(ctx context.Context, arg UpdateMenuParams) error
+	UpdateMenuPhoto(ctx context.Context, arg UpdateMenuPhotoParams) error
+	UpsertDishesIngredients(ctx context.Context, arg UpsertDishesIngredientsParams) error
+	UpsertMenuNutrition(ctx context.Context, arg UpsertMenuNutritionParams) error
 }
 
 var _ Querier = (*Queries)(nil)
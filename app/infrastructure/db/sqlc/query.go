@@ -9,8 +9,17 @@ import (
 
 type Query interface {
 	Querier
-	CreateDishTx(ctx context.Context, dish *domain.Dish, menuID string) error
-	CreateDishesTx(ctx context.Context, dishes []*domain.Dish, menuID string) error
+	CreateMenuTx(ctx context.Context, arg CreateMenuParams) error
+	UpdateMenuTx(ctx context.Context, arg UpdateMenuParams) error
+	CreateDishTx(ctx context.Context, dish *domain.Dish, menuID string) (CreateDishesTxResult, error)
+	CreateDishesTx(ctx context.Context, dishes []*domain.Dish, menuID string) (CreateDishesTxResult, error)
+	CreateDishesAllergensTx(ctx context.Context, dishID string, allergens []*domain.Allergen) error
+	UpsertDishesIngredientsTx(ctx context.Context, dishID string, ingredients []*domain.DishIngredient) error
+	DeleteMenuTx(ctx context.Context, menuID string) (DeleteMenuTxResult, error)
+	DeleteDishTx(ctx context.Context, dishID string, force bool) (DeleteDishTxResult, error)
+	DeleteIngredientTx(ctx context.Context, ingredientID int32) error
+	ImportMenusTx(ctx context.Context, cityCode int32, rows []*domain.ImportMenuRow) (ImportMenusTxResult, error)
+	DeleteUserTx(ctx context.Context, userID int32) error
 }
 
 type SQLQuery struct {
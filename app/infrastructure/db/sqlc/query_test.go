@@ -2,8 +2,10 @@ package db
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/ogurilab/school-lunch-api/domain"
 	"github.com/ogurilab/school-lunch-api/util"
@@ -35,7 +37,7 @@ func TestCreateDishTx(t *testing.T) {
 			dish, err := domain.NewDish(util.RandomString(10))
 			require.NoError(t, err)
 
-			err = testQuery.CreateDishTx(ctx, dish, menu.ID)
+			_, err = testQuery.CreateDishTx(ctx, dish, menu.ID)
 			errs <- err
 			results <- createDishResult{
 				dish:     dish,
@@ -74,7 +76,7 @@ func TestCreateDishTxRollback(t *testing.T) {
 
 			ctx := context.Background()
 
-			err = testQuery.CreateDishTx(ctx, duplicateDish, menu.ID)
+			_, err = testQuery.CreateDishTx(ctx, duplicateDish, menu.ID)
 
 			errs <- err
 			results <- createDishResult{
@@ -98,9 +100,8 @@ func TestCreateDishTxRollback(t *testing.T) {
 		} else {
 			// 2回目以降は失敗する
 			require.Error(t, err)
-			expectError := fmt.Sprintf("Error 1062 (23000): Duplicate entry '%s' for key 'dishes.PRIMARY'", duplicateDish.ID)
-
-			require.EqualError(t, err, expectError)
+			// 同名の料理は再利用されるため、献立との紐付けが重複してエラーになる
+			require.True(t, util.IsDuplicateEntry(err))
 
 			// MenuDishが保存されていないことを確認する
 			count, err := testQuery.getMenuDishesAllCount(menu.ID)
@@ -134,7 +135,7 @@ func TestCreateDishesTx(t *testing.T) {
 
 			ctx := context.Background()
 
-			err := testQuery.CreateDishesTx(ctx, dishes, menu.ID)
+			_, err := testQuery.CreateDishesTx(ctx, dishes, menu.ID)
 
 			errs <- err
 
@@ -191,7 +192,7 @@ func TestCreateDishesTxRollback(t *testing.T) {
 
 			ctx := context.Background()
 
-			err = testQuery.CreateDishesTx(ctx, dishes, menu.ID)
+			_, err = testQuery.CreateDishesTx(ctx, dishes, menu.ID)
 
 			errs <- err
 
@@ -222,9 +223,8 @@ func TestCreateDishesTxRollback(t *testing.T) {
 		} else {
 			// 2回目以降は失敗する
 			require.Error(t, err)
-			expectError := fmt.Sprintf("Error 1062 (23000): Duplicate entry '%s' for key 'dishes.PRIMARY'", duplicateDish.ID)
-
-			require.EqualError(t, err, expectError)
+			// 同名の料理は再利用されるため、献立との紐付けが重複してエラーになる
+			require.True(t, util.IsDuplicateEntry(err))
 
 			// MenuDishが保存されていないことを確認する
 			count, err := testQuery.getMenuDishesAllCount(menu.ID)
@@ -235,6 +235,58 @@ func TestCreateDishesTxRollback(t *testing.T) {
 	}
 }
 
+func TestCreateDishesTxReuseByName(t *testing.T) {
+	cityCode := util.RandomCityCode()
+	menu1 := createRandomMenu(t, cityCode)
+	menu2 := createRandomMenu(t, cityCode)
+
+	existing := createRandomDish(t, menu1.ID)
+
+	sameName, err := domain.NewDish(existing.Name)
+	require.NoError(t, err)
+
+	newDish, err := domain.NewDish(util.RandomString(10))
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	result, err := testQuery.CreateDishesTx(ctx, []*domain.Dish{sameName, newDish, newDish}, menu2.ID)
+
+	require.NoError(t, err)
+	require.Equal(t, []*domain.Dish{newDish}, result.Created)
+	require.Len(t, result.Reused, 1)
+	require.Equal(t, existing.ID, result.Reused[0].ID)
+
+	dishes, err := testQuery.ListDishByMenuID(ctx, menu2.ID)
+
+	require.NoError(t, err)
+	require.Len(t, dishes, 2)
+
+	// 既存の料理は別の献立とも紐付いたままになる
+	menuIDs, err := testQuery.ListMenuIDByDishID(ctx, existing.ID)
+
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{menu1.ID, menu2.ID}, menuIDs)
+}
+
+func TestCreateDishTxReuseByName(t *testing.T) {
+	cityCode := util.RandomCityCode()
+	menu1 := createRandomMenu(t, cityCode)
+	menu2 := createRandomMenu(t, cityCode)
+
+	existing := createRandomDish(t, menu1.ID)
+
+	sameName, err := domain.NewDish(existing.Name)
+	require.NoError(t, err)
+
+	result, err := testQuery.CreateDishTx(context.Background(), sameName, menu2.ID)
+
+	require.NoError(t, err)
+	require.Empty(t, result.Created)
+	require.Len(t, result.Reused, 1)
+	require.Equal(t, existing.ID, result.Reused[0].ID)
+}
+
 func requireCreatedDishAndMenuDish(t *testing.T, ctx context.Context, menu *domain.Menu, result createDishResult) {
 	// 正常に処理が完了しているか確認する
 	require.NotEmpty(t, result.dish.ID)
@@ -309,3 +361,356 @@ func requireCreatedDishesAndMenuDishes(t *testing.T, ctx context.Context, menu *
 		require.Equal(t, dish.Name, res[0].Name)
 	}
 }
+
+func TestCreateDishesAllergensTx(t *testing.T) {
+	cityCode := util.RandomCityCode()
+	menu := createRandomMenu(t, cityCode)
+	dish := createRandomDish(t, menu.ID)
+
+	allergens := createRandomAllergens(t, 5)
+
+	err := testQuery.CreateDishesAllergensTx(context.Background(), dish.ID, allergens)
+
+	require.NoError(t, err)
+
+	for _, allergen := range allergens {
+		result, err := testQuery.getDishesAllergens(dish.ID, allergen.ID, int32(allergen.Category))
+
+		require.NoError(t, err)
+		require.Equal(t, dish.ID, result.DishID)
+		require.Equal(t, allergen.ID, result.AllergenID)
+		require.Equal(t, int32(allergen.Category), result.Category)
+	}
+}
+
+func TestCreateDishesAllergensTxRollback(t *testing.T) {
+	cityCode := util.RandomCityCode()
+	menu := createRandomMenu(t, cityCode)
+	dish := createRandomDish(t, menu.ID)
+
+	allergens := createRandomAllergens(t, 5)
+
+	// 同じアレルゲンを重複して登録しようとするとエラーになる
+	duplicated := append(allergens, allergens[0])
+
+	err := testQuery.CreateDishesAllergensTx(context.Background(), dish.ID, duplicated)
+
+	require.Error(t, err)
+
+	// 1件も保存されていないことを確認する
+	for _, allergen := range allergens {
+		_, err := testQuery.getDishesAllergens(dish.ID, allergen.ID, int32(allergen.Category))
+
+		require.ErrorIs(t, err, sql.ErrNoRows)
+	}
+}
+
+func TestDeleteMenuTx(t *testing.T) {
+	cityCode := util.RandomCityCode()
+	menu := createRandomMenu(t, cityCode)
+
+	dishIDs := make([]string, 0, 3)
+
+	for i := 0; i < 3; i++ {
+		dish := createRandomDish(t, menu.ID)
+		dishIDs = append(dishIDs, dish.ID)
+	}
+
+	result, err := testQuery.DeleteMenuTx(context.Background(), menu.ID)
+
+	require.NoError(t, err)
+	require.Equal(t, menu.ID, result.MenuID)
+	require.ElementsMatch(t, dishIDs, result.DishIDs)
+
+	_, err = testQuery.GetMenuByID(context.Background(), menu.ID)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	// 料理自体は削除されず、献立との紐付けのみが削除される
+	dishes, err := testQuery.ListDishByMenuID(context.Background(), menu.ID)
+	require.NoError(t, err)
+	require.Empty(t, dishes)
+}
+
+func TestDeleteMenuTxNotFound(t *testing.T) {
+	_, err := testQuery.DeleteMenuTx(context.Background(), util.RandomUlid())
+
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestDeleteDishTx(t *testing.T) {
+	cityCode := util.RandomCityCode()
+	menu := createRandomMenu(t, cityCode)
+	dish := createRandomDish(t, menu.ID)
+
+	allergens := createRandomAllergens(t, 3)
+	err := testQuery.CreateDishesAllergensTx(context.Background(), dish.ID, allergens)
+	require.NoError(t, err)
+
+	ingredients := createRandomIngredients(t, 2)
+	err = testQuery.UpsertDishesIngredientsTx(context.Background(), dish.ID, toDishIngredients(dish.ID, ingredients))
+	require.NoError(t, err)
+
+	// 献立に紐付いている場合はforceなしでは削除できない
+	_, err = testQuery.DeleteDishTx(context.Background(), dish.ID, false)
+	require.ErrorIs(t, err, domain.ErrDishInUse)
+
+	_, err = testQuery.GetDishByID(context.Background(), dish.ID)
+	require.NoError(t, err)
+
+	result, err := testQuery.DeleteDishTx(context.Background(), dish.ID, true)
+
+	require.NoError(t, err)
+	require.Equal(t, dish.ID, result.DishID)
+	require.Equal(t, []string{menu.ID}, result.MenuIDs)
+	require.Equal(t, int64(len(allergens)), result.AllergensRemoved)
+	require.Equal(t, int64(len(ingredients)), result.IngredientsRemoved)
+
+	_, err = testQuery.GetDishByID(context.Background(), dish.ID)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	menuIDs, err := testQuery.ListMenuIDByDishID(context.Background(), dish.ID)
+	require.NoError(t, err)
+	require.Empty(t, menuIDs)
+}
+
+func TestDeleteMenuDish(t *testing.T) {
+	cityCode := util.RandomCityCode()
+	menu := createRandomMenu(t, cityCode)
+	dish := createRandomDish(t, menu.ID)
+
+	arg := DeleteMenuDishParams{
+		MenuID: menu.ID,
+		DishID: dish.ID,
+	}
+
+	affected, err := testQuery.DeleteMenuDish(context.Background(), arg)
+
+	require.NoError(t, err)
+	require.Equal(t, int64(1), affected)
+
+	// 料理自体は残る
+	_, err = testQuery.GetDishByID(context.Background(), dish.ID)
+	require.NoError(t, err)
+
+	menuIDs, err := testQuery.ListMenuIDByDishID(context.Background(), dish.ID)
+	require.NoError(t, err)
+	require.Empty(t, menuIDs)
+}
+
+func TestCreateMenuTx(t *testing.T) {
+	city := createRandomCity(t)
+
+	arg := CreateMenuParams{
+		ID:                       util.RandomUlid(),
+		OfferedAt:                util.RandomDate(),
+		PhotoUrl:                 util.RandomNullURL(),
+		ElementarySchoolCalories: util.RandomInt32(),
+		JuniorHighSchoolCalories: util.RandomInt32(),
+		CityCode:                 city.CityCode,
+	}
+
+	err := testQuery.CreateMenuTx(context.Background(), arg)
+
+	require.NoError(t, err)
+
+	menu, err := testQuery.GetMenuByID(context.Background(), arg.ID)
+
+	require.NoError(t, err)
+	require.Equal(t, arg.CityCode, menu.CityCode)
+
+	// 献立を登録した市区町村は給食のデータが利用可能になる
+	result, err := testQuery.GetCity(context.Background(), city.CityCode)
+
+	require.NoError(t, err)
+	require.True(t, result.SchoolLunchInfoAvailable)
+}
+
+func TestUpdateMenuTx(t *testing.T) {
+	menu := createRandomMenu(t, createRandomCity(t).CityCode)
+	city := createRandomCity(t)
+
+	arg := UpdateMenuParams{
+		ID:                       menu.ID,
+		OfferedAt:                menu.OfferedAt,
+		PhotoUrl:                 menu.PhotoUrl,
+		ElementarySchoolCalories: menu.ElementarySchoolCalories,
+		JuniorHighSchoolCalories: menu.JuniorHighSchoolCalories,
+		CityCode:                 city.CityCode,
+	}
+
+	err := testQuery.UpdateMenuTx(context.Background(), arg)
+
+	require.NoError(t, err)
+
+	updated, err := testQuery.GetMenuByID(context.Background(), arg.ID)
+
+	require.NoError(t, err)
+	require.Equal(t, city.CityCode, updated.CityCode)
+
+	// 献立の移った先の市区町村は給食のデータが利用可能になる
+	result, err := testQuery.GetCity(context.Background(), city.CityCode)
+
+	require.NoError(t, err)
+	require.True(t, result.SchoolLunchInfoAvailable)
+}
+
+func TestImportMenusTx(t *testing.T) {
+	cityCode := util.RandomCityCode()
+	existing := createRandomDish(t, createRandomMenu(t, cityCode).ID)
+	allergen := createRandomAllergen(t, util.RandomString(50), int32(domain.AllergenCategoryContains))
+	mayContain := createRandomAllergen(t, util.RandomString(50), int32(domain.AllergenCategoryMayContain))
+
+	rows := []*domain.ImportMenuRow{
+		randomImportMenuRow(t, 2, cityCode, existing.Name, allergen.Name, mayContain.Name),
+		randomImportMenuRow(t, 3, cityCode, existing.Name, allergen.Name, mayContain.Name),
+	}
+
+	result, err := testQuery.ImportMenusTx(context.Background(), cityCode, rows)
+
+	require.NoError(t, err)
+	require.True(t, result.Imported)
+	require.Len(t, result.Rows, len(rows))
+
+	for i, r := range result.Rows {
+		require.Equal(t, rows[i].Line, r.Line)
+		require.Equal(t, rows[i].Menu.ID, r.MenuID)
+		require.Empty(t, r.Errors)
+		// 既存の料理は再利用される
+		require.Contains(t, r.DishIDs, existing.ID)
+
+		dishes, err := testQuery.ListDishByMenuID(context.Background(), r.MenuID)
+		require.NoError(t, err)
+		require.Len(t, dishes, len(rows[i].Dishes))
+	}
+
+	allergens, err := testQuery.ListAllergenByDishID(context.Background(), existing.ID)
+	require.NoError(t, err)
+	require.Len(t, allergens, 1)
+	require.Equal(t, allergen.ID, allergens[0].ID)
+	require.Equal(t, int32(domain.AllergenCategoryContains), allergens[0].Category)
+
+	// 混入の可能性があるアレルゲンは category を 1 で登録する
+	var otherID string
+
+	for _, id := range result.Rows[0].DishIDs {
+		if id != existing.ID {
+			otherID = id
+		}
+	}
+
+	allergens, err = testQuery.ListAllergenByDishID(context.Background(), otherID)
+	require.NoError(t, err)
+	require.Len(t, allergens, 1)
+	require.Equal(t, mayContain.ID, allergens[0].ID)
+	require.Equal(t, int32(domain.AllergenCategoryMayContain), allergens[0].Category)
+}
+
+func TestImportMenusTxRollback(t *testing.T) {
+	cityCode := util.RandomCityCode()
+	menu := createRandomMenu(t, cityCode)
+
+	allergen := createRandomAllergen(t, util.RandomString(50), int32(domain.AllergenCategoryContains))
+
+	valid := randomImportMenuRow(t, 2, cityCode, util.RandomString(50), allergen.Name, allergen.Name)
+	duplicated := randomImportMenuRow(t, 3, cityCode, util.RandomString(50), allergen.Name, allergen.Name)
+	duplicated.Menu.OfferedAt = menu.OfferedAt
+
+	result, err := testQuery.ImportMenusTx(context.Background(), cityCode, []*domain.ImportMenuRow{valid, duplicated})
+
+	require.NoError(t, err)
+	require.False(t, result.Imported)
+	require.Len(t, result.Rows, 2)
+
+	require.Empty(t, result.Rows[0].Errors)
+	require.Empty(t, result.Rows[0].MenuID)
+	require.NotEmpty(t, result.Rows[1].Errors)
+
+	// 1行でも失敗した場合は全ての行がロールバックされる
+	_, err = testQuery.GetMenuByID(context.Background(), valid.Menu.ID)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	_, err = testQuery.GetAllergenByName(context.Background(), valid.Dishes[0].Allergens[0])
+	require.NoError(t, err)
+}
+
+func TestImportMenusTxUnknownAllergen(t *testing.T) {
+	cityCode := util.RandomCityCode()
+	allergen := createRandomAllergen(t, util.RandomString(50), int32(domain.AllergenCategoryContains))
+	unknown := util.RandomString(50)
+
+	valid := randomImportMenuRow(t, 2, cityCode, util.RandomString(50), allergen.Name, allergen.Name)
+	invalid := randomImportMenuRow(t, 3, cityCode, util.RandomString(50), unknown, allergen.Name)
+
+	result, err := testQuery.ImportMenusTx(context.Background(), cityCode, []*domain.ImportMenuRow{valid, invalid})
+
+	require.NoError(t, err)
+	require.False(t, result.Imported)
+	require.Len(t, result.Rows, 2)
+
+	require.Empty(t, result.Rows[0].Errors)
+	require.Equal(t, []string{fmt.Sprintf("unknown allergen %q", unknown)}, result.Rows[1].Errors)
+
+	// 未登録のアレルゲンはマスタに追加されず、全ての行がロールバックされる
+	_, err = testQuery.GetAllergenByName(context.Background(), unknown)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	_, err = testQuery.GetMenuByID(context.Background(), valid.Menu.ID)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func randomImportMenuRow(t *testing.T, line int, cityCode int32, dishName string, allergen string, mayContain string) *domain.ImportMenuRow {
+	menu, err := domain.NewMenu(
+		util.RandomDate(),
+		util.RandomNullURL(),
+		util.RandomInt32(),
+		util.RandomInt32(),
+		cityCode,
+	)
+
+	require.NoError(t, err)
+
+	dish, err := domain.NewDish(dishName)
+	require.NoError(t, err)
+
+	other, err := domain.NewDish(util.RandomString(50))
+	require.NoError(t, err)
+
+	return &domain.ImportMenuRow{
+		Line: line,
+		Menu: menu,
+		Dishes: []*domain.ImportDish{
+			{Dish: dish, Allergens: []string{allergen}},
+			{Dish: other, MayContain: []string{mayContain}},
+		},
+	}
+}
+
+func TestDeleteUserTx(t *testing.T) {
+	user := createRandomUser(t)
+
+	arg := CreateSessionParams{
+		ID:        util.NewUlid(),
+		UserID:    user.ID,
+		ExpiresAt: time.Now().Add(time.Hour).UTC().Truncate(time.Second),
+	}
+
+	err := testQuery.CreateSession(context.Background(), arg)
+
+	require.NoError(t, err)
+
+	err = testQuery.DeleteUserTx(context.Background(), user.ID)
+
+	require.NoError(t, err)
+
+	_, err = testQuery.GetUser(context.Background(), user.ID)
+
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	_, err = testQuery.GetSession(context.Background(), arg.ID)
+
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	err = testQuery.DeleteUserTx(context.Background(), user.ID)
+
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
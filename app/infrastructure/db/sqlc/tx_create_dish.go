@@ -6,27 +6,44 @@ import (
 	"github.com/ogurilab/school-lunch-api/domain"
 )
 
-func (q *SQLQuery) CreateDishTx(ctx context.Context, dish *domain.Dish, menuID string) error {
+func (q *SQLQuery) CreateDishTx(ctx context.Context, dish *domain.Dish, menuID string) (CreateDishesTxResult, error) {
+	var result CreateDishesTxResult
 
 	err := q.execTx(ctx, func(q *Queries) error {
-		dishArgs := CreateDishParams{
-			ID:   dish.ID,
-			Name: dish.Name,
-		}
 
-		err := q.CreateDish(ctx, dishArgs)
+		created, reused, err := resolveDishes(ctx, q, []*domain.Dish{dish})
 
 		if err != nil {
 			return err
 		}
 
-		menuDishArgs := CreateMenuDishParams{
-			MenuID: menuID,
-			DishID: dish.ID,
+		for _, d := range created {
+			dishArgs := CreateDishParams{
+				ID:   d.ID,
+				Name: d.Name,
+			}
+
+			if err := q.CreateDish(ctx, dishArgs); err != nil {
+				return err
+			}
+		}
+
+		for _, d := range append(created, reused...) {
+			menuDishArgs := CreateMenuDishParams{
+				MenuID: menuID,
+				DishID: d.ID,
+			}
+
+			if err := q.CreateMenuDish(ctx, menuDishArgs); err != nil {
+				return err
+			}
 		}
 
-		return q.CreateMenuDish(ctx, menuDishArgs)
+		result.Created = created
+		result.Reused = reused
+
+		return nil
 	})
 
-	return err
+	return result, err
 }
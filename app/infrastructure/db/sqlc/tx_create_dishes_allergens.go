@@ -0,0 +1,46 @@
+package db
+
+import (
+	"context"
+
+	"github.com/ogurilab/school-lunch-api/domain"
+)
+
+type bulkInsertDishesAllergensQuery struct {
+	query string
+	args  []any
+}
+
+func (q *SQLQuery) CreateDishesAllergensTx(ctx context.Context, dishID string, allergens []*domain.Allergen) error {
+
+	err := q.execTx(ctx, func(q *Queries) error {
+
+		dishesAllergensSQL := createBulkInsertDishesAllergensQuery(dishID, allergens)
+
+		_, err := q.db.ExecContext(ctx, dishesAllergensSQL.query, dishesAllergensSQL.args...)
+
+		return err
+	})
+
+	return err
+}
+
+func createBulkInsertDishesAllergensQuery(dishID string, allergens []*domain.Allergen) bulkInsertDishesAllergensQuery {
+
+	insert := `INSERT INTO dishes_allergens (dish_id, allergen_id, category) VALUES `
+
+	values := make([]any, 0, len(allergens)*3)
+
+	for _, allergen := range allergens {
+		values = append(values, dishID, allergen.ID, allergen.Category)
+
+		insert += "(?, ?, ?),"
+	}
+
+	insert = insert[:len(insert)-1]
+
+	return bulkInsertDishesAllergensQuery{
+		query: insert,
+		args:  values,
+	}
+}
@@ -6,6 +6,11 @@ import (
 	"github.com/ogurilab/school-lunch-api/domain"
 )
 
+type CreateDishesTxResult struct {
+	Created []*domain.Dish
+	Reused  []*domain.Dish
+}
+
 type bulkInsertDishQuery struct {
 	query string
 	args  []any
@@ -16,19 +21,28 @@ type bulkInsertMenuDishQuery struct {
 	args  []any
 }
 
-func (q *SQLQuery) CreateDishesTx(ctx context.Context, dishes []*domain.Dish, menuID string) error {
+func (q *SQLQuery) CreateDishesTx(ctx context.Context, dishes []*domain.Dish, menuID string) (CreateDishesTxResult, error) {
+	var result CreateDishesTxResult
 
 	err := q.execTx(ctx, func(q *Queries) error {
 
-		dishSQL := createBulkInsertDishQuery(dishes)
-
-		_, err := q.db.ExecContext(ctx, dishSQL.query, dishSQL.args...)
+		created, reused, err := resolveDishes(ctx, q, dishes)
 
 		if err != nil {
 			return err
 		}
 
-		menuDishSQL := createBulkInsertMenuDishQuery(dishes, menuID)
+		if len(created) > 0 {
+			dishSQL := createBulkInsertDishQuery(created)
+
+			_, err := q.db.ExecContext(ctx, dishSQL.query, dishSQL.args...)
+
+			if err != nil {
+				return err
+			}
+		}
+
+		menuDishSQL := createBulkInsertMenuDishQuery(append(created, reused...), menuID)
 
 		_, err = q.db.ExecContext(ctx, menuDishSQL.query, menuDishSQL.args...)
 
@@ -36,10 +50,64 @@ func (q *SQLQuery) CreateDishesTx(ctx context.Context, dishes []*domain.Dish, me
 			return err
 		}
 
+		result.Created = created
+		result.Reused = reused
+
 		return nil
 	})
 
-	return err
+	return result, err
+}
+
+// resolveDishes は料理名が既に登録されている料理を既存のIDで再利用し、未登録の料理のみを新規作成の対象とする
+func resolveDishes(ctx context.Context, q *Queries, dishes []*domain.Dish) ([]*domain.Dish, []*domain.Dish, error) {
+
+	names := make([]string, 0, len(dishes))
+
+	for _, dish := range dishes {
+		names = append(names, dish.Name)
+	}
+
+	rows, err := q.ListDishInNames(ctx, names)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	existing := make(map[string]string, len(rows))
+
+	for _, row := range rows {
+		existing[row.Name] = row.ID
+	}
+
+	created := make([]*domain.Dish, 0, len(dishes))
+	reused := make([]*domain.Dish, 0, len(rows))
+	seen := make(map[string]bool, len(dishes))
+
+	for _, dish := range dishes {
+		if seen[dish.Name] {
+			continue
+		}
+
+		seen[dish.Name] = true
+
+		id, ok := existing[dish.Name]
+
+		if !ok {
+			created = append(created, dish)
+			continue
+		}
+
+		d, err := domain.ReNewDish(id, dish.Name)
+
+		if err != nil {
+			return nil, nil, err
+		}
+
+		reused = append(reused, d)
+	}
+
+	return created, reused, nil
 }
 
 func createBulkInsertDishQuery(dishes []*domain.Dish) bulkInsertDishQuery {
@@ -0,0 +1,20 @@
+package db
+
+import (
+	"context"
+)
+
+func (q *SQLQuery) CreateMenuTx(ctx context.Context, arg CreateMenuParams) error {
+
+	err := q.execTx(ctx, func(q *Queries) error {
+
+		if err := q.CreateMenu(ctx, arg); err != nil {
+			return err
+		}
+
+		// 献立が登録された市区町村は給食のデータが利用可能になる
+		return q.UpdateAvailable(ctx, arg.CityCode)
+	})
+
+	return err
+}
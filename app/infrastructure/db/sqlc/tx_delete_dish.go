@@ -0,0 +1,68 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/ogurilab/school-lunch-api/domain"
+)
+
+type DeleteDishTxResult struct {
+	DishID             string
+	MenuIDs            []string
+	AllergensRemoved   int64
+	IngredientsRemoved int64
+}
+
+func (q *SQLQuery) DeleteDishTx(ctx context.Context, dishID string, force bool) (DeleteDishTxResult, error) {
+	var result DeleteDishTxResult
+
+	err := q.execTx(ctx, func(q *Queries) error {
+
+		menuIDs, err := q.ListMenuIDByDishID(ctx, dishID)
+
+		if err != nil {
+			return err
+		}
+
+		// 献立に紐付いている料理は、forceが指定されない限り削除しない
+		if len(menuIDs) > 0 && !force {
+			return domain.ErrDishInUse
+		}
+
+		if _, err := q.DeleteMenuDishesByDishID(ctx, dishID); err != nil {
+			return err
+		}
+
+		allergens, err := q.DeleteDishesAllergensByDishID(ctx, dishID)
+
+		if err != nil {
+			return err
+		}
+
+		ingredients, err := q.DeleteDishesIngredientsByDishID(ctx, dishID)
+
+		if err != nil {
+			return err
+		}
+
+		affected, err := q.DeleteDish(ctx, dishID)
+
+		if err != nil {
+			return err
+		}
+
+		if affected == 0 {
+			return sql.ErrNoRows
+		}
+
+		result.DishID = dishID
+		result.MenuIDs = menuIDs
+		result.AllergensRemoved = allergens
+		result.IngredientsRemoved = ingredients
+
+		return nil
+	})
+
+	return result, err
+}
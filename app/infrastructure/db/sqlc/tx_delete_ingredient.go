@@ -0,0 +1,31 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+)
+
+// DeleteIngredientTx は食材と料理との紐付けをまとめて削除する
+func (q *SQLQuery) DeleteIngredientTx(ctx context.Context, ingredientID int32) error {
+
+	err := q.execTx(ctx, func(q *Queries) error {
+
+		if _, err := q.DeleteDishesIngredientsByIngredientID(ctx, ingredientID); err != nil {
+			return err
+		}
+
+		affected, err := q.DeleteIngredient(ctx, ingredientID)
+
+		if err != nil {
+			return err
+		}
+
+		if affected == 0 {
+			return sql.ErrNoRows
+		}
+
+		return nil
+	})
+
+	return err
+}
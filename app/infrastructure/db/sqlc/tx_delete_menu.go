@@ -0,0 +1,55 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+)
+
+type DeleteMenuTxResult struct {
+	MenuID  string
+	DishIDs []string
+}
+
+func (q *SQLQuery) DeleteMenuTx(ctx context.Context, menuID string) (DeleteMenuTxResult, error) {
+	var result DeleteMenuTxResult
+
+	err := q.execTx(ctx, func(q *Queries) error {
+
+		dishes, err := q.ListDishByMenuID(ctx, menuID)
+
+		if err != nil {
+			return err
+		}
+
+		if _, err := q.DeleteMenuDishesByMenuID(ctx, menuID); err != nil {
+			return err
+		}
+
+		if err := q.DeleteMenuNutritionsByMenuID(ctx, menuID); err != nil {
+			return err
+		}
+
+		affected, err := q.DeleteMenu(ctx, menuID)
+
+		if err != nil {
+			return err
+		}
+
+		if affected == 0 {
+			return sql.ErrNoRows
+		}
+
+		dishIDs := make([]string, 0, len(dishes))
+
+		for _, dish := range dishes {
+			dishIDs = append(dishIDs, dish.ID)
+		}
+
+		result.MenuID = menuID
+		result.DishIDs = dishIDs
+
+		return nil
+	})
+
+	return result, err
+}
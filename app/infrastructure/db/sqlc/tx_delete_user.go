@@ -0,0 +1,28 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+)
+
+// DeleteUserTx はユーザーと、そのユーザーに発行した全てのセッションを削除する
+func (q *SQLQuery) DeleteUserTx(ctx context.Context, userID int32) error {
+	return q.execTx(ctx, func(q *Queries) error {
+
+		if err := q.DeleteSessionsByUser(ctx, userID); err != nil {
+			return err
+		}
+
+		affected, err := q.DeleteUser(ctx, userID)
+
+		if err != nil {
+			return err
+		}
+
+		if affected == 0 {
+			return sql.ErrNoRows
+		}
+
+		return nil
+	})
+}
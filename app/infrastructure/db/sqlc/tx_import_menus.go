@@ -0,0 +1,215 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/ogurilab/school-lunch-api/domain"
+)
+
+type ImportMenusTxResult struct {
+	Rows     []*domain.ImportMenuResult
+	Imported bool
+}
+
+// importedDishAllergen は料理に登録するアレルゲンとその含まれ方
+type importedDishAllergen struct {
+	allergenID int32
+	category   domain.AllergenCategory
+}
+
+type bulkInsertImportedDishesAllergensQuery struct {
+	query string
+	args  []any
+}
+
+var errImportRowsFailed = errors.New("failed to import some rows")
+
+// ImportMenusTx はCSVから読み込んだ1ヶ月分の献立を1つのトランザクションで登録する
+// 1行でも登録に失敗した場合は全体をロールバックし、行ごとのエラーを返す
+func (q *SQLQuery) ImportMenusTx(ctx context.Context, cityCode int32, rows []*domain.ImportMenuRow) (ImportMenusTxResult, error) {
+	result := ImportMenusTxResult{
+		Rows: make([]*domain.ImportMenuResult, 0, len(rows)),
+	}
+
+	err := q.execTx(ctx, func(q *Queries) error {
+
+		allergenIDs := make(map[string]int32)
+		failed := false
+
+		for _, row := range rows {
+			r := &domain.ImportMenuResult{
+				Line:      row.Line,
+				OfferedAt: row.Menu.OfferedAt.Format("2006-01-02"),
+			}
+
+			result.Rows = append(result.Rows, r)
+
+			dishIDs, err := importMenuRow(ctx, q, row, allergenIDs)
+
+			if err != nil {
+				r.Errors = append(r.Errors, err.Error())
+				failed = true
+				continue
+			}
+
+			r.MenuID = row.Menu.ID
+			r.DishIDs = dishIDs
+		}
+
+		if failed {
+			return errImportRowsFailed
+		}
+
+		// 献立が登録された市区町村は給食のデータが利用可能になる
+		return q.UpdateAvailable(ctx, cityCode)
+	})
+
+	if errors.Is(err, errImportRowsFailed) {
+		// ロールバックされたため、登録されなかったIDは返さない
+		for _, r := range result.Rows {
+			r.MenuID = ""
+			r.DishIDs = nil
+		}
+
+		return result, nil
+	}
+
+	if err != nil {
+		return result, err
+	}
+
+	result.Imported = true
+
+	return result, nil
+}
+
+func importMenuRow(ctx context.Context, q *Queries, row *domain.ImportMenuRow, allergenIDs map[string]int32) ([]string, error) {
+	menu := row.Menu
+
+	err := q.CreateMenu(ctx, CreateMenuParams{
+		ID:                       menu.ID,
+		OfferedAt:                menu.OfferedAt,
+		PhotoUrl:                 menu.PhotoUrl,
+		ElementarySchoolCalories: menu.ElementarySchoolCalories,
+		JuniorHighSchoolCalories: menu.JuniorHighSchoolCalories,
+		CityCode:                 menu.CityCode,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	dishes := make([]*domain.Dish, 0, len(row.Dishes))
+
+	for _, d := range row.Dishes {
+		dishes = append(dishes, d.Dish)
+	}
+
+	created, reused, err := resolveDishes(ctx, q, dishes)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(created) > 0 {
+		dishSQL := createBulkInsertDishQuery(created)
+
+		if _, err := q.db.ExecContext(ctx, dishSQL.query, dishSQL.args...); err != nil {
+			return nil, err
+		}
+	}
+
+	resolved := append(created, reused...)
+
+	menuDishSQL := createBulkInsertMenuDishQuery(resolved, menu.ID)
+
+	if _, err := q.db.ExecContext(ctx, menuDishSQL.query, menuDishSQL.args...); err != nil {
+		return nil, err
+	}
+
+	ids := make(map[string]string, len(resolved))
+	dishIDs := make([]string, 0, len(resolved))
+
+	for _, dish := range resolved {
+		ids[dish.Name] = dish.ID
+		dishIDs = append(dishIDs, dish.ID)
+	}
+
+	allergens := make(map[string][]importedDishAllergen)
+
+	for _, d := range row.Dishes {
+		for category, names := range map[domain.AllergenCategory][]string{
+			domain.AllergenCategoryContains:   d.Allergens,
+			domain.AllergenCategoryMayContain: d.MayContain,
+		} {
+			for _, name := range names {
+				id, err := resolveAllergen(ctx, q, name, allergenIDs)
+
+				if err != nil {
+					return nil, err
+				}
+
+				dishID := ids[d.Dish.Name]
+				allergens[dishID] = append(allergens[dishID], importedDishAllergen{allergenID: id, category: category})
+			}
+		}
+	}
+
+	if len(allergens) > 0 {
+		allergenSQL := createBulkInsertImportedDishesAllergensQuery(allergens)
+
+		if _, err := q.db.ExecContext(ctx, allergenSQL.query, allergenSQL.args...); err != nil {
+			return nil, err
+		}
+	}
+
+	return dishIDs, nil
+}
+
+// resolveAllergen はアレルゲン名からIDを取得する
+// アレルゲンのマスタはCSVからは追加せず、未登録の名前は行のエラーとする
+func resolveAllergen(ctx context.Context, q *Queries, name string, cache map[string]int32) (int32, error) {
+	if id, ok := cache[name]; ok {
+		return id, nil
+	}
+
+	allergen, err := q.GetAllergenByName(ctx, name)
+
+	if err == sql.ErrNoRows {
+		return 0, fmt.Errorf("unknown allergen %q", name)
+	}
+
+	if err != nil {
+		return 0, err
+	}
+
+	cache[name] = allergen.ID
+
+	return allergen.ID, nil
+}
+
+// 既存の料理を再利用した場合は同じアレルゲンが登録済みのことがあるため、重複は無視する
+func createBulkInsertImportedDishesAllergensQuery(allergens map[string][]importedDishAllergen) bulkInsertImportedDishesAllergensQuery {
+
+	insert := `INSERT IGNORE INTO dishes_allergens (dish_id, allergen_id, category) VALUES `
+
+	values := make([]any, 0, len(allergens)*3)
+
+	for dishID, ids := range allergens {
+		for _, a := range ids {
+			values = append(values, dishID, a.allergenID, a.category)
+
+			insert += "(?, ?, ?),"
+		}
+	}
+
+	insert = insert[:len(insert)-1]
+
+	return bulkInsertImportedDishesAllergensQuery{
+		query: insert,
+		args:  values,
+	}
+}
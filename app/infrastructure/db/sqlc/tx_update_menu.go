@@ -0,0 +1,20 @@
+package db
+
+import (
+	"context"
+)
+
+func (q *SQLQuery) UpdateMenuTx(ctx context.Context, arg UpdateMenuParams) error {
+
+	err := q.execTx(ctx, func(q *Queries) error {
+
+		if err := q.UpdateMenu(ctx, arg); err != nil {
+			return err
+		}
+
+		// 献立が別の市区町村に移った場合も、移った先の市区町村は給食のデータが利用可能になる
+		return q.UpdateAvailable(ctx, arg.CityCode)
+	})
+
+	return err
+}
@@ -0,0 +1,33 @@
+package db
+
+import (
+	"context"
+
+	"github.com/ogurilab/school-lunch-api/domain"
+	"github.com/ogurilab/school-lunch-api/util"
+)
+
+// UpsertDishesIngredientsTx は料理に食材を紐付ける
+// 既に紐付いている食材は使用量と産地を上書きする
+func (q *SQLQuery) UpsertDishesIngredientsTx(ctx context.Context, dishID string, ingredients []*domain.DishIngredient) error {
+
+	err := q.execTx(ctx, func(q *Queries) error {
+
+		for _, ingredient := range ingredients {
+			arg := UpsertDishesIngredientsParams{
+				DishID:               dishID,
+				IngredientID:         ingredient.ID,
+				Quantity:             util.PointerToNullFloat64(ingredient.Quantity),
+				OriginPrefectureCode: util.PointerToNullInt32(ingredient.OriginCode()),
+			}
+
+			if err := q.UpsertDishesIngredients(ctx, arg); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+
+	return err
+}
@@ -0,0 +1,105 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ogurilab/school-lunch-api/domain"
+	"github.com/ogurilab/school-lunch-api/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateUser(t *testing.T) {
+	createRandomUser(t)
+}
+
+func TestGetUserByUsername(t *testing.T) {
+	user := createRandomUser(t)
+
+	user2, err := testQuery.GetUserByUsername(context.Background(), user.Username)
+
+	require.NoError(t, err)
+	require.Equal(t, user.ID, user2.ID)
+	require.Equal(t, user.HashedPassword, user2.HashedPassword)
+}
+
+func TestListUsers(t *testing.T) {
+	for i := 0; i < 5; i++ {
+		createRandomUser(t)
+	}
+
+	arg := ListUsersParams{
+		Limit:  5,
+		Offset: 0,
+	}
+
+	users, err := testQuery.ListUsers(context.Background(), arg)
+
+	require.NoError(t, err)
+	require.Len(t, users, 5)
+}
+
+func TestRevokeSessionsByUser(t *testing.T) {
+	user := createRandomUser(t)
+
+	var ids []string
+
+	for i := 0; i < 2; i++ {
+		arg := CreateSessionParams{
+			ID:        util.NewUlid(),
+			UserID:    user.ID,
+			ExpiresAt: time.Now().Add(time.Hour).UTC().Truncate(time.Second),
+		}
+
+		err := testQuery.CreateSession(context.Background(), arg)
+
+		require.NoError(t, err)
+
+		ids = append(ids, arg.ID)
+	}
+
+	affected, err := testQuery.RevokeSessionsByUser(context.Background(), user.ID)
+
+	require.NoError(t, err)
+	require.Equal(t, int64(2), affected)
+
+	for _, id := range ids {
+		session, err := testQuery.GetSession(context.Background(), id)
+
+		require.NoError(t, err)
+		require.Equal(t, user.ID, session.UserID)
+		require.True(t, session.Revoked)
+	}
+}
+
+func createRandomUser(t *testing.T) User {
+	hashed, err := util.HashPassword(util.RandomString(12))
+
+	require.NoError(t, err)
+
+	arg := CreateUserParams{
+		Username:       util.RandomString(20),
+		HashedPassword: hashed,
+		Email:          util.RandomString(20) + "@example.com",
+		Role:           domain.UserRoleMunicipality,
+		CityCode:       util.RandomCityCode(),
+	}
+
+	id, err := testQuery.CreateUser(context.Background(), arg)
+
+	require.NoError(t, err)
+	require.NotZero(t, id)
+
+	user, err := testQuery.GetUser(context.Background(), int32(id))
+
+	require.NoError(t, err)
+	require.Equal(t, arg.Username, user.Username)
+	require.Equal(t, arg.HashedPassword, user.HashedPassword)
+	require.Equal(t, arg.Email, user.Email)
+	require.Equal(t, arg.Role, user.Role)
+	require.Equal(t, arg.CityCode, user.CityCode)
+	require.NotZero(t, user.CreatedAt)
+
+	return user
+}
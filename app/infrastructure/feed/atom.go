@@ -0,0 +1,83 @@
+package feed
+
+import (
+	"encoding/xml"
+	"time"
+
+	"github.com/ogurilab/school-lunch-api/domain"
+)
+
+type atomFeed struct {
+	XMLName xml.Name    `xml:"http://www.w3.org/2005/Atom feed"`
+	ID      string      `xml:"id"`
+	Title   string      `xml:"title"`
+	Updated string      `xml:"updated"`
+	Author  atomAuthor  `xml:"author"`
+	Links   []atomLink  `xml:"link"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+type atomAuthor struct {
+	Name string `xml:"name"`
+}
+
+type atomLink struct {
+	Rel  string `xml:"rel,attr,omitempty"`
+	Type string `xml:"type,attr,omitempty"`
+	Href string `xml:"href,attr"`
+}
+
+type atomText struct {
+	Type string `xml:"type,attr"`
+	Body string `xml:",chardata"`
+}
+
+type atomEntry struct {
+	ID        string     `xml:"id"`
+	Title     string     `xml:"title"`
+	Published string     `xml:"published"`
+	Updated   string     `xml:"updated"`
+	Links     []atomLink `xml:"link"`
+	Content   atomText   `xml:"content"`
+}
+
+// newAtomFeed は献立の API の URL を各エントリの ID とリンクにする
+func newAtomFeed(feed *domain.MenuFeed) *atomFeed {
+	self := feedURL(feed, domain.FeedFormatAtom)
+
+	f := &atomFeed{
+		ID:      self,
+		Title:   feedTitle(feed),
+		Updated: lastUpdated(feed).Format(time.RFC3339),
+		Author:  atomAuthor{Name: feed.City.CityName},
+		Links: []atomLink{
+			{Rel: "self", Type: "application/atom+xml", Href: self},
+			{Rel: "alternate", Type: "application/json", Href: menusURL(feed)},
+		},
+		Entries: make([]atomEntry, 0, len(feed.Menus)),
+	}
+
+	for _, menu := range feed.Menus {
+		link := menuURL(feed, menu)
+		created := menu.CreatedAt.UTC().Format(time.RFC3339)
+
+		entry := atomEntry{
+			ID:        link,
+			Title:     entryTitle(menu),
+			Published: created,
+			Updated:   created,
+			Links: []atomLink{
+				{Rel: "alternate", Type: "application/json", Href: link},
+			},
+			Content: atomText{Type: "text", Body: entryContent(menu)},
+		}
+
+		if e := photoEnclosure(menu); e != nil {
+			entry.Links = append(entry.Links, atomLink{Rel: "enclosure", Type: e.contentType, Href: e.url})
+		}
+
+		f.Entries = append(f.Entries, entry)
+	}
+
+	return f
+}
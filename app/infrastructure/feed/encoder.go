@@ -0,0 +1,128 @@
+package feed
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"mime"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/ogurilab/school-lunch-api/domain"
+)
+
+type xmlEncoder struct{}
+
+// NewXMLEncoder は献立のフィードを Atom と RSS 2.0 で書き出す MenuFeedEncoder を返す
+func NewXMLEncoder() domain.MenuFeedEncoder {
+	return &xmlEncoder{}
+}
+
+func (e *xmlEncoder) Encode(w io.Writer, format domain.FeedFormat, feed *domain.MenuFeed) error {
+	var v interface{}
+
+	switch format {
+	case domain.FeedFormatAtom:
+		v = newAtomFeed(feed)
+	case domain.FeedFormatRSS:
+		v = newRSSFeed(feed)
+	default:
+		return domain.ErrUnsupportedFeedFormat
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+
+	if err := enc.Encode(v); err != nil {
+		return err
+	}
+
+	return enc.Close()
+}
+
+func feedTitle(feed *domain.MenuFeed) string {
+	return fmt.Sprintf("%s%sの給食", feed.City.PrefectureName, feed.City.CityName)
+}
+
+func menusURL(feed *domain.MenuFeed) string {
+	return fmt.Sprintf("%s/cities/%d/menus", feed.BaseURL, feed.City.CityCode)
+}
+
+func menuURL(feed *domain.MenuFeed, menu *domain.MenuWithDishes) string {
+	return fmt.Sprintf("%s/%s", menusURL(feed), menu.ID)
+}
+
+func feedURL(feed *domain.MenuFeed, format domain.FeedFormat) string {
+	return fmt.Sprintf("%s/cities/%d/feed.%s", feed.BaseURL, feed.City.CityCode, format)
+}
+
+// lastUpdated はフィードに含まれる献立のうち最も新しい登録日時を返す
+// 献立がない場合も内容が変わらないよう、Unix エポックを返す
+func lastUpdated(feed *domain.MenuFeed) time.Time {
+	updated := time.Unix(0, 0).UTC()
+
+	for _, menu := range feed.Menus {
+		if menu.CreatedAt.After(updated) {
+			updated = menu.CreatedAt.UTC()
+		}
+	}
+
+	return updated
+}
+
+func entryTitle(menu *domain.MenuWithDishes) string {
+	return fmt.Sprintf("%sの給食", menu.OfferedAt.Format("2006年1月2日"))
+}
+
+// entryContent は料理名とエネルギー、写真のクレジットを書き出す
+func entryContent(menu *domain.MenuWithDishes) string {
+	names := make([]string, 0, len(menu.Dishes))
+
+	for _, dish := range menu.Dishes {
+		names = append(names, dish.Name)
+	}
+
+	lines := []string{
+		strings.Join(names, "、"),
+		fmt.Sprintf("エネルギー: 小学校 %dkcal / 中学校 %dkcal", menu.ElementarySchoolCalories, menu.JuniorHighSchoolCalories),
+	}
+
+	if menu.PhotoCredit != nil && menu.PhotoCredit.Attribution != "" {
+		lines = append(lines, "写真: "+menu.PhotoCredit.Attribution)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+type enclosure struct {
+	url         string
+	contentType string
+}
+
+// photoEnclosure は献立の写真を返す
+// サムネイルがある場合は JPEG の大きいサムネイルを優先する
+func photoEnclosure(menu *domain.MenuWithDishes) *enclosure {
+	if menu.Photos != nil && menu.Photos.Large != "" {
+		return &enclosure{url: menu.Photos.Large, contentType: domain.ThumbnailContentType}
+	}
+
+	if !menu.PhotoUrl.Valid || menu.PhotoUrl.String == "" {
+		return nil
+	}
+
+	contentType := "image/jpeg"
+
+	if u, err := url.Parse(menu.PhotoUrl.String); err == nil {
+		if t := mime.TypeByExtension(strings.ToLower(path.Ext(u.Path))); strings.HasPrefix(t, "image/") {
+			contentType = t
+		}
+	}
+
+	return &enclosure{url: menu.PhotoUrl.String, contentType: contentType}
+}
@@ -0,0 +1,136 @@
+package feed
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/xml"
+	"testing"
+	"time"
+
+	"github.com/ogurilab/school-lunch-api/domain"
+	"github.com/ogurilab/school-lunch-api/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeAtom(t *testing.T) {
+	withThumbnail := randomMenuWithDishes(t, time.Date(2024, 4, 2, 9, 0, 0, 0, time.UTC), "ごはん", "牛乳")
+	withThumbnail.Photos = &domain.MenuPhotos{Large: "https://example.com/photos/large.jpg"}
+	withThumbnail.PhotoCredit = &domain.PhotoCredit{Attribution: "Photo by ogurilab, CC BY-SA 4.0"}
+
+	withoutPhoto := randomMenuWithDishes(t, time.Date(2024, 4, 1, 9, 0, 0, 0, time.UTC), "パン")
+
+	feed := randomFeed([]*domain.MenuWithDishes{withThumbnail, withoutPhoto})
+
+	var buf bytes.Buffer
+
+	err := NewXMLEncoder().Encode(&buf, domain.FeedFormatAtom, feed)
+
+	require.NoError(t, err)
+
+	var got atomFeed
+
+	require.NoError(t, xml.Unmarshal(buf.Bytes(), &got))
+
+	require.Equal(t, "https://example.com/v1/cities/131016/feed.atom", got.ID)
+	require.Equal(t, "東京都千代田区の給食", got.Title)
+	require.Equal(t, "2024-04-02T09:00:00Z", got.Updated)
+	require.Len(t, got.Entries, 2)
+
+	entry := got.Entries[0]
+	require.Equal(t, "https://example.com/v1/cities/131016/menus/"+withThumbnail.ID, entry.ID)
+	require.Equal(t, "2024年4月10日の給食", entry.Title)
+	require.Equal(t, "2024-04-02T09:00:00Z", entry.Published)
+	require.Equal(t, "ごはん、牛乳\nエネルギー: 小学校 620kcal / 中学校 830kcal\n写真: Photo by ogurilab, CC BY-SA 4.0", entry.Content.Body)
+	require.Contains(t, entry.Links, atomLink{Rel: "enclosure", Type: "image/jpeg", Href: "https://example.com/photos/large.jpg"})
+
+	for _, link := range got.Entries[1].Links {
+		require.NotEqual(t, "enclosure", link.Rel)
+	}
+}
+
+func TestEncodeRSS(t *testing.T) {
+	withPhotoURL := randomMenuWithDishes(t, time.Date(2024, 4, 2, 9, 0, 0, 0, time.UTC), "ごはん")
+	withPhotoURL.PhotoUrl = sql.NullString{String: "https://example.com/photos/menu.png", Valid: true}
+
+	feed := randomFeed([]*domain.MenuWithDishes{withPhotoURL})
+
+	var buf bytes.Buffer
+
+	err := NewXMLEncoder().Encode(&buf, domain.FeedFormatRSS, feed)
+
+	require.NoError(t, err)
+
+	var got rssFeed
+
+	require.NoError(t, xml.Unmarshal(buf.Bytes(), &got))
+
+	require.Equal(t, "2.0", got.Version)
+	require.Equal(t, "https://example.com/v1/cities/131016/menus", got.Channel.Link)
+	require.Equal(t, "ja", got.Channel.Language)
+	require.Equal(t, "Tue, 02 Apr 2024 09:00:00 +0000", got.Channel.LastBuildDate)
+	require.Len(t, got.Channel.Items, 1)
+
+	item := got.Channel.Items[0]
+	require.Equal(t, "https://example.com/v1/cities/131016/menus/"+withPhotoURL.ID, item.Link)
+	require.Equal(t, item.Link, item.GUID.Value)
+	require.Equal(t, "Tue, 02 Apr 2024 09:00:00 +0000", item.PubDate)
+	require.NotNil(t, item.Enclosure)
+	require.Equal(t, "https://example.com/photos/menu.png", item.Enclosure.URL)
+	require.Equal(t, "image/png", item.Enclosure.Type)
+}
+
+func TestEncodeEmptyFeed(t *testing.T) {
+	feed := randomFeed([]*domain.MenuWithDishes{})
+
+	for _, format := range []domain.FeedFormat{domain.FeedFormatAtom, domain.FeedFormatRSS} {
+		var first, second bytes.Buffer
+
+		require.NoError(t, NewXMLEncoder().Encode(&first, format, feed))
+		require.NoError(t, NewXMLEncoder().Encode(&second, format, feed))
+
+		// 献立がなくても同じ内容を返す
+		require.Equal(t, first.Bytes(), second.Bytes())
+	}
+}
+
+func TestEncodeUnsupportedFormat(t *testing.T) {
+	var buf bytes.Buffer
+
+	err := NewXMLEncoder().Encode(&buf, domain.FeedFormat("json"), randomFeed(nil))
+
+	require.ErrorIs(t, err, domain.ErrUnsupportedFeedFormat)
+	require.Empty(t, buf.Bytes())
+}
+
+func randomFeed(menus []*domain.MenuWithDishes) *domain.MenuFeed {
+	return &domain.MenuFeed{
+		City: &domain.City{
+			CityCode:       131016,
+			CityName:       "千代田区",
+			PrefectureCode: 13,
+			PrefectureName: "東京都",
+		},
+		BaseURL: "https://example.com/v1",
+		Menus:   menus,
+	}
+}
+
+func randomMenuWithDishes(t *testing.T, createdAt time.Time, names ...string) *domain.MenuWithDishes {
+	dishes := make([]*domain.Dish, 0, len(names))
+
+	for _, name := range names {
+		dish, err := domain.ReNewDish(util.NewUlid(), name)
+		require.NoError(t, err)
+
+		dishes = append(dishes, dish)
+	}
+
+	offeredAt := time.Date(2024, 4, 10, 0, 0, 0, 0, time.UTC)
+
+	menu, err := domain.ReNewMenuWithDishes(util.NewUlid(), offeredAt, sql.NullString{}, 620, 830, 131016, dishes)
+	require.NoError(t, err)
+
+	menu.CreatedAt = createdAt
+
+	return menu
+}
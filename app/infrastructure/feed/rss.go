@@ -0,0 +1,80 @@
+package feed
+
+import (
+	"encoding/xml"
+	"time"
+
+	"github.com/ogurilab/school-lunch-api/domain"
+)
+
+type rssFeed struct {
+	XMLName xml.Name   `xml:"rss"`
+	Version string     `xml:"version,attr"`
+	Channel rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title         string    `xml:"title"`
+	Link          string    `xml:"link"`
+	Description   string    `xml:"description"`
+	Language      string    `xml:"language"`
+	LastBuildDate string    `xml:"lastBuildDate"`
+	Items         []rssItem `xml:"item"`
+}
+
+type rssGUID struct {
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+// rssEnclosure の length は必須だが、写真のサイズは保存していないため0にする
+type rssEnclosure struct {
+	URL    string `xml:"url,attr"`
+	Length int64  `xml:"length,attr"`
+	Type   string `xml:"type,attr"`
+}
+
+type rssItem struct {
+	Title       string        `xml:"title"`
+	Link        string        `xml:"link"`
+	Description string        `xml:"description"`
+	GUID        rssGUID       `xml:"guid"`
+	PubDate     string        `xml:"pubDate"`
+	Enclosure   *rssEnclosure `xml:"enclosure,omitempty"`
+}
+
+func newRSSFeed(feed *domain.MenuFeed) *rssFeed {
+	title := feedTitle(feed)
+
+	channel := rssChannel{
+		Title:         title,
+		Link:          menusURL(feed),
+		Description:   title + "の献立",
+		Language:      "ja",
+		LastBuildDate: lastUpdated(feed).Format(time.RFC1123Z),
+		Items:         make([]rssItem, 0, len(feed.Menus)),
+	}
+
+	for _, menu := range feed.Menus {
+		link := menuURL(feed, menu)
+
+		item := rssItem{
+			Title:       entryTitle(menu),
+			Link:        link,
+			Description: entryContent(menu),
+			GUID:        rssGUID{IsPermaLink: true, Value: link},
+			PubDate:     menu.CreatedAt.UTC().Format(time.RFC1123Z),
+		}
+
+		if e := photoEnclosure(menu); e != nil {
+			item.Enclosure = &rssEnclosure{URL: e.url, Type: e.contentType}
+		}
+
+		channel.Items = append(channel.Items, item)
+	}
+
+	return &rssFeed{
+		Version: "2.0",
+		Channel: channel,
+	}
+}
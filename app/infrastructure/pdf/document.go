@@ -0,0 +1,343 @@
+package pdf
+
+import (
+	"bytes"
+	"compress/zlib"
+	"errors"
+	"fmt"
+	"image/color"
+	"image/jpeg"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// A4 の大きさ (pt)
+const (
+	a4Width  = 595.28
+	a4Height = 841.89
+)
+
+const producer = "school-lunch-api"
+
+var errUnsupportedImage = errors.New("image must be a grayscale or RGB jpeg")
+
+type rgb struct {
+	r, g, b float64
+}
+
+var (
+	colorBlack     = rgb{0, 0, 0}
+	colorWhite     = rgb{1, 1, 1}
+	colorGray      = rgb{0.4, 0.4, 0.4}
+	colorLightGray = rgb{0.93, 0.93, 0.93}
+	colorRed       = rgb{0.8, 0, 0}
+)
+
+// document は日本語の文字と JPEG 画像だけを扱う最小限の PDF
+// 作成日時などは書き出さないため、同じ内容からは同じ PDF になる
+type document struct {
+	pages  []*page
+	images []*jpegImage
+}
+
+func newDocument() *document {
+	return &document{}
+}
+
+type jpegImage struct {
+	name       string
+	width      int
+	height     int
+	colorSpace string
+	body       []byte
+}
+
+// addJPEG は JPEG を展開せずにそのまま埋め込む
+func (d *document) addJPEG(body []byte) (*jpegImage, error) {
+	config, err := jpeg.DecodeConfig(bytes.NewReader(body))
+
+	if err != nil {
+		return nil, err
+	}
+
+	var colorSpace string
+
+	switch config.ColorModel {
+	case color.GrayModel:
+		colorSpace = "DeviceGray"
+	case color.YCbCrModel:
+		colorSpace = "DeviceRGB"
+	default:
+		return nil, errUnsupportedImage
+	}
+
+	img := &jpegImage{
+		name:       fmt.Sprintf("Im%d", len(d.images)+1),
+		width:      config.Width,
+		height:     config.Height,
+		colorSpace: colorSpace,
+		body:       body,
+	}
+
+	d.images = append(d.images, img)
+
+	return img, nil
+}
+
+// page は左上を原点とし、下に向かって y が増える座標で描く
+type page struct {
+	width   float64
+	height  float64
+	content bytes.Buffer
+
+	// runes はページで描いた文字で、埋め込むフォントのグリフを決めるのに使う
+	runes map[rune]bool
+}
+
+func (d *document) addPage(width float64, height float64) *page {
+	p := &page{width: width, height: height, runes: make(map[rune]bool)}
+	d.pages = append(d.pages, p)
+
+	return p
+}
+
+func (p *page) printf(format string, args ...interface{}) {
+	fmt.Fprintf(&p.content, format, args...)
+	p.content.WriteByte('\n')
+}
+
+func (p *page) setFill(c rgb) {
+	p.printf("%s %s %s rg", num(c.r), num(c.g), num(c.b))
+}
+
+func (p *page) setStroke(c rgb, width float64) {
+	p.printf("%s %s %s RG %s w", num(c.r), num(c.g), num(c.b), num(width))
+}
+
+func (p *page) fillRect(x float64, y float64, w float64, h float64, c rgb) {
+	p.setFill(c)
+	p.printf("%s %s %s %s re f", num(x), num(p.height-y-h), num(w), num(h))
+}
+
+func (p *page) strokeRect(x float64, y float64, w float64, h float64, c rgb, width float64) {
+	p.setStroke(c, width)
+	p.printf("%s %s %s %s re S", num(x), num(p.height-y-h), num(w), num(h))
+}
+
+func (p *page) line(x1 float64, y1 float64, x2 float64, y2 float64, c rgb, width float64) {
+	p.setStroke(c, width)
+	p.printf("%s %s m %s %s l S", num(x1), num(p.height-y1), num(x2), num(p.height-y2))
+}
+
+// text は y を文字の上端として1行の文字を描く
+func (p *page) text(x float64, y float64, size float64, c rgb, s string) {
+	if s == "" {
+		return
+	}
+
+	p.setFill(c)
+	p.printf("BT /%s %s Tf %s %s Td <%s> Tj ET", fontResourceName, num(size), num(x), num(p.height-y-size*fontAscent), p.encode(s))
+}
+
+// textUp は左に90度回転させた1行の文字を、(x, y) を左下として下から上に向かって描く
+// 文字は x から x+size までの幅に収まる
+func (p *page) textUp(x float64, y float64, size float64, c rgb, s string) {
+	if s == "" {
+		return
+	}
+
+	p.setFill(c)
+	p.printf("BT /%s %s Tf 0 1 -1 0 %s %s Tm <%s> Tj ET", fontResourceName, num(size), num(x+size*fontAscent), num(p.height-y), p.encode(s))
+}
+
+// encode は文字列を encodeText で書き出し、描いた文字を記録する
+func (p *page) encode(s string) string {
+	for _, r := range s {
+		p.runes[fontRune(r)] = true
+	}
+
+	return encodeText(s)
+}
+
+// image は画像を縦横比を保ったまま w×h の枠の中央に描く
+func (p *page) image(img *jpegImage, x float64, y float64, w float64, h float64) {
+	scale := w / float64(img.width)
+
+	if s := h / float64(img.height); s < scale {
+		scale = s
+	}
+
+	iw := float64(img.width) * scale
+	ih := float64(img.height) * scale
+	ix := x + (w-iw)/2
+	iy := y + (h-ih)/2
+
+	p.printf("q %s 0 0 %s %s %s cm /%s Do Q", num(iw), num(ih), num(ix), num(p.height-iy-ih), img.name)
+}
+
+// clip は restore を呼ぶまで描画を枠の中に制限する
+func (p *page) clip(x float64, y float64, w float64, h float64) {
+	p.printf("q %s %s %s %s re W n", num(x), num(p.height-y-h), num(w), num(h))
+}
+
+func (p *page) restore() {
+	p.printf("Q")
+}
+
+// num は座標などの数値を小数点以下2桁までの文字列にする
+func num(v float64) string {
+	s := strconv.FormatFloat(v, 'f', 2, 64)
+	s = strings.TrimRight(strings.TrimRight(s, "0"), ".")
+
+	if s == "" || s == "-" || s == "-0" {
+		return "0"
+	}
+
+	return s
+}
+
+// objectWriter は書き出したオブジェクトの位置を記録する
+type objectWriter struct {
+	w       io.Writer
+	n       int64
+	offsets []int64
+	err     error
+}
+
+func (ow *objectWriter) write(s string) {
+	if ow.err != nil {
+		return
+	}
+
+	n, err := io.WriteString(ow.w, s)
+	ow.n += int64(n)
+	ow.err = err
+}
+
+func (ow *objectWriter) object(id int, body string) {
+	ow.offsets[id-1] = ow.n
+	ow.write(fmt.Sprintf("%d 0 obj\n%s\nendobj\n", id, body))
+}
+
+func (ow *objectWriter) stream(id int, dict string, body []byte) {
+	ow.offsets[id-1] = ow.n
+	ow.write(fmt.Sprintf("%d 0 obj\n<< %s /Length %d >>\nstream\n", id, dict, len(body)))
+	ow.write(string(body))
+	ow.write("\nendstream\nendobj\n")
+}
+
+func deflate(b []byte) ([]byte, error) {
+	var buf bytes.Buffer
+
+	zw, err := zlib.NewWriterLevel(&buf, zlib.BestCompression)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := zw.Write(b); err != nil {
+		return nil, err
+	}
+
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// writeTo は PDF 1.4 として書き出す
+//
+// オブジェクトの番号は次の順に振る
+//
+//	1: カタログ, 2: ページツリー, 3-8: フォント, 9: 情報辞書
+//	10 から: 画像, その後: ページと内容のストリームの組
+func (d *document) writeTo(w io.Writer) error {
+	const (
+		catalogID = 1
+		pagesID   = 2
+		fontID    = 3
+		infoID    = 9
+		firstID   = 10
+	)
+
+	imageID := func(i int) int { return firstID + i }
+	pageID := func(i int) int { return firstID + len(d.images) + i*2 }
+
+	total := firstID - 1 + len(d.images) + len(d.pages)*2
+
+	ow := &objectWriter{w: w, offsets: make([]int64, total)}
+
+	ow.write("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+
+	ow.object(catalogID, fmt.Sprintf("<< /Type /Catalog /Pages %d 0 R >>", pagesID))
+
+	kids := make([]string, 0, len(d.pages))
+
+	for i := range d.pages {
+		kids = append(kids, fmt.Sprintf("%d 0 R", pageID(i)))
+	}
+
+	ow.object(pagesID, fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(d.pages)))
+
+	runes := make(map[rune]bool)
+
+	for _, p := range d.pages {
+		for r := range p.runes {
+			runes[r] = true
+		}
+	}
+
+	fonts, streams, err := fontObjects(fontID, runes)
+
+	if err != nil {
+		return err
+	}
+
+	for i, body := range fonts {
+		ow.object(fontID+i, body)
+	}
+
+	for i, s := range streams {
+		ow.stream(fontID+len(fonts)+i, s.dict, s.body)
+	}
+
+	ow.object(infoID, fmt.Sprintf("<< /Producer (%s) >>", producer))
+
+	xobjects := make([]string, 0, len(d.images))
+
+	for i, img := range d.images {
+		xobjects = append(xobjects, fmt.Sprintf("/%s %d 0 R", img.name, imageID(i)))
+
+		dict := fmt.Sprintf("/Type /XObject /Subtype /Image /Width %d /Height %d /ColorSpace /%s /BitsPerComponent 8 /Filter /DCTDecode",
+			img.width, img.height, img.colorSpace)
+		ow.stream(imageID(i), dict, img.body)
+	}
+
+	resources := fmt.Sprintf("<< /Font << /%s %d 0 R >> /XObject << %s >> >>", fontResourceName, fontID, strings.Join(xobjects, " "))
+
+	for i, p := range d.pages {
+		content, err := deflate(p.content.Bytes())
+
+		if err != nil {
+			return err
+		}
+
+		ow.object(pageID(i), fmt.Sprintf("<< /Type /Page /Parent %d 0 R /MediaBox [0 0 %s %s] /Resources %s /Contents %d 0 R >>",
+			pagesID, num(p.width), num(p.height), resources, pageID(i)+1))
+		ow.stream(pageID(i)+1, "/Filter /FlateDecode", content)
+	}
+
+	xref := ow.n
+
+	ow.write(fmt.Sprintf("xref\n0 %d\n0000000000 65535 f \n", total+1))
+
+	for _, offset := range ow.offsets {
+		ow.write(fmt.Sprintf("%010d 00000 n \n", offset))
+	}
+
+	ow.write(fmt.Sprintf("trailer\n<< /Size %d /Root %d 0 R /Info %d 0 R >>\nstartxref\n%d\n%%%%EOF\n", total+1, catalogID, infoID, xref))
+
+	return ow.err
+}
@@ -0,0 +1,182 @@
+package pdf
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteTo(t *testing.T) {
+	doc := newDocument()
+
+	img, err := doc.addJPEG(randomJPEG(t, 40, 30))
+	require.NoError(t, err)
+	require.Equal(t, "Im1", img.name)
+	require.Equal(t, "DeviceRGB", img.colorSpace)
+
+	p := doc.addPage(a4Width, a4Height)
+	p.text(10, 10, 12, colorBlack, "給食")
+	p.image(img, 10, 30, 80, 30)
+
+	var buf bytes.Buffer
+
+	require.NoError(t, doc.writeTo(&buf))
+
+	body := buf.Bytes()
+	requireValidPDF(t, body)
+
+	contents := pageContents(t, body)
+	require.Len(t, contents, 1)
+	require.Contains(t, contents[0], "<7D6698DF> Tj")
+	require.Contains(t, contents[0], "/Im1 Do")
+
+	// 縦横比を保つため、高さに合わせて縮小する
+	require.Contains(t, contents[0], "q 40 0 0 30 30 ")
+
+	require.Contains(t, string(body), "/Encoding /Identity-H")
+
+	// 描いた文字のグリフだけを埋め込む
+	font := requireEmbeddedGlyphs(t, body, "給食")
+
+	gid, _ := embeddedFont.glyph('献')
+	require.Empty(t, font.glyphData(gid))
+}
+
+func TestAddJPEG(t *testing.T) {
+	doc := newDocument()
+
+	var gray bytes.Buffer
+	require.NoError(t, jpeg.Encode(&gray, image.NewGray(image.Rect(0, 0, 8, 8)), nil))
+
+	img, err := doc.addJPEG(gray.Bytes())
+	require.NoError(t, err)
+	require.Equal(t, "DeviceGray", img.colorSpace)
+
+	_, err = doc.addJPEG([]byte("not a jpeg"))
+	require.Error(t, err)
+	require.Len(t, doc.images, 1)
+}
+
+func TestNum(t *testing.T) {
+	require.Equal(t, "0", num(0))
+	require.Equal(t, "0", num(-0.001))
+	require.Equal(t, "12", num(12))
+	require.Equal(t, "12.5", num(12.5))
+	require.Equal(t, "841.89", num(841.89))
+	require.Equal(t, "-3.14", num(-3.14159))
+}
+
+func randomJPEG(t *testing.T, width int, height int) []byte {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+
+	for x := 0; x < width; x++ {
+		for y := 0; y < height; y++ {
+			img.Set(x, y, color.RGBA{R: uint8(x * 4), G: uint8(y * 4), B: 128, A: 255})
+		}
+	}
+
+	var buf bytes.Buffer
+
+	require.NoError(t, jpeg.Encode(&buf, img, nil))
+
+	return buf.Bytes()
+}
+
+var xrefEntry = regexp.MustCompile(`^(\d{10}) 00000 n $`)
+
+// requireValidPDF は相互参照表が各オブジェクトの位置を指していることを確認する
+func requireValidPDF(t *testing.T, body []byte) {
+	s := string(body)
+
+	require.True(t, strings.HasPrefix(s, "%PDF-1.4\n"))
+	require.True(t, strings.HasSuffix(s, "%%EOF\n"))
+
+	i := strings.LastIndex(s, "startxref\n")
+	require.NotEqual(t, -1, i)
+
+	xref, err := strconv.Atoi(strings.SplitN(s[i+len("startxref\n"):], "\n", 2)[0])
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(s[xref:], "xref\n0 "))
+
+	lines := strings.Split(s[xref:], "\n")
+
+	size, err := strconv.Atoi(strings.Fields(lines[1])[1])
+	require.NoError(t, err)
+	require.Equal(t, "0000000000 65535 f ", lines[2])
+
+	for id := 1; id < size; id++ {
+		m := xrefEntry.FindStringSubmatch(lines[2+id])
+		require.NotNil(t, m, lines[2+id])
+
+		offset, err := strconv.Atoi(m[1])
+		require.NoError(t, err)
+		require.True(t, strings.HasPrefix(s[offset:], fmt.Sprintf("%d 0 obj\n", id)), "object %d", id)
+	}
+
+	require.Contains(t, s, fmt.Sprintf("/Size %d", size))
+}
+
+var fontFileRef = regexp.MustCompile(`/FontFile2 (\d+) 0 R`)
+
+// requireEmbeddedGlyphs は PDF に埋め込んだフォントが s の文字のグリフを含むことを確認する
+func requireEmbeddedGlyphs(t *testing.T, body []byte, s string) *trueTypeFont {
+	m := fontFileRef.FindSubmatch(body)
+	require.NotNil(t, m)
+
+	font, err := parseTrueType(inflateObject(t, body, string(m[1])))
+	require.NoError(t, err)
+
+	for _, r := range s {
+		gid, ok := embeddedFont.glyph(r)
+		require.True(t, ok, string(r))
+		require.NotEmpty(t, font.glyphData(gid), string(r))
+	}
+
+	return font
+}
+
+var (
+	contentsRef = regexp.MustCompile(`/Contents (\d+) 0 R`)
+	flateStream = regexp.MustCompile(`(?s)<< /Filter /FlateDecode(?: /Length1 \d+)? /Length (\d+) >>\nstream\n`)
+)
+
+// pageContents はページの内容のストリームを展開して返す
+func pageContents(t *testing.T, body []byte) []string {
+	var contents []string
+
+	for _, ref := range contentsRef.FindAllSubmatch(body, -1) {
+		contents = append(contents, string(inflateObject(t, body, string(ref[1]))))
+	}
+
+	return contents
+}
+
+// inflateObject は番号 id のストリームを展開して返す
+func inflateObject(t *testing.T, body []byte, id string) []byte {
+	start := bytes.Index(body, []byte("\n"+id+" 0 obj\n"))
+	require.NotEqual(t, -1, start, "object %s", id)
+
+	m := flateStream.FindSubmatchIndex(body[start:])
+	require.NotNil(t, m)
+
+	length, err := strconv.Atoi(string(body[start+m[2] : start+m[3]]))
+	require.NoError(t, err)
+
+	zr, err := zlib.NewReader(bytes.NewReader(body[start+m[1] : start+m[1]+length]))
+	require.NoError(t, err)
+
+	b, err := io.ReadAll(zr)
+	require.NoError(t, err)
+
+	return b
+}
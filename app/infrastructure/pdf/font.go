@@ -0,0 +1,300 @@
+package pdf
+
+import (
+	_ "embed"
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"strings"
+)
+
+/*
+日本語のフォント
+
+fonts/ の TrueType フォント (Unifont JP の JIS X 0208 のサブセット) を、
+PDF ごとに使った文字のグリフだけに絞って CIDFontType2 として埋め込む。
+ビューアや印刷環境に日本語フォントがなくても同じ字形で表示される。
+
+文字は Identity-H で UCS-2 のまま書き出し、CIDToGIDMap で CID (= Unicode) をグリフに対応付ける。
+ToUnicode も書き出すため、PDF から文字をコピーや検索できる。
+フォントにない文字は replacementChar に置き換える。
+*/
+
+const (
+	fontResourceName = "F1"
+	fontBaseName     = "Unifont-JP"
+
+	// UCS-2 で表せない文字や、フォントにない文字の代わりに描く文字 (〓)
+	replacementChar = '〓'
+)
+
+//go:embed fonts/unifont-jp-jisx0208.ttf
+var fontData []byte
+
+var embeddedFont = mustParseTrueType(fontData)
+
+func mustParseTrueType(b []byte) *trueTypeFont {
+	f, err := parseTrueType(b)
+
+	if err != nil {
+		panic(err)
+	}
+
+	if _, ok := f.glyph(replacementChar); !ok {
+		panic(fmt.Errorf("%w: replacement character is missing", errInvalidFont))
+	}
+
+	return f
+}
+
+// fontAscent は文字の大きさに対する上端からベースラインまでの比率
+var fontAscent = float64(embeddedFont.ascent) / float64(embeddedFont.unitsPerEm)
+
+// fontRune は文字をフォントで描ける文字に置き換える
+// 制御文字は空白、フォントにない文字は replacementChar にする
+func fontRune(r rune) rune {
+	if r < 0x20 {
+		return ' '
+	}
+
+	if _, ok := embeddedFont.glyph(r); !ok {
+		return replacementChar
+	}
+
+	return r
+}
+
+// fontStream は埋め込むフォントのストリームの辞書と内容
+type fontStream struct {
+	dict string
+	body []byte
+}
+
+// fontObjects は Type0 フォント、CIDFont、フォントディスクリプタ、
+// フォントファイル、CIDToGIDMap、ToUnicode の各オブジェクトを id から順に返す
+// runes は PDF で使った文字で、そのグリフだけを埋め込む
+func fontObjects(id int, runes map[rune]bool) ([]string, []fontStream, error) {
+	f := embeddedFont
+
+	used := make([]rune, 0, len(runes))
+
+	for r := range runes {
+		used = append(used, r)
+	}
+
+	sort.Slice(used, func(i, j int) bool { return used[i] < used[j] })
+
+	name := subsetTag(used) + "+" + fontBaseName
+
+	gids := make(map[uint16]bool, len(used))
+
+	var (
+		widths  []string
+		maxCode rune
+	)
+
+	for _, r := range used {
+		gid, _ := f.glyph(r)
+		gids[gid] = true
+		maxCode = r
+
+		if w := f.advance(gid); w != 1000 {
+			widths = append(widths, fmt.Sprintf("%d [%d]", r, w))
+		}
+	}
+
+	// CID から2バイトのグリフの番号への対応表。使っていない CID は0 (.notdef) になる
+	cidToGID := make([]byte, (maxCode+1)*2)
+
+	for _, r := range used {
+		gid, _ := f.glyph(r)
+		cidToGID[r*2] = byte(gid >> 8)
+		cidToGID[r*2+1] = byte(gid)
+	}
+
+	fontFile := f.subset(gids)
+
+	compressedFont, err := deflate(fontFile)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	compressedMap, err := deflate(cidToGID)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	toUnicode, err := deflate(toUnicodeCMap(used))
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	objects := []string{
+		fmt.Sprintf("<< /Type /Font /Subtype /Type0 /BaseFont /%s /Encoding /Identity-H /DescendantFonts [%d 0 R] /ToUnicode %d 0 R >>",
+			name, id+1, id+5),
+		fmt.Sprintf("<< /Type /Font /Subtype /CIDFontType2 /BaseFont /%s "+
+			"/CIDSystemInfo << /Registry (Adobe) /Ordering (Identity) /Supplement 0 >> "+
+			"/FontDescriptor %d 0 R /DW 1000 /W [%s] /CIDToGIDMap %d 0 R >>",
+			name, id+2, strings.Join(widths, " "), id+4),
+		fmt.Sprintf("<< /Type /FontDescriptor /FontName /%s /Flags 4 /FontBBox [%d %d %d %d] "+
+			"/ItalicAngle 0 /Ascent %d /Descent %d /CapHeight %d /StemV 80 /FontFile2 %d 0 R >>",
+			name, f.scale(f.bbox[0]), f.scale(f.bbox[1]), f.scale(f.bbox[2]), f.scale(f.bbox[3]),
+			f.scale(f.ascent), f.scale(f.descent), f.scale(f.capHeight), id+3),
+	}
+
+	streams := []fontStream{
+		{dict: fmt.Sprintf("/Filter /FlateDecode /Length1 %d", len(fontFile)), body: compressedFont},
+		{dict: "/Filter /FlateDecode", body: compressedMap},
+		{dict: "/Filter /FlateDecode", body: toUnicode},
+	}
+
+	return objects, streams, nil
+}
+
+// subsetTag は使った文字から、サブセットのフォント名に付ける6文字の英大文字を作る
+func subsetTag(runes []rune) string {
+	h := fnv.New32a()
+
+	for _, r := range runes {
+		fmt.Fprintf(h, "%04X", r)
+	}
+
+	sum := h.Sum32()
+	tag := make([]byte, 6)
+
+	for i := range tag {
+		tag[i] = byte('A' + sum%26)
+		sum /= 26
+	}
+
+	return string(tag)
+}
+
+// toUnicodeCMap は CID (= UCS-2) をそのまま Unicode に対応付ける CMap を返す
+func toUnicodeCMap(runes []rune) []byte {
+	var b strings.Builder
+
+	b.WriteString("/CIDInit /ProcSet findresource begin\n12 dict begin\nbegincmap\n" +
+		"/CIDSystemInfo << /Registry (Adobe) /Ordering (UCS) /Supplement 0 >> def\n" +
+		"/CMapName /Adobe-Identity-UCS def\n/CMapType 2 def\n" +
+		"1 begincodespacerange\n<0000> <FFFF>\nendcodespacerange\n")
+
+	// 1つの範囲は上位バイトが同じ CID に限られ、1ブロックは100個まで
+	var highs []rune
+
+	for _, r := range runes {
+		if h := r >> 8; len(highs) == 0 || highs[len(highs)-1] != h {
+			highs = append(highs, h)
+		}
+	}
+
+	for len(highs) > 0 {
+		n := len(highs)
+
+		if n > 100 {
+			n = 100
+		}
+
+		fmt.Fprintf(&b, "%d beginbfrange\n", n)
+
+		for _, h := range highs[:n] {
+			fmt.Fprintf(&b, "<%02X00> <%02XFF> <%02X00>\n", h, h, h)
+		}
+
+		b.WriteString("endbfrange\n")
+
+		highs = highs[n:]
+	}
+
+	b.WriteString("endcmap\nCMapName currentdict /CMap defineresource pop\nend\nend\n")
+
+	return []byte(b.String())
+}
+
+// encodeText は文字列を UCS-2 の16進数の文字列にする
+func encodeText(s string) string {
+	var b strings.Builder
+
+	for _, r := range s {
+		fmt.Fprintf(&b, "%04X", fontRune(r))
+	}
+
+	return b.String()
+}
+
+// runeWidth は文字の幅を文字の大きさに対する比率で返す
+func runeWidth(r rune) float64 {
+	gid, _ := embeddedFont.glyph(fontRune(r))
+
+	return float64(embeddedFont.advance(gid)) / 1000
+}
+
+func textWidth(s string, size float64) float64 {
+	var w float64
+
+	for _, r := range s {
+		w += runeWidth(r)
+	}
+
+	return w * size
+}
+
+// wrapText は文字列を幅 width に収まるように折り返す
+// 1文字も収まらない場合でも、少なくとも1文字は各行に含める
+func wrapText(s string, size float64, width float64) []string {
+	var (
+		lines []string
+		line  []rune
+		w     float64
+	)
+
+	for _, r := range s {
+		rw := runeWidth(r) * size
+
+		if len(line) > 0 && w+rw > width {
+			lines = append(lines, string(line))
+			line, w = nil, 0
+		}
+
+		line = append(line, r)
+		w += rw
+	}
+
+	if len(line) > 0 {
+		lines = append(lines, string(line))
+	}
+
+	return lines
+}
+
+// truncateText は文字列が幅 width に収まらない場合に、末尾を … に置き換えて切り詰める
+func truncateText(s string, size float64, width float64) string {
+	if textWidth(s, size) <= width {
+		return s
+	}
+
+	const ellipsis = "…"
+
+	limit := width - textWidth(ellipsis, size)
+
+	var (
+		line []rune
+		w    float64
+	)
+
+	for _, r := range s {
+		rw := runeWidth(r) * size
+
+		if w+rw > limit {
+			break
+		}
+
+		line = append(line, r)
+		w += rw
+	}
+
+	return string(line) + ellipsis
+}
@@ -0,0 +1,69 @@
+package pdf
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeText(t *testing.T) {
+	require.Equal(t, "7D6698DF0041", encodeText("給食A"))
+	require.Equal(t, "0020", encodeText("\n"))
+
+	// UCS-2 で表せない文字やフォントにない文字は 〓 にする
+	require.Equal(t, "3013", encodeText("𠮷"))
+	require.Equal(t, "3013", encodeText("한"))
+}
+
+func TestFontObjects(t *testing.T) {
+	objects, streams, err := fontObjects(3, map[rune]bool{'給': true, 'A': true})
+	require.NoError(t, err)
+	require.Len(t, objects, 3)
+	require.Len(t, streams, 3)
+
+	require.Regexp(t, `^<< /Type /Font /Subtype /Type0 /BaseFont /[A-Z]{6}\+Unifont-JP /Encoding /Identity-H /DescendantFonts \[4 0 R\] /ToUnicode 8 0 R >>$`, objects[0])
+	require.Contains(t, objects[1], "/W [65 [500]]")
+	require.Contains(t, objects[1], "/CIDToGIDMap 7 0 R")
+	require.Contains(t, objects[2], "/FontFile2 6 0 R")
+
+	// 同じ文字からは同じフォント名になる
+	again, _, err := fontObjects(3, map[rune]bool{'A': true, '給': true})
+	require.NoError(t, err)
+	require.Equal(t, objects[0], again[0])
+}
+
+func TestToUnicodeCMap(t *testing.T) {
+	cmap := string(toUnicodeCMap([]rune{'A', 'B', '給', '食'}))
+
+	require.Contains(t, cmap, "3 beginbfrange\n<0000> <00FF> <0000>\n<7D00> <7DFF> <7D00>\n<9800> <98FF> <9800>\nendbfrange")
+}
+
+func TestTextWidth(t *testing.T) {
+	require.Equal(t, 10.0, textWidth("給食", 5))
+	require.Equal(t, 5.0, textWidth("AB", 5))
+	require.Equal(t, 2.5, textWidth("ｶ", 5))
+
+	// フォントにない文字は 〓 の幅になる
+	require.Equal(t, 5.0, textWidth("한", 5))
+}
+
+func TestWrapText(t *testing.T) {
+	require.Equal(t, []string{"ごはん"}, wrapText("ごはん", 10, 30))
+	require.Equal(t, []string{"ごは", "ん"}, wrapText("ごはん", 10, 25))
+	require.Equal(t, []string{"ABCD", "E"}, wrapText("ABCDE", 10, 20))
+
+	// 幅が狭くても1文字ずつは描く
+	require.Equal(t, []string{"ご", "は"}, wrapText("ごは", 10, 1))
+	require.Nil(t, wrapText("", 10, 30))
+}
+
+func TestTruncateText(t *testing.T) {
+	require.Equal(t, "ごはん", truncateText("ごはん", 10, 30))
+	require.Equal(t, "ごは…", truncateText("ごはん", 10, 25))
+	require.Equal(t, "ご…", truncateText("ごはん", 10, 20))
+	require.Equal(t, "ABCD…", truncateText("ABCDEF", 10, 25))
+	require.Equal(t, "ABC…", truncateText("ABCDEF", 10, 20))
+
+	// 幅が狭い場合は … だけにする
+	require.Equal(t, "…", truncateText("ごはん", 10, 5))
+}
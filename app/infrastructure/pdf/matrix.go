@@ -0,0 +1,179 @@
+package pdf
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/ogurilab/school-lunch-api/domain"
+)
+
+// アレルギー一覧表は A4 横に、料理を行、アレルゲンを列とする表として描く
+// 1ページに収まらない行は次のページに送り、各ページに見出しの行を繰り返す
+const (
+	matrixMargin          = 28.0
+	matrixTitleSize       = 14.0
+	matrixLegendSize      = 7.0
+	matrixHeaderSize      = 7.0
+	matrixHeaderMinHeight = 24.0
+	matrixHeaderMaxHeight = 72.0
+	matrixRowHeight       = 13.0
+	matrixTextSize        = 7.5
+	matrixSymbolSize      = 8.0
+	matrixDateWidth       = 48.0
+	matrixDishWidth       = 130.0
+	matrixCellPadding     = 3.0
+	matrixFooterHeight    = 12.0
+	matrixBorderWidth     = 0.5
+	matrixMenuBorderWidth = 1.0
+)
+
+const matrixLegend = "● 原材料として含む　△ 製造工程で混入する可能性がある　見出しの網掛けは表示義務のある特定原材料です。"
+
+type matrixRenderer struct{}
+
+// NewAllergenMatrixRenderer はアレルギー一覧表を A4 横の PDF に描く AllergenMatrixRenderer を返す
+func NewAllergenMatrixRenderer() domain.AllergenMatrixRenderer {
+	return &matrixRenderer{}
+}
+
+func (r *matrixRenderer) Render(w io.Writer, matrix *domain.AllergenMatrix) error {
+	doc := newDocument()
+
+	width, height := a4Height, a4Width
+
+	title := fmt.Sprintf("%s%s %s アレルギー一覧表", matrix.City.PrefectureName, matrix.City.CityName, matrix.Month.Format("2006年1月"))
+	tableY := matrixMargin + matrixTitleSize + 4 + matrixLegendSize + 8
+	headerH := matrixHeaderHeight(matrix.Allergens)
+
+	var cellW float64
+
+	if len(matrix.Allergens) > 0 {
+		cellW = (width - matrixMargin*2 - matrixDateWidth - matrixDishWidth) / float64(len(matrix.Allergens))
+	}
+
+	perPage := int((height - tableY - headerH - matrixMargin - matrixFooterHeight) / matrixRowHeight)
+	pages := (len(matrix.Rows) + perPage - 1) / perPage
+
+	if pages == 0 {
+		pages = 1
+	}
+
+	for i := 0; i < pages; i++ {
+		p := doc.addPage(width, height)
+
+		p.text(matrixMargin, matrixMargin, matrixTitleSize, colorBlack, title)
+		p.text(matrixMargin, matrixMargin+matrixTitleSize+4, matrixLegendSize, colorGray, matrixLegend)
+
+		drawMatrixHeader(p, matrix.Allergens, tableY, headerH, cellW)
+
+		from := i * perPage
+		to := from + perPage
+
+		if to > len(matrix.Rows) {
+			to = len(matrix.Rows)
+		}
+
+		rowsY := tableY + headerH
+
+		for j, row := range matrix.Rows[from:to] {
+			// ページの最初の行と献立の最初の料理の行にだけ提供日を書く
+			first := j == 0 || matrix.Rows[from+j-1].MenuID != row.MenuID
+
+			drawMatrixRow(p, matrix.Allergens, row, rowsY+matrixRowHeight*float64(j), cellW, first)
+		}
+
+		if len(matrix.Rows) == 0 {
+			p.text(matrixMargin, rowsY+matrixCellPadding*2, matrixTextSize, colorGray, sheetEmptyNotes)
+		}
+
+		footer := fmt.Sprintf("%d / %d", i+1, pages)
+		p.text(width-matrixMargin-textWidth(footer, matrixLegendSize), height-matrixMargin-matrixLegendSize, matrixLegendSize, colorGray, footer)
+	}
+
+	return doc.writeTo(w)
+}
+
+// matrixHeaderHeight はアレルゲン名を縦に書く見出しの行の高さを返す
+// 長い名前に合わせて高くし、matrixHeaderMaxHeight を超える名前は切り詰める
+func matrixHeaderHeight(allergens []*domain.AllergenMatrixColumn) float64 {
+	h := matrixHeaderMinHeight
+
+	for _, allergen := range allergens {
+		if nh := textWidth(allergen.Name, matrixHeaderSize) + matrixCellPadding*2; nh > h {
+			h = nh
+		}
+	}
+
+	if h > matrixHeaderMaxHeight {
+		h = matrixHeaderMaxHeight
+	}
+
+	return h
+}
+
+func drawMatrixHeader(p *page, allergens []*domain.AllergenMatrixColumn, y float64, h float64, cellW float64) {
+	x := matrixMargin
+
+	for _, label := range []struct {
+		text  string
+		width float64
+	}{
+		{"提供日", matrixDateWidth},
+		{"料理", matrixDishWidth},
+	} {
+		p.fillRect(x, y, label.width, h, colorLightGray)
+		p.strokeRect(x, y, label.width, h, colorGray, matrixBorderWidth)
+		p.text(x+(label.width-textWidth(label.text, matrixTextSize))/2, y+(h-matrixTextSize)/2, matrixTextSize, colorBlack, label.text)
+
+		x += label.width
+	}
+
+	for _, allergen := range allergens {
+		if allergen.Kind == domain.AllergenKindSpecified {
+			p.fillRect(x, y, cellW, h, colorLightGray)
+		}
+
+		p.strokeRect(x, y, cellW, h, colorGray, matrixBorderWidth)
+
+		name := truncateText(allergen.Name, matrixHeaderSize, h-matrixCellPadding*2)
+		p.textUp(x+(cellW-matrixHeaderSize)/2, y+h-matrixCellPadding, matrixHeaderSize, colorBlack, name)
+
+		x += cellW
+	}
+}
+
+func drawMatrixRow(p *page, allergens []*domain.AllergenMatrixColumn, row *domain.AllergenMatrixRow, y float64, cellW float64, first bool) {
+	x := matrixMargin
+	ty := y + (matrixRowHeight-matrixTextSize)/2
+	right := matrixMargin + matrixDateWidth + matrixDishWidth + cellW*float64(len(allergens))
+
+	p.strokeRect(x, y, matrixDateWidth, matrixRowHeight, colorGray, matrixBorderWidth)
+
+	if first {
+		date := fmt.Sprintf("%d日(%s)", row.OfferedAt.Day(), weekdayLabels[row.OfferedAt.Weekday()])
+		p.text(x+matrixCellPadding, ty, matrixTextSize, colorBlack, date)
+	}
+
+	x += matrixDateWidth
+
+	p.strokeRect(x, y, matrixDishWidth, matrixRowHeight, colorGray, matrixBorderWidth)
+	p.text(x+matrixCellPadding, ty, matrixTextSize, colorBlack, truncateText(row.DishName, matrixTextSize, matrixDishWidth-matrixCellPadding*2))
+
+	x += matrixDishWidth
+
+	for _, allergen := range allergens {
+		p.strokeRect(x, y, cellW, matrixRowHeight, colorGray, matrixBorderWidth)
+
+		if category, ok := row.Category(allergen.ID); ok {
+			symbol := category.Symbol()
+			p.text(x+(cellW-textWidth(symbol, matrixSymbolSize))/2, y+(matrixRowHeight-matrixSymbolSize)/2, matrixSymbolSize, colorBlack, symbol)
+		}
+
+		x += cellW
+	}
+
+	// 献立の区切りは太い線で示す
+	if first {
+		p.line(matrixMargin, y, right, y, colorBlack, matrixMenuBorderWidth)
+	}
+}
@@ -0,0 +1,134 @@
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ogurilab/school-lunch-api/domain"
+	"github.com/ogurilab/school-lunch-api/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderAllergenMatrix(t *testing.T) {
+	month := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	menuID := util.NewUlid()
+
+	matrix := &domain.AllergenMatrix{
+		City:      randomSheetCity(),
+		Month:     month,
+		Allergens: randomMatrixColumns(),
+		Rows: []*domain.AllergenMatrixRow{
+			{MenuID: menuID, OfferedAt: month, DishID: util.NewUlid(), DishName: "オムレツ", Contains: []int32{1}, MayContain: []int32{2}},
+			{MenuID: menuID, OfferedAt: month, DishID: util.NewUlid(), DishName: "ごはん", Contains: []int32{}, MayContain: []int32{}},
+		},
+	}
+
+	var buf bytes.Buffer
+
+	require.NoError(t, NewAllergenMatrixRenderer().Render(&buf, matrix))
+
+	body := buf.Bytes()
+	requireValidPDF(t, body)
+
+	contents := pageContents(t, body)
+	require.Len(t, contents, 1)
+
+	content := contents[0]
+	require.Contains(t, content, encodeText("静岡県浜松市 2024年5月 アレルギー一覧表"))
+	require.Contains(t, content, encodeText(matrixLegend))
+	require.Contains(t, content, encodeText("オムレツ"))
+	require.Contains(t, content, encodeText("●"))
+	require.Contains(t, content, encodeText("△"))
+	require.Contains(t, content, encodeText("1 / 1"))
+
+	// アレルゲン名は回転させて描く
+	require.Contains(t, content, "0 1 -1 0")
+	require.Contains(t, content, encodeText("マカダミアナッツ"))
+
+	// 同じ献立の2品目には提供日を書かない
+	require.Equal(t, 1, bytes.Count([]byte(content), []byte(encodeText("1日(水)"))))
+
+	// 記号と回転させたアレルゲン名も埋め込んだフォントで描く
+	requireEmbeddedGlyphs(t, body, "●△マカダミアナッツ")
+
+	var again bytes.Buffer
+
+	require.NoError(t, NewAllergenMatrixRenderer().Render(&again, matrix))
+	require.Equal(t, body, again.Bytes())
+}
+
+func TestRenderAllergenMatrixPages(t *testing.T) {
+	month := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+
+	matrix := &domain.AllergenMatrix{
+		City:      randomSheetCity(),
+		Month:     month,
+		Allergens: randomMatrixColumns(),
+		Rows:      make([]*domain.AllergenMatrixRow, 0, 100),
+	}
+
+	for i := 0; i < 100; i++ {
+		offeredAt := month.AddDate(0, 0, i/4)
+
+		matrix.Rows = append(matrix.Rows, &domain.AllergenMatrixRow{
+			MenuID:     fmt.Sprintf("menu-%d", i/4),
+			OfferedAt:  offeredAt,
+			DishID:     util.NewUlid(),
+			DishName:   fmt.Sprintf("料理%d", i),
+			Contains:   []int32{},
+			MayContain: []int32{},
+		})
+	}
+
+	var buf bytes.Buffer
+
+	require.NoError(t, NewAllergenMatrixRenderer().Render(&buf, matrix))
+	requireValidPDF(t, buf.Bytes())
+
+	contents := pageContents(t, buf.Bytes())
+	require.Greater(t, len(contents), 1)
+
+	for i, content := range contents {
+		// 見出しは各ページに繰り返す
+		require.Contains(t, content, encodeText("料理"))
+		require.Contains(t, content, encodeText(fmt.Sprintf("%d / %d", i+1, len(contents))))
+	}
+
+	require.Contains(t, contents[len(contents)-1], encodeText("料理99"))
+}
+
+func TestRenderEmptyAllergenMatrix(t *testing.T) {
+	matrix := &domain.AllergenMatrix{
+		City:      randomSheetCity(),
+		Month:     time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC),
+		Allergens: randomMatrixColumns(),
+		Rows:      []*domain.AllergenMatrixRow{},
+	}
+
+	var buf bytes.Buffer
+
+	require.NoError(t, NewAllergenMatrixRenderer().Render(&buf, matrix))
+	requireValidPDF(t, buf.Bytes())
+
+	contents := pageContents(t, buf.Bytes())
+	require.Len(t, contents, 1)
+	require.Contains(t, contents[0], encodeText(sheetEmptyNotes))
+}
+
+func TestMatrixHeaderHeight(t *testing.T) {
+	require.Equal(t, matrixHeaderMinHeight, matrixHeaderHeight(nil))
+	require.Equal(t, matrixHeaderSize*8+matrixCellPadding*2, matrixHeaderHeight(randomMatrixColumns()))
+
+	long := []*domain.AllergenMatrixColumn{{ID: 1, Name: "とても長い名前のアレルゲンとても長い名前のアレルゲン"}}
+	require.Equal(t, matrixHeaderMaxHeight, matrixHeaderHeight(long))
+}
+
+func randomMatrixColumns() []*domain.AllergenMatrixColumn {
+	return []*domain.AllergenMatrixColumn{
+		{ID: 1, Name: "卵", Kind: domain.AllergenKindSpecified, KindLabel: domain.AllergenKindSpecified.Label()},
+		{ID: 2, Name: "乳", Kind: domain.AllergenKindSpecified, KindLabel: domain.AllergenKindSpecified.Label()},
+		{ID: 3, Name: "マカダミアナッツ", Kind: domain.AllergenKindRecommended, KindLabel: domain.AllergenKindRecommended.Label()},
+	}
+}
@@ -0,0 +1,260 @@
+package pdf
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/ogurilab/school-lunch-api/domain"
+)
+
+// 献立表は A4 横の1ページに、月曜始まりの週を行とするカレンダーとして描く
+const (
+	sheetMargin          = 28.0
+	sheetTitleSize       = 16.0
+	sheetHeaderHeight    = 16.0
+	sheetLegendHeight    = 18.0
+	sheetCellPadding     = 4.0
+	sheetDateSize        = 9.0
+	sheetDishSize        = 7.0
+	sheetNoteSize        = 6.5
+	sheetLineSpacing     = 1.25
+	sheetThumbnailWidth  = 44.0
+	sheetThumbnailHeight = 33.0
+	sheetBorderWidth     = 0.5
+)
+
+const (
+	sheetLegend     = "アレルゲンは赤字で示し、(混入) は製造工程で混入する可能性があるものです。エネルギーの 小 は小学校、中 は中学校です。"
+	sheetEmptyNotes = "この月の献立は登録されていません。"
+)
+
+var weekdayLabels = [...]string{"日", "月", "火", "水", "木", "金", "土"}
+
+type sheetRenderer struct{}
+
+// NewMenuSheetRenderer は献立表を A4 横の PDF に描く MenuSheetRenderer を返す
+func NewMenuSheetRenderer() domain.MenuSheetRenderer {
+	return &sheetRenderer{}
+}
+
+func (r *sheetRenderer) Render(w io.Writer, sheet *domain.MenuSheet) error {
+	doc := newDocument()
+	p := doc.addPage(a4Height, a4Width)
+
+	title := fmt.Sprintf("%s%s %s 給食献立表", sheet.City.PrefectureName, sheet.City.CityName, sheet.Month.Format("2006年1月"))
+	p.text(sheetMargin, sheetMargin, sheetTitleSize, colorBlack, title)
+
+	days := make(map[int]*domain.MenuSheetDay, len(sheet.Days))
+
+	for _, day := range sheet.Days {
+		if _, ok := days[day.Menu.OfferedAt.Day()]; !ok {
+			days[day.Menu.OfferedAt.Day()] = day
+		}
+	}
+
+	columns := sheetColumns(sheet.Days)
+	weeks := sheetWeeks(sheet.Month, columns)
+
+	gridX := sheetMargin
+	gridY := sheetMargin + sheetTitleSize + 12
+	cellW := (p.width - sheetMargin*2) / float64(len(columns))
+	rowH := (p.height - gridY - sheetMargin - sheetLegendHeight - sheetHeaderHeight) / float64(len(weeks))
+
+	for i, weekday := range columns {
+		x := gridX + cellW*float64(i)
+		label := weekdayLabels[weekday]
+
+		p.fillRect(x, gridY, cellW, sheetHeaderHeight, colorLightGray)
+		p.strokeRect(x, gridY, cellW, sheetHeaderHeight, colorGray, sheetBorderWidth)
+		p.text(x+(cellW-textWidth(label, sheetDateSize))/2, gridY+(sheetHeaderHeight-sheetDateSize)/2, sheetDateSize, colorBlack, label)
+	}
+
+	for row, week := range weeks {
+		y := gridY + sheetHeaderHeight + rowH*float64(row)
+
+		for i, date := range week {
+			x := gridX + cellW*float64(i)
+
+			if date.IsZero() {
+				p.fillRect(x, y, cellW, rowH, colorLightGray)
+			} else {
+				drawSheetCell(doc, p, x, y, cellW, rowH, date, days[date.Day()])
+			}
+
+			p.strokeRect(x, y, cellW, rowH, colorGray, sheetBorderWidth)
+		}
+	}
+
+	legend := sheetLegend
+
+	if len(sheet.Days) == 0 {
+		legend = sheetEmptyNotes
+	}
+
+	p.text(sheetMargin, p.height-sheetMargin-sheetNoteSize, sheetNoteSize, colorGray, legend)
+
+	return doc.writeTo(w)
+}
+
+// drawSheetCell は1日分のマスを描く
+// エネルギーとアレルゲンはマスの下端に揃え、料理が多い場合は料理名のほうを切り詰める
+func drawSheetCell(doc *document, p *page, x float64, y float64, w float64, h float64, date time.Time, day *domain.MenuSheetDay) {
+	cx := x + sheetCellPadding
+	cy := y + sheetCellPadding
+	cw := w - sheetCellPadding*2
+	bottom := y + h - sheetCellPadding
+
+	p.clip(x, y, w, h)
+	defer p.restore()
+
+	p.text(cx, cy, sheetDateSize, colorBlack, fmt.Sprintf("%d日(%s)", date.Day(), weekdayLabels[date.Weekday()]))
+
+	if day == nil {
+		return
+	}
+
+	dishWidth := cw
+
+	if day.Thumbnail != nil {
+		if img, err := doc.addJPEG(day.Thumbnail); err == nil {
+			p.image(img, x+w-sheetCellPadding-sheetThumbnailWidth, cy, sheetThumbnailWidth, sheetThumbnailHeight)
+			dishWidth = cw - sheetThumbnailWidth - sheetCellPadding
+		}
+	}
+
+	noteLeading := sheetNoteSize * sheetLineSpacing
+
+	energy := wrapText(fmt.Sprintf("エネルギー 小 %dkcal / 中 %dkcal", day.Menu.ElementarySchoolCalories, day.Menu.JuniorHighSchoolCalories), sheetNoteSize, cw)
+
+	var allergens []string
+
+	if label := sheetAllergenLabel(day); label != "" {
+		allergens = wrapText("アレルゲン: "+label, sheetNoteSize, cw)
+	}
+
+	notesY := bottom - noteLeading*float64(len(energy)+len(allergens))
+
+	for i, line := range energy {
+		p.text(cx, notesY+noteLeading*float64(i), sheetNoteSize, colorGray, line)
+	}
+
+	for i, line := range allergens {
+		p.text(cx, notesY+noteLeading*float64(len(energy)+i), sheetNoteSize, colorRed, line)
+	}
+
+	p.clip(x, y, w, notesY-y)
+	defer p.restore()
+
+	bullet := "・"
+	indent := textWidth(bullet, sheetDishSize)
+	leading := sheetDishSize * sheetLineSpacing
+	ty := cy + sheetDateSize + 3
+
+	for _, dish := range day.Menu.Dishes {
+		p.text(cx, ty, sheetDishSize, colorBlack, bullet)
+
+		for _, line := range wrapText(dish.Name, sheetDishSize, dishWidth-indent) {
+			p.text(cx+indent, ty, sheetDishSize, colorBlack, line)
+			ty += leading
+		}
+	}
+}
+
+// sheetAllergenLabel はその日の料理に含まれるアレルゲンを重複なく並べる
+// いずれかの料理に原材料として含まれる場合は、混入の可能性として扱わない
+func sheetAllergenLabel(day *domain.MenuSheetDay) string {
+	var order []*domain.Allergen
+
+	contains := make(map[int32]bool)
+
+	for _, dish := range day.Menu.Dishes {
+		for _, allergen := range day.Allergens[dish.ID] {
+			if _, ok := contains[allergen.ID]; !ok {
+				order = append(order, allergen)
+				contains[allergen.ID] = false
+			}
+
+			if allergen.Category == domain.AllergenCategoryContains {
+				contains[allergen.ID] = true
+			}
+		}
+	}
+
+	names := make([]string, 0, len(order))
+
+	for _, allergen := range order {
+		if contains[allergen.ID] {
+			names = append(names, allergen.Name)
+			continue
+		}
+
+		names = append(names, allergen.Name+"(混入)")
+	}
+
+	return strings.Join(names, "、")
+}
+
+// sheetColumns は月曜から金曜までの曜日と、献立のある土曜・日曜を月曜始まりの順で返す
+func sheetColumns(days []*domain.MenuSheetDay) []time.Weekday {
+	weekend := make(map[time.Weekday]bool)
+
+	for _, day := range days {
+		weekend[day.Menu.OfferedAt.Weekday()] = true
+	}
+
+	columns := []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday}
+
+	for _, weekday := range []time.Weekday{time.Saturday, time.Sunday} {
+		if weekend[weekday] {
+			columns = append(columns, weekday)
+		}
+	}
+
+	return columns
+}
+
+// sheetWeeks は月の日付を週ごとに columns の列に並べる
+// 月の外の日付は zero value とし、columns の曜日を1日も含まない週は省く
+func sheetWeeks(month time.Time, columns []time.Weekday) [][]time.Time {
+	index := make(map[time.Weekday]int, len(columns))
+
+	for i, weekday := range columns {
+		index[weekday] = i
+	}
+
+	start := time.Date(month.Year(), month.Month(), 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 1, -1)
+
+	// 1日が週の何日目か (月曜を0とする)
+	offset := (int(start.Weekday()) + 6) % 7
+
+	weeks := make([][]time.Time, (offset+end.Day()+6)/7)
+
+	for date := start; !date.After(end); date = date.AddDate(0, 0, 1) {
+		i, ok := index[date.Weekday()]
+
+		if !ok {
+			continue
+		}
+
+		week := (offset + date.Day() - 1) / 7
+
+		if weeks[week] == nil {
+			weeks[week] = make([]time.Time, len(columns))
+		}
+
+		weeks[week][i] = date
+	}
+
+	compact := weeks[:0]
+
+	for _, week := range weeks {
+		if week != nil {
+			compact = append(compact, week)
+		}
+	}
+
+	return compact
+}
@@ -0,0 +1,153 @@
+package pdf
+
+import (
+	"bytes"
+	"database/sql"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ogurilab/school-lunch-api/domain"
+	"github.com/ogurilab/school-lunch-api/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderSheet(t *testing.T) {
+	month := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+
+	first := randomSheetDay(t, time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), "ごはん", "えびフライ")
+	first.Thumbnail = randomJPEG(t, 240, 180)
+	first.Allergens[first.Menu.Dishes[1].ID] = []*domain.Allergen{
+		domain.ReNewAllergen(1, "えび", domain.AllergenKindSpecified, domain.AllergenCategoryMayContain),
+	}
+
+	// 写真を展開できない場合は写真なしで描く
+	second := randomSheetDay(t, time.Date(2024, 5, 2, 0, 0, 0, 0, time.UTC), "パン")
+	second.Thumbnail = []byte("not a jpeg")
+
+	sheet := &domain.MenuSheet{
+		City:  randomSheetCity(),
+		Month: month,
+		Days:  []*domain.MenuSheetDay{first, second},
+	}
+
+	var buf bytes.Buffer
+
+	require.NoError(t, NewMenuSheetRenderer().Render(&buf, sheet))
+
+	body := buf.Bytes()
+	requireValidPDF(t, body)
+
+	require.Equal(t, 1, strings.Count(string(body), "/Subtype /Image"))
+
+	contents := pageContents(t, body)
+	require.Len(t, contents, 1)
+
+	content := contents[0]
+	require.Contains(t, content, encodeText("静岡県浜松市 2024年5月 給食献立表"))
+	require.Contains(t, content, encodeText("1日(水)"))
+	require.Contains(t, content, encodeText("31日(金)"))
+	require.Contains(t, content, encodeText("えびフライ"))
+	require.Contains(t, content, encodeText("アレルゲン: えび(混入)"))
+	require.Contains(t, content, encodeText("エネルギー 小 620kcal / 中 830kcal"))
+	require.Contains(t, content, encodeText(sheetLegend))
+	require.Contains(t, content, "/Im1 Do")
+
+	// 土曜・日曜に献立がない月は月曜から金曜までを描く
+	require.NotContains(t, content, encodeText("土"))
+
+	var again bytes.Buffer
+
+	require.NoError(t, NewMenuSheetRenderer().Render(&again, sheet))
+	require.Equal(t, body, again.Bytes())
+}
+
+func TestRenderEmptySheet(t *testing.T) {
+	sheet := &domain.MenuSheet{
+		City:  randomSheetCity(),
+		Month: time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC),
+		Days:  []*domain.MenuSheetDay{},
+	}
+
+	var buf bytes.Buffer
+
+	require.NoError(t, NewMenuSheetRenderer().Render(&buf, sheet))
+	requireValidPDF(t, buf.Bytes())
+
+	contents := pageContents(t, buf.Bytes())
+	require.Contains(t, contents[0], encodeText(sheetEmptyNotes))
+	require.Contains(t, contents[0], encodeText("3日(月)"))
+	require.NotContains(t, contents[0], encodeText("1日(土)"))
+}
+
+func TestSheetWeeks(t *testing.T) {
+	weekdays := []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday}
+
+	// 2024年5月1日は水曜日
+	weeks := sheetWeeks(time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), weekdays)
+	require.Len(t, weeks, 5)
+	require.True(t, weeks[0][0].IsZero())
+	require.Equal(t, 1, weeks[0][2].Day())
+	require.Equal(t, 31, weeks[4][4].Day())
+
+	// 2024年6月1日は土曜日のため、最初の週は平日を含まない
+	weeks = sheetWeeks(time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC), weekdays)
+	require.Len(t, weeks, 4)
+	require.Equal(t, 3, weeks[0][0].Day())
+
+	weeks = sheetWeeks(time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC), append(weekdays, time.Saturday))
+	require.Len(t, weeks, 5)
+	require.Equal(t, 1, weeks[0][5].Day())
+	require.Equal(t, 29, weeks[4][5].Day())
+}
+
+func TestSheetColumns(t *testing.T) {
+	saturday := randomSheetDay(t, time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC), "ごはん")
+
+	require.Equal(t, []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday}, sheetColumns(nil))
+	require.Equal(t, time.Saturday, sheetColumns([]*domain.MenuSheetDay{saturday})[5])
+}
+
+func TestSheetAllergenLabel(t *testing.T) {
+	day := randomSheetDay(t, time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), "ごはん", "牛乳", "クッキー")
+
+	day.Allergens[day.Menu.Dishes[1].ID] = []*domain.Allergen{
+		domain.ReNewAllergen(1, "乳", domain.AllergenKindSpecified, domain.AllergenCategoryContains),
+	}
+	day.Allergens[day.Menu.Dishes[2].ID] = []*domain.Allergen{
+		domain.ReNewAllergen(1, "乳", domain.AllergenKindSpecified, domain.AllergenCategoryMayContain),
+		domain.ReNewAllergen(2, "小麦", domain.AllergenKindSpecified, domain.AllergenCategoryContains),
+		domain.ReNewAllergen(3, "落花生", domain.AllergenKindSpecified, domain.AllergenCategoryMayContain),
+	}
+
+	// 他の料理に原材料として含まれるアレルゲンは混入の可能性として扱わない
+	require.Equal(t, "乳、小麦、落花生(混入)", sheetAllergenLabel(day))
+}
+
+func randomSheetCity() *domain.City {
+	return &domain.City{
+		CityCode:       221309,
+		CityName:       "浜松市",
+		PrefectureCode: 22,
+		PrefectureName: "静岡県",
+	}
+}
+
+func randomSheetDay(t *testing.T, offeredAt time.Time, names ...string) *domain.MenuSheetDay {
+	dishes := make([]*domain.Dish, 0, len(names))
+
+	for _, name := range names {
+		dish, err := domain.ReNewDish(util.NewUlid(), name)
+		require.NoError(t, err)
+
+		dishes = append(dishes, dish)
+	}
+
+	menu, err := domain.ReNewMenuWithDishes(util.NewUlid(), offeredAt, sql.NullString{}, 620, 830, 221309, dishes)
+	require.NoError(t, err)
+
+	return &domain.MenuSheetDay{
+		Menu:      menu,
+		Allergens: map[string][]*domain.Allergen{},
+	}
+}
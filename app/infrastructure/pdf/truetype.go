@@ -0,0 +1,513 @@
+package pdf
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sort"
+)
+
+var errInvalidFont = errors.New("invalid truetype font")
+
+// subsetTables は PDF に埋め込む TrueType フォントに必要なテーブル
+// 文字とグリフは CIDToGIDMap で対応付けるが、cmap を必須とするビューアのために残す
+var subsetTables = []string{"cmap", "cvt ", "fpgm", "glyf", "head", "hhea", "hmtx", "loca", "maxp", "prep"}
+
+// trueTypeFont は TrueType のアウトラインを持つフォントのうち、PDF への埋め込みに必要な情報
+type trueTypeFont struct {
+	tables     map[string][]byte
+	unitsPerEm int
+	bbox       [4]int
+	ascent     int
+	descent    int
+	capHeight  int
+	longLoca   bool
+	loca       []int
+	advances   []int
+	cmap       map[rune]uint16
+}
+
+func parseTrueType(b []byte) (*trueTypeFont, error) {
+	if len(b) < 12 {
+		return nil, errInvalidFont
+	}
+
+	f := &trueTypeFont{
+		tables: make(map[string][]byte),
+	}
+
+	numTables := int(binary.BigEndian.Uint16(b[4:]))
+
+	if len(b) < 12+numTables*16 {
+		return nil, errInvalidFont
+	}
+
+	for i := 0; i < numTables; i++ {
+		record := b[12+i*16:]
+		tag := string(record[:4])
+		offset := int(binary.BigEndian.Uint32(record[8:]))
+		length := int(binary.BigEndian.Uint32(record[12:]))
+
+		if offset < 0 || length < 0 || offset+length > len(b) {
+			return nil, fmt.Errorf("%w: table %q is out of range", errInvalidFont, tag)
+		}
+
+		f.tables[tag] = b[offset : offset+length]
+	}
+
+	for _, tag := range []string{"cmap", "glyf", "head", "hhea", "hmtx", "loca", "maxp"} {
+		if _, ok := f.tables[tag]; !ok {
+			return nil, fmt.Errorf("%w: %q table is missing", errInvalidFont, tag)
+		}
+	}
+
+	head := f.tables["head"]
+	hhea := f.tables["hhea"]
+	maxp := f.tables["maxp"]
+
+	if len(head) < 54 || len(hhea) < 36 || len(maxp) < 6 {
+		return nil, errInvalidFont
+	}
+
+	f.unitsPerEm = int(binary.BigEndian.Uint16(head[18:]))
+
+	for i := range f.bbox {
+		f.bbox[i] = int(int16(binary.BigEndian.Uint16(head[36+i*2:])))
+	}
+
+	f.longLoca = binary.BigEndian.Uint16(head[50:]) == 1
+	f.ascent = int(int16(binary.BigEndian.Uint16(hhea[4:])))
+	f.descent = int(int16(binary.BigEndian.Uint16(hhea[6:])))
+	f.capHeight = f.ascent
+
+	// OS/2 のバージョン2以降には大文字の高さがある
+	if os2 := f.tables["OS/2"]; len(os2) >= 90 && binary.BigEndian.Uint16(os2) >= 2 {
+		f.capHeight = int(int16(binary.BigEndian.Uint16(os2[88:])))
+	}
+
+	numGlyphs := int(binary.BigEndian.Uint16(maxp[4:]))
+	numHMetrics := int(binary.BigEndian.Uint16(hhea[34:]))
+
+	if f.unitsPerEm == 0 || numHMetrics == 0 || numHMetrics > numGlyphs {
+		return nil, errInvalidFont
+	}
+
+	if err := f.parseLoca(numGlyphs); err != nil {
+		return nil, err
+	}
+
+	if err := f.parseHmtx(numGlyphs, numHMetrics); err != nil {
+		return nil, err
+	}
+
+	cmap, err := parseCmap(f.tables["cmap"])
+
+	if err != nil {
+		return nil, err
+	}
+
+	f.cmap = cmap
+
+	return f, nil
+}
+
+func (f *trueTypeFont) parseLoca(numGlyphs int) error {
+	loca := f.tables["loca"]
+	f.loca = make([]int, numGlyphs+1)
+
+	for i := range f.loca {
+		if f.longLoca {
+			if len(loca) < (i+1)*4 {
+				return errInvalidFont
+			}
+
+			f.loca[i] = int(binary.BigEndian.Uint32(loca[i*4:]))
+		} else {
+			if len(loca) < (i+1)*2 {
+				return errInvalidFont
+			}
+
+			f.loca[i] = int(binary.BigEndian.Uint16(loca[i*2:])) * 2
+		}
+
+		if f.loca[i] > len(f.tables["glyf"]) || (i > 0 && f.loca[i] < f.loca[i-1]) {
+			return fmt.Errorf("%w: glyph %d is out of range", errInvalidFont, i)
+		}
+	}
+
+	return nil
+}
+
+// parseHmtx はグリフごとの送り幅を読み込む
+// numHMetrics 以降のグリフは最後の送り幅と同じになる
+func (f *trueTypeFont) parseHmtx(numGlyphs int, numHMetrics int) error {
+	hmtx := f.tables["hmtx"]
+
+	if len(hmtx) < numHMetrics*4 {
+		return errInvalidFont
+	}
+
+	f.advances = make([]int, numGlyphs)
+
+	for i := range f.advances {
+		if i < numHMetrics {
+			f.advances[i] = int(binary.BigEndian.Uint16(hmtx[i*4:]))
+		} else {
+			f.advances[i] = f.advances[numHMetrics-1]
+		}
+	}
+
+	return nil
+}
+
+// parseCmap は Unicode の文字とグリフの対応を読み込む
+// 対応する形式は format 4 (BMP) と format 12 (全ての文字)
+func parseCmap(cmap []byte) (map[rune]uint16, error) {
+	if len(cmap) < 4 {
+		return nil, errInvalidFont
+	}
+
+	var format4, format12 []byte
+
+	numTables := int(binary.BigEndian.Uint16(cmap[2:]))
+
+	for i := 0; i < numTables; i++ {
+		if len(cmap) < 4+(i+1)*8 {
+			return nil, errInvalidFont
+		}
+
+		record := cmap[4+i*8:]
+		platformID := binary.BigEndian.Uint16(record)
+		encodingID := binary.BigEndian.Uint16(record[2:])
+		offset := int(binary.BigEndian.Uint32(record[4:]))
+
+		if offset+2 > len(cmap) {
+			return nil, errInvalidFont
+		}
+
+		// Unicode のサブテーブルのみ使う
+		if platformID != 0 && !(platformID == 3 && (encodingID == 1 || encodingID == 10)) {
+			continue
+		}
+
+		switch binary.BigEndian.Uint16(cmap[offset:]) {
+		case 4:
+			format4 = cmap[offset:]
+		case 12:
+			format12 = cmap[offset:]
+		}
+	}
+
+	switch {
+	case format12 != nil:
+		return parseCmapFormat12(format12)
+	case format4 != nil:
+		return parseCmapFormat4(format4)
+	default:
+		return nil, fmt.Errorf("%w: unicode cmap is missing", errInvalidFont)
+	}
+}
+
+func parseCmapFormat4(b []byte) (map[rune]uint16, error) {
+	if len(b) < 14 {
+		return nil, errInvalidFont
+	}
+
+	segments := int(binary.BigEndian.Uint16(b[6:])) / 2
+
+	if len(b) < 16+segments*8 {
+		return nil, errInvalidFont
+	}
+
+	endCodes := b[14:]
+	startCodes := b[16+segments*2:]
+	idDeltas := b[16+segments*4:]
+	idRangeOffsets := b[16+segments*6:]
+
+	cmap := make(map[rune]uint16)
+
+	for i := 0; i < segments; i++ {
+		end := int(binary.BigEndian.Uint16(endCodes[i*2:]))
+		start := int(binary.BigEndian.Uint16(startCodes[i*2:]))
+		delta := binary.BigEndian.Uint16(idDeltas[i*2:])
+		rangeOffset := int(binary.BigEndian.Uint16(idRangeOffsets[i*2:]))
+
+		for c := start; c <= end && c != 0xffff; c++ {
+			gid := uint16(c) + delta
+
+			if rangeOffset != 0 {
+				// idRangeOffset は自身の位置から glyphIdArray の要素までのバイト数
+				at := 16 + segments*6 + i*2 + rangeOffset + (c-start)*2
+
+				if at+2 > len(b) {
+					return nil, errInvalidFont
+				}
+
+				if gid = binary.BigEndian.Uint16(b[at:]); gid != 0 {
+					gid += delta
+				}
+			}
+
+			if gid != 0 {
+				cmap[rune(c)] = gid
+			}
+		}
+	}
+
+	return cmap, nil
+}
+
+func parseCmapFormat12(b []byte) (map[rune]uint16, error) {
+	if len(b) < 16 {
+		return nil, errInvalidFont
+	}
+
+	groups := int(binary.BigEndian.Uint32(b[12:]))
+
+	if groups < 0 || len(b) < 16+groups*12 {
+		return nil, errInvalidFont
+	}
+
+	cmap := make(map[rune]uint16)
+
+	for i := 0; i < groups; i++ {
+		group := b[16+i*12:]
+		start := rune(binary.BigEndian.Uint32(group))
+		end := rune(binary.BigEndian.Uint32(group[4:]))
+		gid := binary.BigEndian.Uint32(group[8:])
+
+		for c := start; c <= end; c++ {
+			if g := gid + uint32(c-start); g > 0 && g <= 0xffff {
+				cmap[c] = uint16(g)
+			}
+		}
+	}
+
+	return cmap, nil
+}
+
+// glyph は文字のグリフの番号を返す
+func (f *trueTypeFont) glyph(r rune) (uint16, bool) {
+	gid, ok := f.cmap[r]
+
+	return gid, ok
+}
+
+// advance はグリフの送り幅を 1000 を1文字分とした単位で返す
+func (f *trueTypeFont) advance(gid uint16) int {
+	if int(gid) >= len(f.advances) {
+		return 0
+	}
+
+	return f.advances[gid] * 1000 / f.unitsPerEm
+}
+
+// scale はフォントの単位の値を 1000 を1文字分とした単位に変換する
+func (f *trueTypeFont) scale(v int) int {
+	return v * 1000 / f.unitsPerEm
+}
+
+func (f *trueTypeFont) glyphData(gid uint16) []byte {
+	return f.tables["glyf"][f.loca[gid]:f.loca[gid+1]]
+}
+
+// components は複合グリフが参照するグリフの番号を返す
+func (f *trueTypeFont) components(gid uint16) []uint16 {
+	const (
+		argsAreWords   = 0x0001
+		hasScale       = 0x0008
+		moreComponents = 0x0020
+		hasXYScale     = 0x0040
+		hasTwoByTwo    = 0x0080
+	)
+
+	data := f.glyphData(gid)
+
+	if len(data) < 10 || int16(binary.BigEndian.Uint16(data)) >= 0 {
+		return nil
+	}
+
+	var gids []uint16
+
+	for at := 10; at+4 <= len(data); {
+		flags := binary.BigEndian.Uint16(data[at:])
+		gids = append(gids, binary.BigEndian.Uint16(data[at+2:]))
+		at += 4
+
+		if flags&argsAreWords != 0 {
+			at += 4
+		} else {
+			at += 2
+		}
+
+		switch {
+		case flags&hasScale != 0:
+			at += 2
+		case flags&hasXYScale != 0:
+			at += 4
+		case flags&hasTwoByTwo != 0:
+			at += 8
+		}
+
+		if flags&moreComponents == 0 {
+			break
+		}
+	}
+
+	return gids
+}
+
+// subset は gids のグリフ (と複合グリフが参照するグリフ) だけを残したフォントを返す
+// グリフの番号は変えず、使わないグリフのアウトラインを空にする
+func (f *trueTypeFont) subset(gids map[uint16]bool) []byte {
+	keep := map[uint16]bool{0: true}
+	queue := make([]uint16, 0, len(gids))
+
+	for gid := range gids {
+		queue = append(queue, gid)
+	}
+
+	for len(queue) > 0 {
+		gid := queue[0]
+		queue = queue[1:]
+
+		if keep[gid] || int(gid) >= len(f.loca)-1 {
+			continue
+		}
+
+		keep[gid] = true
+		queue = append(queue, f.components(gid)...)
+	}
+
+	var glyf bytes.Buffer
+
+	offsets := make([]int, len(f.loca))
+
+	for gid := 0; gid < len(f.loca)-1; gid++ {
+		offsets[gid] = glyf.Len()
+
+		if keep[uint16(gid)] {
+			glyf.Write(f.glyphData(uint16(gid)))
+
+			// short 形式の loca は2バイト単位のため、グリフを偶数の長さにそろえる
+			if glyf.Len()%2 == 1 {
+				glyf.WriteByte(0)
+			}
+		}
+	}
+
+	offsets[len(offsets)-1] = glyf.Len()
+
+	longLoca := f.longLoca || glyf.Len() > 0x1fffe
+	loca := make([]byte, 0, len(offsets)*4)
+
+	for _, offset := range offsets {
+		if longLoca {
+			loca = binary.BigEndian.AppendUint32(loca, uint32(offset))
+		} else {
+			loca = binary.BigEndian.AppendUint16(loca, uint16(offset/2))
+		}
+	}
+
+	head := append([]byte{}, f.tables["head"]...)
+
+	if longLoca {
+		binary.BigEndian.PutUint16(head[50:], 1)
+	} else {
+		binary.BigEndian.PutUint16(head[50:], 0)
+	}
+
+	tables := map[string][]byte{
+		"glyf": glyf.Bytes(),
+		"head": head,
+		"loca": loca,
+	}
+
+	for _, tag := range subsetTables {
+		if _, ok := tables[tag]; !ok {
+			if t, ok := f.tables[tag]; ok {
+				tables[tag] = t
+			}
+		}
+	}
+
+	return writeTrueType(tables)
+}
+
+// writeTrueType はテーブルを sfnt の形式で書き出し、head の checkSumAdjustment を設定する
+func writeTrueType(tables map[string][]byte) []byte {
+	tags := make([]string, 0, len(tables))
+
+	for tag := range tables {
+		tags = append(tags, tag)
+	}
+
+	sort.Strings(tags)
+
+	entrySelector := 0
+
+	for 1<<(entrySelector+1) <= len(tags) {
+		entrySelector++
+	}
+
+	searchRange := (1 << entrySelector) * 16
+
+	var out bytes.Buffer
+
+	out.Write([]byte{0, 1, 0, 0})
+	out.Write(binary.BigEndian.AppendUint16(nil, uint16(len(tags))))
+	out.Write(binary.BigEndian.AppendUint16(nil, uint16(searchRange)))
+	out.Write(binary.BigEndian.AppendUint16(nil, uint16(entrySelector)))
+	out.Write(binary.BigEndian.AppendUint16(nil, uint16(len(tags)*16-searchRange)))
+
+	offset := 12 + len(tags)*16
+	headOffset := -1
+
+	for _, tag := range tags {
+		t := tables[tag]
+
+		if tag == "head" {
+			// checkSumAdjustment を0にしてからチェックサムを計算する
+			t = append([]byte{}, t...)
+			binary.BigEndian.PutUint32(t[8:], 0)
+			tables[tag] = t
+			headOffset = offset
+		}
+
+		out.WriteString(tag)
+		out.Write(binary.BigEndian.AppendUint32(nil, tableChecksum(t)))
+		out.Write(binary.BigEndian.AppendUint32(nil, uint32(offset)))
+		out.Write(binary.BigEndian.AppendUint32(nil, uint32(len(t))))
+
+		offset += (len(t) + 3) &^ 3
+	}
+
+	for _, tag := range tags {
+		t := tables[tag]
+
+		out.Write(t)
+		out.Write(make([]byte, ((len(t)+3)&^3)-len(t)))
+	}
+
+	b := out.Bytes()
+
+	if headOffset >= 0 {
+		binary.BigEndian.PutUint32(b[headOffset+8:], 0xb1b0afba-tableChecksum(b))
+	}
+
+	return b
+}
+
+func tableChecksum(b []byte) uint32 {
+	var sum uint32
+
+	for i := 0; i < len(b); i += 4 {
+		var word [4]byte
+
+		copy(word[:], b[i:])
+		sum += binary.BigEndian.Uint32(word[:])
+	}
+
+	return sum
+}
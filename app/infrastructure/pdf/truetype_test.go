@@ -0,0 +1,78 @@
+package pdf
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTrueType(t *testing.T) {
+	f, err := parseTrueType(fontData)
+	require.NoError(t, err)
+	require.Equal(t, 1024, f.unitsPerEm)
+
+	gid, ok := f.glyph('給')
+	require.True(t, ok)
+	require.Equal(t, 1000, f.advance(gid))
+	require.NotEmpty(t, f.glyphData(gid))
+
+	gid, ok = f.glyph('A')
+	require.True(t, ok)
+	require.Equal(t, 500, f.advance(gid))
+
+	_, ok = f.glyph('한')
+	require.False(t, ok)
+
+	_, err = parseTrueType([]byte("not a font"))
+	require.ErrorIs(t, err, errInvalidFont)
+
+	// 必要なテーブルがない
+	_, err = parseTrueType(writeTrueType(map[string][]byte{"head": f.tables["head"]}))
+	require.ErrorIs(t, err, errInvalidFont)
+}
+
+func TestSubset(t *testing.T) {
+	f := embeddedFont
+
+	used, _ := f.glyph('給')
+	unused, _ := f.glyph('食')
+
+	b := f.subset(map[uint16]bool{used: true})
+
+	// checkSumAdjustment を設定するため、ファイル全体のチェックサムは固定の値になる
+	require.Equal(t, uint32(0xb1b0afba), tableChecksum(b))
+
+	sub, err := parseTrueType(b)
+	require.NoError(t, err)
+	require.Len(t, sub.loca, len(f.loca))
+	require.Equal(t, f.cmap, sub.cmap)
+
+	// グリフの番号と送り幅は変えない
+	require.Equal(t, f.glyphData(used), sub.glyphData(used))
+	require.Equal(t, f.glyphData(0), sub.glyphData(0))
+	require.Empty(t, sub.glyphData(unused))
+	require.Equal(t, f.advances, sub.advances)
+	require.Equal(t, f.bbox, sub.bbox)
+}
+
+func TestParseCmapFormat4(t *testing.T) {
+	// 0x41-0x42 を idDelta、0x61 を glyphIdArray で対応付ける
+	var b []byte
+
+	for _, v := range []uint16{
+		4, 0, 0, 6, 4, 1, 2,
+		0x42, 0x61, 0xffff, // endCode
+		0,                  // reservedPad
+		0x41, 0x61, 0xffff, // startCode
+		0xffc4, 0, 1, // idDelta (-60)
+		0, 4, 0, // idRangeOffset
+		7, // glyphIdArray
+	} {
+		b = binary.BigEndian.AppendUint16(b, v)
+	}
+
+	cmap, err := parseCmapFormat4(b)
+	require.NoError(t, err)
+	require.Equal(t, map[rune]uint16{'A': 5, 'B': 6, 'a': 7}, cmap)
+}
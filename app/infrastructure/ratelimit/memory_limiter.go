@@ -0,0 +1,152 @@
+package ratelimit
+
+import (
+	"sync"
+	"time"
+
+	"github.com/ogurilab/school-lunch-api/domain"
+	"golang.org/x/time/rate"
+)
+
+// 1日のリクエスト数は日本時間の0時にリセットする
+var jst = time.FixedZone("Asia/Tokyo", 9*60*60)
+
+const (
+	// idleTimeout より長くリクエストのない利用者のトークンバケットは破棄する
+	idleTimeout = time.Hour
+	// sweepInterval ごとに破棄するエントリーを探す
+	sweepInterval = time.Minute
+)
+
+type entry struct {
+	bucket *rate.Limiter
+	limit  domain.RateLimit
+	day    time.Time
+	count  int32
+	last   time.Time
+}
+
+type memoryLimiter struct {
+	mu      sync.Mutex
+	entries map[string]*entry
+	day     time.Time
+	swept   time.Time
+	now     func() time.Time
+}
+
+// NewMemoryLimiter はプロセス内のメモリで制限を管理する RateLimiter を返す
+// 複数台で動かす場合は台数分だけ制限が緩くなり、再起動すると1日のリクエスト数もリセットされる
+func NewMemoryLimiter() domain.RateLimiter {
+	return newMemoryLimiter(time.Now)
+}
+
+func newMemoryLimiter(now func() time.Time) *memoryLimiter {
+	return &memoryLimiter{
+		entries: make(map[string]*entry),
+		now:     now,
+	}
+}
+
+func (l *memoryLimiter) Allow(key string, limit domain.RateLimit) *domain.RateLimitResult {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	day := startOfDay(now)
+
+	// 日付が変わったら前日までのエントリーを破棄する
+	if !l.day.Equal(day) {
+		for k, e := range l.entries {
+			if !e.day.Equal(day) {
+				delete(l.entries, k)
+			}
+		}
+
+		l.day = day
+	}
+
+	// 接続元が増え続けてもメモリを使い続けないよう、しばらくリクエストのない利用者のトークンバケットを破棄する
+	// 1日のリクエスト数は日付が変わるまで残し、1度も受け付けていないエントリーのみ削除する
+	// 破棄したバケットは満杯の状態と同じなので、次のリクエストで作り直しても制限は変わらない
+	if now.Sub(l.swept) >= sweepInterval {
+		for k, e := range l.entries {
+			if now.Sub(e.last) < idleTimeout {
+				continue
+			}
+
+			if e.count == 0 {
+				delete(l.entries, k)
+				continue
+			}
+
+			e.bucket = nil
+		}
+
+		l.swept = now
+	}
+
+	e, ok := l.entries[key]
+
+	if !ok {
+		e = &entry{
+			day: day,
+		}
+
+		l.entries[key] = e
+	}
+
+	// 制限値が変更された場合はバケットを作り直す
+	if e.bucket == nil || e.limit != limit {
+		e.bucket = rate.NewLimiter(rate.Limit(float64(limit.PerMinute)/60), int(limit.Burst))
+		e.limit = limit
+	}
+
+	e.last = now
+
+	reset := day.AddDate(0, 0, 1)
+
+	result := &domain.RateLimitResult{
+		Limit: limit.DailyQuota,
+		Reset: reset,
+	}
+
+	if e.count >= limit.DailyQuota {
+		result.RetryAfter = reset.Sub(now)
+		result.Err = domain.ErrQuotaExceeded
+
+		return result
+	}
+
+	reservation := e.bucket.ReserveN(now, 1)
+
+	if !reservation.OK() {
+		result.Remaining = limit.DailyQuota - e.count
+		result.RetryAfter = time.Minute
+		result.Err = domain.ErrRateLimited
+
+		return result
+	}
+
+	if delay := reservation.DelayFrom(now); delay > 0 {
+		reservation.CancelAt(now)
+
+		result.Remaining = limit.DailyQuota - e.count
+		result.RetryAfter = delay
+		result.Err = domain.ErrRateLimited
+
+		return result
+	}
+
+	e.count++
+
+	result.Allowed = true
+	result.Remaining = limit.DailyQuota - e.count
+
+	return result
+}
+
+func startOfDay(t time.Time) time.Time {
+	t = t.In(jst)
+
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, jst)
+}
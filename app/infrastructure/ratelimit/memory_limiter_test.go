@@ -0,0 +1,96 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ogurilab/school-lunch-api/domain"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryLimiterBurst(t *testing.T) {
+	now := time.Date(2024, 1, 18, 12, 0, 0, 0, jst)
+	l := newMemoryLimiter(func() time.Time { return now })
+
+	limit := domain.RateLimit{PerMinute: 60, Burst: 2, DailyQuota: 100}
+
+	for i := 0; i < 2; i++ {
+		res := l.Allow("key", limit)
+		require.True(t, res.Allowed)
+		require.Equal(t, int32(100), res.Limit)
+		require.Equal(t, int32(100-i-1), res.Remaining)
+	}
+
+	res := l.Allow("key", limit)
+	require.False(t, res.Allowed)
+	require.ErrorIs(t, res.Err, domain.ErrRateLimited)
+	require.Equal(t, time.Second, res.RetryAfter)
+	require.Equal(t, int32(98), res.Remaining)
+
+	// 他のキーには影響しない
+	require.True(t, l.Allow("other", limit).Allowed)
+
+	// トークンが補充されれば再び受け付ける
+	now = now.Add(time.Second)
+	require.True(t, l.Allow("key", limit).Allowed)
+}
+
+func TestMemoryLimiterDailyQuota(t *testing.T) {
+	now := time.Date(2024, 1, 18, 23, 59, 0, 0, jst)
+	l := newMemoryLimiter(func() time.Time { return now })
+
+	limit := domain.RateLimit{PerMinute: 600, Burst: 10, DailyQuota: 3}
+
+	for i := 0; i < 3; i++ {
+		require.True(t, l.Allow("key", limit).Allowed)
+	}
+
+	res := l.Allow("key", limit)
+	require.False(t, res.Allowed)
+	require.ErrorIs(t, res.Err, domain.ErrQuotaExceeded)
+	require.Equal(t, int32(0), res.Remaining)
+	require.Equal(t, time.Date(2024, 1, 19, 0, 0, 0, 0, jst), res.Reset)
+	require.Equal(t, time.Minute, res.RetryAfter)
+
+	// 日本時間の0時にリセットされる
+	now = now.Add(time.Minute)
+
+	res = l.Allow("key", limit)
+	require.True(t, res.Allowed)
+	require.Equal(t, int32(2), res.Remaining)
+}
+
+func TestMemoryLimiterEvictIdle(t *testing.T) {
+	now := time.Date(2024, 1, 18, 12, 0, 0, 0, jst)
+	l := newMemoryLimiter(func() time.Time { return now })
+
+	limit := domain.RateLimit{PerMinute: 60, Burst: 2, DailyQuota: 3}
+
+	require.True(t, l.Allow("idle", limit).Allowed)
+	require.True(t, l.Allow("idle", limit).Allowed)
+
+	// 1度も受け付けていないエントリー
+	require.False(t, l.Allow("rejected", domain.RateLimit{PerMinute: 60, Burst: 1, DailyQuota: 0}).Allowed)
+
+	now = now.Add(idleTimeout - time.Minute)
+	require.True(t, l.Allow("active", limit).Allowed)
+	require.Len(t, l.entries, 3)
+
+	// しばらくリクエストのないエントリーはバケットだけを破棄し、1日のリクエスト数は残す
+	now = now.Add(time.Minute)
+	require.True(t, l.Allow("active", limit).Allowed)
+	require.Len(t, l.entries, 2)
+	require.NotContains(t, l.entries, "rejected")
+	require.Nil(t, l.entries["idle"].bucket)
+	require.Equal(t, int32(2), l.entries["idle"].count)
+	require.Equal(t, int32(2), l.entries["active"].count)
+
+	// 時間をおいても1日の上限は回復しない
+	result := l.Allow("idle", limit)
+	require.True(t, result.Allowed)
+	require.Equal(t, int32(0), result.Remaining)
+
+	result = l.Allow("idle", limit)
+	require.False(t, result.Allowed)
+	require.ErrorIs(t, result.Err, domain.ErrQuotaExceeded)
+}
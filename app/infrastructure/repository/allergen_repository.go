@@ -2,6 +2,8 @@ package repository
 
 import (
 	"context"
+	"database/sql"
+	"time"
 
 	"github.com/ogurilab/school-lunch-api/domain"
 	db "github.com/ogurilab/school-lunch-api/infrastructure/db/sqlc"
@@ -17,6 +19,46 @@ func NewAllergenRepository(query db.Query) domain.AllergenRepository {
 	}
 }
 
+func (r *allergenRepository) Create(ctx context.Context, name string) (*domain.Allergen, error) {
+
+	if err := r.query.CreateAllergen(ctx, name); err != nil {
+		return nil, err
+	}
+
+	result, err := r.query.GetAllergenByName(ctx, name)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.ReNewAllergen(result.ID, result.Name, domain.AllergenKind(result.Kind), domain.AllergenCategoryContains), nil
+}
+
+func (r *allergenRepository) AttachToDish(ctx context.Context, dishID string, allergens []*domain.Allergen) error {
+
+	return r.query.CreateDishesAllergensTx(ctx, dishID, allergens)
+}
+
+func (r *allergenRepository) DetachFromDish(ctx context.Context, dishID string, allergenID int32, category domain.AllergenCategory) error {
+	arg := db.DeleteDishesAllergensParams{
+		DishID:     dishID,
+		AllergenID: allergenID,
+		Category:   int32(category),
+	}
+
+	affected, err := r.query.DeleteDishesAllergens(ctx, arg)
+
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
 func (r *allergenRepository) FetchByDishID(ctx context.Context, dishID string) ([]*domain.Allergen, error) {
 
 	results, err := r.query.ListAllergenByDishID(ctx, dishID)
@@ -28,7 +70,7 @@ func (r *allergenRepository) FetchByDishID(ctx context.Context, dishID string) (
 	allergens := make([]*domain.Allergen, 0, len(results))
 
 	for _, result := range results {
-		allergen := domain.ReNewAllergen(result.ID, result.Name, result.Category)
+		allergen := domain.ReNewAllergen(result.ID, result.Name, domain.AllergenKind(result.Kind), domain.AllergenCategory(result.Category))
 
 		allergens = append(allergens, allergen)
 	}
@@ -36,6 +78,44 @@ func (r *allergenRepository) FetchByDishID(ctx context.Context, dishID string) (
 	return allergens, nil
 }
 
+func (r *allergenRepository) FetchByDishIDs(ctx context.Context, dishIDs []string) (map[string][]*domain.Allergen, error) {
+
+	results, err := r.query.ListAllergenByDishIDs(ctx, dishIDs)
+
+	if err != nil {
+		return nil, err
+	}
+
+	allergens := make(map[string][]*domain.Allergen)
+
+	for _, result := range results {
+		allergen := domain.ReNewAllergen(result.ID, result.Name, domain.AllergenKind(result.Kind), domain.AllergenCategory(result.Category))
+
+		allergens[result.DishID] = append(allergens[result.DishID], allergen)
+	}
+
+	return allergens, nil
+}
+
+// FetchByIDs は ids のうち登録されているアレルゲンを返す
+// 料理に紐づかないため、Category は AllergenCategoryContains とする
+func (r *allergenRepository) FetchByIDs(ctx context.Context, ids []int32) ([]*domain.Allergen, error) {
+
+	results, err := r.query.ListAllergensByIDs(ctx, ids)
+
+	if err != nil {
+		return nil, err
+	}
+
+	allergens := make([]*domain.Allergen, 0, len(results))
+
+	for _, result := range results {
+		allergens = append(allergens, domain.ReNewAllergen(result.ID, result.Name, domain.AllergenKind(result.Kind), domain.AllergenCategoryContains))
+	}
+
+	return allergens, nil
+}
+
 func (r *allergenRepository) FetchInDish(ctx context.Context, dishIDs []string) ([]*domain.Allergen, error) {
 
 	results, err := r.query.ListAllergenInDish(ctx, dishIDs)
@@ -47,7 +127,93 @@ func (r *allergenRepository) FetchInDish(ctx context.Context, dishIDs []string)
 	allergens := make([]*domain.Allergen, 0, len(results))
 
 	for _, result := range results {
-		allergen := domain.ReNewAllergen(result.ID, result.Name, result.Category)
+		allergen := domain.ReNewAllergen(result.ID, result.Name, domain.AllergenKind(result.Kind), domain.AllergenCategory(result.Category))
+
+		allergens = append(allergens, allergen)
+	}
+
+	return allergens, nil
+}
+
+func (r *allergenRepository) FetchMatchedInDish(ctx context.Context, dishIDs []string, allergenIDs []int32) (map[string][]*domain.Allergen, error) {
+	arg := db.ListAllergenInDishByAllergenIDsParams{
+		DishIds:     dishIDs,
+		AllergenIds: allergenIDs,
+	}
+
+	results, err := r.query.ListAllergenInDishByAllergenIDs(ctx, arg)
+
+	if err != nil {
+		return nil, err
+	}
+
+	allergens := make(map[string][]*domain.Allergen)
+
+	for _, result := range results {
+		allergen := domain.ReNewAllergen(result.ID, result.Name, domain.AllergenKind(result.Kind), domain.AllergenCategory(result.Category))
+
+		allergens[result.DishID] = append(allergens[result.DishID], allergen)
+	}
+
+	return allergens, nil
+}
+
+// FetchMenuDishesByCity は start から end までの献立の料理と、料理ごとのアレルゲンを提供日の昇順に返す
+func (r *allergenRepository) FetchMenuDishesByCity(ctx context.Context, start time.Time, end time.Time, city int32) ([]*domain.MenuDishAllergens, error) {
+	arg := db.ListAllergenMatrixByCityParams{
+		CityCode: city,
+		StartAt:  start,
+		EndAt:    end,
+	}
+
+	results, err := r.query.ListAllergenMatrixByCity(ctx, arg)
+
+	if err != nil {
+		return nil, err
+	}
+
+	dishes := make([]*domain.MenuDishAllergens, 0)
+
+	var current *domain.MenuDishAllergens
+
+	// 行は献立と料理の順に並ぶため、続く行を1品にまとめる
+	for _, result := range results {
+		if current == nil || current.MenuID != result.MenuID || current.DishID != result.DishID {
+			current = &domain.MenuDishAllergens{
+				MenuID:    result.MenuID,
+				OfferedAt: result.OfferedAt,
+				DishID:    result.DishID,
+				DishName:  result.DishName,
+				Allergens: make([]*domain.Allergen, 0),
+			}
+
+			dishes = append(dishes, current)
+		}
+
+		if !result.AllergenID.Valid {
+			continue
+		}
+
+		allergen := domain.ReNewAllergen(result.AllergenID.Int32, result.AllergenName.String, domain.AllergenKind(result.AllergenKind.String), domain.AllergenCategory(result.Category.Int32))
+
+		current.Allergens = append(current.Allergens, allergen)
+	}
+
+	return dishes, nil
+}
+
+func (r *allergenRepository) FetchStandard(ctx context.Context) ([]*domain.StandardAllergen, error) {
+
+	results, err := r.query.ListStandardAllergens(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	allergens := make([]*domain.StandardAllergen, 0, len(results))
+
+	for _, result := range results {
+		allergen := domain.ReNewStandardAllergen(result.ID, result.Code.String, result.Name, domain.AllergenKind(result.Kind))
 
 		allergens = append(allergens, allergen)
 	}
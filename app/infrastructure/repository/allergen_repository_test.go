@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"testing"
+	"time"
 
 	"github.com/ogurilab/school-lunch-api/domain"
 	db "github.com/ogurilab/school-lunch-api/infrastructure/db/sqlc"
@@ -121,6 +122,525 @@ func TestFetchInDish(t *testing.T) {
 	}
 }
 
+func TestCreateAllergen(t *testing.T) {
+	name := util.RandomString(50)
+	result := db.Allergen{
+		ID:   util.RandomInt32(),
+		Name: name,
+		Kind: string(domain.AllergenKindOther),
+	}
+
+	testCases := []struct {
+		name       string
+		buildStubs func(query *mocks.MockQuery)
+		check      func(t *testing.T, allergen *domain.Allergen, err error)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(query *mocks.MockQuery) {
+				query.EXPECT().CreateAllergen(gomock.Any(), gomock.Eq(name)).Times(1).Return(nil)
+				query.EXPECT().GetAllergenByName(gomock.Any(), gomock.Eq(name)).Times(1).Return(result, nil)
+			},
+			check: func(t *testing.T, allergen *domain.Allergen, err error) {
+				require.NoError(t, err)
+				require.Equal(t, result.ID, allergen.ID)
+				require.Equal(t, result.Name, allergen.Name)
+				require.Equal(t, domain.AllergenKindOther, allergen.Kind)
+				require.Equal(t, domain.AllergenCategoryContains, allergen.Category)
+			},
+		},
+		{
+			name: "NG - CreateAllergen",
+			buildStubs: func(query *mocks.MockQuery) {
+				query.EXPECT().CreateAllergen(gomock.Any(), gomock.Eq(name)).Times(1).Return(sql.ErrConnDone)
+				query.EXPECT().GetAllergenByName(gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, allergen *domain.Allergen, err error) {
+				require.Error(t, err)
+				require.Nil(t, allergen)
+			},
+		},
+		{
+			name: "NG - GetAllergenByName",
+			buildStubs: func(query *mocks.MockQuery) {
+				query.EXPECT().CreateAllergen(gomock.Any(), gomock.Eq(name)).Times(1).Return(nil)
+				query.EXPECT().GetAllergenByName(gomock.Any(), gomock.Eq(name)).Times(1).Return(db.Allergen{}, sql.ErrConnDone)
+			},
+			check: func(t *testing.T, allergen *domain.Allergen, err error) {
+				require.Error(t, err)
+				require.Nil(t, allergen)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			query := mocks.NewMockQuery(ctrl)
+			tc.buildStubs(query)
+
+			repo := NewAllergenRepository(query)
+
+			allergen, err := repo.Create(context.Background(), name)
+
+			tc.check(t, allergen, err)
+		})
+	}
+}
+
+func TestAttachAllergensToDish(t *testing.T) {
+	dish := randomDish(t)
+	allergens := []*domain.Allergen{
+		domain.ReNewAllergen(util.RandomInt32(), "", "", domain.AllergenCategoryContains),
+		domain.ReNewAllergen(util.RandomInt32(), "", "", domain.AllergenCategoryMayContain),
+	}
+
+	testCases := []struct {
+		name       string
+		buildStubs func(query *mocks.MockQuery)
+		check      func(t *testing.T, err error)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(query *mocks.MockQuery) {
+				query.EXPECT().CreateDishesAllergensTx(gomock.Any(), gomock.Eq(dish.ID), gomock.Eq(allergens)).Times(1).Return(nil)
+			},
+			check: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "NG",
+			buildStubs: func(query *mocks.MockQuery) {
+				query.EXPECT().CreateDishesAllergensTx(gomock.Any(), gomock.Eq(dish.ID), gomock.Eq(allergens)).Times(1).Return(sql.ErrConnDone)
+			},
+			check: func(t *testing.T, err error) {
+				require.Error(t, err)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			query := mocks.NewMockQuery(ctrl)
+			tc.buildStubs(query)
+
+			repo := NewAllergenRepository(query)
+
+			err := repo.AttachToDish(context.Background(), dish.ID, allergens)
+
+			tc.check(t, err)
+		})
+	}
+}
+
+func TestDetachAllergenFromDish(t *testing.T) {
+	dish := randomDish(t)
+	arg := db.DeleteDishesAllergensParams{
+		DishID:     dish.ID,
+		AllergenID: util.RandomInt32(),
+		Category:   int32(domain.AllergenCategoryMayContain),
+	}
+
+	testCases := []struct {
+		name       string
+		buildStubs func(query *mocks.MockQuery)
+		check      func(t *testing.T, err error)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(query *mocks.MockQuery) {
+				query.EXPECT().DeleteDishesAllergens(gomock.Any(), gomock.Eq(arg)).Times(1).Return(int64(1), nil)
+			},
+			check: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "Not Found",
+			buildStubs: func(query *mocks.MockQuery) {
+				query.EXPECT().DeleteDishesAllergens(gomock.Any(), gomock.Eq(arg)).Times(1).Return(int64(0), nil)
+			},
+			check: func(t *testing.T, err error) {
+				require.ErrorIs(t, err, sql.ErrNoRows)
+			},
+		},
+		{
+			name: "NG",
+			buildStubs: func(query *mocks.MockQuery) {
+				query.EXPECT().DeleteDishesAllergens(gomock.Any(), gomock.Eq(arg)).Times(1).Return(int64(0), sql.ErrConnDone)
+			},
+			check: func(t *testing.T, err error) {
+				require.ErrorIs(t, err, sql.ErrConnDone)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			query := mocks.NewMockQuery(ctrl)
+			tc.buildStubs(query)
+
+			repo := NewAllergenRepository(query)
+
+			err := repo.DetachFromDish(context.Background(), arg.DishID, arg.AllergenID, domain.AllergenCategory(arg.Category))
+
+			tc.check(t, err)
+		})
+	}
+}
+
+func TestFetchAllergensByDishIDs(t *testing.T) {
+	dishIDs := []string{util.NewUlid(), util.NewUlid()}
+	results := []db.ListAllergenByDishIDsRow{
+		{DishID: dishIDs[0], ID: 1, Name: "卵", Kind: string(domain.AllergenKindSpecified), Category: int32(domain.AllergenCategoryContains)},
+		{DishID: dishIDs[1], ID: 1, Name: "卵", Kind: string(domain.AllergenKindSpecified), Category: int32(domain.AllergenCategoryContains)},
+		{DishID: dishIDs[1], ID: 2, Name: "乳", Kind: string(domain.AllergenKindSpecified), Category: int32(domain.AllergenCategoryMayContain)},
+	}
+
+	testCases := []struct {
+		name       string
+		buildStubs func(query *mocks.MockQuery)
+		check      func(t *testing.T, allergens map[string][]*domain.Allergen, err error)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(query *mocks.MockQuery) {
+				query.EXPECT().ListAllergenByDishIDs(gomock.Any(), gomock.Eq(dishIDs)).Times(1).Return(results, nil)
+			},
+			check: func(t *testing.T, allergens map[string][]*domain.Allergen, err error) {
+				require.NoError(t, err)
+				require.Len(t, allergens, 2)
+				require.Len(t, allergens[dishIDs[0]], 1)
+				require.Len(t, allergens[dishIDs[1]], 2)
+
+				require.Equal(t, domain.AllergenCategoryMayContain, allergens[dishIDs[1]][1].Category)
+			},
+		},
+		{
+			name: "NG",
+			buildStubs: func(query *mocks.MockQuery) {
+				query.EXPECT().ListAllergenByDishIDs(gomock.Any(), gomock.Eq(dishIDs)).Times(1).Return(nil, sql.ErrConnDone)
+			},
+			check: func(t *testing.T, allergens map[string][]*domain.Allergen, err error) {
+				require.ErrorIs(t, err, sql.ErrConnDone)
+				require.Nil(t, allergens)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			query := mocks.NewMockQuery(ctrl)
+			tc.buildStubs(query)
+
+			repo := NewAllergenRepository(query)
+
+			allergens, err := repo.FetchByDishIDs(context.Background(), dishIDs)
+
+			tc.check(t, allergens, err)
+		})
+	}
+}
+
+func TestFetchAllergensByIDs(t *testing.T) {
+	ids := []int32{1, 2}
+	results := []db.ListAllergensByIDsRow{
+		{ID: 1, Name: "卵", Kind: string(domain.AllergenKindSpecified)},
+		{ID: 2, Name: "大豆", Kind: string(domain.AllergenKindRecommended)},
+	}
+
+	testCases := []struct {
+		name       string
+		buildStubs func(query *mocks.MockQuery)
+		check      func(t *testing.T, allergens []*domain.Allergen, err error)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(query *mocks.MockQuery) {
+				query.EXPECT().ListAllergensByIDs(gomock.Any(), gomock.Eq(ids)).Times(1).Return(results, nil)
+			},
+			check: func(t *testing.T, allergens []*domain.Allergen, err error) {
+				require.NoError(t, err)
+				require.Len(t, allergens, len(results))
+
+				for i, allergen := range allergens {
+					require.Equal(t, results[i].ID, allergen.ID)
+					require.Equal(t, results[i].Name, allergen.Name)
+					require.Equal(t, domain.AllergenKind(results[i].Kind), allergen.Kind)
+				}
+			},
+		},
+		{
+			name: "NG",
+			buildStubs: func(query *mocks.MockQuery) {
+				query.EXPECT().ListAllergensByIDs(gomock.Any(), gomock.Eq(ids)).Times(1).Return(nil, sql.ErrConnDone)
+			},
+			check: func(t *testing.T, allergens []*domain.Allergen, err error) {
+				require.ErrorIs(t, err, sql.ErrConnDone)
+				require.Nil(t, allergens)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			query := mocks.NewMockQuery(ctrl)
+			tc.buildStubs(query)
+
+			repo := NewAllergenRepository(query)
+
+			allergens, err := repo.FetchByIDs(context.Background(), ids)
+
+			tc.check(t, allergens, err)
+		})
+	}
+}
+
+func TestFetchMatchedAllergensInDish(t *testing.T) {
+	dishIDs := []string{util.NewUlid(), util.NewUlid()}
+	allergenIDs := []int32{1, 2}
+	arg := db.ListAllergenInDishByAllergenIDsParams{
+		DishIds:     dishIDs,
+		AllergenIds: allergenIDs,
+	}
+	results := []db.ListAllergenInDishByAllergenIDsRow{
+		{DishID: dishIDs[0], ID: 1, Name: "卵", Kind: string(domain.AllergenKindSpecified), Category: int32(domain.AllergenCategoryContains)},
+		{DishID: dishIDs[0], ID: 2, Name: "乳", Kind: string(domain.AllergenKindSpecified), Category: int32(domain.AllergenCategoryMayContain)},
+	}
+
+	testCases := []struct {
+		name       string
+		buildStubs func(query *mocks.MockQuery)
+		check      func(t *testing.T, allergens map[string][]*domain.Allergen, err error)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(query *mocks.MockQuery) {
+				query.EXPECT().ListAllergenInDishByAllergenIDs(gomock.Any(), gomock.Eq(arg)).Times(1).Return(results, nil)
+			},
+			check: func(t *testing.T, allergens map[string][]*domain.Allergen, err error) {
+				require.NoError(t, err)
+				require.Len(t, allergens, 1)
+				require.Len(t, allergens[dishIDs[0]], 2)
+				require.Empty(t, allergens[dishIDs[1]])
+
+				require.Equal(t, int32(1), allergens[dishIDs[0]][0].ID)
+				require.Equal(t, domain.AllergenCategoryContains, allergens[dishIDs[0]][0].Category)
+				require.Equal(t, domain.AllergenCategoryMayContain, allergens[dishIDs[0]][1].Category)
+			},
+		},
+		{
+			name: "NG",
+			buildStubs: func(query *mocks.MockQuery) {
+				query.EXPECT().ListAllergenInDishByAllergenIDs(gomock.Any(), gomock.Eq(arg)).Times(1).Return(nil, sql.ErrConnDone)
+			},
+			check: func(t *testing.T, allergens map[string][]*domain.Allergen, err error) {
+				require.ErrorIs(t, err, sql.ErrConnDone)
+				require.Nil(t, allergens)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			query := mocks.NewMockQuery(ctrl)
+			tc.buildStubs(query)
+
+			repo := NewAllergenRepository(query)
+
+			allergens, err := repo.FetchMatchedInDish(context.Background(), dishIDs, allergenIDs)
+
+			tc.check(t, allergens, err)
+		})
+	}
+}
+
+func TestFetchMenuDishesByCity(t *testing.T) {
+	city := util.RandomCityCode()
+	start := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 5, 31, 0, 0, 0, 0, time.UTC)
+	arg := db.ListAllergenMatrixByCityParams{
+		CityCode: city,
+		StartAt:  start,
+		EndAt:    end,
+	}
+
+	menuID := util.NewUlid()
+	dishIDs := []string{util.NewUlid(), util.NewUlid()}
+	results := []db.ListAllergenMatrixByCityRow{
+		{
+			MenuID:       menuID,
+			OfferedAt:    start,
+			DishID:       dishIDs[0],
+			DishName:     "オムレツ",
+			AllergenID:   sql.NullInt32{Int32: 1, Valid: true},
+			AllergenName: sql.NullString{String: "卵", Valid: true},
+			AllergenKind: sql.NullString{String: string(domain.AllergenKindSpecified), Valid: true},
+			Category:     sql.NullInt32{Int32: int32(domain.AllergenCategoryContains), Valid: true},
+		},
+		{
+			MenuID:       menuID,
+			OfferedAt:    start,
+			DishID:       dishIDs[0],
+			DishName:     "オムレツ",
+			AllergenID:   sql.NullInt32{Int32: 2, Valid: true},
+			AllergenName: sql.NullString{String: "乳", Valid: true},
+			AllergenKind: sql.NullString{String: string(domain.AllergenKindSpecified), Valid: true},
+			Category:     sql.NullInt32{Int32: int32(domain.AllergenCategoryMayContain), Valid: true},
+		},
+		{
+			MenuID:    menuID,
+			OfferedAt: start,
+			DishID:    dishIDs[1],
+			DishName:  "ごはん",
+		},
+	}
+
+	testCases := []struct {
+		name       string
+		buildStubs func(query *mocks.MockQuery)
+		check      func(t *testing.T, dishes []*domain.MenuDishAllergens, err error)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(query *mocks.MockQuery) {
+				query.EXPECT().ListAllergenMatrixByCity(gomock.Any(), gomock.Eq(arg)).Times(1).Return(results, nil)
+			},
+			check: func(t *testing.T, dishes []*domain.MenuDishAllergens, err error) {
+				require.NoError(t, err)
+				require.Len(t, dishes, 2)
+
+				require.Equal(t, menuID, dishes[0].MenuID)
+				require.Equal(t, start, dishes[0].OfferedAt)
+				require.Equal(t, dishIDs[0], dishes[0].DishID)
+				require.Equal(t, "オムレツ", dishes[0].DishName)
+				require.Len(t, dishes[0].Allergens, 2)
+				require.Equal(t, domain.AllergenCategoryContains, dishes[0].Allergens[0].Category)
+				require.Equal(t, domain.AllergenCategoryMayContain, dishes[0].Allergens[1].Category)
+				require.Equal(t, "特定原材料", dishes[0].Allergens[1].KindLabel)
+
+				// アレルゲンのない料理
+				require.Equal(t, dishIDs[1], dishes[1].DishID)
+				require.NotNil(t, dishes[1].Allergens)
+				require.Empty(t, dishes[1].Allergens)
+			},
+		},
+		{
+			name: "OK - Empty",
+			buildStubs: func(query *mocks.MockQuery) {
+				query.EXPECT().ListAllergenMatrixByCity(gomock.Any(), gomock.Eq(arg)).Times(1).Return([]db.ListAllergenMatrixByCityRow{}, nil)
+			},
+			check: func(t *testing.T, dishes []*domain.MenuDishAllergens, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, dishes)
+				require.Empty(t, dishes)
+			},
+		},
+		{
+			name: "NG",
+			buildStubs: func(query *mocks.MockQuery) {
+				query.EXPECT().ListAllergenMatrixByCity(gomock.Any(), gomock.Eq(arg)).Times(1).Return(nil, sql.ErrConnDone)
+			},
+			check: func(t *testing.T, dishes []*domain.MenuDishAllergens, err error) {
+				require.ErrorIs(t, err, sql.ErrConnDone)
+				require.Nil(t, dishes)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			query := mocks.NewMockQuery(ctrl)
+			tc.buildStubs(query)
+
+			repo := NewAllergenRepository(query)
+
+			dishes, err := repo.FetchMenuDishesByCity(context.Background(), start, end, city)
+
+			tc.check(t, dishes, err)
+		})
+	}
+}
+
+func TestFetchStandardAllergens(t *testing.T) {
+	results := []db.Allergen{
+		{ID: 1, Name: "卵", Code: sql.NullString{String: "egg", Valid: true}, Kind: string(domain.AllergenKindSpecified)},
+		{ID: 2, Name: "大豆", Code: sql.NullString{String: "soybean", Valid: true}, Kind: string(domain.AllergenKindRecommended)},
+	}
+
+	testCases := []struct {
+		name       string
+		buildStubs func(query *mocks.MockQuery)
+		check      func(t *testing.T, allergens []*domain.StandardAllergen, err error)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(query *mocks.MockQuery) {
+				query.EXPECT().ListStandardAllergens(gomock.Any()).Times(1).Return(results, nil)
+			},
+			check: func(t *testing.T, allergens []*domain.StandardAllergen, err error) {
+				require.NoError(t, err)
+				require.Len(t, allergens, len(results))
+
+				for i, allergen := range allergens {
+					require.Equal(t, results[i].ID, allergen.ID)
+					require.Equal(t, results[i].Code.String, allergen.Code)
+					require.Equal(t, results[i].Name, allergen.Name)
+					require.Equal(t, domain.AllergenKind(results[i].Kind), allergen.Kind)
+					require.Equal(t, allergen.Kind.Label(), allergen.KindLabel)
+				}
+			},
+		},
+		{
+			name: "NG",
+			buildStubs: func(query *mocks.MockQuery) {
+				query.EXPECT().ListStandardAllergens(gomock.Any()).Times(1).Return(nil, sql.ErrConnDone)
+			},
+			check: func(t *testing.T, allergens []*domain.StandardAllergen, err error) {
+				require.Error(t, err)
+				require.Nil(t, allergens)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			query := mocks.NewMockQuery(ctrl)
+			tc.buildStubs(query)
+
+			repo := NewAllergenRepository(query)
+
+			allergens, err := repo.FetchStandard(context.Background())
+
+			tc.check(t, allergens, err)
+		})
+	}
+}
+
 func randomDbListAllergenByDishIDRow(t *testing.T, length int) []db.ListAllergenByDishIDRow {
 
 	allergens := make([]db.ListAllergenByDishIDRow, 0, length)
@@ -129,7 +649,8 @@ func randomDbListAllergenByDishIDRow(t *testing.T, length int) []db.ListAllergen
 		allergen := db.ListAllergenByDishIDRow{
 			ID:       util.RandomInt32(),
 			Name:     util.RandomString(50),
-			Category: util.RandomInt32(),
+			Kind:     string(domain.AllergenKindSpecified),
+			Category: int32(domain.AllergenCategoryContains),
 		}
 
 		allergens = append(allergens, allergen)
@@ -147,7 +668,8 @@ func randomDbListAllergenInDishRow(t *testing.T, length int) []db.ListAllergenIn
 		allergen := db.ListAllergenInDishRow{
 			ID:       util.RandomInt32(),
 			Name:     util.RandomString(50),
-			Category: util.RandomInt32(),
+			Kind:     string(domain.AllergenKindSpecified),
+			Category: int32(domain.AllergenCategoryContains),
 		}
 
 		allergens = append(allergens, allergen)
@@ -0,0 +1,110 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/ogurilab/school-lunch-api/domain"
+	db "github.com/ogurilab/school-lunch-api/infrastructure/db/sqlc"
+)
+
+type apiKeyRepository struct {
+	query db.Query
+}
+
+func NewAPIKeyRepository(query db.Query) domain.APIKeyRepository {
+	return &apiKeyRepository{
+		query: query,
+	}
+}
+
+func (r *apiKeyRepository) Create(ctx context.Context, key *domain.APIKey) (*domain.APIKey, error) {
+	arg := db.CreateApiKeyParams{
+		Name:       key.Name,
+		Prefix:     key.Prefix,
+		HashedKey:  key.HashedKey,
+		RateLimit:  key.RateLimit,
+		Burst:      key.Burst,
+		DailyQuota: key.DailyQuota,
+	}
+
+	id, err := r.query.CreateApiKey(ctx, arg)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return r.GetByID(ctx, int32(id))
+}
+
+func (r *apiKeyRepository) GetByID(ctx context.Context, id int32) (*domain.APIKey, error) {
+
+	result, err := r.query.GetApiKey(ctx, id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return reNewAPIKey(result), nil
+}
+
+func (r *apiKeyRepository) GetByHashedKey(ctx context.Context, hashedKey string) (*domain.APIKey, error) {
+
+	result, err := r.query.GetApiKeyByHashedKey(ctx, hashedKey)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return reNewAPIKey(result), nil
+}
+
+func (r *apiKeyRepository) Fetch(ctx context.Context, limit int32, offset int32) ([]*domain.APIKey, error) {
+	arg := db.ListApiKeysParams{
+		Limit:  limit,
+		Offset: offset,
+	}
+
+	results, err := r.query.ListApiKeys(ctx, arg)
+
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]*domain.APIKey, 0, len(results))
+
+	for _, result := range results {
+		keys = append(keys, reNewAPIKey(result))
+	}
+
+	return keys, nil
+}
+
+func (r *apiKeyRepository) Revoke(ctx context.Context, id int32) error {
+
+	affected, err := r.query.RevokeApiKey(ctx, id)
+
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func reNewAPIKey(result db.ApiKey) *domain.APIKey {
+	return domain.ReNewAPIKey(
+		result.ID,
+		result.Name,
+		result.Prefix,
+		result.HashedKey,
+		result.RateLimit,
+		result.Burst,
+		result.DailyQuota,
+		result.Revoked,
+		result.CreatedAt,
+	)
+}
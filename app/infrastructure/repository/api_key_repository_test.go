@@ -0,0 +1,100 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	db "github.com/ogurilab/school-lunch-api/infrastructure/db/sqlc"
+	"github.com/ogurilab/school-lunch-api/infrastructure/db/sqlc/mocks"
+	"github.com/ogurilab/school-lunch-api/util"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCreateAPIKey(t *testing.T) {
+	result := randomAPIKeyRow()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	query := mocks.NewMockQuery(ctrl)
+
+	arg := db.CreateApiKeyParams{
+		Name:       result.Name,
+		Prefix:     result.Prefix,
+		HashedKey:  result.HashedKey,
+		RateLimit:  result.RateLimit,
+		Burst:      result.Burst,
+		DailyQuota: result.DailyQuota,
+	}
+
+	query.EXPECT().CreateApiKey(gomock.Any(), gomock.Eq(arg)).Times(1).Return(int64(result.ID), nil)
+	query.EXPECT().GetApiKey(gomock.Any(), gomock.Eq(result.ID)).Times(1).Return(result, nil)
+
+	repo := NewAPIKeyRepository(query)
+
+	key := reNewAPIKey(result)
+
+	created, err := repo.Create(context.Background(), key)
+
+	require.NoError(t, err)
+	require.Equal(t, key, created)
+}
+
+func TestRevokeAPIKey(t *testing.T) {
+	testCases := []struct {
+		name       string
+		buildStubs func(query *mocks.MockQuery)
+		check      func(t *testing.T, err error)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(query *mocks.MockQuery) {
+				query.EXPECT().RevokeApiKey(gomock.Any(), gomock.Eq(int32(1))).Times(1).Return(int64(1), nil)
+			},
+			check: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "Not Found",
+			buildStubs: func(query *mocks.MockQuery) {
+				query.EXPECT().RevokeApiKey(gomock.Any(), gomock.Eq(int32(1))).Times(1).Return(int64(0), nil)
+			},
+			check: func(t *testing.T, err error) {
+				require.ErrorIs(t, err, sql.ErrNoRows)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			query := mocks.NewMockQuery(ctrl)
+			tc.buildStubs(query)
+
+			repo := NewAPIKeyRepository(query)
+
+			err := repo.Revoke(context.Background(), 1)
+
+			tc.check(t, err)
+		})
+	}
+}
+
+func randomAPIKeyRow() db.ApiKey {
+	return db.ApiKey{
+		ID:         util.RandomInt32() + 1,
+		Name:       util.RandomString(10),
+		Prefix:     "slk_" + util.RandomString(8),
+		HashedKey:  util.HashAPIKey(util.RandomString(32)),
+		RateLimit:  60,
+		Burst:      10,
+		DailyQuota: 1000,
+		CreatedAt:  time.Now(),
+	}
+}
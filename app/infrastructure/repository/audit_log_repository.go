@@ -0,0 +1,91 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/ogurilab/school-lunch-api/domain"
+	db "github.com/ogurilab/school-lunch-api/infrastructure/db/sqlc"
+)
+
+type auditLogRepository struct {
+	query db.Query
+}
+
+func NewAuditLogRepository(query db.Query) domain.AuditLogRepository {
+	return &auditLogRepository{
+		query: query,
+	}
+}
+
+func (r *auditLogRepository) Create(ctx context.Context, log *domain.AuditLog) error {
+	arg := db.CreateAuditLogParams{
+		ActorID:       log.ActorID,
+		ActorUsername: log.ActorUsername,
+		Action:        log.Action,
+		EntityType:    log.EntityType,
+		EntityID:      log.EntityID,
+		BeforeData:    log.Before,
+		AfterData:     log.After,
+		RequestID:     log.RequestID,
+	}
+
+	if log.CityCode != nil {
+		arg.CityCode = sql.NullInt32{Int32: *log.CityCode, Valid: true}
+	}
+
+	return r.query.CreateAuditLog(ctx, arg)
+}
+
+func (r *auditLogRepository) Fetch(ctx context.Context, filter *domain.AuditLogFilter) ([]*domain.AuditLog, error) {
+	arg := db.ListAuditLogsParams{
+		EntityType: sql.NullString{String: filter.EntityType, Valid: filter.EntityType != ""},
+		EntityID:   sql.NullString{String: filter.EntityID, Valid: filter.EntityID != ""},
+		Limit:      filter.Limit,
+		Offset:     filter.Offset,
+	}
+
+	if filter.CityCode != nil {
+		arg.CityCode = sql.NullInt32{Int32: *filter.CityCode, Valid: true}
+	}
+
+	if filter.From != nil {
+		arg.CreatedFrom = sql.NullTime{Time: *filter.From, Valid: true}
+	}
+
+	if filter.To != nil {
+		arg.CreatedTo = sql.NullTime{Time: *filter.To, Valid: true}
+	}
+
+	results, err := r.query.ListAuditLogs(ctx, arg)
+
+	if err != nil {
+		return nil, err
+	}
+
+	logs := make([]*domain.AuditLog, 0, len(results))
+
+	for _, result := range results {
+		log := &domain.AuditLog{
+			ID:            result.ID,
+			ActorID:       result.ActorID,
+			ActorUsername: result.ActorUsername,
+			Action:        result.Action,
+			EntityType:    result.EntityType,
+			EntityID:      result.EntityID,
+			Before:        result.BeforeData,
+			After:         result.AfterData,
+			RequestID:     result.RequestID,
+			CreatedAt:     result.CreatedAt,
+		}
+
+		if result.CityCode.Valid {
+			cityCode := result.CityCode.Int32
+			log.CityCode = &cityCode
+		}
+
+		logs = append(logs, log)
+	}
+
+	return logs, nil
+}
@@ -0,0 +1,122 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/ogurilab/school-lunch-api/domain"
+	db "github.com/ogurilab/school-lunch-api/infrastructure/db/sqlc"
+	"github.com/ogurilab/school-lunch-api/infrastructure/db/sqlc/mocks"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCreateAuditLog(t *testing.T) {
+	cityCode := int32(23205)
+
+	testCases := []struct {
+		name     string
+		cityCode *int32
+		expected sql.NullInt32
+	}{
+		{
+			name:     "With City",
+			cityCode: &cityCode,
+			expected: sql.NullInt32{Int32: cityCode, Valid: true},
+		},
+		{
+			name:     "Without City",
+			cityCode: nil,
+			expected: sql.NullInt32{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			log := &domain.AuditLog{
+				ActorID:       1,
+				ActorUsername: "admin",
+				Action:        domain.AuditActionCreate,
+				EntityType:    domain.AuditEntityMenu,
+				EntityID:      "menu",
+				CityCode:      tc.cityCode,
+				After:         json.RawMessage(`{"id": "menu"}`),
+				RequestID:     "request",
+			}
+
+			arg := db.CreateAuditLogParams{
+				ActorID:       log.ActorID,
+				ActorUsername: log.ActorUsername,
+				Action:        log.Action,
+				EntityType:    log.EntityType,
+				EntityID:      log.EntityID,
+				CityCode:      tc.expected,
+				AfterData:     log.After,
+				RequestID:     log.RequestID,
+			}
+
+			query := mocks.NewMockQuery(ctrl)
+			query.EXPECT().CreateAuditLog(gomock.Any(), gomock.Eq(arg)).Times(1).Return(nil)
+
+			repo := NewAuditLogRepository(query)
+
+			require.NoError(t, repo.Create(context.Background(), log))
+		})
+	}
+}
+
+func TestFetchAuditLogs(t *testing.T) {
+	cityCode := int32(23205)
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	row := db.AuditLog{
+		ID:            1,
+		ActorID:       1,
+		ActorUsername: "admin",
+		Action:        domain.AuditActionUpdate,
+		EntityType:    domain.AuditEntityMenu,
+		EntityID:      "menu",
+		CityCode:      sql.NullInt32{Int32: cityCode, Valid: true},
+		BeforeData:    json.RawMessage(`{"id": "menu"}`),
+		AfterData:     json.RawMessage(`{"id": "menu"}`),
+		RequestID:     "request",
+		CreatedAt:     from,
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	arg := db.ListAuditLogsParams{
+		CityCode:    sql.NullInt32{Int32: cityCode, Valid: true},
+		EntityType:  sql.NullString{String: domain.AuditEntityMenu, Valid: true},
+		CreatedFrom: sql.NullTime{Time: from, Valid: true},
+		Limit:       10,
+		Offset:      0,
+	}
+
+	query := mocks.NewMockQuery(ctrl)
+	query.EXPECT().ListAuditLogs(gomock.Any(), gomock.Eq(arg)).Times(1).Return([]db.AuditLog{row}, nil)
+
+	repo := NewAuditLogRepository(query)
+
+	filter := &domain.AuditLogFilter{
+		CityCode:   &cityCode,
+		EntityType: domain.AuditEntityMenu,
+		From:       &from,
+		Limit:      10,
+	}
+
+	logs, err := repo.Fetch(context.Background(), filter)
+
+	require.NoError(t, err)
+	require.Len(t, logs, 1)
+	require.Equal(t, row.EntityID, logs[0].EntityID)
+	require.Equal(t, cityCode, *logs[0].CityCode)
+	require.Equal(t, row.BeforeData, logs[0].Before)
+}
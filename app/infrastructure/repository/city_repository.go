@@ -25,11 +25,12 @@ func (r *cityRepository) GetByCityCode(ctx context.Context, code int32) (*domain
 		return nil, err
 	}
 
-	city := domain.NewCity(
+	city := domain.ReNewCity(
 		result.CityCode,
 		result.CityName,
 		result.PrefectureCode,
 		result.PrefectureName,
+		result.SchoolLunchInfoAvailable,
 	)
 
 	return city, nil
@@ -52,11 +53,12 @@ func (r *cityRepository) FetchByName(ctx context.Context, limit int32, offset in
 	var cities []*domain.City
 
 	for _, city := range result {
-		cities = append(cities, domain.NewCity(
+		cities = append(cities, domain.ReNewCity(
 			city.CityCode,
 			city.CityName,
 			city.PrefectureCode,
 			city.PrefectureName,
+			city.SchoolLunchInfoAvailable,
 		))
 	}
 
@@ -78,11 +80,12 @@ func (r *cityRepository) Fetch(ctx context.Context, limit int32, offset int32) (
 	var cities []*domain.City
 
 	for _, city := range result {
-		cities = append(cities, domain.NewCity(
+		cities = append(cities, domain.ReNewCity(
 			city.CityCode,
 			city.CityName,
 			city.PrefectureCode,
 			city.PrefectureName,
+			city.SchoolLunchInfoAvailable,
 		))
 	}
 
@@ -105,13 +108,37 @@ func (r *cityRepository) FetchByPrefectureCode(ctx context.Context, limit int32,
 	var cities []*domain.City
 
 	for _, city := range result {
-		cities = append(cities, domain.NewCity(
+		cities = append(cities, domain.ReNewCity(
 			city.CityCode,
 			city.CityName,
 			city.PrefectureCode,
 			city.PrefectureName,
+			city.SchoolLunchInfoAvailable,
 		))
 	}
 
 	return cities, nil
 }
+
+func (r *cityRepository) Create(ctx context.Context, city *domain.City) error {
+	arg := db.CreateCityParams{
+		CityCode:       city.CityCode,
+		CityName:       city.CityName,
+		PrefectureCode: city.PrefectureCode,
+		PrefectureName: city.PrefectureName,
+	}
+
+	return r.query.CreateCity(ctx, arg)
+}
+
+func (r *cityRepository) Update(ctx context.Context, city *domain.City) error {
+	arg := db.UpdateCityParams{
+		CityName:                 city.CityName,
+		PrefectureCode:           city.PrefectureCode,
+		PrefectureName:           city.PrefectureName,
+		SchoolLunchInfoAvailable: city.SchoolLunchInfoAvailable,
+		CityCode:                 city.CityCode,
+	}
+
+	return r.query.UpdateCity(ctx, arg)
+}
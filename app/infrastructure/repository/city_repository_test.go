@@ -459,3 +459,106 @@ func TestFetchCityByPrefectureCode(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateCity(t *testing.T) {
+	city := domain.NewCity(util.RandomCityCode(), util.RandomString(10), util.RandomInt32(), util.RandomString(10))
+	arg := db.CreateCityParams{
+		CityCode:       city.CityCode,
+		CityName:       city.CityName,
+		PrefectureCode: city.PrefectureCode,
+		PrefectureName: city.PrefectureName,
+	}
+
+	testCases := []struct {
+		name      string
+		buildStub func(query *mocks.MockQuery)
+		check     func(t *testing.T, err error)
+	}{
+		{
+			name: "OK",
+			buildStub: func(query *mocks.MockQuery) {
+				query.EXPECT().CreateCity(gomock.Any(), gomock.Eq(arg)).Times(1).Return(nil)
+			},
+			check: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "NG",
+			buildStub: func(query *mocks.MockQuery) {
+				query.EXPECT().CreateCity(gomock.Any(), gomock.Eq(arg)).Times(1).Return(sql.ErrConnDone)
+			},
+			check: func(t *testing.T, err error) {
+				require.ErrorIs(t, err, sql.ErrConnDone)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			query := mocks.NewMockQuery(ctrl)
+			tc.buildStub(query)
+
+			repo := NewCityRepository(query)
+
+			err := repo.Create(context.Background(), city)
+
+			tc.check(t, err)
+		})
+	}
+}
+
+func TestUpdateCity(t *testing.T) {
+	city := domain.ReNewCity(util.RandomCityCode(), util.RandomString(10), util.RandomInt32(), util.RandomString(10), true)
+	arg := db.UpdateCityParams{
+		CityName:                 city.CityName,
+		PrefectureCode:           city.PrefectureCode,
+		PrefectureName:           city.PrefectureName,
+		SchoolLunchInfoAvailable: true,
+		CityCode:                 city.CityCode,
+	}
+
+	testCases := []struct {
+		name      string
+		buildStub func(query *mocks.MockQuery)
+		check     func(t *testing.T, err error)
+	}{
+		{
+			name: "OK",
+			buildStub: func(query *mocks.MockQuery) {
+				query.EXPECT().UpdateCity(gomock.Any(), gomock.Eq(arg)).Times(1).Return(nil)
+			},
+			check: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "NG",
+			buildStub: func(query *mocks.MockQuery) {
+				query.EXPECT().UpdateCity(gomock.Any(), gomock.Eq(arg)).Times(1).Return(sql.ErrConnDone)
+			},
+			check: func(t *testing.T, err error) {
+				require.ErrorIs(t, err, sql.ErrConnDone)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			query := mocks.NewMockQuery(ctrl)
+			tc.buildStub(query)
+
+			repo := NewCityRepository(query)
+
+			err := repo.Update(context.Background(), city)
+
+			tc.check(t, err)
+		})
+	}
+}
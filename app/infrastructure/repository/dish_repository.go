@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"database/sql"
 
 	"github.com/ogurilab/school-lunch-api/domain"
 	db "github.com/ogurilab/school-lunch-api/infrastructure/db/sqlc"
@@ -17,13 +18,32 @@ func NewDishRepository(query db.Query) domain.DishRepository {
 	}
 }
 
-func (r *dishRepository) Create(ctx context.Context, dish *domain.Dish, menuID string) error {
+func (r *dishRepository) Create(ctx context.Context, dish *domain.Dish, menuID string) (*domain.CreatedDishes, error) {
 
-	return r.query.CreateDishTx(ctx, dish, menuID)
+	result, err := r.query.CreateDishTx(ctx, dish, menuID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.CreatedDishes{
+		Created: result.Created,
+		Reused:  result.Reused,
+	}, nil
 }
 
-func (r *dishRepository) CreateMany(ctx context.Context, dishes []*domain.Dish, menuID string) error {
-	return r.query.CreateDishesTx(ctx, dishes, menuID)
+func (r *dishRepository) CreateMany(ctx context.Context, dishes []*domain.Dish, menuID string) (*domain.CreatedDishes, error) {
+
+	result, err := r.query.CreateDishesTx(ctx, dishes, menuID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.CreatedDishes{
+		Created: result.Created,
+		Reused:  result.Reused,
+	}, nil
 }
 
 func (r *dishRepository) GetByID(ctx context.Context, id string, limit int32, offset int32) (*domain.DishWithMenuIDs, error) {
@@ -171,3 +191,160 @@ func (r *dishRepository) Fetch(ctx context.Context, limit int32, offset int32) (
 
 	return dishes, nil
 }
+
+func (r *dishRepository) FetchByNameExcludingAllergens(ctx context.Context, search string, allergenIDs []int32, limit int32, offset int32) ([]*domain.Dish, error) {
+	arg := db.ListDishByNameExcludingAllergensParams{
+		Name:        search,
+		AllergenIds: allergenIDs,
+		Limit:       limit,
+		Offset:      offset,
+	}
+
+	results, err := r.query.ListDishByNameExcludingAllergens(ctx, arg)
+
+	if err != nil {
+		return nil, err
+	}
+
+	dishes := make([]*domain.Dish, 0, len(results))
+
+	for _, result := range results {
+		dish, err := domain.ReNewDish(
+			result.ID,
+			result.Name,
+		)
+
+		if err != nil {
+			return nil, err
+		}
+
+		dishes = append(dishes, dish)
+	}
+
+	return dishes, nil
+}
+
+func (r *dishRepository) FetchByAllergenID(ctx context.Context, allergenID int32, limit int32, offset int32) ([]*domain.Dish, error) {
+	arg := db.ListDishByAllergenIDParams{
+		AllergenID: allergenID,
+		Limit:      limit,
+		Offset:     offset,
+	}
+
+	results, err := r.query.ListDishByAllergenID(ctx, arg)
+
+	if err != nil {
+		return nil, err
+	}
+
+	dishes := make([]*domain.Dish, 0, len(results))
+
+	for _, result := range results {
+		dish, err := domain.ReNewDish(
+			result.ID,
+			result.Name,
+		)
+
+		if err != nil {
+			return nil, err
+		}
+
+		dishes = append(dishes, dish)
+	}
+
+	return dishes, nil
+}
+
+func (r *dishRepository) FetchByAllergenIDInCity(ctx context.Context, allergenID int32, limit int32, offset int32, city int32) ([]*domain.Dish, error) {
+	arg := db.ListDishByAllergenIDInCityParams{
+		AllergenID: allergenID,
+		CityCode:   city,
+		Limit:      limit,
+		Offset:     offset,
+	}
+
+	results, err := r.query.ListDishByAllergenIDInCity(ctx, arg)
+
+	if err != nil {
+		return nil, err
+	}
+
+	dishes := make([]*domain.Dish, 0, len(results))
+
+	for _, result := range results {
+		dish, err := domain.ReNewDish(
+			result.ID,
+			result.Name,
+		)
+
+		if err != nil {
+			return nil, err
+		}
+
+		dishes = append(dishes, dish)
+	}
+
+	return dishes, nil
+}
+
+func (r *dishRepository) FindByID(ctx context.Context, id string) (*domain.Dish, error) {
+
+	result, err := r.query.GetDishByID(ctx, id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.ReNewDish(
+		result.ID,
+		result.Name,
+	)
+}
+
+func (r *dishRepository) FetchCityCodes(ctx context.Context, id string) ([]int32, error) {
+	return r.query.ListCityCodeByDishID(ctx, id)
+}
+
+func (r *dishRepository) Update(ctx context.Context, dish *domain.Dish) error {
+	arg := db.UpdateDishNameParams{
+		Name: dish.Name,
+		ID:   dish.ID,
+	}
+
+	return r.query.UpdateDishName(ctx, arg)
+}
+
+func (r *dishRepository) DetachFromMenu(ctx context.Context, id string, menuID string) error {
+	arg := db.DeleteMenuDishParams{
+		MenuID: menuID,
+		DishID: id,
+	}
+
+	affected, err := r.query.DeleteMenuDish(ctx, arg)
+
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (r *dishRepository) Delete(ctx context.Context, id string, force bool) (*domain.DeletedDish, error) {
+
+	result, err := r.query.DeleteDishTx(ctx, id, force)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.DeletedDish{
+		ID:                 result.DishID,
+		MenuIDs:            result.MenuIDs,
+		AllergensRemoved:   result.AllergensRemoved,
+		IngredientsRemoved: result.IngredientsRemoved,
+	}, nil
+}
@@ -23,7 +23,7 @@ func TestCreateDish(t *testing.T) {
 		name       string
 		input      *domain.Dish
 		buildStubs func(query *mocks.MockQuery)
-		check      func(err error)
+		check      func(result *domain.CreatedDishes, err error)
 	}{
 		{
 			name:  "OK",
@@ -31,20 +31,22 @@ func TestCreateDish(t *testing.T) {
 			buildStubs: func(query *mocks.MockQuery) {
 				arg, err := domain.ReNewDish(dish.ID, dish.Name)
 				require.NoError(t, err)
-				query.EXPECT().CreateDishTx(gomock.Any(), gomock.Eq(arg), gomock.Eq(menuID)).Times(1).Return(nil)
+				query.EXPECT().CreateDishTx(gomock.Any(), gomock.Eq(arg), gomock.Eq(menuID)).Times(1).Return(db.CreateDishesTxResult{Created: []*domain.Dish{arg}}, nil)
 			},
-			check: func(err error) {
+			check: func(result *domain.CreatedDishes, err error) {
 				require.NoError(t, err)
+				require.NotNil(t, result)
 			},
 		},
 		{
 			name:  "NG",
 			input: &domain.Dish{},
 			buildStubs: func(query *mocks.MockQuery) {
-				query.EXPECT().CreateDishTx(gomock.Any(), gomock.Any(), gomock.Eq(menuID)).Times(1).Return(sql.ErrConnDone)
+				query.EXPECT().CreateDishTx(gomock.Any(), gomock.Any(), gomock.Eq(menuID)).Times(1).Return(db.CreateDishesTxResult{}, sql.ErrConnDone)
 			},
-			check: func(err error) {
+			check: func(result *domain.CreatedDishes, err error) {
 				require.Error(t, err)
+				require.Nil(t, result)
 			},
 		},
 	}
@@ -60,9 +62,9 @@ func TestCreateDish(t *testing.T) {
 
 			repo := NewDishRepository(query)
 
-			err := repo.Create(ctx, tc.input, menuID)
+			result, err := repo.Create(ctx, tc.input, menuID)
 
-			tc.check(err)
+			tc.check(result, err)
 		})
 	}
 }
@@ -80,7 +82,7 @@ func TestCreateManyDish(t *testing.T) {
 		name       string
 		input      []*domain.Dish
 		buildStubs func(query *mocks.MockQuery)
-		check      func(err error)
+		check      func(result *domain.CreatedDishes, err error)
 	}{
 		{
 			name:  "OK",
@@ -92,20 +94,22 @@ func TestCreateManyDish(t *testing.T) {
 					require.NoError(t, err)
 					arg = append(arg, d)
 				}
-				query.EXPECT().CreateDishesTx(gomock.Any(), gomock.Eq(arg), gomock.Eq(menuID)).Times(1).Return(nil)
+				query.EXPECT().CreateDishesTx(gomock.Any(), gomock.Eq(arg), gomock.Eq(menuID)).Times(1).Return(db.CreateDishesTxResult{Created: arg[:1], Reused: arg[1:]}, nil)
 			},
-			check: func(err error) {
+			check: func(result *domain.CreatedDishes, err error) {
 				require.NoError(t, err)
+				require.NotNil(t, result)
 			},
 		},
 		{
 			name:  "NG",
 			input: []*domain.Dish{},
 			buildStubs: func(query *mocks.MockQuery) {
-				query.EXPECT().CreateDishesTx(gomock.Any(), gomock.Any(), gomock.Eq(menuID)).Times(1).Return(sql.ErrConnDone)
+				query.EXPECT().CreateDishesTx(gomock.Any(), gomock.Any(), gomock.Eq(menuID)).Times(1).Return(db.CreateDishesTxResult{}, sql.ErrConnDone)
 			},
-			check: func(err error) {
+			check: func(result *domain.CreatedDishes, err error) {
 				require.Error(t, err)
+				require.Nil(t, result)
 			},
 		},
 	}
@@ -121,9 +125,9 @@ func TestCreateManyDish(t *testing.T) {
 
 			repo := NewDishRepository(query)
 
-			err := repo.CreateMany(ctx, tc.input, menuID)
+			result, err := repo.CreateMany(ctx, tc.input, menuID)
 
-			tc.check(err)
+			tc.check(result, err)
 		})
 	}
 }
@@ -413,6 +417,253 @@ func TestFetchDishByName(t *testing.T) {
 	}
 }
 
+func TestFetchDishByNameExcludingAllergens(t *testing.T) {
+
+	rows := randomListDishByNameRow(t, 10)
+	ctx := context.Background()
+
+	dishes := make([]db.ListDishByNameExcludingAllergensRow, 0, len(rows))
+	for _, r := range rows {
+		dishes = append(dishes, db.ListDishByNameExcludingAllergensRow{ID: r.ID, Name: r.Name})
+	}
+
+	type input struct {
+		search      string
+		allergenIDs []int32
+		limit       int32
+		offset      int32
+	}
+
+	testCases := []struct {
+		name       string
+		input      input
+		buildStubs func(query *mocks.MockQuery)
+		check      func(t *testing.T, result []*domain.Dish, err error)
+	}{
+		{
+			name: "OK",
+			input: input{
+				search:      "%" + dishes[0].Name + "%",
+				allergenIDs: []int32{1, 2},
+				limit:       10,
+				offset:      0,
+			},
+			buildStubs: func(query *mocks.MockQuery) {
+				arg := db.ListDishByNameExcludingAllergensParams{
+					Name:        "%" + dishes[0].Name + "%",
+					AllergenIds: []int32{1, 2},
+					Limit:       10,
+					Offset:      0,
+				}
+				query.EXPECT().ListDishByNameExcludingAllergens(gomock.Any(), gomock.Eq(arg)).Times(1).Return(dishes, nil)
+			},
+			check: func(t *testing.T, result []*domain.Dish, err error) {
+				require.NoError(t, err)
+				require.Len(t, result, len(dishes))
+
+				for i, d := range result {
+					require.Equal(t, dishes[i].ID, d.ID)
+					require.Equal(t, dishes[i].Name, d.Name)
+				}
+			},
+		},
+		{
+			name: "NG",
+			input: input{
+				search:      dishes[0].Name,
+				allergenIDs: []int32{1},
+				limit:       10,
+				offset:      0,
+			},
+			buildStubs: func(query *mocks.MockQuery) {
+				query.EXPECT().ListDishByNameExcludingAllergens(gomock.Any(), gomock.Any()).Times(1).Return([]db.ListDishByNameExcludingAllergensRow{}, sql.ErrConnDone)
+			},
+			check: func(t *testing.T, result []*domain.Dish, err error) {
+				require.Error(t, err)
+				require.Nil(t, result)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			query := mocks.NewMockQuery(ctrl)
+
+			tc.buildStubs(query)
+
+			repo := NewDishRepository(query)
+
+			result, err := repo.FetchByNameExcludingAllergens(ctx, tc.input.search, tc.input.allergenIDs, tc.input.limit, tc.input.offset)
+
+			tc.check(t, result, err)
+		})
+	}
+}
+
+func TestFetchDishByAllergenID(t *testing.T) {
+
+	rows := randomListDishByNameRow(t, 10)
+	ctx := context.Background()
+
+	dishes := make([]db.ListDishByAllergenIDRow, 0, len(rows))
+	for _, r := range rows {
+		dishes = append(dishes, db.ListDishByAllergenIDRow{ID: r.ID, Name: r.Name})
+	}
+
+	type input struct {
+		allergenID int32
+		limit      int32
+		offset     int32
+	}
+
+	testCases := []struct {
+		name       string
+		input      input
+		buildStubs func(query *mocks.MockQuery)
+		check      func(t *testing.T, result []*domain.Dish, err error)
+	}{
+		{
+			name: "OK",
+			input: input{
+				allergenID: 1,
+				limit:      10,
+				offset:     0,
+			},
+			buildStubs: func(query *mocks.MockQuery) {
+				arg := db.ListDishByAllergenIDParams{
+					AllergenID: 1,
+					Limit:      10,
+					Offset:     0,
+				}
+				query.EXPECT().ListDishByAllergenID(gomock.Any(), gomock.Eq(arg)).Times(1).Return(dishes, nil)
+			},
+			check: func(t *testing.T, result []*domain.Dish, err error) {
+				require.NoError(t, err)
+				require.Len(t, result, len(dishes))
+			},
+		},
+		{
+			name: "NG",
+			input: input{
+				allergenID: 1,
+				limit:      10,
+				offset:     0,
+			},
+			buildStubs: func(query *mocks.MockQuery) {
+				query.EXPECT().ListDishByAllergenID(gomock.Any(), gomock.Any()).Times(1).Return([]db.ListDishByAllergenIDRow{}, sql.ErrConnDone)
+			},
+			check: func(t *testing.T, result []*domain.Dish, err error) {
+				require.Error(t, err)
+				require.Nil(t, result)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			query := mocks.NewMockQuery(ctrl)
+
+			tc.buildStubs(query)
+
+			repo := NewDishRepository(query)
+
+			result, err := repo.FetchByAllergenID(ctx, tc.input.allergenID, tc.input.limit, tc.input.offset)
+
+			tc.check(t, result, err)
+		})
+	}
+}
+
+func TestFetchDishByAllergenIDInCity(t *testing.T) {
+
+	rows := randomListDishByNameRow(t, 10)
+	ctx := context.Background()
+
+	dishes := make([]db.ListDishByAllergenIDInCityRow, 0, len(rows))
+	for _, r := range rows {
+		dishes = append(dishes, db.ListDishByAllergenIDInCityRow{ID: r.ID, Name: r.Name})
+	}
+
+	type input struct {
+		allergenID int32
+		limit      int32
+		offset     int32
+		city       int32
+	}
+
+	testCases := []struct {
+		name       string
+		input      input
+		buildStubs func(query *mocks.MockQuery)
+		check      func(t *testing.T, result []*domain.Dish, err error)
+	}{
+		{
+			name: "OK",
+			input: input{
+				allergenID: 1,
+				limit:      10,
+				offset:     0,
+				city:       131001,
+			},
+			buildStubs: func(query *mocks.MockQuery) {
+				arg := db.ListDishByAllergenIDInCityParams{
+					AllergenID: 1,
+					CityCode:   131001,
+					Limit:      10,
+					Offset:     0,
+				}
+				query.EXPECT().ListDishByAllergenIDInCity(gomock.Any(), gomock.Eq(arg)).Times(1).Return(dishes, nil)
+			},
+			check: func(t *testing.T, result []*domain.Dish, err error) {
+				require.NoError(t, err)
+				require.Len(t, result, len(dishes))
+			},
+		},
+		{
+			name: "NG",
+			input: input{
+				allergenID: 1,
+				limit:      10,
+				offset:     0,
+				city:       131001,
+			},
+			buildStubs: func(query *mocks.MockQuery) {
+				query.EXPECT().ListDishByAllergenIDInCity(gomock.Any(), gomock.Any()).Times(1).Return([]db.ListDishByAllergenIDInCityRow{}, sql.ErrConnDone)
+			},
+			check: func(t *testing.T, result []*domain.Dish, err error) {
+				require.Error(t, err)
+				require.Nil(t, result)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			query := mocks.NewMockQuery(ctrl)
+
+			tc.buildStubs(query)
+
+			repo := NewDishRepository(query)
+
+			result, err := repo.FetchByAllergenIDInCity(ctx, tc.input.allergenID, tc.input.limit, tc.input.offset, tc.input.city)
+
+			tc.check(t, result, err)
+		})
+	}
+}
+
 func TestFetchDish(t *testing.T) {
 
 	dishes := randomListDishRow(t, 10)
@@ -482,6 +733,272 @@ func TestFetchDish(t *testing.T) {
 	}
 }
 
+func TestFindDishByID(t *testing.T) {
+	dish := randomDish(t)
+	result := db.GetDishByIDRow{
+		ID:   dish.ID,
+		Name: dish.Name,
+	}
+
+	testCases := []struct {
+		name       string
+		buildStubs func(query *mocks.MockQuery)
+		check      func(t *testing.T, res *domain.Dish, err error)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(query *mocks.MockQuery) {
+				query.EXPECT().GetDishByID(gomock.Any(), gomock.Eq(dish.ID)).Times(1).Return(result, nil)
+			},
+			check: func(t *testing.T, res *domain.Dish, err error) {
+				require.NoError(t, err)
+				require.Equal(t, dish, res)
+			},
+		},
+		{
+			name: "Not Found",
+			buildStubs: func(query *mocks.MockQuery) {
+				query.EXPECT().GetDishByID(gomock.Any(), gomock.Eq(dish.ID)).Times(1).Return(db.GetDishByIDRow{}, sql.ErrNoRows)
+			},
+			check: func(t *testing.T, res *domain.Dish, err error) {
+				require.ErrorIs(t, err, sql.ErrNoRows)
+				require.Nil(t, res)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			query := mocks.NewMockQuery(ctrl)
+			tc.buildStubs(query)
+
+			repo := NewDishRepository(query)
+
+			res, err := repo.FindByID(context.Background(), dish.ID)
+
+			tc.check(t, res, err)
+		})
+	}
+}
+
+func TestFetchDishCityCodes(t *testing.T) {
+	dish := randomDish(t)
+	cityCodes := []int32{util.RandomCityCode(), util.RandomCityCode()}
+
+	testCases := []struct {
+		name       string
+		buildStubs func(query *mocks.MockQuery)
+		check      func(t *testing.T, res []int32, err error)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(query *mocks.MockQuery) {
+				query.EXPECT().ListCityCodeByDishID(gomock.Any(), gomock.Eq(dish.ID)).Times(1).Return(cityCodes, nil)
+			},
+			check: func(t *testing.T, res []int32, err error) {
+				require.NoError(t, err)
+				require.Equal(t, cityCodes, res)
+			},
+		},
+		{
+			name: "Internal Server Error",
+			buildStubs: func(query *mocks.MockQuery) {
+				query.EXPECT().ListCityCodeByDishID(gomock.Any(), gomock.Eq(dish.ID)).Times(1).Return(nil, sql.ErrConnDone)
+			},
+			check: func(t *testing.T, res []int32, err error) {
+				require.ErrorIs(t, err, sql.ErrConnDone)
+				require.Nil(t, res)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			query := mocks.NewMockQuery(ctrl)
+			tc.buildStubs(query)
+
+			repo := NewDishRepository(query)
+
+			res, err := repo.FetchCityCodes(context.Background(), dish.ID)
+
+			tc.check(t, res, err)
+		})
+	}
+}
+
+func TestUpdateDish(t *testing.T) {
+	dish := randomDish(t)
+	arg := db.UpdateDishNameParams{
+		Name: dish.Name,
+		ID:   dish.ID,
+	}
+
+	testCases := []struct {
+		name       string
+		buildStubs func(query *mocks.MockQuery)
+		check      func(t *testing.T, err error)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(query *mocks.MockQuery) {
+				query.EXPECT().UpdateDishName(gomock.Any(), gomock.Eq(arg)).Times(1).Return(nil)
+			},
+			check: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "NG",
+			buildStubs: func(query *mocks.MockQuery) {
+				query.EXPECT().UpdateDishName(gomock.Any(), gomock.Eq(arg)).Times(1).Return(sql.ErrConnDone)
+			},
+			check: func(t *testing.T, err error) {
+				require.ErrorIs(t, err, sql.ErrConnDone)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			query := mocks.NewMockQuery(ctrl)
+			tc.buildStubs(query)
+
+			repo := NewDishRepository(query)
+
+			err := repo.Update(context.Background(), dish)
+
+			tc.check(t, err)
+		})
+	}
+}
+
+func TestDetachDishFromMenu(t *testing.T) {
+	dish := randomDish(t)
+	menu := randomMenu(t)
+	arg := db.DeleteMenuDishParams{
+		MenuID: menu.ID,
+		DishID: dish.ID,
+	}
+
+	testCases := []struct {
+		name       string
+		buildStubs func(query *mocks.MockQuery)
+		check      func(t *testing.T, err error)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(query *mocks.MockQuery) {
+				query.EXPECT().DeleteMenuDish(gomock.Any(), gomock.Eq(arg)).Times(1).Return(int64(1), nil)
+			},
+			check: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "Not Found",
+			buildStubs: func(query *mocks.MockQuery) {
+				query.EXPECT().DeleteMenuDish(gomock.Any(), gomock.Eq(arg)).Times(1).Return(int64(0), nil)
+			},
+			check: func(t *testing.T, err error) {
+				require.ErrorIs(t, err, sql.ErrNoRows)
+			},
+		},
+		{
+			name: "NG",
+			buildStubs: func(query *mocks.MockQuery) {
+				query.EXPECT().DeleteMenuDish(gomock.Any(), gomock.Eq(arg)).Times(1).Return(int64(0), sql.ErrConnDone)
+			},
+			check: func(t *testing.T, err error) {
+				require.ErrorIs(t, err, sql.ErrConnDone)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			query := mocks.NewMockQuery(ctrl)
+			tc.buildStubs(query)
+
+			repo := NewDishRepository(query)
+
+			err := repo.DetachFromMenu(context.Background(), dish.ID, menu.ID)
+
+			tc.check(t, err)
+		})
+	}
+}
+
+func TestDeleteDish(t *testing.T) {
+	dish := randomDish(t)
+	result := db.DeleteDishTxResult{
+		DishID:             dish.ID,
+		MenuIDs:            []string{util.NewUlid()},
+		AllergensRemoved:   2,
+		IngredientsRemoved: 3,
+	}
+
+	testCases := []struct {
+		name       string
+		force      bool
+		buildStubs func(query *mocks.MockQuery)
+		check      func(t *testing.T, deleted *domain.DeletedDish, err error)
+	}{
+		{
+			name:  "OK",
+			force: true,
+			buildStubs: func(query *mocks.MockQuery) {
+				query.EXPECT().DeleteDishTx(gomock.Any(), gomock.Eq(dish.ID), gomock.Eq(true)).Times(1).Return(result, nil)
+			},
+			check: func(t *testing.T, deleted *domain.DeletedDish, err error) {
+				require.NoError(t, err)
+				require.Equal(t, result.DishID, deleted.ID)
+				require.Equal(t, result.MenuIDs, deleted.MenuIDs)
+				require.Equal(t, result.AllergensRemoved, deleted.AllergensRemoved)
+				require.Equal(t, result.IngredientsRemoved, deleted.IngredientsRemoved)
+			},
+		},
+		{
+			name:  "In Use",
+			force: false,
+			buildStubs: func(query *mocks.MockQuery) {
+				query.EXPECT().DeleteDishTx(gomock.Any(), gomock.Eq(dish.ID), gomock.Eq(false)).Times(1).Return(db.DeleteDishTxResult{}, domain.ErrDishInUse)
+			},
+			check: func(t *testing.T, deleted *domain.DeletedDish, err error) {
+				require.ErrorIs(t, err, domain.ErrDishInUse)
+				require.Nil(t, deleted)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			query := mocks.NewMockQuery(ctrl)
+			tc.buildStubs(query)
+
+			repo := NewDishRepository(query)
+
+			deleted, err := repo.Delete(context.Background(), dish.ID, tc.force)
+
+			tc.check(t, deleted, err)
+		})
+	}
+}
+
 func randomDishResult(t *testing.T) db.Dish {
 	dish := randomDish(t)
 
@@ -0,0 +1,157 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/ogurilab/school-lunch-api/domain"
+	db "github.com/ogurilab/school-lunch-api/infrastructure/db/sqlc"
+)
+
+type externalDataSourceRepository struct {
+	query db.Query
+}
+
+func NewExternalDataSourceRepository(query db.Query) domain.ExternalDataSourceRepository {
+	return &externalDataSourceRepository{
+		query: query,
+	}
+}
+
+func (r *externalDataSourceRepository) Create(ctx context.Context, source *domain.ExternalDataSource) (*domain.ExternalDataSource, error) {
+	arg := db.CreateExternalDataSourceParams{
+		CityCode:    source.CityCode,
+		DatasetID:   source.DatasetID,
+		Year:        source.Year,
+		Status:      source.Status,
+		Category:    source.Category,
+		Description: source.Description,
+	}
+
+	id, err := r.query.CreateExternalDataSource(ctx, arg)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return r.GetByID(ctx, int32(id))
+}
+
+func (r *externalDataSourceRepository) GetByID(ctx context.Context, id int32) (*domain.ExternalDataSource, error) {
+
+	result, err := r.query.GetExternalDataSource(ctx, id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return reNewExternalDataSource(result), nil
+}
+
+func (r *externalDataSourceRepository) Fetch(ctx context.Context, limit int32, offset int32) ([]*domain.ExternalDataSource, error) {
+	arg := db.ListExternalDataSourcesParams{
+		Limit:  limit,
+		Offset: offset,
+	}
+
+	results, err := r.query.ListExternalDataSources(ctx, arg)
+
+	if err != nil {
+		return nil, err
+	}
+
+	sources := make([]*domain.ExternalDataSource, 0, len(results))
+
+	for _, result := range results {
+		sources = append(sources, reNewExternalDataSource(result))
+	}
+
+	return sources, nil
+}
+
+func (r *externalDataSourceRepository) FetchByStatus(ctx context.Context, status string) ([]*domain.ExternalDataSource, error) {
+
+	results, err := r.query.ListExternalDataSourcesByStatus(ctx, status)
+
+	if err != nil {
+		return nil, err
+	}
+
+	sources := make([]*domain.ExternalDataSource, 0, len(results))
+
+	for _, result := range results {
+		sources = append(sources, reNewExternalDataSource(result))
+	}
+
+	return sources, nil
+}
+
+func (r *externalDataSourceRepository) Update(ctx context.Context, source *domain.ExternalDataSource) error {
+	arg := db.UpdateExternalDataSourceParams{
+		CityCode:    source.CityCode,
+		DatasetID:   source.DatasetID,
+		Year:        source.Year,
+		Status:      source.Status,
+		Category:    source.Category,
+		Description: source.Description,
+		SourceID:    source.ID,
+	}
+
+	return r.query.UpdateExternalDataSource(ctx, arg)
+}
+
+func (r *externalDataSourceRepository) UpdateStatus(ctx context.Context, id int32, status string, description sql.NullString) error {
+	arg := db.UpdateExternalDataSourceStatusParams{
+		Status:      status,
+		Description: description,
+		SourceID:    id,
+	}
+
+	return r.query.UpdateExternalDataSourceStatus(ctx, arg)
+}
+
+// StartSync はステータスを Updating に変更する
+// 既に Updating の場合は、別の同期処理が実行中のため ErrDataSourceUpdating を返す
+// ただし1時間以上 Updating のままのデータソースは、同期処理が中断されたものとして開始し直す
+func (r *externalDataSourceRepository) StartSync(ctx context.Context, id int32) error {
+
+	affected, err := r.query.StartExternalDataSourceSync(ctx, id)
+
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return domain.ErrDataSourceUpdating
+	}
+
+	return nil
+}
+
+func (r *externalDataSourceRepository) Delete(ctx context.Context, id int32) error {
+
+	affected, err := r.query.DeleteExternalDataSource(ctx, id)
+
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func reNewExternalDataSource(result db.ExternalDataSource) *domain.ExternalDataSource {
+	return domain.ReNewExternalDataSource(
+		result.SourceID,
+		result.CityCode,
+		result.DatasetID,
+		result.Year,
+		result.UpdatedAt,
+		result.Status,
+		result.Category,
+		result.Description,
+	)
+}
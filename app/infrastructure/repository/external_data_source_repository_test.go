@@ -0,0 +1,215 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/ogurilab/school-lunch-api/domain"
+	db "github.com/ogurilab/school-lunch-api/infrastructure/db/sqlc"
+	"github.com/ogurilab/school-lunch-api/infrastructure/db/sqlc/mocks"
+	"github.com/ogurilab/school-lunch-api/util"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCreateExternalDataSource(t *testing.T) {
+	ctx := context.Background()
+	result := randomExternalDataSourceResult()
+
+	source := domain.NewExternalDataSource(
+		result.CityCode,
+		result.DatasetID,
+		result.Year,
+		result.Status,
+		result.Category,
+		result.Description,
+	)
+
+	testCases := []struct {
+		name      string
+		buildStub func(query *mocks.MockQuery)
+		check     func(t *testing.T, created *domain.ExternalDataSource, err error)
+	}{
+		{
+			name: "OK",
+			buildStub: func(query *mocks.MockQuery) {
+				arg := db.CreateExternalDataSourceParams{
+					CityCode:    result.CityCode,
+					DatasetID:   result.DatasetID,
+					Year:        result.Year,
+					Status:      result.Status,
+					Category:    result.Category,
+					Description: result.Description,
+				}
+				query.EXPECT().CreateExternalDataSource(gomock.Any(), gomock.Eq(arg)).Times(1).Return(int64(result.SourceID), nil)
+				query.EXPECT().GetExternalDataSource(gomock.Any(), gomock.Eq(result.SourceID)).Times(1).Return(result, nil)
+			},
+			check: func(t *testing.T, created *domain.ExternalDataSource, err error) {
+				require.NoError(t, err)
+				require.Equal(t, result.SourceID, created.ID)
+				require.Equal(t, result.DatasetID, created.DatasetID)
+				require.Equal(t, result.UpdatedAt, created.UpdatedAt)
+			},
+		},
+		{
+			name: "Internal Server Error",
+			buildStub: func(query *mocks.MockQuery) {
+				query.EXPECT().CreateExternalDataSource(gomock.Any(), gomock.Any()).Times(1).Return(int64(0), sql.ErrConnDone)
+				query.EXPECT().GetExternalDataSource(gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, created *domain.ExternalDataSource, err error) {
+				require.ErrorIs(t, err, sql.ErrConnDone)
+				require.Nil(t, created)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			query := mocks.NewMockQuery(ctrl)
+			tc.buildStub(query)
+
+			repo := NewExternalDataSourceRepository(query)
+
+			created, err := repo.Create(ctx, source)
+
+			tc.check(t, created, err)
+		})
+	}
+}
+
+func TestFetchExternalDataSourcesByStatus(t *testing.T) {
+	ctx := context.Background()
+	results := []db.ExternalDataSource{randomExternalDataSourceResult(), randomExternalDataSourceResult()}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	query := mocks.NewMockQuery(ctrl)
+	query.EXPECT().ListExternalDataSourcesByStatus(gomock.Any(), gomock.Eq(domain.DataSourceStatusActive)).Times(1).Return(results, nil)
+
+	repo := NewExternalDataSourceRepository(query)
+
+	sources, err := repo.FetchByStatus(ctx, domain.DataSourceStatusActive)
+
+	require.NoError(t, err)
+	require.Len(t, sources, len(results))
+
+	for i, source := range sources {
+		require.Equal(t, results[i].SourceID, source.ID)
+		require.Equal(t, results[i].Status, source.Status)
+	}
+}
+
+func TestStartExternalDataSourceSync(t *testing.T) {
+	ctx := context.Background()
+	id := util.RandomInt32()
+
+	testCases := []struct {
+		name      string
+		buildStub func(query *mocks.MockQuery)
+		check     func(t *testing.T, err error)
+	}{
+		{
+			name: "OK",
+			buildStub: func(query *mocks.MockQuery) {
+				query.EXPECT().StartExternalDataSourceSync(gomock.Any(), gomock.Eq(id)).Times(1).Return(int64(1), nil)
+			},
+			check: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "Already Updating",
+			buildStub: func(query *mocks.MockQuery) {
+				query.EXPECT().StartExternalDataSourceSync(gomock.Any(), gomock.Eq(id)).Times(1).Return(int64(0), nil)
+			},
+			check: func(t *testing.T, err error) {
+				require.ErrorIs(t, err, domain.ErrDataSourceUpdating)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			query := mocks.NewMockQuery(ctrl)
+			tc.buildStub(query)
+
+			repo := NewExternalDataSourceRepository(query)
+
+			err := repo.StartSync(ctx, id)
+
+			tc.check(t, err)
+		})
+	}
+}
+
+func TestDeleteExternalDataSource(t *testing.T) {
+	ctx := context.Background()
+	id := util.RandomInt32()
+
+	testCases := []struct {
+		name      string
+		buildStub func(query *mocks.MockQuery)
+		check     func(t *testing.T, err error)
+	}{
+		{
+			name: "OK",
+			buildStub: func(query *mocks.MockQuery) {
+				query.EXPECT().DeleteExternalDataSource(gomock.Any(), gomock.Eq(id)).Times(1).Return(int64(1), nil)
+			},
+			check: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "Not Found",
+			buildStub: func(query *mocks.MockQuery) {
+				query.EXPECT().DeleteExternalDataSource(gomock.Any(), gomock.Eq(id)).Times(1).Return(int64(0), nil)
+			},
+			check: func(t *testing.T, err error) {
+				require.ErrorIs(t, err, sql.ErrNoRows)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			query := mocks.NewMockQuery(ctrl)
+			tc.buildStub(query)
+
+			repo := NewExternalDataSourceRepository(query)
+
+			err := repo.Delete(ctx, id)
+
+			tc.check(t, err)
+		})
+	}
+}
+
+func randomExternalDataSourceResult() db.ExternalDataSource {
+	return db.ExternalDataSource{
+		SourceID:  util.RandomInt32() + 1,
+		CityCode:  util.RandomCityCode(),
+		DatasetID: util.RandomURL(),
+		Year:      int32(util.RandomInt(2000, 2100)),
+		UpdatedAt: time.Now(),
+		Status:    domain.DataSourceStatusActive,
+		Category:  domain.DataSourceCategoryMenu,
+		Description: sql.NullString{
+			String: util.RandomString(20),
+			Valid:  true,
+		},
+	}
+}
@@ -0,0 +1,168 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/ogurilab/school-lunch-api/domain"
+	db "github.com/ogurilab/school-lunch-api/infrastructure/db/sqlc"
+	"github.com/ogurilab/school-lunch-api/util"
+)
+
+type ingredientRepository struct {
+	query db.Query
+}
+
+func NewIngredientRepository(query db.Query) domain.IngredientRepository {
+	return &ingredientRepository{
+		query: query,
+	}
+}
+
+func (r *ingredientRepository) Create(ctx context.Context, name string) (*domain.Ingredient, error) {
+
+	id, err := r.query.CreateIngredient(ctx, name)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.ReNewIngredient(int32(id), name), nil
+}
+
+func (r *ingredientRepository) Fetch(ctx context.Context, limit int32, offset int32) ([]*domain.Ingredient, error) {
+	arg := db.ListIngredientsParams{
+		Limit:  limit,
+		Offset: offset,
+	}
+
+	results, err := r.query.ListIngredients(ctx, arg)
+
+	if err != nil {
+		return nil, err
+	}
+
+	ingredients := make([]*domain.Ingredient, 0, len(results))
+
+	for _, result := range results {
+		ingredients = append(ingredients, domain.ReNewIngredient(result.ID, result.Name))
+	}
+
+	return ingredients, nil
+}
+
+func (r *ingredientRepository) FetchByIDs(ctx context.Context, ids []int32) ([]*domain.Ingredient, error) {
+
+	results, err := r.query.ListIngredientsByIDs(ctx, ids)
+
+	if err != nil {
+		return nil, err
+	}
+
+	ingredients := make([]*domain.Ingredient, 0, len(results))
+
+	for _, result := range results {
+		ingredients = append(ingredients, domain.ReNewIngredient(result.ID, result.Name))
+	}
+
+	return ingredients, nil
+}
+
+func (r *ingredientRepository) GetByID(ctx context.Context, id int32) (*domain.Ingredient, error) {
+
+	result, err := r.query.GetIngredient(ctx, id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.ReNewIngredient(result.ID, result.Name), nil
+}
+
+func (r *ingredientRepository) Update(ctx context.Context, ingredient *domain.Ingredient) error {
+	arg := db.UpdateIngredientParams{
+		Name: ingredient.Name,
+		ID:   ingredient.ID,
+	}
+
+	return r.query.UpdateIngredient(ctx, arg)
+}
+
+func (r *ingredientRepository) Delete(ctx context.Context, id int32) error {
+
+	return r.query.DeleteIngredientTx(ctx, id)
+}
+
+func (r *ingredientRepository) AttachToDish(ctx context.Context, dishID string, ingredients []*domain.DishIngredient) error {
+
+	return r.query.UpsertDishesIngredientsTx(ctx, dishID, ingredients)
+}
+
+func (r *ingredientRepository) DetachFromDish(ctx context.Context, dishID string, ingredientID int32) error {
+	arg := db.DeleteDishesIngredientsParams{
+		DishID:       dishID,
+		IngredientID: ingredientID,
+	}
+
+	affected, err := r.query.DeleteDishesIngredients(ctx, arg)
+
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (r *ingredientRepository) FetchByDishID(ctx context.Context, dishID string) ([]*domain.DishIngredient, error) {
+
+	results, err := r.query.ListIngredientByDishID(ctx, dishID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	ingredients := make([]*domain.DishIngredient, 0, len(results))
+
+	for _, result := range results {
+		ingredient := domain.ReNewDishIngredient(
+			result.ID,
+			result.Name,
+			result.DishID,
+			util.NullFloat64ToPointer(result.Quantity),
+			util.NullInt32ToPointer(result.OriginPrefectureCode),
+		)
+
+		ingredients = append(ingredients, ingredient)
+	}
+
+	return ingredients, nil
+}
+
+func (r *ingredientRepository) FetchInDish(ctx context.Context, dishIDs []string) ([]*domain.DishIngredient, error) {
+
+	results, err := r.query.ListIngredientInDish(ctx, dishIDs)
+
+	if err != nil {
+		return nil, err
+	}
+
+	ingredients := make([]*domain.DishIngredient, 0, len(results))
+
+	for _, result := range results {
+		ingredient := domain.ReNewDishIngredient(
+			result.ID,
+			result.Name,
+			result.DishID,
+			util.NullFloat64ToPointer(result.Quantity),
+			util.NullInt32ToPointer(result.OriginPrefectureCode),
+		)
+
+		ingredients = append(ingredients, ingredient)
+	}
+
+	return ingredients, nil
+}
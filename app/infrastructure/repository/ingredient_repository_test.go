@@ -0,0 +1,470 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/ogurilab/school-lunch-api/domain"
+	db "github.com/ogurilab/school-lunch-api/infrastructure/db/sqlc"
+	"github.com/ogurilab/school-lunch-api/infrastructure/db/sqlc/mocks"
+	"github.com/ogurilab/school-lunch-api/util"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCreateIngredient(t *testing.T) {
+	name := util.RandomString(50)
+	id := util.RandomInt32()
+
+	testCases := []struct {
+		name       string
+		buildStubs func(query *mocks.MockQuery)
+		check      func(t *testing.T, ingredient *domain.Ingredient, err error)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(query *mocks.MockQuery) {
+				query.EXPECT().CreateIngredient(gomock.Any(), gomock.Eq(name)).Times(1).Return(int64(id), nil)
+			},
+			check: func(t *testing.T, ingredient *domain.Ingredient, err error) {
+				require.NoError(t, err)
+				require.Equal(t, id, ingredient.ID)
+				require.Equal(t, name, ingredient.Name)
+			},
+		},
+		{
+			name: "NG",
+			buildStubs: func(query *mocks.MockQuery) {
+				query.EXPECT().CreateIngredient(gomock.Any(), gomock.Eq(name)).Times(1).Return(int64(0), sql.ErrConnDone)
+			},
+			check: func(t *testing.T, ingredient *domain.Ingredient, err error) {
+				require.ErrorIs(t, err, sql.ErrConnDone)
+				require.Nil(t, ingredient)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			query := mocks.NewMockQuery(ctrl)
+			tc.buildStubs(query)
+
+			repo := NewIngredientRepository(query)
+
+			ingredient, err := repo.Create(context.Background(), name)
+
+			tc.check(t, ingredient, err)
+		})
+	}
+}
+
+func TestFetchIngredients(t *testing.T) {
+	arg := db.ListIngredientsParams{
+		Limit:  10,
+		Offset: 0,
+	}
+
+	results := []db.ListIngredientsRow{
+		{ID: util.RandomInt32(), Name: util.RandomString(50)},
+		{ID: util.RandomInt32(), Name: util.RandomString(50)},
+	}
+
+	testCases := []struct {
+		name       string
+		buildStubs func(query *mocks.MockQuery)
+		check      func(t *testing.T, ingredients []*domain.Ingredient, err error)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(query *mocks.MockQuery) {
+				query.EXPECT().ListIngredients(gomock.Any(), gomock.Eq(arg)).Times(1).Return(results, nil)
+			},
+			check: func(t *testing.T, ingredients []*domain.Ingredient, err error) {
+				require.NoError(t, err)
+				require.Len(t, ingredients, len(results))
+				require.Equal(t, results[0].Name, ingredients[0].Name)
+			},
+		},
+		{
+			name: "NG",
+			buildStubs: func(query *mocks.MockQuery) {
+				query.EXPECT().ListIngredients(gomock.Any(), gomock.Eq(arg)).Times(1).Return(nil, sql.ErrConnDone)
+			},
+			check: func(t *testing.T, ingredients []*domain.Ingredient, err error) {
+				require.Error(t, err)
+				require.Nil(t, ingredients)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			query := mocks.NewMockQuery(ctrl)
+			tc.buildStubs(query)
+
+			repo := NewIngredientRepository(query)
+
+			ingredients, err := repo.Fetch(context.Background(), arg.Limit, arg.Offset)
+
+			tc.check(t, ingredients, err)
+		})
+	}
+}
+
+func TestGetIngredientByID(t *testing.T) {
+	result := db.GetIngredientRow{
+		ID:   util.RandomInt32(),
+		Name: util.RandomString(50),
+	}
+
+	testCases := []struct {
+		name       string
+		buildStubs func(query *mocks.MockQuery)
+		check      func(t *testing.T, ingredient *domain.Ingredient, err error)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(query *mocks.MockQuery) {
+				query.EXPECT().GetIngredient(gomock.Any(), gomock.Eq(result.ID)).Times(1).Return(result, nil)
+			},
+			check: func(t *testing.T, ingredient *domain.Ingredient, err error) {
+				require.NoError(t, err)
+				require.Equal(t, result.ID, ingredient.ID)
+				require.Equal(t, result.Name, ingredient.Name)
+			},
+		},
+		{
+			name: "Not Found",
+			buildStubs: func(query *mocks.MockQuery) {
+				query.EXPECT().GetIngredient(gomock.Any(), gomock.Eq(result.ID)).Times(1).Return(db.GetIngredientRow{}, sql.ErrNoRows)
+			},
+			check: func(t *testing.T, ingredient *domain.Ingredient, err error) {
+				require.ErrorIs(t, err, sql.ErrNoRows)
+				require.Nil(t, ingredient)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			query := mocks.NewMockQuery(ctrl)
+			tc.buildStubs(query)
+
+			repo := NewIngredientRepository(query)
+
+			ingredient, err := repo.GetByID(context.Background(), result.ID)
+
+			tc.check(t, ingredient, err)
+		})
+	}
+}
+
+func TestUpdateIngredient(t *testing.T) {
+	ingredient := domain.ReNewIngredient(util.RandomInt32(), util.RandomString(50))
+	arg := db.UpdateIngredientParams{
+		Name: ingredient.Name,
+		ID:   ingredient.ID,
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	query := mocks.NewMockQuery(ctrl)
+	query.EXPECT().UpdateIngredient(gomock.Any(), gomock.Eq(arg)).Times(1).Return(nil)
+
+	repo := NewIngredientRepository(query)
+
+	require.NoError(t, repo.Update(context.Background(), ingredient))
+}
+
+func TestDeleteIngredient(t *testing.T) {
+	id := util.RandomInt32()
+
+	testCases := []struct {
+		name       string
+		buildStubs func(query *mocks.MockQuery)
+		check      func(t *testing.T, err error)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(query *mocks.MockQuery) {
+				query.EXPECT().DeleteIngredientTx(gomock.Any(), gomock.Eq(id)).Times(1).Return(nil)
+			},
+			check: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "Not Found",
+			buildStubs: func(query *mocks.MockQuery) {
+				query.EXPECT().DeleteIngredientTx(gomock.Any(), gomock.Eq(id)).Times(1).Return(sql.ErrNoRows)
+			},
+			check: func(t *testing.T, err error) {
+				require.ErrorIs(t, err, sql.ErrNoRows)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			query := mocks.NewMockQuery(ctrl)
+			tc.buildStubs(query)
+
+			repo := NewIngredientRepository(query)
+
+			err := repo.Delete(context.Background(), id)
+
+			tc.check(t, err)
+		})
+	}
+}
+
+func TestAttachIngredientsToDish(t *testing.T) {
+	dish := randomDish(t)
+	quantity := 30.0
+	origin := int32(23)
+	ingredients := []*domain.DishIngredient{
+		domain.ReNewDishIngredient(util.RandomInt32(), "", dish.ID, &quantity, &origin),
+		domain.ReNewDishIngredient(util.RandomInt32(), "", dish.ID, nil, nil),
+	}
+
+	testCases := []struct {
+		name       string
+		buildStubs func(query *mocks.MockQuery)
+		check      func(t *testing.T, err error)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(query *mocks.MockQuery) {
+				query.EXPECT().UpsertDishesIngredientsTx(gomock.Any(), gomock.Eq(dish.ID), gomock.Eq(ingredients)).Times(1).Return(nil)
+			},
+			check: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "NG",
+			buildStubs: func(query *mocks.MockQuery) {
+				query.EXPECT().UpsertDishesIngredientsTx(gomock.Any(), gomock.Eq(dish.ID), gomock.Eq(ingredients)).Times(1).Return(sql.ErrConnDone)
+			},
+			check: func(t *testing.T, err error) {
+				require.Error(t, err)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			query := mocks.NewMockQuery(ctrl)
+			tc.buildStubs(query)
+
+			repo := NewIngredientRepository(query)
+
+			err := repo.AttachToDish(context.Background(), dish.ID, ingredients)
+
+			tc.check(t, err)
+		})
+	}
+}
+
+func TestDetachIngredientFromDish(t *testing.T) {
+	dish := randomDish(t)
+	arg := db.DeleteDishesIngredientsParams{
+		DishID:       dish.ID,
+		IngredientID: util.RandomInt32(),
+	}
+
+	testCases := []struct {
+		name       string
+		buildStubs func(query *mocks.MockQuery)
+		check      func(t *testing.T, err error)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(query *mocks.MockQuery) {
+				query.EXPECT().DeleteDishesIngredients(gomock.Any(), gomock.Eq(arg)).Times(1).Return(int64(1), nil)
+			},
+			check: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "Not Found",
+			buildStubs: func(query *mocks.MockQuery) {
+				query.EXPECT().DeleteDishesIngredients(gomock.Any(), gomock.Eq(arg)).Times(1).Return(int64(0), nil)
+			},
+			check: func(t *testing.T, err error) {
+				require.ErrorIs(t, err, sql.ErrNoRows)
+			},
+		},
+		{
+			name: "NG",
+			buildStubs: func(query *mocks.MockQuery) {
+				query.EXPECT().DeleteDishesIngredients(gomock.Any(), gomock.Eq(arg)).Times(1).Return(int64(0), sql.ErrConnDone)
+			},
+			check: func(t *testing.T, err error) {
+				require.ErrorIs(t, err, sql.ErrConnDone)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			query := mocks.NewMockQuery(ctrl)
+			tc.buildStubs(query)
+
+			repo := NewIngredientRepository(query)
+
+			err := repo.DetachFromDish(context.Background(), arg.DishID, arg.IngredientID)
+
+			tc.check(t, err)
+		})
+	}
+}
+
+func TestFetchIngredientByDishID(t *testing.T) {
+	dish := randomDish(t)
+	results := []db.ListIngredientByDishIDRow{
+		{
+			ID:                   util.RandomInt32(),
+			Name:                 "豚肉",
+			DishID:               dish.ID,
+			Quantity:             sql.NullFloat64{Float64: 40, Valid: true},
+			OriginPrefectureCode: sql.NullInt32{Int32: 46, Valid: true},
+		},
+		{
+			ID:     util.RandomInt32(),
+			Name:   "玉ねぎ",
+			DishID: dish.ID,
+		},
+		{
+			ID:                   util.RandomInt32(),
+			Name:                 "にんじん",
+			DishID:               dish.ID,
+			OriginPrefectureCode: sql.NullInt32{Int32: 99, Valid: true},
+		},
+	}
+
+	testCases := []struct {
+		name       string
+		buildStubs func(query *mocks.MockQuery)
+		check      func(t *testing.T, ingredients []*domain.DishIngredient, err error)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(query *mocks.MockQuery) {
+				query.EXPECT().ListIngredientByDishID(gomock.Any(), gomock.Eq(dish.ID)).Times(1).Return(results, nil)
+			},
+			check: func(t *testing.T, ingredients []*domain.DishIngredient, err error) {
+				require.NoError(t, err)
+				require.Len(t, ingredients, len(results))
+
+				require.Equal(t, 40.0, *ingredients[0].Quantity)
+				require.Equal(t, &domain.Prefecture{Code: 46, Name: "鹿児島県"}, ingredients[0].Origin)
+
+				require.Nil(t, ingredients[1].Quantity)
+				require.Nil(t, ingredients[1].Origin)
+
+				// 不明な都道府県コードは産地なしとして扱う
+				require.Nil(t, ingredients[2].Origin)
+			},
+		},
+		{
+			name: "NG",
+			buildStubs: func(query *mocks.MockQuery) {
+				query.EXPECT().ListIngredientByDishID(gomock.Any(), gomock.Eq(dish.ID)).Times(1).Return(nil, sql.ErrConnDone)
+			},
+			check: func(t *testing.T, ingredients []*domain.DishIngredient, err error) {
+				require.Error(t, err)
+				require.Nil(t, ingredients)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			query := mocks.NewMockQuery(ctrl)
+			tc.buildStubs(query)
+
+			repo := NewIngredientRepository(query)
+
+			ingredients, err := repo.FetchByDishID(context.Background(), dish.ID)
+
+			tc.check(t, ingredients, err)
+		})
+	}
+}
+
+func TestFetchIngredientInDish(t *testing.T) {
+	dishIDs := []string{randomDish(t).ID, randomDish(t).ID}
+	results := []db.ListIngredientInDishRow{
+		{ID: util.RandomInt32(), Name: util.RandomString(50), DishID: dishIDs[0]},
+		{ID: util.RandomInt32(), Name: util.RandomString(50), DishID: dishIDs[1]},
+	}
+
+	testCases := []struct {
+		name       string
+		buildStubs func(query *mocks.MockQuery)
+		check      func(t *testing.T, ingredients []*domain.DishIngredient, err error)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(query *mocks.MockQuery) {
+				query.EXPECT().ListIngredientInDish(gomock.Any(), gomock.Eq(dishIDs)).Times(1).Return(results, nil)
+			},
+			check: func(t *testing.T, ingredients []*domain.DishIngredient, err error) {
+				require.NoError(t, err)
+				require.Len(t, ingredients, len(results))
+				require.Equal(t, dishIDs[1], ingredients[1].DishID)
+			},
+		},
+		{
+			name: "NG",
+			buildStubs: func(query *mocks.MockQuery) {
+				query.EXPECT().ListIngredientInDish(gomock.Any(), gomock.Eq(dishIDs)).Times(1).Return(nil, sql.ErrConnDone)
+			},
+			check: func(t *testing.T, ingredients []*domain.DishIngredient, err error) {
+				require.Error(t, err)
+				require.Nil(t, ingredients)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			query := mocks.NewMockQuery(ctrl)
+			tc.buildStubs(query)
+
+			repo := NewIngredientRepository(query)
+
+			ingredients, err := repo.FetchInDish(context.Background(), dishIDs)
+
+			tc.check(t, ingredients, err)
+		})
+	}
+}
@@ -36,7 +36,7 @@ func TestCreate(t *testing.T) {
 					ElementarySchoolCalories: menu.ElementarySchoolCalories,
 					JuniorHighSchoolCalories: menu.JuniorHighSchoolCalories,
 				}
-				query.EXPECT().CreateMenu(ctx, arg).Times(1).Return(nil)
+				query.EXPECT().CreateMenuTx(ctx, arg).Times(1).Return(nil)
 			},
 			check: func(t *testing.T, err error) {
 				require.NoError(t, err)
@@ -61,6 +61,300 @@ func TestCreate(t *testing.T) {
 	}
 }
 
+func TestUpdateMenu(t *testing.T) {
+	ctx := context.Background()
+
+	menu := randomMenu(t)
+	arg := db.UpdateMenuParams{
+		OfferedAt:                menu.OfferedAt,
+		PhotoUrl:                 menu.PhotoUrl,
+		ElementarySchoolCalories: menu.ElementarySchoolCalories,
+		JuniorHighSchoolCalories: menu.JuniorHighSchoolCalories,
+		CityCode:                 menu.CityCode,
+		ID:                       menu.ID,
+	}
+
+	testCases := []struct {
+		name      string
+		buildStub func(query *mocks.MockQuery)
+		check     func(t *testing.T, err error)
+	}{
+		{
+			name: "OK",
+			buildStub: func(query *mocks.MockQuery) {
+				query.EXPECT().UpdateMenuTx(gomock.Any(), gomock.Eq(arg)).Times(1).Return(nil)
+			},
+			check: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "NG",
+			buildStub: func(query *mocks.MockQuery) {
+				query.EXPECT().UpdateMenuTx(gomock.Any(), gomock.Eq(arg)).Times(1).Return(sql.ErrConnDone)
+			},
+			check: func(t *testing.T, err error) {
+				require.ErrorIs(t, err, sql.ErrConnDone)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			query := mocks.NewMockQuery(ctrl)
+			tc.buildStub(query)
+
+			repo := NewMenuRepository(query)
+
+			err := repo.Update(ctx, menu)
+
+			tc.check(t, err)
+		})
+	}
+}
+
+func TestUpdateMenuPhoto(t *testing.T) {
+	ctx := context.Background()
+
+	id := util.NewUlid()
+	photoUrl := util.RandomNullURL()
+	credit := &domain.PhotoCredit{
+		FilePage:    "https://commons.wikimedia.org/wiki/File:School_lunch.jpg",
+		License:     "CC BY-SA 4.0",
+		Attribution: "author, CC BY-SA 4.0, via Wikimedia Commons",
+	}
+	photos := &domain.MenuPhotos{
+		Small:  "http://localhost:8080/storage/menus/photo_240.jpg",
+		Medium: "http://localhost:8080/storage/menus/photo_640.jpg",
+		Large:  "http://localhost:8080/storage/menus/photo_1280.jpg",
+	}
+
+	testCases := []struct {
+		name      string
+		photos    *domain.MenuPhotos
+		credit    *domain.PhotoCredit
+		buildStub func(query *mocks.MockQuery)
+		check     func(t *testing.T, err error)
+	}{
+		{
+			name:   "OK",
+			photos: photos,
+			credit: credit,
+			buildStub: func(query *mocks.MockQuery) {
+				arg := db.UpdateMenuPhotoParams{
+					PhotoUrl:         photoUrl,
+					PhotoFilePage:    sql.NullString{String: credit.FilePage, Valid: true},
+					PhotoLicense:     sql.NullString{String: credit.License, Valid: true},
+					PhotoAttribution: sql.NullString{String: credit.Attribution, Valid: true},
+					PhotoSmallUrl:    sql.NullString{String: photos.Small, Valid: true},
+					PhotoMediumUrl:   sql.NullString{String: photos.Medium, Valid: true},
+					PhotoLargeUrl:    sql.NullString{String: photos.Large, Valid: true},
+					ID:               id,
+				}
+
+				query.EXPECT().UpdateMenuPhoto(gomock.Any(), gomock.Eq(arg)).Times(1).Return(nil)
+			},
+			check: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:   "OK - Without Credit",
+			photos: photos,
+			buildStub: func(query *mocks.MockQuery) {
+				arg := db.UpdateMenuPhotoParams{
+					PhotoUrl:       photoUrl,
+					PhotoSmallUrl:  sql.NullString{String: photos.Small, Valid: true},
+					PhotoMediumUrl: sql.NullString{String: photos.Medium, Valid: true},
+					PhotoLargeUrl:  sql.NullString{String: photos.Large, Valid: true},
+					ID:             id,
+				}
+
+				query.EXPECT().UpdateMenuPhoto(gomock.Any(), gomock.Eq(arg)).Times(1).Return(nil)
+			},
+			check: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "NG",
+			buildStub: func(query *mocks.MockQuery) {
+				query.EXPECT().UpdateMenuPhoto(gomock.Any(), gomock.Any()).Times(1).Return(sql.ErrConnDone)
+			},
+			check: func(t *testing.T, err error) {
+				require.ErrorIs(t, err, sql.ErrConnDone)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			query := mocks.NewMockQuery(ctrl)
+			tc.buildStub(query)
+
+			repo := NewMenuRepository(query)
+
+			err := repo.UpdatePhoto(ctx, id, photoUrl, tc.photos, tc.credit)
+
+			tc.check(t, err)
+		})
+	}
+}
+
+func TestDeleteMenu(t *testing.T) {
+	ctx := context.Background()
+	id := util.NewUlid()
+
+	result := db.DeleteMenuTxResult{
+		MenuID:  id,
+		DishIDs: []string{util.NewUlid(), util.NewUlid()},
+	}
+
+	testCases := []struct {
+		name      string
+		buildStub func(query *mocks.MockQuery)
+		check     func(t *testing.T, deleted *domain.DeletedMenu, err error)
+	}{
+		{
+			name: "OK",
+			buildStub: func(query *mocks.MockQuery) {
+				query.EXPECT().DeleteMenuTx(gomock.Any(), gomock.Eq(id)).Times(1).Return(result, nil)
+			},
+			check: func(t *testing.T, deleted *domain.DeletedMenu, err error) {
+				require.NoError(t, err)
+				require.Equal(t, id, deleted.ID)
+				require.Equal(t, result.DishIDs, deleted.DishIDs)
+			},
+		},
+		{
+			name: "Not Found",
+			buildStub: func(query *mocks.MockQuery) {
+				query.EXPECT().DeleteMenuTx(gomock.Any(), gomock.Eq(id)).Times(1).Return(db.DeleteMenuTxResult{}, sql.ErrNoRows)
+			},
+			check: func(t *testing.T, deleted *domain.DeletedMenu, err error) {
+				require.ErrorIs(t, err, sql.ErrNoRows)
+				require.Nil(t, deleted)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			query := mocks.NewMockQuery(ctrl)
+			tc.buildStub(query)
+
+			repo := NewMenuRepository(query)
+
+			deleted, err := repo.Delete(ctx, id)
+
+			tc.check(t, deleted, err)
+		})
+	}
+}
+
+func TestFindMenuByID(t *testing.T) {
+	ctx := context.Background()
+
+	result := randomMenuResults(1)[0]
+
+	testCases := []struct {
+		name      string
+		buildStub func(query *mocks.MockQuery)
+		check     func(t *testing.T, menu *domain.Menu, err error)
+	}{
+		{
+			name: "OK",
+			buildStub: func(query *mocks.MockQuery) {
+				query.EXPECT().GetMenuByID(gomock.Any(), gomock.Eq(result.ID)).Times(1).Return(result, nil)
+				query.EXPECT().ListMenuNutritionsByMenuIDs(gomock.Any(), gomock.Eq([]string{result.ID})).Times(1).Return([]db.MenuNutrition{
+					{
+						MenuID:      result.ID,
+						SchoolLevel: string(domain.SchoolLevelJuniorHigh),
+						Protein:     sql.NullFloat64{Float64: 30.5, Valid: true},
+						Salt:        sql.NullFloat64{Float64: 2.5, Valid: true},
+					},
+				}, nil)
+			},
+			check: func(t *testing.T, menu *domain.Menu, err error) {
+				require.NoError(t, err)
+				require.Equal(t, result.ID, menu.ID)
+				require.Equal(t, result.CityCode, menu.CityCode)
+				require.Nil(t, menu.PhotoCredit)
+				require.Nil(t, menu.Photos)
+
+				require.NotNil(t, menu.Nutrition)
+				require.Nil(t, menu.Nutrition.Elementary)
+				require.Equal(t, 30.5, *menu.Nutrition.JuniorHigh.Protein)
+				require.Equal(t, 2.5, *menu.Nutrition.JuniorHigh.Salt)
+				require.Nil(t, menu.Nutrition.JuniorHigh.Fat)
+			},
+		},
+		{
+			name: "OK - PhotoCredit And Photos",
+			buildStub: func(query *mocks.MockQuery) {
+				credited := result
+				credited.PhotoFilePage = sql.NullString{String: "https://commons.wikimedia.org/wiki/File:School_lunch.jpg", Valid: true}
+				credited.PhotoLicense = sql.NullString{String: "CC BY-SA 4.0", Valid: true}
+				credited.PhotoAttribution = sql.NullString{String: "author, CC BY-SA 4.0, via Wikimedia Commons", Valid: true}
+				credited.PhotoSmallUrl = sql.NullString{String: "http://localhost:8080/storage/menus/photo_240.jpg", Valid: true}
+				credited.PhotoMediumUrl = sql.NullString{String: "http://localhost:8080/storage/menus/photo_640.jpg", Valid: true}
+				credited.PhotoLargeUrl = sql.NullString{String: "http://localhost:8080/storage/menus/photo_1280.jpg", Valid: true}
+
+				query.EXPECT().GetMenuByID(gomock.Any(), gomock.Eq(result.ID)).Times(1).Return(credited, nil)
+				query.EXPECT().ListMenuNutritionsByMenuIDs(gomock.Any(), gomock.Any()).Times(1).Return([]db.MenuNutrition{}, nil)
+			},
+			check: func(t *testing.T, menu *domain.Menu, err error) {
+				require.NoError(t, err)
+				require.Equal(t, &domain.PhotoCredit{
+					FilePage:    "https://commons.wikimedia.org/wiki/File:School_lunch.jpg",
+					License:     "CC BY-SA 4.0",
+					Attribution: "author, CC BY-SA 4.0, via Wikimedia Commons",
+				}, menu.PhotoCredit)
+				require.Equal(t, &domain.MenuPhotos{
+					Small:  "http://localhost:8080/storage/menus/photo_240.jpg",
+					Medium: "http://localhost:8080/storage/menus/photo_640.jpg",
+					Large:  "http://localhost:8080/storage/menus/photo_1280.jpg",
+				}, menu.Photos)
+			},
+		},
+		{
+			name: "Not Found",
+			buildStub: func(query *mocks.MockQuery) {
+				query.EXPECT().GetMenuByID(gomock.Any(), gomock.Eq(result.ID)).Times(1).Return(db.Menu{}, sql.ErrNoRows)
+			},
+			check: func(t *testing.T, menu *domain.Menu, err error) {
+				require.ErrorIs(t, err, sql.ErrNoRows)
+				require.Nil(t, menu)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			query := mocks.NewMockQuery(ctrl)
+			tc.buildStub(query)
+
+			repo := NewMenuRepository(query)
+
+			menu, err := repo.FindByID(ctx, result.ID)
+
+			tc.check(t, menu, err)
+		})
+	}
+}
+
 func TestGetByID(t *testing.T) {
 
 	ctx := context.Background()
@@ -92,6 +386,7 @@ func TestGetByID(t *testing.T) {
 					JuniorHighSchoolCalories: util.RandomInt32(),
 				}
 				query.EXPECT().GetMenu(ctx, arg).Times(1).Return(result, nil)
+				query.EXPECT().ListMenuNutritionsByMenuIDs(gomock.Any(), gomock.Any()).Times(1).Return([]db.MenuNutrition{}, nil)
 			},
 			check: func(t *testing.T, menu *domain.Menu, err error) {
 				require.NoError(t, err)
@@ -187,6 +482,7 @@ func TestFetchMenuByCity(t *testing.T) {
 				}
 				results := randomMenuResults(10)
 				query.EXPECT().ListMenuByCity(ctx, arg).Times(1).Return(results, nil)
+				query.EXPECT().ListMenuNutritionsByMenuIDs(gomock.Any(), gomock.Any()).Times(1).Return([]db.MenuNutrition{}, nil)
 			},
 			check: func(t *testing.T, menus []*domain.Menu, err error) {
 				require.NoError(t, err)
@@ -238,6 +534,64 @@ func TestFetchMenuByCity(t *testing.T) {
 	}
 }
 
+func TestFetchMenuByCityExcludingAllergens(t *testing.T) {
+	ctx := context.Background()
+	arg := db.ListMenuByCityExcludingAllergensParams{
+		Limit:       10,
+		Offset:      0,
+		OfferedAt:   util.RandomDate(),
+		CityCode:    util.RandomCityCode(),
+		AllergenIds: []int32{1, 2},
+	}
+
+	testCases := []struct {
+		name      string
+		buildStub func(query *mocks.MockQuery)
+		check     func(t *testing.T, menus []*domain.Menu, err error)
+	}{
+		{
+			name: "OK",
+			buildStub: func(query *mocks.MockQuery) {
+				results := randomMenuResults(10)
+				query.EXPECT().ListMenuByCityExcludingAllergens(gomock.Any(), gomock.Eq(arg)).Times(1).Return(results, nil)
+				query.EXPECT().ListMenuNutritionsByMenuIDs(gomock.Any(), gomock.Any()).Times(1).Return([]db.MenuNutrition{}, nil)
+			},
+			check: func(t *testing.T, menus []*domain.Menu, err error) {
+				require.NoError(t, err)
+				require.Len(t, menus, 10)
+			},
+		},
+		{
+			name: "NG",
+			buildStub: func(query *mocks.MockQuery) {
+				query.EXPECT().ListMenuByCityExcludingAllergens(gomock.Any(), gomock.Eq(arg)).Times(1).Return(nil, sql.ErrConnDone)
+				query.EXPECT().ListMenuNutritionsByMenuIDs(gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, menus []*domain.Menu, err error) {
+				require.ErrorIs(t, err, sql.ErrConnDone)
+				require.Nil(t, menus)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			query := mocks.NewMockQuery(ctrl)
+
+			tc.buildStub(query)
+
+			repo := NewMenuRepository(query)
+
+			menus, err := repo.FetchByCityExcludingAllergens(ctx, arg.Limit, arg.Offset, arg.OfferedAt, arg.CityCode, arg.AllergenIds)
+
+			tc.check(t, menus, err)
+		})
+	}
+}
+
 func TestFetchMenu(t *testing.T) {
 	ctx := context.Background()
 	offered := util.RandomDate()
@@ -263,6 +617,7 @@ func TestFetchMenu(t *testing.T) {
 				}
 				results := randomMenuResults(10)
 				query.EXPECT().ListMenu(ctx, arg).Times(1).Return(results, nil)
+				query.EXPECT().ListMenuNutritionsByMenuIDs(gomock.Any(), gomock.Any()).Times(1).Return([]db.MenuNutrition{}, nil)
 			},
 			check: func(t *testing.T, menus []*domain.Menu, err error) {
 				require.NoError(t, err)
@@ -348,6 +703,7 @@ func TestFetchMenuInIds(t *testing.T) {
 				}
 
 				query.EXPECT().ListMenuInIds(ctx, arg).Times(1).Return(splitResults, nil)
+				query.EXPECT().ListMenuNutritionsByMenuIDs(gomock.Any(), gomock.Any()).Times(1).Return([]db.MenuNutrition{}, nil)
 			},
 			check: func(t *testing.T, menus []*domain.Menu, err error) {
 				require.NoError(t, err)
@@ -424,3 +780,60 @@ func randomMenuResults(length int) []db.Menu {
 
 	return menus
 }
+
+func TestImportMenus(t *testing.T) {
+	ctx := context.Background()
+	cityCode := util.RandomCityCode()
+
+	result := db.ImportMenusTxResult{
+		Imported: true,
+		Rows: []*domain.ImportMenuResult{
+			{Line: 2, OfferedAt: "2024-04-08", MenuID: util.NewUlid(), DishIDs: []string{util.NewUlid()}},
+		},
+	}
+
+	testCases := []struct {
+		name      string
+		buildStub func(query *mocks.MockQuery)
+		check     func(t *testing.T, report *domain.ImportMenuReport, err error)
+	}{
+		{
+			name: "OK",
+			buildStub: func(query *mocks.MockQuery) {
+				query.EXPECT().ImportMenusTx(gomock.Any(), gomock.Eq(cityCode), gomock.Any()).Times(1).Return(result, nil)
+			},
+			check: func(t *testing.T, report *domain.ImportMenuReport, err error) {
+				require.NoError(t, err)
+				require.Equal(t, cityCode, report.CityCode)
+				require.True(t, report.Imported)
+				require.Equal(t, result.Rows, report.Rows)
+			},
+		},
+		{
+			name: "Internal Server Error",
+			buildStub: func(query *mocks.MockQuery) {
+				query.EXPECT().ImportMenusTx(gomock.Any(), gomock.Eq(cityCode), gomock.Any()).Times(1).Return(db.ImportMenusTxResult{}, sql.ErrConnDone)
+			},
+			check: func(t *testing.T, report *domain.ImportMenuReport, err error) {
+				require.ErrorIs(t, err, sql.ErrConnDone)
+				require.Nil(t, report)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			query := mocks.NewMockQuery(ctrl)
+			tc.buildStub(query)
+
+			repo := NewMenuRepository(query)
+
+			report, err := repo.Import(ctx, cityCode, nil)
+
+			tc.check(t, report, err)
+		})
+	}
+}
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"database/sql"
 	"time"
 
 	"github.com/ogurilab/school-lunch-api/domain"
@@ -28,7 +29,87 @@ func (r *menuRepository) Create(ctx context.Context, menu *domain.Menu) error {
 		JuniorHighSchoolCalories: menu.JuniorHighSchoolCalories,
 	}
 
-	return r.query.CreateMenu(ctx, arg)
+	return r.query.CreateMenuTx(ctx, arg)
+}
+
+func (r *menuRepository) Update(ctx context.Context, menu *domain.Menu) error {
+	arg := db.UpdateMenuParams{
+		ID:                       menu.ID,
+		OfferedAt:                menu.OfferedAt,
+		CityCode:                 menu.CityCode,
+		PhotoUrl:                 menu.PhotoUrl,
+		ElementarySchoolCalories: menu.ElementarySchoolCalories,
+		JuniorHighSchoolCalories: menu.JuniorHighSchoolCalories,
+	}
+
+	return r.query.UpdateMenuTx(ctx, arg)
+}
+
+func (r *menuRepository) UpdatePhoto(ctx context.Context, id string, photoUrl sql.NullString, photos *domain.MenuPhotos, credit *domain.PhotoCredit) error {
+	arg := db.UpdateMenuPhotoParams{
+		ID:       id,
+		PhotoUrl: photoUrl,
+	}
+
+	if credit != nil {
+		arg.PhotoFilePage = sql.NullString{String: credit.FilePage, Valid: true}
+		arg.PhotoLicense = sql.NullString{String: credit.License, Valid: true}
+		arg.PhotoAttribution = sql.NullString{String: credit.Attribution, Valid: true}
+	}
+
+	if photos != nil {
+		arg.PhotoSmallUrl = sql.NullString{String: photos.Small, Valid: true}
+		arg.PhotoMediumUrl = sql.NullString{String: photos.Medium, Valid: true}
+		arg.PhotoLargeUrl = sql.NullString{String: photos.Large, Valid: true}
+	}
+
+	return r.query.UpdateMenuPhoto(ctx, arg)
+}
+
+func (r *menuRepository) Delete(ctx context.Context, id string) (*domain.DeletedMenu, error) {
+
+	result, err := r.query.DeleteMenuTx(ctx, id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.DeletedMenu{
+		ID:      result.MenuID,
+		DishIDs: result.DishIDs,
+	}, nil
+}
+
+func (r *menuRepository) FindByID(ctx context.Context, id string) (*domain.Menu, error) {
+
+	result, err := r.query.GetMenuByID(ctx, id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	menu, err := domain.ReNewMenu(
+		result.ID,
+		result.OfferedAt,
+		result.PhotoUrl,
+		result.ElementarySchoolCalories,
+		result.JuniorHighSchoolCalories,
+		result.CityCode,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	menu.PhotoCredit = newPhotoCredit(result.PhotoFilePage, result.PhotoLicense, result.PhotoAttribution)
+	menu.Photos = newMenuPhotos(result.PhotoSmallUrl, result.PhotoMediumUrl, result.PhotoLargeUrl)
+	menu.CreatedAt = result.CreatedAt
+
+	if err := attachNutrition(ctx, r.query, menu); err != nil {
+		return nil, err
+	}
+
+	return menu, nil
 }
 
 func (r *menuRepository) GetByID(ctx context.Context, id string, city int32) (*domain.Menu, error) {
@@ -56,6 +137,14 @@ func (r *menuRepository) GetByID(ctx context.Context, id string, city int32) (*d
 		return nil, err
 	}
 
+	menu.PhotoCredit = newPhotoCredit(result.PhotoFilePage, result.PhotoLicense, result.PhotoAttribution)
+	menu.Photos = newMenuPhotos(result.PhotoSmallUrl, result.PhotoMediumUrl, result.PhotoLargeUrl)
+	menu.CreatedAt = result.CreatedAt
+
+	if err := attachNutrition(ctx, r.query, menu); err != nil {
+		return nil, err
+	}
+
 	return menu, nil
 }
 
@@ -89,9 +178,62 @@ func (r *menuRepository) FetchByCity(ctx context.Context, limit int32, offset in
 			return nil, err
 		}
 
+		menu.PhotoCredit = newPhotoCredit(result.PhotoFilePage, result.PhotoLicense, result.PhotoAttribution)
+		menu.Photos = newMenuPhotos(result.PhotoSmallUrl, result.PhotoMediumUrl, result.PhotoLargeUrl)
+		menu.CreatedAt = result.CreatedAt
+
 		menus = append(menus, menu)
 	}
 
+	if err := attachNutrition(ctx, r.query, menus...); err != nil {
+		return nil, err
+	}
+
+	return menus, nil
+}
+
+func (r *menuRepository) FetchByCityExcludingAllergens(ctx context.Context, limit int32, offset int32, offered time.Time, city int32, allergenIDs []int32) ([]*domain.Menu, error) {
+	arg := db.ListMenuByCityExcludingAllergensParams{
+		Limit:       limit,
+		Offset:      offset,
+		OfferedAt:   offered,
+		CityCode:    city,
+		AllergenIds: allergenIDs,
+	}
+
+	results, err := r.query.ListMenuByCityExcludingAllergens(ctx, arg)
+
+	if err != nil {
+		return nil, err
+	}
+
+	menus := make([]*domain.Menu, 0, len(results))
+
+	for _, result := range results {
+		menu, err := domain.ReNewMenu(
+			result.ID,
+			result.OfferedAt,
+			result.PhotoUrl,
+			result.ElementarySchoolCalories,
+			result.JuniorHighSchoolCalories,
+			result.CityCode,
+		)
+
+		if err != nil {
+			return nil, err
+		}
+
+		menu.PhotoCredit = newPhotoCredit(result.PhotoFilePage, result.PhotoLicense, result.PhotoAttribution)
+		menu.Photos = newMenuPhotos(result.PhotoSmallUrl, result.PhotoMediumUrl, result.PhotoLargeUrl)
+		menu.CreatedAt = result.CreatedAt
+
+		menus = append(menus, menu)
+	}
+
+	if err := attachNutrition(ctx, r.query, menus...); err != nil {
+		return nil, err
+	}
+
 	return menus, nil
 }
 
@@ -124,9 +266,17 @@ func (r *menuRepository) Fetch(ctx context.Context, limit int32, offset int32, o
 			return nil, err
 		}
 
+		menu.PhotoCredit = newPhotoCredit(result.PhotoFilePage, result.PhotoLicense, result.PhotoAttribution)
+		menu.Photos = newMenuPhotos(result.PhotoSmallUrl, result.PhotoMediumUrl, result.PhotoLargeUrl)
+		menu.CreatedAt = result.CreatedAt
+
 		menus = append(menus, menu)
 	}
 
+	if err := attachNutrition(ctx, r.query, menus...); err != nil {
+		return nil, err
+	}
+
 	return menus, nil
 }
 
@@ -160,8 +310,67 @@ func (r *menuRepository) FetchByIDs(ctx context.Context, limit int32, offset int
 			return nil, err
 		}
 
+		menu.PhotoCredit = newPhotoCredit(result.PhotoFilePage, result.PhotoLicense, result.PhotoAttribution)
+		menu.Photos = newMenuPhotos(result.PhotoSmallUrl, result.PhotoMediumUrl, result.PhotoLargeUrl)
+		menu.CreatedAt = result.CreatedAt
+
 		menus = append(menus, menu)
 	}
 
+	if err := attachNutrition(ctx, r.query, menus...); err != nil {
+		return nil, err
+	}
+
 	return menus, nil
 }
+
+func (r *menuRepository) Import(ctx context.Context, cityCode int32, rows []*domain.ImportMenuRow) (*domain.ImportMenuReport, error) {
+
+	result, err := r.query.ImportMenusTx(ctx, cityCode, rows)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.ImportMenuReport{
+		CityCode: cityCode,
+		Imported: result.Imported,
+		Rows:     result.Rows,
+	}, nil
+}
+
+func (r *menuRepository) FetchOfferedAtByCity(ctx context.Context, city int32, start time.Time, end time.Time) ([]time.Time, error) {
+	arg := db.ListMenuOfferedAtByCityParams{
+		CityCode: city,
+		StartAt:  start,
+		EndAt:    end,
+	}
+
+	return r.query.ListMenuOfferedAtByCity(ctx, arg)
+}
+
+// newPhotoCredit は Wikimedia Commons に公開していない写真の場合は nil を返す
+func newPhotoCredit(filePage sql.NullString, license sql.NullString, attribution sql.NullString) *domain.PhotoCredit {
+	if !filePage.Valid {
+		return nil
+	}
+
+	return &domain.PhotoCredit{
+		FilePage:    filePage.String,
+		License:     license.String,
+		Attribution: attribution.String,
+	}
+}
+
+// newMenuPhotos はサムネイルを作成していない写真の場合は nil を返す
+func newMenuPhotos(small sql.NullString, medium sql.NullString, large sql.NullString) *domain.MenuPhotos {
+	if !small.Valid {
+		return nil
+	}
+
+	return &domain.MenuPhotos{
+		Small:  small.String,
+		Medium: medium.String,
+		Large:  large.String,
+	}
+}
@@ -53,7 +53,7 @@ func (r *menuWithDishesRepository) GetByID(ctx context.Context, id string, city
 
 	menuData := results[0]
 
-	return domain.ReNewMenuWithDishes(
+	menu, err := domain.ReNewMenuWithDishes(
 		menuData.ID,
 		menuData.OfferedAt,
 		menuData.PhotoUrl,
@@ -62,6 +62,20 @@ func (r *menuWithDishesRepository) GetByID(ctx context.Context, id string, city
 		menuData.CityCode,
 		dishes,
 	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	menu.PhotoCredit = newPhotoCredit(menuData.PhotoFilePage, menuData.PhotoLicense, menuData.PhotoAttribution)
+	menu.Photos = newMenuPhotos(menuData.PhotoSmallUrl, menuData.PhotoMediumUrl, menuData.PhotoLargeUrl)
+	menu.CreatedAt = menuData.CreatedAt
+
+	if err := attachNutrition(ctx, r.query, &menu.Menu); err != nil {
+		return nil, err
+	}
+
+	return menu, nil
 }
 
 type mapKey struct {
@@ -76,6 +90,9 @@ type processMenuDishesMapInput struct {
 	elementarySchoolCalories int32
 	juniorHighSchoolCalories int32
 	cityCode                 int32
+	photoCredit              *domain.PhotoCredit
+	photos                   *domain.MenuPhotos
+	createdAt                time.Time
 	dishID                   string
 	dishName                 string
 	key                      mapKey
@@ -110,6 +127,109 @@ func (r *menuWithDishesRepository) FetchByCity(ctx context.Context, limit int32,
 			elementarySchoolCalories: result.ElementarySchoolCalories,
 			juniorHighSchoolCalories: result.JuniorHighSchoolCalories,
 			cityCode:                 result.CityCode,
+			photoCredit:              newPhotoCredit(result.PhotoFilePage, result.PhotoLicense, result.PhotoAttribution),
+			photos:                   newMenuPhotos(result.PhotoSmallUrl, result.PhotoMediumUrl, result.PhotoLargeUrl),
+			createdAt:                result.CreatedAt,
+			dishID:                   result.DishID,
+			dishName:                 result.DishName,
+			key:                      key,
+			menuMap:                  menusMap,
+			dishesMap:                dishesMap,
+		})
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := attachNutritionToMenuMap(ctx, r.query, menusMap); err != nil {
+		return nil, err
+	}
+
+	return processMenuWithDishesResults(menusMap, dishesMap, len(menusMap))
+}
+
+// FetchByCityBetween は from から to までに提供される献立を件数の上限なしで返す
+// 返す献立は他のメソッドと同じく提供日の降順に並ぶ
+func (r *menuWithDishesRepository) FetchByCityBetween(ctx context.Context, from time.Time, to time.Time, city int32) ([]*domain.MenuWithDishes, error) {
+	arg := db.ListMenuWithDishesByCityBetweenParams{
+		CityCode: city,
+		StartAt:  from,
+		EndAt:    to,
+	}
+
+	results, err := r.query.ListMenuWithDishesByCityBetween(ctx, arg)
+
+	if err != nil {
+		return nil, err
+	}
+
+	menusMap := make(map[mapKey]*domain.Menu)
+	dishesMap := make(map[mapKey][]*domain.Dish)
+
+	for _, result := range results {
+		key := mapKey{id: result.ID, offered: result.OfferedAt}
+
+		err := processMenuDishesMap(processMenuDishesMapInput{
+			id:                       result.ID,
+			offered:                  result.OfferedAt,
+			photoUrl:                 result.PhotoUrl,
+			elementarySchoolCalories: result.ElementarySchoolCalories,
+			juniorHighSchoolCalories: result.JuniorHighSchoolCalories,
+			cityCode:                 result.CityCode,
+			photoCredit:              newPhotoCredit(result.PhotoFilePage, result.PhotoLicense, result.PhotoAttribution),
+			photos:                   newMenuPhotos(result.PhotoSmallUrl, result.PhotoMediumUrl, result.PhotoLargeUrl),
+			createdAt:                result.CreatedAt,
+			dishID:                   result.DishID,
+			dishName:                 result.DishName,
+			key:                      key,
+			menuMap:                  menusMap,
+			dishesMap:                dishesMap,
+		})
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := attachNutritionToMenuMap(ctx, r.query, menusMap); err != nil {
+		return nil, err
+	}
+
+	return processMenuWithDishesResults(menusMap, dishesMap, len(menusMap))
+}
+
+func (r *menuWithDishesRepository) FetchByCityExcludingAllergens(ctx context.Context, limit int32, offset int32, offered time.Time, city int32, allergenIDs []int32) ([]*domain.MenuWithDishes, error) {
+	arg := db.ListMenuWithDishesByCityExcludingAllergensParams{
+		Limit:       limit,
+		Offset:      offset,
+		OfferedAt:   offered,
+		CityCode:    city,
+		AllergenIds: allergenIDs,
+	}
+
+	results, err := r.query.ListMenuWithDishesByCityExcludingAllergens(ctx, arg)
+
+	if err != nil {
+		return nil, err
+	}
+
+	menusMap := make(map[mapKey]*domain.Menu)
+	dishesMap := make(map[mapKey][]*domain.Dish)
+
+	for _, result := range results {
+		key := mapKey{id: result.ID, offered: result.OfferedAt}
+
+		err := processMenuDishesMap(processMenuDishesMapInput{
+			id:                       result.ID,
+			offered:                  result.OfferedAt,
+			photoUrl:                 result.PhotoUrl,
+			elementarySchoolCalories: result.ElementarySchoolCalories,
+			juniorHighSchoolCalories: result.JuniorHighSchoolCalories,
+			cityCode:                 result.CityCode,
+			photoCredit:              newPhotoCredit(result.PhotoFilePage, result.PhotoLicense, result.PhotoAttribution),
+			photos:                   newMenuPhotos(result.PhotoSmallUrl, result.PhotoMediumUrl, result.PhotoLargeUrl),
+			createdAt:                result.CreatedAt,
 			dishID:                   result.DishID,
 			dishName:                 result.DishName,
 			key:                      key,
@@ -122,6 +242,10 @@ func (r *menuWithDishesRepository) FetchByCity(ctx context.Context, limit int32,
 		}
 	}
 
+	if err := attachNutritionToMenuMap(ctx, r.query, menusMap); err != nil {
+		return nil, err
+	}
+
 	return processMenuWithDishesResults(menusMap, dishesMap, len(menusMap))
 }
 
@@ -151,6 +275,9 @@ func (r *menuWithDishesRepository) Fetch(ctx context.Context, limit int32, offse
 			elementarySchoolCalories: result.ElementarySchoolCalories,
 			juniorHighSchoolCalories: result.JuniorHighSchoolCalories,
 			cityCode:                 result.CityCode,
+			photoCredit:              newPhotoCredit(result.PhotoFilePage, result.PhotoLicense, result.PhotoAttribution),
+			photos:                   newMenuPhotos(result.PhotoSmallUrl, result.PhotoMediumUrl, result.PhotoLargeUrl),
+			createdAt:                result.CreatedAt,
 			dishID:                   result.DishID,
 			dishName:                 result.DishName,
 			key:                      key,
@@ -163,9 +290,72 @@ func (r *menuWithDishesRepository) Fetch(ctx context.Context, limit int32, offse
 		}
 	}
 
+	if err := attachNutritionToMenuMap(ctx, r.query, menusMap); err != nil {
+		return nil, err
+	}
+
 	return processMenuWithDishesResults(menusMap, dishesMap, len(menusMap))
 }
 
+// FetchLatestByCity は登録日時が新しい順に limit 件の献立を返す
+// 返す献立は他のメソッドと同じく提供日の降順に並ぶ
+func (r *menuWithDishesRepository) FetchLatestByCity(ctx context.Context, limit int32, city int32) ([]*domain.MenuWithDishes, error) {
+	arg := db.ListLatestMenuWithDishesByCityParams{
+		CityCode: city,
+		Limit:    limit,
+	}
+
+	results, err := r.query.ListLatestMenuWithDishesByCity(ctx, arg)
+
+	if err != nil {
+		return nil, err
+	}
+
+	menusMap := make(map[mapKey]*domain.Menu)
+	dishesMap := make(map[mapKey][]*domain.Dish)
+
+	for _, result := range results {
+		key := mapKey{id: result.ID, offered: result.OfferedAt}
+
+		err := processMenuDishesMap(processMenuDishesMapInput{
+			id:                       result.ID,
+			offered:                  result.OfferedAt,
+			photoUrl:                 result.PhotoUrl,
+			elementarySchoolCalories: result.ElementarySchoolCalories,
+			juniorHighSchoolCalories: result.JuniorHighSchoolCalories,
+			cityCode:                 result.CityCode,
+			photoCredit:              newPhotoCredit(result.PhotoFilePage, result.PhotoLicense, result.PhotoAttribution),
+			photos:                   newMenuPhotos(result.PhotoSmallUrl, result.PhotoMediumUrl, result.PhotoLargeUrl),
+			createdAt:                result.CreatedAt,
+			dishID:                   result.DishID,
+			dishName:                 result.DishName,
+			key:                      key,
+			menuMap:                  menusMap,
+			dishesMap:                dishesMap,
+		})
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := attachNutritionToMenuMap(ctx, r.query, menusMap); err != nil {
+		return nil, err
+	}
+
+	return processMenuWithDishesResults(menusMap, dishesMap, len(menusMap))
+}
+
+func attachNutritionToMenuMap(ctx context.Context, query db.Query, menuMap map[mapKey]*domain.Menu) error {
+	menus := make([]*domain.Menu, 0, len(menuMap))
+
+	for _, menu := range menuMap {
+		menus = append(menus, menu)
+	}
+
+	return attachNutrition(ctx, query, menus...)
+}
+
 func processMenuDishesMap(
 	input processMenuDishesMapInput,
 ) error {
@@ -187,6 +377,10 @@ func processMenuDishesMap(
 			return err
 		}
 
+		menu.PhotoCredit = input.photoCredit
+		menu.Photos = input.photos
+		menu.CreatedAt = input.createdAt
+
 		menusMap[key] = menu
 	}
 
@@ -235,6 +429,11 @@ func processMenuWithDishesResults(menuMap map[mapKey]*domain.Menu, dishesMap map
 			return nil, err
 		}
 
+		menuWithDishes.PhotoCredit = menu.PhotoCredit
+		menuWithDishes.Photos = menu.Photos
+		menuWithDishes.Nutrition = menu.Nutrition
+		menuWithDishes.CreatedAt = menu.CreatedAt
+
 		menus = append(menus, menuWithDishes)
 	}
 
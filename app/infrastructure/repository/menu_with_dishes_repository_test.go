@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"testing"
+	"time"
 
 	"github.com/ogurilab/school-lunch-api/domain"
 	db "github.com/ogurilab/school-lunch-api/infrastructure/db/sqlc"
@@ -35,6 +36,7 @@ func TestGetByIDWithDishes(t *testing.T) {
 					CityCode: 1,
 				}
 				query.EXPECT().GetMenuWithDishes(context.Background(), arg).Times(1).Return(queryResults, nil)
+				query.EXPECT().ListMenuNutritionsByMenuIDs(gomock.Any(), gomock.Any()).Times(1).Return([]db.MenuNutrition{}, nil)
 			},
 			check: func(t *testing.T, menu *domain.MenuWithDishes, err error) {
 				menuData := queryResults[0]
@@ -125,6 +127,7 @@ func TestFetchByCityWithDishes(t *testing.T) {
 				results := randomWithDishesByCityResults(int(arg.Limit))
 
 				query.EXPECT().ListMenuWithDishesByCity(context.Background(), arg).Times(1).Return(results, nil)
+				query.EXPECT().ListMenuNutritionsByMenuIDs(gomock.Any(), gomock.Any()).Times(1).Return([]db.MenuNutrition{}, nil)
 			},
 			check: func(t *testing.T, menus []*domain.MenuWithDishes, err error) {
 				require.NoError(t, err)
@@ -175,6 +178,209 @@ func TestFetchByCityWithDishes(t *testing.T) {
 	}
 }
 
+func TestFetchByCityExcludingAllergensWithDishes(t *testing.T) {
+	arg := db.ListMenuWithDishesByCityExcludingAllergensParams{
+		CityCode:    util.RandomCityCode(),
+		Limit:       10,
+		Offset:      0,
+		OfferedAt:   util.RandomDate(),
+		AllergenIds: []int32{1, 2},
+	}
+
+	testCases := []struct {
+		name  string
+		build func(query *mocks.MockQuery)
+		check func(t *testing.T, menus []*domain.MenuWithDishes, err error)
+	}{
+		{
+			name: "OK",
+			build: func(query *mocks.MockQuery) {
+				rows := randomWithDishesByCityResults(int(arg.Limit))
+				results := make([]db.ListMenuWithDishesByCityExcludingAllergensRow, 0, len(rows))
+
+				for _, row := range rows {
+					results = append(results, db.ListMenuWithDishesByCityExcludingAllergensRow(row))
+				}
+
+				query.EXPECT().ListMenuWithDishesByCityExcludingAllergens(gomock.Any(), gomock.Eq(arg)).Times(1).Return(results, nil)
+				query.EXPECT().ListMenuNutritionsByMenuIDs(gomock.Any(), gomock.Any()).Times(1).Return([]db.MenuNutrition{}, nil)
+			},
+			check: func(t *testing.T, menus []*domain.MenuWithDishes, err error) {
+				require.NoError(t, err)
+				require.Len(t, menus, 10)
+			},
+		},
+		{
+			name: "NG",
+			build: func(query *mocks.MockQuery) {
+				query.EXPECT().ListMenuWithDishesByCityExcludingAllergens(gomock.Any(), gomock.Eq(arg)).Times(1).Return(nil, sql.ErrConnDone)
+				query.EXPECT().ListMenuNutritionsByMenuIDs(gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, menus []*domain.MenuWithDishes, err error) {
+				require.ErrorIs(t, err, sql.ErrConnDone)
+				require.Nil(t, menus)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			query := mocks.NewMockQuery(ctrl)
+			tc.build(query)
+
+			repo := NewMenuWithDishesRepository(query)
+
+			menus, err := repo.FetchByCityExcludingAllergens(context.Background(), arg.Limit, arg.Offset, arg.OfferedAt, arg.CityCode, arg.AllergenIds)
+
+			tc.check(t, menus, err)
+		})
+	}
+}
+
+func TestFetchByCityBetweenWithDishes(t *testing.T) {
+	arg := db.ListMenuWithDishesByCityBetweenParams{
+		CityCode: util.RandomCityCode(),
+		StartAt:  time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC),
+		EndAt:    time.Date(2024, 5, 7, 0, 0, 0, 0, time.UTC),
+	}
+
+	testCases := []struct {
+		name  string
+		build func(query *mocks.MockQuery)
+		check func(t *testing.T, menus []*domain.MenuWithDishes, err error)
+	}{
+		{
+			name: "OK",
+			build: func(query *mocks.MockQuery) {
+				rows := randomWithDishesByCityResults(5)
+				results := make([]db.ListMenuWithDishesByCityBetweenRow, 0, len(rows))
+
+				for _, row := range rows {
+					results = append(results, db.ListMenuWithDishesByCityBetweenRow(row))
+				}
+
+				query.EXPECT().ListMenuWithDishesByCityBetween(gomock.Any(), gomock.Eq(arg)).Times(1).Return(results, nil)
+				query.EXPECT().ListMenuNutritionsByMenuIDs(gomock.Any(), gomock.Any()).Times(1).Return([]db.MenuNutrition{}, nil)
+			},
+			check: func(t *testing.T, menus []*domain.MenuWithDishes, err error) {
+				require.NoError(t, err)
+				require.Len(t, menus, 5)
+
+				for i := 1; i < len(menus); i++ {
+					require.False(t, menus[i].OfferedAt.After(menus[i-1].OfferedAt))
+				}
+			},
+		},
+		{
+			name: "NG",
+			build: func(query *mocks.MockQuery) {
+				query.EXPECT().ListMenuWithDishesByCityBetween(gomock.Any(), gomock.Eq(arg)).Times(1).Return(nil, sql.ErrConnDone)
+				query.EXPECT().ListMenuNutritionsByMenuIDs(gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, menus []*domain.MenuWithDishes, err error) {
+				require.ErrorIs(t, err, sql.ErrConnDone)
+				require.Nil(t, menus)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			query := mocks.NewMockQuery(ctrl)
+			tc.build(query)
+
+			repo := NewMenuWithDishesRepository(query)
+
+			menus, err := repo.FetchByCityBetween(context.Background(), arg.StartAt, arg.EndAt, arg.CityCode)
+
+			tc.check(t, menus, err)
+		})
+	}
+}
+
+func TestFetchLatestByCityWithDishes(t *testing.T) {
+	arg := db.ListLatestMenuWithDishesByCityParams{
+		CityCode: util.RandomCityCode(),
+		Limit:    10,
+	}
+
+	testCases := []struct {
+		name  string
+		build func(query *mocks.MockQuery) []db.ListLatestMenuWithDishesByCityRow
+		check func(t *testing.T, rows []db.ListLatestMenuWithDishesByCityRow, menus []*domain.MenuWithDishes, err error)
+	}{
+		{
+			name: "OK",
+			build: func(query *mocks.MockQuery) []db.ListLatestMenuWithDishesByCityRow {
+				rows := randomWithDishesByCityResults(int(arg.Limit))
+				results := make([]db.ListLatestMenuWithDishesByCityRow, 0, len(rows))
+
+				for i, row := range rows {
+					row.CreatedAt = time.Date(2024, 4, 1, 0, 0, i, 0, time.UTC)
+					results = append(results, db.ListLatestMenuWithDishesByCityRow(row))
+				}
+
+				query.EXPECT().ListLatestMenuWithDishesByCity(gomock.Any(), gomock.Eq(arg)).Times(1).Return(results, nil)
+				query.EXPECT().ListMenuNutritionsByMenuIDs(gomock.Any(), gomock.Any()).Times(1).Return([]db.MenuNutrition{}, nil)
+
+				return results
+			},
+			check: func(t *testing.T, rows []db.ListLatestMenuWithDishesByCityRow, menus []*domain.MenuWithDishes, err error) {
+				require.NoError(t, err)
+				require.Len(t, menus, 10)
+
+				createdAt := make(map[string]time.Time, len(rows))
+
+				for _, row := range rows {
+					createdAt[row.ID] = row.CreatedAt
+				}
+
+				for _, menu := range menus {
+					require.Equal(t, createdAt[menu.ID], menu.CreatedAt)
+				}
+			},
+		},
+		{
+			name: "NG",
+			build: func(query *mocks.MockQuery) []db.ListLatestMenuWithDishesByCityRow {
+				query.EXPECT().ListLatestMenuWithDishesByCity(gomock.Any(), gomock.Eq(arg)).Times(1).Return(nil, sql.ErrConnDone)
+				query.EXPECT().ListMenuNutritionsByMenuIDs(gomock.Any(), gomock.Any()).Times(0)
+
+				return nil
+			},
+			check: func(t *testing.T, rows []db.ListLatestMenuWithDishesByCityRow, menus []*domain.MenuWithDishes, err error) {
+				require.ErrorIs(t, err, sql.ErrConnDone)
+				require.Nil(t, menus)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			query := mocks.NewMockQuery(ctrl)
+			rows := tc.build(query)
+
+			repo := NewMenuWithDishesRepository(query)
+
+			menus, err := repo.FetchLatestByCity(context.Background(), arg.Limit, arg.CityCode)
+
+			tc.check(t, rows, menus, err)
+		})
+	}
+}
+
 func TestFetchWithDishes(t *testing.T) {
 	offered := util.RandomDate()
 
@@ -199,6 +405,7 @@ func TestFetchWithDishes(t *testing.T) {
 				}
 				results := randomWithDishesResults(int(arg.Limit))
 				query.EXPECT().ListMenuWithDishes(context.Background(), arg).Times(1).Return(results, nil)
+				query.EXPECT().ListMenuNutritionsByMenuIDs(gomock.Any(), gomock.Any()).Times(1).Return([]db.MenuNutrition{}, nil)
 			},
 			check: func(t *testing.T, menus []*domain.MenuWithDishes, err error) {
 				require.NoError(t, err)
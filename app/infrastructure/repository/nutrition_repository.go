@@ -0,0 +1,123 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/ogurilab/school-lunch-api/domain"
+	db "github.com/ogurilab/school-lunch-api/infrastructure/db/sqlc"
+	"github.com/ogurilab/school-lunch-api/util"
+)
+
+type nutritionRepository struct {
+	query db.Query
+}
+
+func NewNutritionRepository(query db.Query) domain.NutritionRepository {
+	return &nutritionRepository{
+		query: query,
+	}
+}
+
+func (r *nutritionRepository) Upsert(ctx context.Context, menuID string, level domain.SchoolLevel, facts *domain.NutritionFacts) error {
+	arg := db.UpsertMenuNutritionParams{
+		MenuID:       menuID,
+		SchoolLevel:  string(level),
+		Protein:      util.PointerToNullFloat64(facts.Protein),
+		Fat:          util.PointerToNullFloat64(facts.Fat),
+		Carbohydrate: util.PointerToNullFloat64(facts.Carbohydrate),
+		Salt:         util.PointerToNullFloat64(facts.Salt),
+		Calcium:      util.PointerToNullFloat64(facts.Calcium),
+		Iron:         util.PointerToNullFloat64(facts.Iron),
+		VitaminA:     util.PointerToNullFloat64(facts.VitaminA),
+		VitaminB1:    util.PointerToNullFloat64(facts.VitaminB1),
+		VitaminB2:    util.PointerToNullFloat64(facts.VitaminB2),
+		VitaminC:     util.PointerToNullFloat64(facts.VitaminC),
+	}
+
+	return r.query.UpsertMenuNutrition(ctx, arg)
+}
+
+func (r *nutritionRepository) Delete(ctx context.Context, menuID string, level domain.SchoolLevel) error {
+	arg := db.DeleteMenuNutritionParams{
+		MenuID:      menuID,
+		SchoolLevel: string(level),
+	}
+
+	affected, err := r.query.DeleteMenuNutrition(ctx, arg)
+
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (r *nutritionRepository) FetchByMenuIDs(ctx context.Context, menuIDs []string) (map[string]*domain.MenuNutrition, error) {
+	return fetchMenuNutritions(ctx, r.query, menuIDs)
+}
+
+// fetchMenuNutritions は献立IDごとの栄養価を返す
+// 栄養価が登録されていない献立は含まない
+func fetchMenuNutritions(ctx context.Context, query db.Query, menuIDs []string) (map[string]*domain.MenuNutrition, error) {
+	nutritions := make(map[string]*domain.MenuNutrition)
+
+	if len(menuIDs) == 0 {
+		return nutritions, nil
+	}
+
+	results, err := query.ListMenuNutritionsByMenuIDs(ctx, menuIDs)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, result := range results {
+		nutrition, ok := nutritions[result.MenuID]
+
+		if !ok {
+			nutrition = &domain.MenuNutrition{}
+			nutritions[result.MenuID] = nutrition
+		}
+
+		nutrition.Set(domain.SchoolLevel(result.SchoolLevel), &domain.NutritionFacts{
+			Protein:      util.NullFloat64ToPointer(result.Protein),
+			Fat:          util.NullFloat64ToPointer(result.Fat),
+			Carbohydrate: util.NullFloat64ToPointer(result.Carbohydrate),
+			Salt:         util.NullFloat64ToPointer(result.Salt),
+			Calcium:      util.NullFloat64ToPointer(result.Calcium),
+			Iron:         util.NullFloat64ToPointer(result.Iron),
+			VitaminA:     util.NullFloat64ToPointer(result.VitaminA),
+			VitaminB1:    util.NullFloat64ToPointer(result.VitaminB1),
+			VitaminB2:    util.NullFloat64ToPointer(result.VitaminB2),
+			VitaminC:     util.NullFloat64ToPointer(result.VitaminC),
+		})
+	}
+
+	return nutritions, nil
+}
+
+// attachNutrition は献立に栄養価を設定する
+func attachNutrition(ctx context.Context, query db.Query, menus ...*domain.Menu) error {
+	ids := make([]string, 0, len(menus))
+
+	for _, menu := range menus {
+		ids = append(ids, menu.ID)
+	}
+
+	nutritions, err := fetchMenuNutritions(ctx, query, ids)
+
+	if err != nil {
+		return err
+	}
+
+	for _, menu := range menus {
+		menu.Nutrition = nutritions[menu.ID]
+	}
+
+	return nil
+}
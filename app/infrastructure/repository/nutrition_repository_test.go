@@ -0,0 +1,158 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/ogurilab/school-lunch-api/domain"
+	db "github.com/ogurilab/school-lunch-api/infrastructure/db/sqlc"
+	"github.com/ogurilab/school-lunch-api/infrastructure/db/sqlc/mocks"
+	"github.com/ogurilab/school-lunch-api/util"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestUpsertNutrition(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	menuID := util.NewUlid()
+	protein := 25.5
+	calcium := 300.0
+
+	arg := db.UpsertMenuNutritionParams{
+		MenuID:      menuID,
+		SchoolLevel: "elementary",
+		Protein:     sql.NullFloat64{Float64: protein, Valid: true},
+		Calcium:     sql.NullFloat64{Float64: calcium, Valid: true},
+	}
+
+	query := mocks.NewMockQuery(ctrl)
+	query.EXPECT().UpsertMenuNutrition(gomock.Any(), gomock.Eq(arg)).Times(1).Return(nil)
+
+	repo := NewNutritionRepository(query)
+
+	err := repo.Upsert(context.Background(), menuID, domain.SchoolLevelElementary, &domain.NutritionFacts{
+		Protein: &protein,
+		Calcium: &calcium,
+	})
+
+	require.NoError(t, err)
+}
+
+func TestDeleteNutrition(t *testing.T) {
+	menuID := util.NewUlid()
+	arg := db.DeleteMenuNutritionParams{
+		MenuID:      menuID,
+		SchoolLevel: "junior_high",
+	}
+
+	testCases := []struct {
+		name      string
+		buildStub func(query *mocks.MockQuery)
+		check     func(t *testing.T, err error)
+	}{
+		{
+			name: "OK",
+			buildStub: func(query *mocks.MockQuery) {
+				query.EXPECT().DeleteMenuNutrition(gomock.Any(), gomock.Eq(arg)).Times(1).Return(int64(1), nil)
+			},
+			check: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "Not Found",
+			buildStub: func(query *mocks.MockQuery) {
+				query.EXPECT().DeleteMenuNutrition(gomock.Any(), gomock.Eq(arg)).Times(1).Return(int64(0), nil)
+			},
+			check: func(t *testing.T, err error) {
+				require.ErrorIs(t, err, sql.ErrNoRows)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			query := mocks.NewMockQuery(ctrl)
+			tc.buildStub(query)
+
+			repo := NewNutritionRepository(query)
+
+			tc.check(t, repo.Delete(context.Background(), menuID, domain.SchoolLevelJuniorHigh))
+		})
+	}
+}
+
+func TestFetchNutritionByMenuIDs(t *testing.T) {
+	ids := []string{util.NewUlid(), util.NewUlid()}
+
+	testCases := []struct {
+		name      string
+		ids       []string
+		buildStub func(query *mocks.MockQuery)
+		check     func(t *testing.T, nutritions map[string]*domain.MenuNutrition, err error)
+	}{
+		{
+			name: "OK",
+			ids:  ids,
+			buildStub: func(query *mocks.MockQuery) {
+				query.EXPECT().ListMenuNutritionsByMenuIDs(gomock.Any(), gomock.Eq(ids)).Times(1).Return([]db.MenuNutrition{
+					{MenuID: ids[0], SchoolLevel: "elementary", Salt: sql.NullFloat64{Float64: 2.0, Valid: true}},
+					{MenuID: ids[0], SchoolLevel: "junior_high", Salt: sql.NullFloat64{Float64: 2.5, Valid: true}},
+				}, nil)
+			},
+			check: func(t *testing.T, nutritions map[string]*domain.MenuNutrition, err error) {
+				require.NoError(t, err)
+				require.Len(t, nutritions, 1)
+
+				nutrition := nutritions[ids[0]]
+				require.Equal(t, 2.0, *nutrition.Elementary.Salt)
+				require.Equal(t, 2.5, *nutrition.JuniorHigh.Salt)
+				require.Nil(t, nutrition.Elementary.Protein)
+
+				require.Nil(t, nutritions[ids[1]])
+			},
+		},
+		{
+			name: "Empty",
+			buildStub: func(query *mocks.MockQuery) {
+				query.EXPECT().ListMenuNutritionsByMenuIDs(gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, nutritions map[string]*domain.MenuNutrition, err error) {
+				require.NoError(t, err)
+				require.Empty(t, nutritions)
+			},
+		},
+		{
+			name: "NG",
+			ids:  ids,
+			buildStub: func(query *mocks.MockQuery) {
+				query.EXPECT().ListMenuNutritionsByMenuIDs(gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrConnDone)
+			},
+			check: func(t *testing.T, nutritions map[string]*domain.MenuNutrition, err error) {
+				require.ErrorIs(t, err, sql.ErrConnDone)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			query := mocks.NewMockQuery(ctrl)
+			tc.buildStub(query)
+
+			repo := NewNutritionRepository(query)
+
+			nutritions, err := repo.FetchByMenuIDs(context.Background(), tc.ids)
+
+			tc.check(t, nutritions, err)
+		})
+	}
+}
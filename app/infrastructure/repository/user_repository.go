@@ -0,0 +1,129 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/ogurilab/school-lunch-api/domain"
+	db "github.com/ogurilab/school-lunch-api/infrastructure/db/sqlc"
+)
+
+type userRepository struct {
+	query db.Query
+}
+
+func NewUserRepository(query db.Query) domain.UserRepository {
+	return &userRepository{
+		query: query,
+	}
+}
+
+func (r *userRepository) Create(ctx context.Context, user *domain.User) (*domain.User, error) {
+	arg := db.CreateUserParams{
+		Username:       user.Username,
+		HashedPassword: user.HashedPassword,
+		Email:          user.Email,
+		Role:           user.Role,
+		CityCode:       user.CityCode,
+	}
+
+	id, err := r.query.CreateUser(ctx, arg)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return r.GetByID(ctx, int32(id))
+}
+
+func (r *userRepository) GetByID(ctx context.Context, id int32) (*domain.User, error) {
+
+	result, err := r.query.GetUser(ctx, id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return reNewUser(result), nil
+}
+
+func (r *userRepository) GetByUsername(ctx context.Context, username string) (*domain.User, error) {
+
+	result, err := r.query.GetUserByUsername(ctx, username)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return reNewUser(result), nil
+}
+
+func (r *userRepository) Fetch(ctx context.Context, limit int32, offset int32) ([]*domain.User, error) {
+	arg := db.ListUsersParams{
+		Limit:  limit,
+		Offset: offset,
+	}
+
+	results, err := r.query.ListUsers(ctx, arg)
+
+	if err != nil {
+		return nil, err
+	}
+
+	users := make([]*domain.User, 0, len(results))
+
+	for _, result := range results {
+		users = append(users, reNewUser(result))
+	}
+
+	return users, nil
+}
+
+func (r *userRepository) Delete(ctx context.Context, id int32) error {
+	return r.query.DeleteUserTx(ctx, id)
+}
+
+func (r *userRepository) CreateSession(ctx context.Context, session *domain.Session) error {
+	arg := db.CreateSessionParams{
+		ID:        session.ID,
+		UserID:    session.UserID,
+		ExpiresAt: session.ExpiresAt,
+	}
+
+	return r.query.CreateSession(ctx, arg)
+}
+
+func (r *userRepository) GetSession(ctx context.Context, id string) (*domain.Session, error) {
+
+	result, err := r.query.GetSession(ctx, id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.Session{
+		ID:        result.ID,
+		UserID:    result.UserID,
+		Revoked:   result.Revoked,
+		ExpiresAt: result.ExpiresAt,
+		CreatedAt: result.CreatedAt,
+	}, nil
+}
+
+func (r *userRepository) RevokeSessions(ctx context.Context, userID int32) error {
+
+	_, err := r.query.RevokeSessionsByUser(ctx, userID)
+
+	return err
+}
+
+func reNewUser(result db.User) *domain.User {
+	return domain.ReNewUser(
+		result.ID,
+		result.Username,
+		result.HashedPassword,
+		result.Email,
+		result.Role,
+		result.CityCode,
+		result.CreatedAt,
+	)
+}
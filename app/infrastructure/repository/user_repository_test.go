@@ -0,0 +1,134 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/ogurilab/school-lunch-api/domain"
+	db "github.com/ogurilab/school-lunch-api/infrastructure/db/sqlc"
+	"github.com/ogurilab/school-lunch-api/infrastructure/db/sqlc/mocks"
+	"github.com/ogurilab/school-lunch-api/util"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCreateUser(t *testing.T) {
+	ctx := context.Background()
+	result := randomUserResult()
+
+	user := domain.NewUser(result.Username, result.Email, result.Role, result.CityCode)
+	user.HashedPassword = result.HashedPassword
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	query := mocks.NewMockQuery(ctrl)
+
+	arg := db.CreateUserParams{
+		Username:       result.Username,
+		HashedPassword: result.HashedPassword,
+		Email:          result.Email,
+		Role:           result.Role,
+		CityCode:       result.CityCode,
+	}
+
+	query.EXPECT().CreateUser(gomock.Any(), gomock.Eq(arg)).Times(1).Return(int64(result.ID), nil)
+	query.EXPECT().GetUser(gomock.Any(), gomock.Eq(result.ID)).Times(1).Return(result, nil)
+
+	repo := NewUserRepository(query)
+
+	created, err := repo.Create(ctx, user)
+
+	require.NoError(t, err)
+	require.Equal(t, result.ID, created.ID)
+	require.Equal(t, result.HashedPassword, created.HashedPassword)
+	require.Equal(t, result.CreatedAt, created.CreatedAt)
+}
+
+func TestGetSession(t *testing.T) {
+	ctx := context.Background()
+
+	result := db.Session{
+		ID:        util.NewUlid(),
+		UserID:    util.RandomInt32() + 1,
+		Revoked:   true,
+		ExpiresAt: time.Now().Add(time.Hour),
+		CreatedAt: time.Now(),
+	}
+
+	testCases := []struct {
+		name      string
+		buildStub func(query *mocks.MockQuery)
+		check     func(t *testing.T, session *domain.Session, err error)
+	}{
+		{
+			name: "OK",
+			buildStub: func(query *mocks.MockQuery) {
+				query.EXPECT().GetSession(gomock.Any(), gomock.Eq(result.ID)).Times(1).Return(result, nil)
+			},
+			check: func(t *testing.T, session *domain.Session, err error) {
+				require.NoError(t, err)
+				require.Equal(t, result.ID, session.ID)
+				require.Equal(t, result.UserID, session.UserID)
+				require.True(t, session.Revoked)
+			},
+		},
+		{
+			name: "Not Found",
+			buildStub: func(query *mocks.MockQuery) {
+				query.EXPECT().GetSession(gomock.Any(), gomock.Eq(result.ID)).Times(1).Return(db.Session{}, sql.ErrNoRows)
+			},
+			check: func(t *testing.T, session *domain.Session, err error) {
+				require.ErrorIs(t, err, sql.ErrNoRows)
+				require.Nil(t, session)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			query := mocks.NewMockQuery(ctrl)
+			tc.buildStub(query)
+
+			repo := NewUserRepository(query)
+
+			session, err := repo.GetSession(ctx, result.ID)
+
+			tc.check(t, session, err)
+		})
+	}
+}
+
+func TestDeleteUser(t *testing.T) {
+	ctx := context.Background()
+	id := util.RandomInt32() + 1
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	query := mocks.NewMockQuery(ctrl)
+	query.EXPECT().DeleteUserTx(gomock.Any(), gomock.Eq(id)).Times(1).Return(sql.ErrNoRows)
+
+	repo := NewUserRepository(query)
+
+	err := repo.Delete(ctx, id)
+
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func randomUserResult() db.User {
+	return db.User{
+		ID:             util.RandomInt32() + 1,
+		Username:       util.RandomString(10),
+		HashedPassword: util.RandomString(60),
+		Email:          util.RandomString(10) + "@example.com",
+		Role:           domain.UserRoleMunicipality,
+		CreatedAt:      time.Now(),
+		CityCode:       util.RandomCityCode(),
+	}
+}
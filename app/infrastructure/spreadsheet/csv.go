@@ -0,0 +1,60 @@
+package spreadsheet
+
+import (
+	"encoding/csv"
+	"io"
+	"strings"
+)
+
+// Excel で開いても文字化けしないよう、UTF-8 の BOM を先頭に付ける
+const utf8BOM = "\ufeff"
+
+// formulaPrefixes で始まるセルは表計算ソフトで数式として実行されるため、先頭に ' を付けて文字列として扱わせる
+// XLSX はセルを文字列として書き出すため対象外
+const (
+	formulaPrefixes = "=+-@\t\r"
+	formulaEscape   = "'"
+)
+
+type csvWriter struct {
+	w *csv.Writer
+}
+
+func newCSVWriter(w io.Writer) (*csvWriter, error) {
+	if _, err := io.WriteString(w, utf8BOM); err != nil {
+		return nil, err
+	}
+
+	cw := csv.NewWriter(w)
+	cw.UseCRLF = true
+
+	return &csvWriter{w: cw}, nil
+}
+
+func (cw *csvWriter) WriteRow(cells []string) error {
+	escaped := make([]string, len(cells))
+
+	for i, cell := range cells {
+		escaped[i] = escapeFormula(cell)
+	}
+
+	return cw.w.Write(escaped)
+}
+
+func escapeFormula(cell string) string {
+	if cell != "" && strings.ContainsRune(formulaPrefixes, rune(cell[0])) {
+		return formulaEscape + cell
+	}
+
+	return cell
+}
+
+func (cw *csvWriter) Flush() error {
+	cw.w.Flush()
+
+	return cw.w.Error()
+}
+
+func (cw *csvWriter) Close() error {
+	return cw.Flush()
+}
@@ -0,0 +1,25 @@
+package spreadsheet
+
+import (
+	"io"
+
+	"github.com/ogurilab/school-lunch-api/domain"
+)
+
+type encoder struct{}
+
+// NewEncoder は CSV と XLSX を1行ずつ書き出す SpreadsheetEncoder を返す
+func NewEncoder() domain.SpreadsheetEncoder {
+	return &encoder{}
+}
+
+func (e *encoder) NewWriter(w io.Writer, format domain.ExportFormat, sheetName string) (domain.SpreadsheetWriter, error) {
+	switch format {
+	case domain.ExportFormatCSV:
+		return newCSVWriter(w)
+	case domain.ExportFormatXLSX:
+		return newXLSXWriter(w, sheetName)
+	default:
+		return nil, domain.ErrUnsupportedExportFormat
+	}
+}
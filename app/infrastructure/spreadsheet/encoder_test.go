@@ -0,0 +1,165 @@
+package spreadsheet
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/csv"
+	"encoding/xml"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/ogurilab/school-lunch-api/domain"
+	"github.com/stretchr/testify/require"
+)
+
+var testRows = [][]string{
+	{"提供日", "献立", "アレルゲン", "小学校エネルギー", "中学校エネルギー"},
+	{"2024-05-01", "ごはん|えびフライ, タルタルソース", "|えび;卵", "620", "820"},
+	{"2024-05-02", "パン & \"スープ\"", "", "600", "0800"},
+}
+
+func TestWriteCSV(t *testing.T) {
+	var buf bytes.Buffer
+
+	writeRows(t, &buf, domain.ExportFormatCSV)
+
+	body := buf.String()
+	require.True(t, strings.HasPrefix(body, utf8BOM))
+	require.Contains(t, body, "\r\n")
+
+	records, err := csv.NewReader(strings.NewReader(strings.TrimPrefix(body, utf8BOM))).ReadAll()
+
+	require.NoError(t, err)
+	require.Equal(t, testRows, records)
+}
+
+func TestWriteCSVEscapeFormula(t *testing.T) {
+	var buf bytes.Buffer
+
+	enc := NewEncoder()
+	w, err := enc.NewWriter(&buf, domain.ExportFormatCSV, "2024-05")
+	require.NoError(t, err)
+
+	require.NoError(t, w.WriteRow([]string{"=HYPERLINK(\"https://example.com\")", "+1", "-1", "@SUM(A1)", "\tcmd", "ごはん", "", "1=1"}))
+	require.NoError(t, w.Close())
+
+	records, err := csv.NewReader(strings.NewReader(strings.TrimPrefix(buf.String(), utf8BOM))).ReadAll()
+
+	require.NoError(t, err)
+	require.Equal(t, [][]string{{"'=HYPERLINK(\"https://example.com\")", "'+1", "'-1", "'@SUM(A1)", "'\tcmd", "ごはん", "", "1=1"}}, records)
+}
+
+func TestWriteXLSX(t *testing.T) {
+	var buf bytes.Buffer
+
+	writeRows(t, &buf, domain.ExportFormatXLSX)
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+
+	files := make(map[string][]byte, len(zr.File))
+
+	for _, f := range zr.File {
+		rc, err := f.Open()
+		require.NoError(t, err)
+
+		b, err := io.ReadAll(rc)
+		require.NoError(t, err)
+		require.NoError(t, rc.Close())
+
+		files[f.Name] = b
+	}
+
+	for _, name := range []string{"[Content_Types].xml", "_rels/.rels", "xl/workbook.xml", "xl/_rels/workbook.xml.rels", "xl/worksheets/sheet1.xml"} {
+		require.Contains(t, files, name)
+	}
+
+	var workbook struct {
+		Sheets []struct {
+			Name string `xml:"name,attr"`
+		} `xml:"sheets>sheet"`
+	}
+
+	require.NoError(t, xml.Unmarshal(files["xl/workbook.xml"], &workbook))
+	require.Len(t, workbook.Sheets, 1)
+	require.Equal(t, "2024-05", workbook.Sheets[0].Name)
+
+	var sheet struct {
+		Rows []struct {
+			R     int `xml:"r,attr"`
+			Cells []struct {
+				R      string `xml:"r,attr"`
+				T      string `xml:"t,attr"`
+				V      string `xml:"v"`
+				Inline string `xml:"is>t"`
+			} `xml:"c"`
+		} `xml:"sheetData>row"`
+	}
+
+	require.NoError(t, xml.Unmarshal(files["xl/worksheets/sheet1.xml"], &sheet))
+	require.Len(t, sheet.Rows, len(testRows))
+
+	for i, row := range sheet.Rows {
+		require.Equal(t, i+1, row.R)
+		require.Len(t, row.Cells, len(testRows[i]))
+
+		for j, cell := range row.Cells {
+			if cell.T == "inlineStr" {
+				require.Equal(t, testRows[i][j], cell.Inline)
+			} else {
+				require.Equal(t, testRows[i][j], cell.V)
+			}
+		}
+	}
+
+	// 整数は数値、先頭に0の付く値は文字列として書き出す
+	require.Equal(t, "D2", sheet.Rows[1].Cells[3].R)
+	require.Empty(t, sheet.Rows[1].Cells[3].T)
+	require.Equal(t, "inlineStr", sheet.Rows[2].Cells[4].T)
+}
+
+func TestWriteIsDeterministic(t *testing.T) {
+	for _, format := range []domain.ExportFormat{domain.ExportFormatCSV, domain.ExportFormatXLSX} {
+		var first, second bytes.Buffer
+
+		writeRows(t, &first, format)
+		writeRows(t, &second, format)
+
+		require.Equal(t, first.Bytes(), second.Bytes())
+	}
+}
+
+func TestNewWriterUnsupportedFormat(t *testing.T) {
+	var buf bytes.Buffer
+
+	_, err := NewEncoder().NewWriter(&buf, domain.ExportFormat("pdf"), "2024-05")
+
+	require.ErrorIs(t, err, domain.ErrUnsupportedExportFormat)
+	require.Empty(t, buf.Bytes())
+}
+
+func TestColumnName(t *testing.T) {
+	require.Equal(t, "A", columnName(0))
+	require.Equal(t, "Z", columnName(25))
+	require.Equal(t, "AA", columnName(26))
+	require.Equal(t, "AZ", columnName(51))
+	require.Equal(t, "BA", columnName(52))
+}
+
+func TestSanitizeSheetName(t *testing.T) {
+	require.Equal(t, "202405", sanitizeSheetName("2024/05"))
+	require.Equal(t, "Sheet1", sanitizeSheetName("[]"))
+	require.Len(t, []rune(sanitizeSheetName(strings.Repeat("献", 40))), maxSheetNameLength)
+}
+
+func writeRows(t *testing.T, w io.Writer, format domain.ExportFormat) {
+	writer, err := NewEncoder().NewWriter(w, format, "2024-05")
+	require.NoError(t, err)
+
+	for _, row := range testRows {
+		require.NoError(t, writer.WriteRow(row))
+	}
+
+	require.NoError(t, writer.Close())
+}
@@ -0,0 +1,195 @@
+package spreadsheet
+
+import (
+	"archive/zip"
+	"bufio"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxSheetNameLength は Excel のシート名の最大文字数
+const maxSheetNameLength = 31
+
+const xlsxContentTypes = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+	`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+	`<Default Extension="xml" ContentType="application/xml"/>` +
+	`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>` +
+	`<Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>` +
+	`</Types>`
+
+const xlsxRootRels = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+	`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>` +
+	`</Relationships>`
+
+const xlsxWorkbookRels = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+	`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/>` +
+	`</Relationships>`
+
+const xlsxWorkbook = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">` +
+	`<sheets><sheet name="%s" sheetId="1" r:id="rId1"/></sheets>` +
+	`</workbook>`
+
+const (
+	xlsxSheetHeader = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`
+	xlsxSheetFooter = `</sheetData></worksheet>`
+)
+
+// xlsxWriter は1枚のシートだけを持つ XLSX を書き出す
+// シートは ZIP の最後のエントリとし、行を書くたびにそのまま圧縮して書き出す
+type xlsxWriter struct {
+	zw    *zip.Writer
+	sheet *bufio.Writer
+	row   int
+}
+
+func newXLSXWriter(w io.Writer, sheetName string) (*xlsxWriter, error) {
+	zw := zip.NewWriter(w)
+
+	parts := []struct {
+		name string
+		body string
+	}{
+		{name: "[Content_Types].xml", body: xlsxContentTypes},
+		{name: "_rels/.rels", body: xlsxRootRels},
+		{name: "xl/workbook.xml", body: fmt.Sprintf(xlsxWorkbook, escapeXML(sanitizeSheetName(sheetName)))},
+		{name: "xl/_rels/workbook.xml.rels", body: xlsxWorkbookRels},
+	}
+
+	for _, part := range parts {
+		f, err := createXLSXPart(zw, part.name)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if _, err := io.WriteString(f, part.body); err != nil {
+			return nil, err
+		}
+	}
+
+	f, err := createXLSXPart(zw, "xl/worksheets/sheet1.xml")
+
+	if err != nil {
+		return nil, err
+	}
+
+	sheet := bufio.NewWriter(f)
+
+	if _, err := sheet.WriteString(xlsxSheetHeader); err != nil {
+		return nil, err
+	}
+
+	return &xlsxWriter{zw: zw, sheet: sheet}, nil
+}
+
+// createXLSXPart は同じ内容から同じファイルになるよう、更新日時を固定してエントリを作る
+func createXLSXPart(zw *zip.Writer, name string) (io.Writer, error) {
+	return zw.CreateHeader(&zip.FileHeader{
+		Name:     name,
+		Method:   zip.Deflate,
+		Modified: time.Date(1980, 1, 1, 0, 0, 0, 0, time.UTC),
+	})
+}
+
+// WriteRow は整数の値を数値、それ以外を文字列のセルとして書き出す
+func (xw *xlsxWriter) WriteRow(cells []string) error {
+	xw.row++
+
+	var b strings.Builder
+
+	fmt.Fprintf(&b, `<row r="%d">`, xw.row)
+
+	for i, cell := range cells {
+		ref := columnName(i) + strconv.Itoa(xw.row)
+
+		if isInteger(cell) {
+			fmt.Fprintf(&b, `<c r="%s"><v>%s</v></c>`, ref, cell)
+			continue
+		}
+
+		fmt.Fprintf(&b, `<c r="%s" t="inlineStr"><is><t xml:space="preserve">%s</t></is></c>`, ref, escapeXML(cell))
+	}
+
+	b.WriteString(`</row>`)
+
+	_, err := xw.sheet.WriteString(b.String())
+
+	return err
+}
+
+func (xw *xlsxWriter) Flush() error {
+	if err := xw.sheet.Flush(); err != nil {
+		return err
+	}
+
+	return xw.zw.Flush()
+}
+
+func (xw *xlsxWriter) Close() error {
+	if _, err := xw.sheet.WriteString(xlsxSheetFooter); err != nil {
+		return err
+	}
+
+	if err := xw.sheet.Flush(); err != nil {
+		return err
+	}
+
+	return xw.zw.Close()
+}
+
+// columnName は0始まりの列番号を A, B, ..., Z, AA の形式にする
+func columnName(i int) string {
+	name := ""
+
+	for i++; i > 0; i = (i - 1) / 26 {
+		name = string(rune('A'+(i-1)%26)) + name
+	}
+
+	return name
+}
+
+// isInteger は先頭に0の付かない整数かどうかを返す
+// 先頭が0の値は郵便番号などの可能性があるため文字列のまま書き出す
+func isInteger(s string) bool {
+	n, err := strconv.ParseInt(s, 10, 64)
+
+	return err == nil && strconv.FormatInt(n, 10) == s
+}
+
+func escapeXML(s string) string {
+	var b strings.Builder
+
+	xml.EscapeText(&b, []byte(s))
+
+	return b.String()
+}
+
+// sanitizeSheetName は Excel のシート名に使えない文字を取り除く
+func sanitizeSheetName(name string) string {
+	name = strings.Map(func(r rune) rune {
+		if strings.ContainsRune(`[]:*?/\`, r) {
+			return -1
+		}
+
+		return r
+	}, name)
+
+	if name == "" {
+		return "Sheet1"
+	}
+
+	if r := []rune(name); len(r) > maxSheetNameLength {
+		return string(r[:maxSheetNameLength])
+	}
+
+	return name
+}
@@ -0,0 +1,96 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ogurilab/school-lunch-api/domain"
+)
+
+type localStorage struct {
+	dir     string
+	baseURL string
+}
+
+// NewLocalStorage はローカルのディレクトリにファイルを保存する Storage を返す
+// 開発環境やテストでネットワークを使わずに動かすためのもので、dir は baseURL で配信する
+func NewLocalStorage(dir string, baseURL string) domain.Storage {
+	return &localStorage{
+		dir:     dir,
+		baseURL: strings.TrimRight(baseURL, "/"),
+	}
+}
+
+func (s *localStorage) Put(ctx context.Context, key string, body []byte, contentType string) (string, error) {
+	path, err := s.path(key)
+
+	if err != nil {
+		return "", err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return "", err
+	}
+
+	if err := os.WriteFile(path, body, 0o644); err != nil {
+		return "", err
+	}
+
+	return s.baseURL + "/" + key, nil
+}
+
+func (s *localStorage) Get(ctx context.Context, key string) ([]byte, error) {
+	path, err := s.path(key)
+
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := os.Stat(path)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if info.Size() > domain.MaxPhotoSize {
+		return nil, domain.ErrPhotoTooLarge
+	}
+
+	return os.ReadFile(path)
+}
+
+func (s *localStorage) Delete(ctx context.Context, key string) error {
+	path, err := s.path(key)
+
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	return nil
+}
+
+func (s *localStorage) Key(url string) (string, bool) {
+	key, ok := strings.CutPrefix(url, s.baseURL+"/")
+
+	return key, ok && key != ""
+}
+
+// path はキーを保存先のパスに変換する
+// dir の外を指すキーはエラーにする
+func (s *localStorage) path(key string) (string, error) {
+	root := filepath.Clean(s.dir)
+	path := filepath.Join(root, filepath.FromSlash(key))
+
+	if !strings.HasPrefix(path, root+string(filepath.Separator)) {
+		return "", fmt.Errorf("invalid storage key: %s", key)
+	}
+
+	return path, nil
+}
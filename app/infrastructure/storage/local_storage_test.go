@@ -0,0 +1,57 @@
+package storage
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalStorage(t *testing.T) {
+	dir := t.TempDir()
+	s := NewLocalStorage(dir, "http://localhost:8080/storage/")
+
+	body := []byte("photo")
+
+	url, err := s.Put(context.Background(), "menus/1/photo.jpg", body, "image/jpeg")
+	require.NoError(t, err)
+	require.Equal(t, "http://localhost:8080/storage/menus/1/photo.jpg", url)
+
+	b, err := os.ReadFile(filepath.Join(dir, "menus", "1", "photo.jpg"))
+	require.NoError(t, err)
+	require.Equal(t, body, b)
+
+	key, ok := s.Key(url)
+	require.True(t, ok)
+	require.Equal(t, "menus/1/photo.jpg", key)
+
+	b, err = s.Get(context.Background(), key)
+	require.NoError(t, err)
+	require.Equal(t, body, b)
+
+	_, ok = s.Key("https://example.com/menus/1/photo.jpg")
+	require.False(t, ok)
+
+	require.NoError(t, s.Delete(context.Background(), "menus/1/photo.jpg"))
+
+	_, err = os.Stat(filepath.Join(dir, "menus", "1", "photo.jpg"))
+	require.True(t, os.IsNotExist(err))
+
+	_, err = s.Get(context.Background(), "menus/1/photo.jpg")
+	require.True(t, os.IsNotExist(err))
+
+	// 存在しないファイルの削除はエラーにしない
+	require.NoError(t, s.Delete(context.Background(), "menus/1/photo.jpg"))
+}
+
+func TestLocalStorageInvalidKey(t *testing.T) {
+	s := NewLocalStorage(t.TempDir(), "http://localhost:8080/storage")
+
+	_, err := s.Put(context.Background(), "../photo.jpg", []byte("photo"), "image/jpeg")
+	require.Error(t, err)
+
+	_, err = s.Get(context.Background(), "../photo.jpg")
+	require.Error(t, err)
+}
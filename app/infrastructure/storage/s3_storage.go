@@ -0,0 +1,158 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ogurilab/school-lunch-api/domain"
+)
+
+const defaultRequestTimeout = 60 * time.Second
+
+// S3Config は S3 互換のオブジェクトストレージへの接続情報
+// Cloudflare R2 の場合、Endpoint は https://<ACCOUNT_ID>.r2.cloudflarestorage.com、Region は auto になる
+type S3Config struct {
+	Endpoint        string
+	Region          string
+	Bucket          string
+	AccessKeyID     string
+	SecretAccessKey string
+	// PublicURL は保存したファイルを配信するURL
+	PublicURL string
+}
+
+type s3Storage struct {
+	client      *http.Client
+	config      S3Config
+	credentials credentials
+	now         func() time.Time
+}
+
+// NewS3Storage は S3 互換のオブジェクトストレージにファイルを保存する Storage を返す
+// client が nil の場合はタイムアウトを設定したクライアントを使用する
+func NewS3Storage(client *http.Client, config S3Config) domain.Storage {
+	if client == nil {
+		client = &http.Client{Timeout: defaultRequestTimeout}
+	}
+
+	if config.Region == "" {
+		config.Region = "auto"
+	}
+
+	config.Endpoint = strings.TrimRight(config.Endpoint, "/")
+	config.PublicURL = strings.TrimRight(config.PublicURL, "/")
+
+	return &s3Storage{
+		client: client,
+		config: config,
+		credentials: credentials{
+			accessKeyID:     config.AccessKeyID,
+			secretAccessKey: config.SecretAccessKey,
+			region:          config.Region,
+			service:         "s3",
+		},
+		now: time.Now,
+	}
+}
+
+func (s *s3Storage) Put(ctx context.Context, key string, body []byte, contentType string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, s.objectURL(key), bytes.NewReader(body))
+
+	if err != nil {
+		return "", err
+	}
+
+	req.Header.Set("Content-Type", contentType)
+
+	if err := s.do(req, hashSHA256(body)); err != nil {
+		return "", err
+	}
+
+	return s.config.PublicURL + "/" + key, nil
+}
+
+func (s *s3Storage) Get(ctx context.Context, key string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.objectURL(key), nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := s.send(req, hashSHA256(nil))
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(res.Body, domain.MaxPhotoSize+1))
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(body) > domain.MaxPhotoSize {
+		return nil, domain.ErrPhotoTooLarge
+	}
+
+	return body, nil
+}
+
+func (s *s3Storage) Delete(ctx context.Context, key string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, s.objectURL(key), nil)
+
+	if err != nil {
+		return err
+	}
+
+	return s.do(req, hashSHA256(nil))
+}
+
+func (s *s3Storage) Key(url string) (string, bool) {
+	key, ok := strings.CutPrefix(url, s.config.PublicURL+"/")
+
+	return key, ok && key != ""
+}
+
+// objectURL はパス形式でオブジェクトのURLを返す
+func (s *s3Storage) objectURL(key string) string {
+	return s.config.Endpoint + "/" + s.config.Bucket + "/" + key
+}
+
+func (s *s3Storage) do(req *http.Request, payloadHash string) error {
+	res, err := s.send(req, payloadHash)
+
+	if err != nil {
+		return err
+	}
+
+	return res.Body.Close()
+}
+
+// send は署名したリクエストを送り、成功した場合はレスポンスを返す
+// レスポンスの Body は呼び出し元で閉じる
+func (s *s3Storage) send(req *http.Request, payloadHash string) (*http.Response, error) {
+	s.credentials.sign(req, payloadHash, s.now())
+
+	res, err := s.client.Do(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode < http.StatusOK || res.StatusCode >= http.StatusMultipleChoices {
+		defer res.Body.Close()
+
+		b, _ := io.ReadAll(io.LimitReader(res.Body, 1024))
+
+		return nil, fmt.Errorf("failed to %s %s: %s %s", req.Method, req.URL.Path, res.Status, strings.TrimSpace(string(b)))
+	}
+
+	return res, nil
+}
@@ -0,0 +1,147 @@
+package storage
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestS3StoragePut(t *testing.T) {
+	body := []byte("photo")
+
+	testCases := []struct {
+		name   string
+		status int
+		check  func(t *testing.T, url string, err error)
+	}{
+		{
+			name:   "OK",
+			status: http.StatusOK,
+			check: func(t *testing.T, url string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "https://photos.example.com/menus/1/photo.jpg", url)
+			},
+		},
+		{
+			name:   "Forbidden",
+			status: http.StatusForbidden,
+			check: func(t *testing.T, url string, err error) {
+				require.Error(t, err)
+				require.Empty(t, url)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, http.MethodPut, r.Method)
+				require.Equal(t, "/bucket/menus/1/photo.jpg", r.URL.Path)
+				require.Equal(t, "image/jpeg", r.Header.Get("Content-Type"))
+				require.Equal(t, hashSHA256(body), r.Header.Get(headerAmzContentSha256))
+				require.True(t, strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=access/"))
+				require.Contains(t, r.Header.Get("Authorization"), "/auto/s3/aws4_request,SignedHeaders=content-type;host;")
+
+				b, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+				require.Equal(t, body, b)
+
+				w.WriteHeader(tc.status)
+			}))
+			defer server.Close()
+
+			s := NewS3Storage(server.Client(), S3Config{
+				Endpoint:        server.URL + "/",
+				Bucket:          "bucket",
+				AccessKeyID:     "access",
+				SecretAccessKey: "secret",
+				PublicURL:       "https://photos.example.com/",
+			})
+
+			url, err := s.Put(context.Background(), "menus/1/photo.jpg", body, "image/jpeg")
+
+			tc.check(t, url, err)
+		})
+	}
+}
+
+func TestS3StorageGet(t *testing.T) {
+	body := []byte("photo")
+
+	testCases := []struct {
+		name   string
+		status int
+		check  func(t *testing.T, b []byte, err error)
+	}{
+		{
+			name:   "OK",
+			status: http.StatusOK,
+			check: func(t *testing.T, b []byte, err error) {
+				require.NoError(t, err)
+				require.Equal(t, body, b)
+			},
+		},
+		{
+			name:   "Not Found",
+			status: http.StatusNotFound,
+			check: func(t *testing.T, b []byte, err error) {
+				require.Error(t, err)
+				require.Nil(t, b)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, http.MethodGet, r.Method)
+				require.Equal(t, "/bucket/menus/1/photo.jpg", r.URL.Path)
+				require.Equal(t, hashSHA256(nil), r.Header.Get(headerAmzContentSha256))
+
+				w.WriteHeader(tc.status)
+				w.Write(body)
+			}))
+			defer server.Close()
+
+			s := NewS3Storage(server.Client(), S3Config{
+				Endpoint:        server.URL,
+				Bucket:          "bucket",
+				AccessKeyID:     "access",
+				SecretAccessKey: "secret",
+				PublicURL:       "https://photos.example.com",
+			})
+
+			key, ok := s.Key("https://photos.example.com/menus/1/photo.jpg")
+			require.True(t, ok)
+
+			b, err := s.Get(context.Background(), key)
+
+			tc.check(t, b, err)
+		})
+	}
+}
+
+func TestS3StorageDelete(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodDelete, r.Method)
+		require.Equal(t, "/bucket/menus/1/photo.jpg", r.URL.Path)
+		require.Equal(t, hashSHA256(nil), r.Header.Get(headerAmzContentSha256))
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	s := NewS3Storage(server.Client(), S3Config{
+		Endpoint:        server.URL,
+		Bucket:          "bucket",
+		AccessKeyID:     "access",
+		SecretAccessKey: "secret",
+	})
+
+	require.NoError(t, s.Delete(context.Background(), "menus/1/photo.jpg"))
+}
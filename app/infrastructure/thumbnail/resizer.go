@@ -0,0 +1,92 @@
+package thumbnail
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/jpeg"
+	_ "image/png"
+
+	"github.com/ogurilab/school-lunch-api/domain"
+	"golang.org/x/image/draw"
+	_ "golang.org/x/image/webp"
+)
+
+const (
+	DefaultQuality = 80
+
+	// 展開後のサイズが大きすぎる写真でメモリを使い切らないように、画素数を制限する
+	maxPixels = 40_000_000
+)
+
+type jpegResizer struct {
+	quality int
+}
+
+// NewJPEGResizer は JPEG・PNG・WebP の写真を縮小し、JPEG のサムネイルを作成する PhotoResizer を返す
+func NewJPEGResizer(quality int) domain.PhotoResizer {
+	if quality <= 0 || quality > 100 {
+		quality = DefaultQuality
+	}
+
+	return &jpegResizer{
+		quality: quality,
+	}
+}
+
+// Resize は写真を一度だけ展開し、縦横比を保ったまま各幅に縮小する
+func (r *jpegResizer) Resize(body []byte, widths []int) ([][]byte, error) {
+	config, _, err := image.DecodeConfig(bytes.NewReader(body))
+
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", domain.ErrInvalidPhoto, err)
+	}
+
+	if config.Width*config.Height > maxPixels {
+		return nil, fmt.Errorf("%w: %dx%d is too large", domain.ErrInvalidPhoto, config.Width, config.Height)
+	}
+
+	src, _, err := image.Decode(bytes.NewReader(body))
+
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", domain.ErrInvalidPhoto, err)
+	}
+
+	bounds := src.Bounds()
+	thumbnails := make([][]byte, 0, len(widths))
+
+	for _, width := range widths {
+		w, h := fit(bounds.Dx(), bounds.Dy(), width)
+		dst := image.NewRGBA(image.Rect(0, 0, w, h))
+
+		// JPEG は透過できないため、透過部分は白にする
+		draw.Draw(dst, dst.Bounds(), image.White, image.Point{}, draw.Src)
+		draw.CatmullRom.Scale(dst, dst.Bounds(), src, bounds, draw.Over, nil)
+
+		var buf bytes.Buffer
+
+		if err := jpeg.Encode(&buf, dst, &jpeg.Options{Quality: r.quality}); err != nil {
+			return nil, err
+		}
+
+		thumbnails = append(thumbnails, buf.Bytes())
+	}
+
+	return thumbnails, nil
+}
+
+// fit は幅を width に合わせた大きさを返す
+// 元の写真の幅が width 以下の場合は拡大しない
+func fit(srcWidth int, srcHeight int, width int) (int, int) {
+	if srcWidth <= width {
+		return srcWidth, srcHeight
+	}
+
+	height := srcHeight * width / srcWidth
+
+	if height < 1 {
+		height = 1
+	}
+
+	return width, height
+}
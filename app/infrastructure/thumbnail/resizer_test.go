@@ -0,0 +1,112 @@
+package thumbnail
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	"github.com/ogurilab/school-lunch-api/domain"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResize(t *testing.T) {
+	widths := []int{domain.SmallPhotoWidth, domain.MediumPhotoWidth, domain.LargePhotoWidth}
+
+	testCases := []struct {
+		name  string
+		body  []byte
+		check func(t *testing.T, thumbnails [][]byte, err error)
+	}{
+		{
+			name: "OK",
+			body: encodePNG(t, 2000, 1000),
+			check: func(t *testing.T, thumbnails [][]byte, err error) {
+				require.NoError(t, err)
+				require.Len(t, thumbnails, 3)
+
+				requireJPEGSize(t, thumbnails[0], 240, 120)
+				requireJPEGSize(t, thumbnails[1], 640, 320)
+				requireJPEGSize(t, thumbnails[2], 1280, 640)
+			},
+		},
+		{
+			name: "OK - Not Enlarged",
+			body: encodePNG(t, 300, 200),
+			check: func(t *testing.T, thumbnails [][]byte, err error) {
+				require.NoError(t, err)
+				require.Len(t, thumbnails, 3)
+
+				requireJPEGSize(t, thumbnails[0], 240, 160)
+				requireJPEGSize(t, thumbnails[1], 300, 200)
+				requireJPEGSize(t, thumbnails[2], 300, 200)
+			},
+		},
+		{
+			name: "OK - Transparent",
+			body: encodePNG(t, 100, 100),
+			check: func(t *testing.T, thumbnails [][]byte, err error) {
+				require.NoError(t, err)
+
+				img, err := jpeg.Decode(bytes.NewReader(thumbnails[0]))
+				require.NoError(t, err)
+
+				// 透過部分は白になる
+				r, g, b, _ := img.At(0, 0).RGBA()
+				require.Greater(t, r, uint32(0xe000))
+				require.Greater(t, g, uint32(0xe000))
+				require.Greater(t, b, uint32(0xe000))
+			},
+		},
+		{
+			name: "Invalid Photo",
+			body: []byte("\x89PNG\r\n\x1a\nbroken"),
+			check: func(t *testing.T, thumbnails [][]byte, err error) {
+				require.True(t, domain.IsInvalidPhoto(err))
+				require.Nil(t, thumbnails)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resizer := NewJPEGResizer(DefaultQuality)
+
+			thumbnails, err := resizer.Resize(tc.body, widths)
+
+			tc.check(t, thumbnails, err)
+		})
+	}
+}
+
+// encodePNG は左上が透明な PNG を作成する
+func encodePNG(t *testing.T, width int, height int) []byte {
+	img := image.NewNRGBA(image.Rect(0, 0, width, height))
+
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			if x < width/2 && y < height/2 {
+				continue
+			}
+
+			img.Set(x, y, color.NRGBA{R: 200, G: 80, B: 40, A: 255})
+		}
+	}
+
+	var buf bytes.Buffer
+
+	require.NoError(t, png.Encode(&buf, img))
+
+	return buf.Bytes()
+}
+
+func requireJPEGSize(t *testing.T, body []byte, width int, height int) {
+	config, format, err := image.DecodeConfig(bytes.NewReader(body))
+	require.NoError(t, err)
+
+	require.Equal(t, "jpeg", format)
+	require.Equal(t, width, config.Width)
+	require.Equal(t, height, config.Height)
+}
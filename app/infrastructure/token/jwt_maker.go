@@ -0,0 +1,110 @@
+package token
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/ogurilab/school-lunch-api/domain"
+	"github.com/ogurilab/school-lunch-api/util"
+)
+
+// HS256 の鍵として十分な長さ
+const minSecretKeySize = 32
+
+type jwtMaker struct {
+	secretKey []byte
+}
+
+type jwtClaims struct {
+	Username string `json:"username"`
+	Role     string `json:"role"`
+	CityCode int32  `json:"city_code"`
+	jwt.StandardClaims
+}
+
+// NewJWTMaker は HS256 で署名する TokenMaker を返す
+func NewJWTMaker(secretKey string) (domain.TokenMaker, error) {
+	if len(secretKey) < minSecretKeySize {
+		return nil, fmt.Errorf("invalid key size: must be at least %d characters", minSecretKeySize)
+	}
+
+	return &jwtMaker{
+		secretKey: []byte(secretKey),
+	}, nil
+}
+
+func (m *jwtMaker) CreateToken(user *domain.User, duration time.Duration) (string, *domain.TokenPayload, error) {
+	now := time.Now()
+
+	payload := &domain.TokenPayload{
+		ID:        util.NewUlid(),
+		UserID:    user.ID,
+		Username:  user.Username,
+		Role:      user.Role,
+		CityCode:  user.CityCode,
+		IssuedAt:  now.Truncate(time.Second),
+		ExpiredAt: now.Add(duration).Truncate(time.Second),
+	}
+
+	claims := jwtClaims{
+		Username: payload.Username,
+		Role:     payload.Role,
+		CityCode: payload.CityCode,
+		StandardClaims: jwt.StandardClaims{
+			Id:        payload.ID,
+			Subject:   strconv.Itoa(int(payload.UserID)),
+			IssuedAt:  payload.IssuedAt.Unix(),
+			ExpiresAt: payload.ExpiredAt.Unix(),
+		},
+	}
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(m.secretKey)
+
+	if err != nil {
+		return "", nil, err
+	}
+
+	return token, payload, nil
+}
+
+func (m *jwtMaker) VerifyToken(token string) (*domain.TokenPayload, error) {
+	keyFunc := func(t *jwt.Token) (interface{}, error) {
+		// alg を none や公開鍵方式に差し替えたトークンは受け付けない
+		if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, domain.ErrInvalidToken
+		}
+
+		return m.secretKey, nil
+	}
+
+	var claims jwtClaims
+
+	if _, err := jwt.ParseWithClaims(token, &claims, keyFunc); err != nil {
+		var vErr *jwt.ValidationError
+
+		if errors.As(err, &vErr) && vErr.Errors&jwt.ValidationErrorExpired != 0 {
+			return nil, domain.ErrExpiredToken
+		}
+
+		return nil, domain.ErrInvalidToken
+	}
+
+	userID, err := strconv.ParseInt(claims.Subject, 10, 32)
+
+	if err != nil || claims.Id == "" {
+		return nil, domain.ErrInvalidToken
+	}
+
+	return &domain.TokenPayload{
+		ID:        claims.Id,
+		UserID:    int32(userID),
+		Username:  claims.Username,
+		Role:      claims.Role,
+		CityCode:  claims.CityCode,
+		IssuedAt:  time.Unix(claims.IssuedAt, 0),
+		ExpiredAt: time.Unix(claims.ExpiresAt, 0),
+	}, nil
+}
@@ -0,0 +1,113 @@
+package token
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/ogurilab/school-lunch-api/domain"
+	"github.com/ogurilab/school-lunch-api/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJWTMaker(t *testing.T) {
+	maker, err := NewJWTMaker(util.RandomString(32))
+	require.NoError(t, err)
+
+	user := randomUser()
+	duration := time.Minute
+
+	token, payload, err := maker.CreateToken(user, duration)
+	require.NoError(t, err)
+	require.NotEmpty(t, token)
+	require.NotEmpty(t, payload.ID)
+
+	verified, err := maker.VerifyToken(token)
+	require.NoError(t, err)
+
+	require.Equal(t, payload.ID, verified.ID)
+	require.Equal(t, user.ID, verified.UserID)
+	require.Equal(t, user.Username, verified.Username)
+	require.Equal(t, user.Role, verified.Role)
+	require.Equal(t, user.CityCode, verified.CityCode)
+	require.WithinDuration(t, time.Now(), verified.IssuedAt, time.Second)
+	require.WithinDuration(t, time.Now().Add(duration), verified.ExpiredAt, time.Second)
+}
+
+func TestExpiredJWTToken(t *testing.T) {
+	maker, err := NewJWTMaker(util.RandomString(32))
+	require.NoError(t, err)
+
+	token, _, err := maker.CreateToken(randomUser(), -time.Minute)
+	require.NoError(t, err)
+
+	payload, err := maker.VerifyToken(token)
+	require.ErrorIs(t, err, domain.ErrExpiredToken)
+	require.Nil(t, payload)
+}
+
+func TestInvalidJWTToken(t *testing.T) {
+	maker, err := NewJWTMaker(util.RandomString(32))
+	require.NoError(t, err)
+
+	other, err := NewJWTMaker(util.RandomString(32))
+	require.NoError(t, err)
+
+	signedByOther, _, err := other.CreateToken(randomUser(), time.Minute)
+	require.NoError(t, err)
+
+	claims := jwtClaims{
+		StandardClaims: jwt.StandardClaims{
+			Id:        util.NewUlid(),
+			Subject:   "1",
+			ExpiresAt: time.Now().Add(time.Minute).Unix(),
+		},
+	}
+
+	none, err := jwt.NewWithClaims(jwt.SigningMethodNone, claims).SignedString(jwt.UnsafeAllowNoneSignatureType)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name  string
+		token string
+	}{
+		{
+			name:  "Other Key",
+			token: signedByOther,
+		},
+		{
+			name:  "None Algorithm",
+			token: none,
+		},
+		{
+			name:  "Malformed",
+			token: "invalid",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			payload, err := maker.VerifyToken(tc.token)
+			require.ErrorIs(t, err, domain.ErrInvalidToken)
+			require.Nil(t, payload)
+		})
+	}
+}
+
+func TestInvalidSecretKeySize(t *testing.T) {
+	maker, err := NewJWTMaker(util.RandomString(minSecretKeySize - 1))
+	require.Error(t, err)
+	require.Nil(t, maker)
+}
+
+func randomUser() *domain.User {
+	return domain.ReNewUser(
+		util.RandomInt32()+1,
+		util.RandomString(10),
+		"",
+		util.RandomString(10)+"@example.com",
+		domain.UserRoleMunicipality,
+		util.RandomCityCode(),
+		time.Now(),
+	)
+}
@@ -14,12 +14,16 @@ import (
 type adminController struct {
 	mu domain.MenuUsecase
 	du domain.DishUsecase
+	au domain.AllergenUsecase
+	cu domain.CityUsecase
 }
 
-func NewAdminController(mu domain.MenuUsecase, du domain.DishUsecase) domain.AdminController {
+func NewAdminController(mu domain.MenuUsecase, du domain.DishUsecase, au domain.AllergenUsecase, cu domain.CityUsecase) domain.AdminController {
 	return &adminController{
 		mu: mu,
 		du: du,
+		au: au,
+		cu: cu,
 	}
 }
 
@@ -28,7 +32,7 @@ type createMenuRequest struct {
 	PhotoUrl                 string `json:"photo_url" validate:"omitempty,url"`
 	ElementarySchoolCalories int32  `json:"elementary_school_calories" validate:"gt=0"`
 	JuniorHighSchoolCalories int32  `json:"junior_high_school_calories" validate:"gt=0"`
-	CityCode                 int32  `param:"code" validate:"required,gt=0"`
+	CityCode                 int32  `json:"city_code" param:"code" validate:"required,city_code"`
 }
 
 func (ac *adminController) CreateMenu(c echo.Context) error {
@@ -67,7 +71,218 @@ func (ac *adminController) CreateMenu(c echo.Context) error {
 		return c.JSON(errors.NewInternalServerError(err))
 	}
 
-	return c.NoContent(http.StatusCreated)
+	return c.JSON(http.StatusCreated, menu)
+}
+
+type updateMenuRequest struct {
+	ID string `param:"id" validate:"required,ulid"`
+	createMenuRequest
+}
+
+func (ac *adminController) UpdateMenu(c echo.Context) error {
+	var req updateMenuRequest
+
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	return ac.updateMenu(c, req.ID, req.createMenuRequest)
+}
+
+type patchMenuRequest struct {
+	ID                       string  `param:"id" validate:"required,ulid"`
+	OfferedAt                *string `json:"offered_at"`
+	PhotoUrl                 *string `json:"photo_url"`
+	ElementarySchoolCalories *int32  `json:"elementary_school_calories"`
+	JuniorHighSchoolCalories *int32  `json:"junior_high_school_calories"`
+	CityCode                 *int32  `json:"city_code"`
+}
+
+func (ac *adminController) PatchMenu(c echo.Context) error {
+	var req patchMenuRequest
+
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	ctx := c.Request().Context()
+
+	menu, err := ac.mu.FindByID(ctx, req.ID)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return c.JSON(errors.NewNotFoundError(err))
+		}
+
+		return c.JSON(errors.NewInternalServerError(err))
+	}
+
+	// 指定されたフィールドのみを既存のメニューに上書きし、作成時と同じルールで検証する
+	merged := createMenuRequest{
+		OfferedAt:                util.FormatDate(menu.OfferedAt),
+		PhotoUrl:                 menu.PhotoUrl.String,
+		ElementarySchoolCalories: menu.ElementarySchoolCalories,
+		JuniorHighSchoolCalories: menu.JuniorHighSchoolCalories,
+		CityCode:                 menu.CityCode,
+	}
+
+	if req.OfferedAt != nil {
+		merged.OfferedAt = *req.OfferedAt
+	}
+
+	if req.PhotoUrl != nil {
+		merged.PhotoUrl = *req.PhotoUrl
+	}
+
+	if req.ElementarySchoolCalories != nil {
+		merged.ElementarySchoolCalories = *req.ElementarySchoolCalories
+	}
+
+	if req.JuniorHighSchoolCalories != nil {
+		merged.JuniorHighSchoolCalories = *req.JuniorHighSchoolCalories
+	}
+
+	if req.CityCode != nil {
+		merged.CityCode = *req.CityCode
+	}
+
+	if err := c.Validate(&merged); err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	return ac.updateMenu(c, req.ID, merged)
+}
+
+func (ac *adminController) updateMenu(c echo.Context, id string, req createMenuRequest) error {
+	ctx := c.Request().Context()
+
+	offeredAt, err := util.ParseDate(req.OfferedAt)
+
+	if err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	menu, err := domain.ReNewMenu(
+		id,
+		offeredAt,
+		sql.NullString{String: req.PhotoUrl, Valid: req.PhotoUrl != ""},
+		req.ElementarySchoolCalories,
+		req.JuniorHighSchoolCalories,
+		req.CityCode,
+	)
+
+	if err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	if err := ac.mu.Update(ctx, menu); err != nil {
+		if err == sql.ErrNoRows {
+			return c.JSON(errors.NewNotFoundError(err))
+		}
+
+		if util.IsDuplicateEntry(err) {
+			return c.JSON(errors.NewConflictError(err))
+		}
+
+		return c.JSON(errors.NewInternalServerError(err))
+	}
+
+	return c.JSON(http.StatusOK, menu)
+}
+
+type deleteMenuRequest struct {
+	ID string `param:"id" validate:"required,ulid"`
+}
+
+func (ac *adminController) DeleteMenu(c echo.Context) error {
+	var req deleteMenuRequest
+
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	ctx := c.Request().Context()
+
+	deleted, err := ac.mu.Delete(ctx, req.ID)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return c.JSON(errors.NewNotFoundError(err))
+		}
+
+		return c.JSON(errors.NewInternalServerError(err))
+	}
+
+	return c.JSON(http.StatusOK, deleted)
+}
+
+type importMenusRequest struct {
+	CityCode string `param:"code" validate:"required,city_code"`
+}
+
+// ImportMenus は1ヶ月分の献立CSVを一括登録する
+// CSVは multipart の file フィールド、または text/csv のリクエストボディで受け取る
+func (ac *adminController) ImportMenus(c echo.Context) error {
+	var req importMenusRequest
+
+	// ボディはCSVのため、パスパラメータのみをバインドする
+	if err := (&echo.DefaultBinder{}).BindPathParams(c, &req); err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	cityCode, err := util.ParseCityCode(req.CityCode)
+
+	if err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	body := c.Request().Body
+
+	if file, err := c.FormFile("file"); err == nil {
+		src, err := file.Open()
+
+		if err != nil {
+			return c.JSON(errors.NewBadRequestError(err))
+		}
+
+		defer src.Close()
+
+		body = src
+	}
+
+	ctx := c.Request().Context()
+
+	report, err := ac.mu.Import(ctx, cityCode, body)
+
+	if err != nil {
+		if domain.IsInvalidMenuCSV(err) {
+			return c.JSON(errors.NewBadRequestError(err))
+		}
+
+		return c.JSON(errors.NewInternalServerError(err))
+	}
+
+	if !report.Imported {
+		return c.JSON(http.StatusUnprocessableEntity, report)
+	}
+
+	return c.JSON(http.StatusCreated, report)
 }
 
 type createDishRequest struct {
@@ -94,11 +309,17 @@ func (ac *adminController) CreateDish(c echo.Context) error {
 		return c.JSON(errors.NewInternalServerError(err))
 	}
 
-	if err := ac.du.Create(ctx, dish, req.MenuID); err != nil {
+	result, err := ac.du.Create(ctx, dish, req.MenuID)
+
+	if err != nil {
+		if util.IsDuplicateEntry(err) {
+			return c.JSON(errors.NewConflictError(err))
+		}
+
 		return c.JSON(errors.NewInternalServerError(err))
 	}
 
-	return c.NoContent(http.StatusCreated)
+	return c.JSON(http.StatusCreated, result)
 }
 
 type createDishesRequest struct {
@@ -131,9 +352,334 @@ func (ac *adminController) CreateDishes(c echo.Context) error {
 		dishes = append(dishes, dish)
 	}
 
-	if err := ac.du.CreateMany(ctx, dishes, req.MenuID); err != nil {
+	result, err := ac.du.CreateMany(ctx, dishes, req.MenuID)
+
+	if err != nil {
+		if util.IsDuplicateEntry(err) {
+			return c.JSON(errors.NewConflictError(err))
+		}
+
+		return c.JSON(errors.NewInternalServerError(err))
+	}
+
+	return c.JSON(http.StatusCreated, result)
+}
+
+type updateDishRequest struct {
+	ID   string `param:"id" validate:"required,ulid"`
+	Name string `json:"name" validate:"required"`
+}
+
+func (ac *adminController) UpdateDish(c echo.Context) error {
+	var req updateDishRequest
+
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	ctx := c.Request().Context()
+
+	dish, err := domain.ReNewDish(req.ID, req.Name)
+
+	if err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	if err := ac.du.Update(ctx, dish); err != nil {
+		if err == sql.ErrNoRows {
+			return c.JSON(errors.NewNotFoundError(err))
+		}
+
+		if util.IsDuplicateEntry(err) {
+			return c.JSON(errors.NewConflictError(err))
+		}
+
+		return c.JSON(errors.NewInternalServerError(err))
+	}
+
+	return c.JSON(http.StatusOK, dish)
+}
+
+type detachDishRequest struct {
+	MenuID string `param:"id" validate:"required,ulid"`
+	DishID string `param:"dishID" validate:"required,ulid"`
+}
+
+func (ac *adminController) DetachDish(c echo.Context) error {
+	var req detachDishRequest
+
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	ctx := c.Request().Context()
+
+	if err := ac.du.DetachFromMenu(ctx, req.DishID, req.MenuID); err != nil {
+		if err == sql.ErrNoRows {
+			return c.JSON(errors.NewNotFoundError(err))
+		}
+
+		return c.JSON(errors.NewInternalServerError(err))
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+type deleteDishRequest struct {
+	ID    string `param:"id" validate:"required,ulid"`
+	Force bool   `query:"force"`
+}
+
+func (ac *adminController) DeleteDish(c echo.Context) error {
+	var req deleteDishRequest
+
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	ctx := c.Request().Context()
+
+	deleted, err := ac.du.Delete(ctx, req.ID, req.Force)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return c.JSON(errors.NewNotFoundError(err))
+		}
+
+		if err == domain.ErrDishInUse {
+			return c.JSON(errors.NewConflictError(err))
+		}
+
+		return c.JSON(errors.NewInternalServerError(err))
+	}
+
+	return c.JSON(http.StatusOK, deleted)
+}
+
+type createAllergenRequest struct {
+	Name string `json:"name" validate:"required,max=255"`
+}
+
+func (ac *adminController) CreateAllergen(c echo.Context) error {
+	var req createAllergenRequest
+
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	ctx := c.Request().Context()
+
+	allergen, err := ac.au.Create(ctx, req.Name)
+
+	if err != nil {
+		if util.IsDuplicateEntry(err) {
+			return c.JSON(errors.NewConflictError(err))
+		}
+
+		return c.JSON(errors.NewInternalServerError(err))
+	}
+
+	return c.JSON(http.StatusCreated, allergen)
+}
+
+type dishAllergenRequest struct {
+	ID       int32                   `json:"id" validate:"required,gt=0"`
+	Category domain.AllergenCategory `json:"category" validate:"oneof=0 1"`
+}
+
+type createDishAllergensRequest struct {
+	DishID    string                `param:"id" validate:"required,ulid"`
+	Allergens []dishAllergenRequest `json:"allergens" validate:"required,min=1,dive"`
+}
+
+func (ac *adminController) CreateDishAllergens(c echo.Context) error {
+	var req createDishAllergensRequest
+
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	ctx := c.Request().Context()
+
+	allergens := make([]*domain.Allergen, 0, len(req.Allergens))
+
+	for _, a := range req.Allergens {
+		allergens = append(allergens, domain.ReNewAllergen(a.ID, "", "", a.Category))
+	}
+
+	if err := ac.au.AttachToDish(ctx, req.DishID, allergens); err != nil {
+		if err == sql.ErrNoRows {
+			return c.JSON(errors.NewNotFoundError(err))
+		}
+
+		if util.IsDuplicateEntry(err) {
+			return c.JSON(errors.NewConflictError(err))
+		}
+
 		return c.JSON(errors.NewInternalServerError(err))
 	}
 
 	return c.NoContent(http.StatusCreated)
 }
+
+type deleteDishAllergenRequest struct {
+	DishID     string                  `param:"id" validate:"required,ulid"`
+	AllergenID int32                   `param:"allergenID" validate:"required,gt=0"`
+	Category   domain.AllergenCategory `query:"category" validate:"oneof=0 1"`
+}
+
+func (ac *adminController) DeleteDishAllergen(c echo.Context) error {
+	var req deleteDishAllergenRequest
+
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	ctx := c.Request().Context()
+
+	if err := ac.au.DetachFromDish(ctx, req.DishID, req.AllergenID, req.Category); err != nil {
+		if err == sql.ErrNoRows {
+			return c.JSON(errors.NewNotFoundError(err))
+		}
+
+		return c.JSON(errors.NewInternalServerError(err))
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+type createCityRequest struct {
+	CityCode       int32  `json:"city_code" validate:"required,city_code"`
+	CityName       string `json:"city_name" validate:"required,max=100"`
+	PrefectureCode int32  `json:"prefecture_code" validate:"required,min=1,max=47"`
+	PrefectureName string `json:"prefecture_name" validate:"required,max=100"`
+}
+
+func (ac *adminController) CreateCity(c echo.Context) error {
+	var req createCityRequest
+
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	// 市区町村コードの上2桁は都道府県コード
+	if util.CityPrefectureCode(req.CityCode) != req.PrefectureCode {
+		return c.JSON(errors.NewBadRequestError(domain.ErrPrefectureCodeMismatch))
+	}
+
+	ctx := c.Request().Context()
+
+	city := domain.NewCity(
+		req.CityCode,
+		req.CityName,
+		req.PrefectureCode,
+		req.PrefectureName,
+	)
+
+	if err := ac.cu.Create(ctx, city); err != nil {
+		if util.IsDuplicateEntry(err) {
+			return c.JSON(errors.NewConflictError(err))
+		}
+
+		return c.JSON(errors.NewInternalServerError(err))
+	}
+
+	return c.JSON(http.StatusCreated, city)
+}
+
+type updateCityRequest struct {
+	CityCode                 string `param:"code" validate:"required,city_code"`
+	CityName                 string `json:"city_name" validate:"required,max=100"`
+	PrefectureCode           int32  `json:"prefecture_code" validate:"required,min=1,max=47"`
+	PrefectureName           string `json:"prefecture_name" validate:"required,max=100"`
+	SchoolLunchInfoAvailable *bool  `json:"school_lunch_info_available"`
+}
+
+func (ac *adminController) UpdateCity(c echo.Context) error {
+	var req updateCityRequest
+
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	cityCode, err := util.ParseCityCode(req.CityCode)
+
+	if err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	if util.CityPrefectureCode(cityCode) != req.PrefectureCode {
+		return c.JSON(errors.NewBadRequestError(domain.ErrPrefectureCodeMismatch))
+	}
+
+	ctx := c.Request().Context()
+
+	// 指定がない場合は現在の値を保つ
+	var available bool
+
+	if req.SchoolLunchInfoAvailable != nil {
+		available = *req.SchoolLunchInfoAvailable
+	} else {
+		current, err := ac.cu.GetByCityCode(ctx, cityCode)
+
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return c.JSON(errors.NewNotFoundError(err))
+			}
+
+			return c.JSON(errors.NewInternalServerError(err))
+		}
+
+		available = current.SchoolLunchInfoAvailable
+	}
+
+	city := domain.ReNewCity(
+		cityCode,
+		req.CityName,
+		req.PrefectureCode,
+		req.PrefectureName,
+		available,
+	)
+
+	if err := ac.cu.Update(ctx, city); err != nil {
+		if err == sql.ErrNoRows {
+			return c.JSON(errors.NewNotFoundError(err))
+		}
+
+		return c.JSON(errors.NewInternalServerError(err))
+	}
+
+	return c.JSON(http.StatusOK, city)
+}
@@ -2,23 +2,38 @@ package controller
 
 import (
 	"bytes"
+	"context"
 	"database/sql"
 	"encoding/json"
 	"fmt"
+	"io"
+	"mime/multipart"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
+	"github.com/go-sql-driver/mysql"
 	"github.com/labstack/echo/v4"
 	"github.com/ogurilab/school-lunch-api/bootstrap"
+	"github.com/ogurilab/school-lunch-api/domain"
 	"github.com/ogurilab/school-lunch-api/domain/mocks"
+	"github.com/ogurilab/school-lunch-api/infrastructure/token"
 	"github.com/ogurilab/school-lunch-api/server/validator"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 )
 
-func createValidAdminKey(t *testing.T, env bootstrap.Env, req *http.Request) {
-	req.Header.Set("X-Admin-Key", env.ADMIN_KEY)
+func createValidAdminToken(t *testing.T, env bootstrap.Env, req *http.Request) {
+	maker, err := token.NewJWTMaker(env.TokenSymmetricKey)
+	require.NoError(t, err)
+
+	user := domain.ReNewUser(1, "admin", "", "admin@example.com", domain.UserRoleAdmin, 0, time.Now())
+
+	accessToken, _, err := maker.CreateToken(user, time.Minute)
+	require.NoError(t, err)
+
+	req.Header.Set(echo.HeaderAuthorization, "Bearer "+accessToken)
 }
 
 func TestCreateMenu(t *testing.T) {
@@ -42,12 +57,17 @@ func TestCreateMenu(t *testing.T) {
 				JuniorHighSchoolCalories: menu.JuniorHighSchoolCalories,
 				CityCode:                 menu.CityCode,
 			},
-			setUpKey: createValidAdminKey,
+			setUpKey: createValidAdminToken,
 			buildStub: func(uc *mocks.MockMenuUsecase) {
 				uc.EXPECT().Create(gomock.Any(), gomock.Any()).Times(1).Return(nil)
 			},
 			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusCreated, recorder.Code)
+
+				var res domain.Menu
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+				require.NotEmpty(t, res.ID)
+				require.Equal(t, menu.CityCode, res.CityCode)
 			},
 		},
 		{
@@ -59,7 +79,7 @@ func TestCreateMenu(t *testing.T) {
 				JuniorHighSchoolCalories: menu.JuniorHighSchoolCalories,
 				CityCode:                 menu.CityCode,
 			},
-			setUpKey: createValidAdminKey,
+			setUpKey: createValidAdminToken,
 			buildStub: func(uc *mocks.MockMenuUsecase) {
 				uc.EXPECT().Create(gomock.Any(), gomock.Any()).Times(0)
 
@@ -77,7 +97,7 @@ func TestCreateMenu(t *testing.T) {
 				JuniorHighSchoolCalories: menu.JuniorHighSchoolCalories,
 				CityCode:                 menu.CityCode,
 			},
-			setUpKey: createValidAdminKey,
+			setUpKey: createValidAdminToken,
 			buildStub: func(uc *mocks.MockMenuUsecase) {
 				uc.EXPECT().Create(gomock.Any(), gomock.Any()).Times(0)
 			},
@@ -94,7 +114,7 @@ func TestCreateMenu(t *testing.T) {
 				JuniorHighSchoolCalories: menu.JuniorHighSchoolCalories,
 				CityCode:                 menu.CityCode,
 			},
-			setUpKey: createValidAdminKey,
+			setUpKey: createValidAdminToken,
 			buildStub: func(uc *mocks.MockMenuUsecase) {
 				uc.EXPECT().Create(gomock.Any(), gomock.Any()).Times(0)
 			},
@@ -111,7 +131,7 @@ func TestCreateMenu(t *testing.T) {
 				JuniorHighSchoolCalories: -1,
 				CityCode:                 menu.CityCode,
 			},
-			setUpKey: createValidAdminKey,
+			setUpKey: createValidAdminToken,
 			buildStub: func(uc *mocks.MockMenuUsecase) {
 				uc.EXPECT().Create(gomock.Any(), gomock.Any()).Times(0)
 			},
@@ -128,7 +148,7 @@ func TestCreateMenu(t *testing.T) {
 				JuniorHighSchoolCalories: menu.JuniorHighSchoolCalories,
 				CityCode:                 -1,
 			},
-			setUpKey: createValidAdminKey,
+			setUpKey: createValidAdminToken,
 			buildStub: func(uc *mocks.MockMenuUsecase) {
 				uc.EXPECT().Create(gomock.Any(), gomock.Any()).Times(0)
 			},
@@ -145,7 +165,7 @@ func TestCreateMenu(t *testing.T) {
 				JuniorHighSchoolCalories: menu.JuniorHighSchoolCalories,
 				CityCode:                 menu.CityCode,
 			},
-			setUpKey: createValidAdminKey,
+			setUpKey: createValidAdminToken,
 			buildStub: func(uc *mocks.MockMenuUsecase) {
 				uc.EXPECT().Create(gomock.Any(), gomock.Any()).Times(1).Return(sql.ErrConnDone)
 			},
@@ -163,7 +183,7 @@ func TestCreateMenu(t *testing.T) {
 				CityCode:                 menu.CityCode,
 			},
 			setUpKey: func(t *testing.T, env bootstrap.Env, req *http.Request) {
-				req.Header.Set("X-Admin-Key", "invalid")
+				req.Header.Set(echo.HeaderAuthorization, "Bearer invalid")
 			},
 			buildStub: func(uc *mocks.MockMenuUsecase) {
 				uc.EXPECT().Create(gomock.Any(), gomock.Any()).Times(0)
@@ -173,7 +193,7 @@ func TestCreateMenu(t *testing.T) {
 			},
 		},
 		{
-			name: "No Admin Key",
+			name: "No Token",
 			body: body{
 				OfferedAt:                offered,
 				PhotoUrl:                 menu.PhotoUrl.String,
@@ -218,271 +238,280 @@ func TestCreateMenu(t *testing.T) {
 		e, env := newSetupAdminTestServer(t)
 		tc.setUpKey(t, env, req)
 
-		e.POST(url, NewAdminController(uc, nil).CreateMenu)
+		e.POST(url, NewAdminController(uc, nil, nil, nil).CreateMenu)
 		e.ServeHTTP(recorder, req)
 
 		tc.check(t, recorder)
 	}
 }
 
-func TestCreateDish(t *testing.T) {
+func TestUpdateMenu(t *testing.T) {
 	menu := randomMenu(t)
-	dish := randomDish(t)
+	offered := menu.OfferedAt.Format("2006-01-02")
+	type body createMenuRequest
 
-	type body struct {
-		Name string `json:"name" validate:"required"`
+	valid := body{
+		OfferedAt:                offered,
+		PhotoUrl:                 menu.PhotoUrl.String,
+		ElementarySchoolCalories: menu.ElementarySchoolCalories,
+		JuniorHighSchoolCalories: menu.JuniorHighSchoolCalories,
+		CityCode:                 menu.CityCode,
 	}
 
 	testCases := []struct {
 		name      string
-		menuID    string
+		id        string
 		body      body
-		setUpKey  func(t *testing.T, env bootstrap.Env, req *http.Request)
-		buildStub func(uc *mocks.MockDishUsecase)
+		buildStub func(uc *mocks.MockMenuUsecase)
 		check     func(t *testing.T, recorder *httptest.ResponseRecorder)
 	}{
 		{
-			name:   "OK",
-			menuID: menu.ID,
-			body: body{
-				Name: dish.Name,
-			},
-			setUpKey: createValidAdminKey,
-			buildStub: func(uc *mocks.MockDishUsecase) {
-				uc.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(nil)
+			name: "OK",
+			id:   menu.ID,
+			body: valid,
+			buildStub: func(uc *mocks.MockMenuUsecase) {
+				uc.EXPECT().Update(gomock.Any(), gomock.Eq(menu)).Times(1).Return(nil)
 			},
 			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
-				require.Equal(t, http.StatusCreated, recorder.Code)
+				require.Equal(t, http.StatusOK, recorder.Code)
 			},
 		},
 		{
-			name:   "Bad Request - Invalid MenuID",
-			menuID: "invalid",
-			body: body{
-				Name: dish.Name,
-			},
-			setUpKey: createValidAdminKey,
-			buildStub: func(uc *mocks.MockDishUsecase) {
-				uc.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			name: "Bad Request - Invalid ID",
+			id:   "invalid",
+			body: valid,
+			buildStub: func(uc *mocks.MockMenuUsecase) {
+				uc.EXPECT().Update(gomock.Any(), gomock.Any()).Times(0)
 			},
 			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusBadRequest, recorder.Code)
 			},
 		},
 		{
-			name:   "Bad Request - Invalid Name",
-			menuID: menu.ID,
+			name: "Bad Request - Invalid ElementarySchoolCalories",
+			id:   menu.ID,
 			body: body{
-				Name: "",
+				OfferedAt:                offered,
+				PhotoUrl:                 menu.PhotoUrl.String,
+				ElementarySchoolCalories: -1,
+				JuniorHighSchoolCalories: menu.JuniorHighSchoolCalories,
+				CityCode:                 menu.CityCode,
 			},
-			setUpKey: createValidAdminKey,
-			buildStub: func(uc *mocks.MockDishUsecase) {
-				uc.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			buildStub: func(uc *mocks.MockMenuUsecase) {
+				uc.EXPECT().Update(gomock.Any(), gomock.Any()).Times(0)
 			},
 			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusBadRequest, recorder.Code)
 			},
 		},
 		{
-			name:   "Internal Server Error",
-			menuID: menu.ID,
-			body: body{
-				Name: dish.Name,
-			},
-			setUpKey: createValidAdminKey,
-			buildStub: func(uc *mocks.MockDishUsecase) {
-				uc.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(sql.ErrConnDone)
+			name: "Not Found",
+			id:   menu.ID,
+			body: valid,
+			buildStub: func(uc *mocks.MockMenuUsecase) {
+				uc.EXPECT().Update(gomock.Any(), gomock.Any()).Times(1).Return(sql.ErrNoRows)
 			},
 			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
-				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+				require.Equal(t, http.StatusNotFound, recorder.Code)
 			},
 		},
 		{
-			name:   "Bad Admin Key",
-			menuID: menu.ID,
-			body: body{
-				Name: dish.Name,
-			},
-			setUpKey: func(t *testing.T, env bootstrap.Env, req *http.Request) {
-				req.Header.Set("X-Admin-Key", "invalid")
-			},
-			buildStub: func(uc *mocks.MockDishUsecase) {
-				uc.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			name: "Conflict",
+			id:   menu.ID,
+			body: valid,
+			buildStub: func(uc *mocks.MockMenuUsecase) {
+				uc.EXPECT().Update(gomock.Any(), gomock.Any()).Times(1).Return(&mysql.MySQLError{Number: 1062})
 			},
 			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
-				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+				require.Equal(t, http.StatusConflict, recorder.Code)
 			},
 		},
 		{
-			name:   "No Admin Key",
-			menuID: menu.ID,
-			body: body{
-				Name: dish.Name,
-			},
-			setUpKey: func(t *testing.T, env bootstrap.Env, req *http.Request) {
-				// do nothing
-			},
-			buildStub: func(uc *mocks.MockDishUsecase) {
-				uc.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			name: "Internal Server Error",
+			id:   menu.ID,
+			body: valid,
+			buildStub: func(uc *mocks.MockMenuUsecase) {
+				uc.EXPECT().Update(gomock.Any(), gomock.Any()).Times(1).Return(sql.ErrConnDone)
 			},
 			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
-				require.Equal(t, http.StatusBadRequest, recorder.Code)
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
 			},
 		},
 	}
 
 	for _, tc := range testCases {
-		ctrl := gomock.NewController(t)
-		defer ctrl.Finish()
-
-		uc := mocks.NewMockDishUsecase(ctrl)
-		tc.buildStub(uc)
-
-		recorder := httptest.NewRecorder()
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
 
-		jsonData, err := json.Marshal(tc.body)
-		reqBody := bytes.NewBuffer(jsonData)
+			uc := mocks.NewMockMenuUsecase(ctrl)
+			tc.buildStub(uc)
 
-		require.NotNil(t, reqBody)
-		require.NoError(t, err)
+			recorder := httptest.NewRecorder()
 
-		url := fmt.Sprintf("/admin/menus/%s/dishes", tc.menuID)
-		req, err := http.NewRequest(http.MethodPost, url, reqBody)
+			jsonData, err := json.Marshal(tc.body)
+			require.NoError(t, err)
 
-		require.NoError(t, err)
+			url := fmt.Sprintf("/admin/menus/%s", tc.id)
+			req, err := http.NewRequest(http.MethodPut, url, bytes.NewBuffer(jsonData))
+			require.NoError(t, err)
 
-		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 
-		e, env := newSetupAdminTestServer(t)
-		tc.setUpKey(t, env, req)
+			e, env := newSetupAdminTestServer(t)
+			createValidAdminToken(t, env, req)
 
-		e.POST("/admin/menus/:id/dishes", NewAdminController(nil, uc).CreateDish)
-		e.ServeHTTP(recorder, req)
+			e.PUT("/admin/menus/:id", NewAdminController(uc, nil, nil, nil).UpdateMenu)
+			e.ServeHTTP(recorder, req)
 
-		tc.check(t, recorder)
+			tc.check(t, recorder)
+		})
 	}
 }
 
-func TestCreateDishes(t *testing.T) {
+func TestPatchMenu(t *testing.T) {
 	menu := randomMenu(t)
 
-	dishes := make([]validator.Dish, 0, 3)
-	badDishes := make([]validator.Dish, 0, 3)
-
-	for i := 0; i < 3; i++ {
-		d := randomDish(t)
-		dishes = append(dishes, validator.Dish{
-			Name: d.Name,
-		})
-
-		if i == 0 {
-			d.Name = ""
-		}
-
-		badDishes = append(badDishes, validator.Dish{
-			Name: d.Name,
-		})
-	}
-
-	type body struct {
-		Dishes []validator.Dish `json:"dishes" validate:"required,dishes"`
-	}
-
 	testCases := []struct {
 		name      string
-		menuID    string
-		body      body
-		setUpKey  func(t *testing.T, env bootstrap.Env, req *http.Request)
-		buildStub func(uc *mocks.MockDishUsecase)
+		body      map[string]any
+		buildStub func(uc *mocks.MockMenuUsecase)
 		check     func(t *testing.T, recorder *httptest.ResponseRecorder)
 	}{
 		{
-			name:   "OK",
-			menuID: menu.ID,
-			body: body{
-				Dishes: dishes,
+			name: "OK",
+			body: map[string]any{
+				"elementary_school_calories": 600,
 			},
-			setUpKey: createValidAdminKey,
-			buildStub: func(uc *mocks.MockDishUsecase) {
-
-				uc.EXPECT().CreateMany(gomock.Any(), gomock.Any(), gomock.Eq(menu.ID)).Times(1).Return(nil)
+			buildStub: func(uc *mocks.MockMenuUsecase) {
+				uc.EXPECT().FindByID(gomock.Any(), gomock.Eq(menu.ID)).Times(1).Return(menu, nil)
+				uc.EXPECT().Update(gomock.Any(), gomock.Any()).Times(1).
+					DoAndReturn(func(_ any, updated *domain.Menu) error {
+						// 指定されていないフィールドは既存の値が維持される
+						require.Equal(t, int32(600), updated.ElementarySchoolCalories)
+						require.Equal(t, menu.JuniorHighSchoolCalories, updated.JuniorHighSchoolCalories)
+						require.Equal(t, menu.CityCode, updated.CityCode)
+						require.Equal(t, menu.PhotoUrl, updated.PhotoUrl)
+						return nil
+					})
 			},
 			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
-				require.Equal(t, http.StatusCreated, recorder.Code)
+				require.Equal(t, http.StatusOK, recorder.Code)
 			},
 		},
 		{
-			name:   "Bad Request - Invalid MenuID",
-			menuID: "invalid",
-			body: body{
-				Dishes: dishes,
+			name: "Bad Request - Invalid PhotoUrl",
+			body: map[string]any{
+				"photo_url": "invalid-url",
 			},
-			setUpKey: createValidAdminKey,
-			buildStub: func(uc *mocks.MockDishUsecase) {
-				uc.EXPECT().CreateMany(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			buildStub: func(uc *mocks.MockMenuUsecase) {
+				uc.EXPECT().FindByID(gomock.Any(), gomock.Eq(menu.ID)).Times(1).Return(menu, nil)
+				uc.EXPECT().Update(gomock.Any(), gomock.Any()).Times(0)
 			},
 			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusBadRequest, recorder.Code)
 			},
 		},
 		{
-			name:   "Bad Request - Invalid Dishes",
-			menuID: menu.ID,
-			body: body{
-				Dishes: badDishes,
+			name: "Not Found",
+			body: map[string]any{
+				"elementary_school_calories": 600,
 			},
-			setUpKey: createValidAdminKey,
-			buildStub: func(uc *mocks.MockDishUsecase) {
-				uc.EXPECT().CreateMany(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			buildStub: func(uc *mocks.MockMenuUsecase) {
+				uc.EXPECT().FindByID(gomock.Any(), gomock.Eq(menu.ID)).Times(1).Return(nil, sql.ErrNoRows)
+				uc.EXPECT().Update(gomock.Any(), gomock.Any()).Times(0)
 			},
 			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
-				require.Equal(t, http.StatusBadRequest, recorder.Code)
+				require.Equal(t, http.StatusNotFound, recorder.Code)
 			},
 		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc := mocks.NewMockMenuUsecase(ctrl)
+			tc.buildStub(uc)
+
+			recorder := httptest.NewRecorder()
+
+			jsonData, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := fmt.Sprintf("/admin/menus/%s", menu.ID)
+			req, err := http.NewRequest(http.MethodPatch, url, bytes.NewBuffer(jsonData))
+			require.NoError(t, err)
+
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+			e, env := newSetupAdminTestServer(t)
+			createValidAdminToken(t, env, req)
+
+			e.PATCH("/admin/menus/:id", NewAdminController(uc, nil, nil, nil).PatchMenu)
+			e.ServeHTTP(recorder, req)
+
+			tc.check(t, recorder)
+		})
+	}
+}
+
+func TestDeleteMenu(t *testing.T) {
+	menu := randomMenu(t)
+	deleted := &domain.DeletedMenu{
+		ID:      menu.ID,
+		DishIDs: []string{randomDish(t).ID},
+	}
+
+	testCases := []struct {
+		name      string
+		id        string
+		buildStub func(uc *mocks.MockMenuUsecase)
+		check     func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
 		{
-			name:   "Internal Server Error",
-			menuID: menu.ID,
-			body: body{
-				Dishes: dishes,
-			},
-			setUpKey: createValidAdminKey,
-			buildStub: func(uc *mocks.MockDishUsecase) {
-				uc.EXPECT().CreateMany(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(sql.ErrConnDone)
+			name: "OK",
+			id:   menu.ID,
+			buildStub: func(uc *mocks.MockMenuUsecase) {
+				uc.EXPECT().Delete(gomock.Any(), gomock.Eq(menu.ID)).Times(1).Return(deleted, nil)
 			},
 			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
-				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var res domain.DeletedMenu
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+				require.Equal(t, *deleted, res)
 			},
 		},
 		{
-			name:   "Bad Admin Key",
-			menuID: menu.ID,
-			body: body{
-				Dishes: dishes,
-			},
-			setUpKey: func(t *testing.T, env bootstrap.Env, req *http.Request) {
-				req.Header.Set("X-Admin-Key", "invalid")
-			},
-			buildStub: func(uc *mocks.MockDishUsecase) {
-				uc.EXPECT().CreateMany(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			name: "Bad Request - Invalid ID",
+			id:   "invalid",
+			buildStub: func(uc *mocks.MockMenuUsecase) {
+				uc.EXPECT().Delete(gomock.Any(), gomock.Any()).Times(0)
 			},
 			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
-				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
 			},
 		},
 		{
-			name:   "No Admin Key",
-			menuID: menu.ID,
-			body: body{
-				Dishes: dishes,
+			name: "Not Found",
+			id:   menu.ID,
+			buildStub: func(uc *mocks.MockMenuUsecase) {
+				uc.EXPECT().Delete(gomock.Any(), gomock.Eq(menu.ID)).Times(1).Return(nil, sql.ErrNoRows)
 			},
-			setUpKey: func(t *testing.T, env bootstrap.Env, req *http.Request) {
-				// do nothing
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
 			},
-			buildStub: func(uc *mocks.MockDishUsecase) {
-				uc.EXPECT().CreateMany(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+		},
+		{
+			name: "Internal Server Error",
+			id:   menu.ID,
+			buildStub: func(uc *mocks.MockMenuUsecase) {
+				uc.EXPECT().Delete(gomock.Any(), gomock.Eq(menu.ID)).Times(1).Return(nil, sql.ErrConnDone)
 			},
 			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
-				require.Equal(t, http.StatusBadRequest, recorder.Code)
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
 			},
 		},
 	}
@@ -492,28 +521,1358 @@ func TestCreateDishes(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
-			uc := mocks.NewMockDishUsecase(ctrl)
+			uc := mocks.NewMockMenuUsecase(ctrl)
 			tc.buildStub(uc)
 
 			recorder := httptest.NewRecorder()
 
-			jsonData, err := json.Marshal(tc.body)
-			reqBody := bytes.NewBuffer(jsonData)
-
-			require.NotNil(t, reqBody)
+			url := fmt.Sprintf("/admin/menus/%s", tc.id)
+			req, err := http.NewRequest(http.MethodDelete, url, nil)
 			require.NoError(t, err)
 
-			url := fmt.Sprintf("/admin/menus/%s/dishes/bulk", tc.menuID)
-			req, err := http.NewRequest(http.MethodPost, url, reqBody)
+			e, env := newSetupAdminTestServer(t)
+			createValidAdminToken(t, env, req)
 
-			require.NoError(t, err)
+			e.DELETE("/admin/menus/:id", NewAdminController(uc, nil, nil, nil).DeleteMenu)
+			e.ServeHTTP(recorder, req)
 
-			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			tc.check(t, recorder)
+		})
+	}
+}
 
-			e, env := newSetupAdminTestServer(t)
-			tc.setUpKey(t, env, req)
+func TestImportMenus(t *testing.T) {
+	menu := randomMenu(t)
+	csv := "offered_at,dishes,allergens,elementary_school_calories,junior_high_school_calories\n" +
+		"2024-04-08,ごはん|牛乳,|乳,620,820\n"
+
+	imported := &domain.ImportMenuReport{
+		CityCode: menu.CityCode,
+		Imported: true,
+		Rows: []*domain.ImportMenuResult{
+			{Line: 2, OfferedAt: "2024-04-08", MenuID: menu.ID, DishIDs: []string{randomDish(t).ID}},
+		},
+	}
+
+	failed := &domain.ImportMenuReport{
+		CityCode: menu.CityCode,
+		Imported: false,
+		Rows: []*domain.ImportMenuResult{
+			{Line: 2, OfferedAt: "2024-04-08", Errors: []string{"duplicate entry"}},
+		},
+	}
+
+	testCases := []struct {
+		name      string
+		code      string
+		multipart bool
+		buildStub func(uc *mocks.MockMenuUsecase)
+		check     func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			code: fmt.Sprint(menu.CityCode),
+			buildStub: func(uc *mocks.MockMenuUsecase) {
+				uc.EXPECT().Import(gomock.Any(), gomock.Eq(menu.CityCode), gomock.Any()).Times(1).
+					DoAndReturn(func(_ context.Context, _ int32, r io.Reader) (*domain.ImportMenuReport, error) {
+						b, err := io.ReadAll(r)
+						require.NoError(t, err)
+						require.Equal(t, csv, string(b))
+
+						return imported, nil
+					})
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusCreated, recorder.Code)
+
+				var res domain.ImportMenuReport
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+				require.True(t, res.Imported)
+				require.Equal(t, menu.ID, res.Rows[0].MenuID)
+			},
+		},
+		{
+			name:      "OK - Multipart",
+			code:      fmt.Sprint(menu.CityCode),
+			multipart: true,
+			buildStub: func(uc *mocks.MockMenuUsecase) {
+				uc.EXPECT().Import(gomock.Any(), gomock.Eq(menu.CityCode), gomock.Any()).Times(1).
+					DoAndReturn(func(_ context.Context, _ int32, r io.Reader) (*domain.ImportMenuReport, error) {
+						b, err := io.ReadAll(r)
+						require.NoError(t, err)
+						require.Equal(t, csv, string(b))
+
+						return imported, nil
+					})
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusCreated, recorder.Code)
+			},
+		},
+		{
+			name: "Unprocessable Entity",
+			code: fmt.Sprint(menu.CityCode),
+			buildStub: func(uc *mocks.MockMenuUsecase) {
+				uc.EXPECT().Import(gomock.Any(), gomock.Eq(menu.CityCode), gomock.Any()).Times(1).Return(failed, nil)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+
+				var res domain.ImportMenuReport
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+				require.False(t, res.Imported)
+				require.Equal(t, failed.Rows[0].Errors, res.Rows[0].Errors)
+			},
+		},
+		{
+			name: "Bad Request - Invalid CityCode",
+			code: "48201",
+			buildStub: func(uc *mocks.MockMenuUsecase) {
+				uc.EXPECT().Import(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Bad Request - Invalid CSV",
+			code: fmt.Sprint(menu.CityCode),
+			buildStub: func(uc *mocks.MockMenuUsecase) {
+				uc.EXPECT().Import(gomock.Any(), gomock.Eq(menu.CityCode), gomock.Any()).Times(1).Return(nil, domain.ErrInvalidMenuCSV)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Internal Server Error",
+			code: fmt.Sprint(menu.CityCode),
+			buildStub: func(uc *mocks.MockMenuUsecase) {
+				uc.EXPECT().Import(gomock.Any(), gomock.Eq(menu.CityCode), gomock.Any()).Times(1).Return(nil, sql.ErrConnDone)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc := mocks.NewMockMenuUsecase(ctrl)
+			tc.buildStub(uc)
+
+			recorder := httptest.NewRecorder()
+
+			body := new(bytes.Buffer)
+			contentType := "text/csv"
+
+			if tc.multipart {
+				writer := multipart.NewWriter(body)
+				part, err := writer.CreateFormFile("file", "menus.csv")
+				require.NoError(t, err)
+
+				_, err = part.Write([]byte(csv))
+				require.NoError(t, err)
+				require.NoError(t, writer.Close())
+
+				contentType = writer.FormDataContentType()
+			} else {
+				body.WriteString(csv)
+			}
+
+			url := fmt.Sprintf("/admin/cities/%s/menus/import", tc.code)
+			req, err := http.NewRequest(http.MethodPost, url, body)
+			require.NoError(t, err)
+
+			req.Header.Set(echo.HeaderContentType, contentType)
+
+			e, env := newSetupAdminTestServer(t)
+			createValidAdminToken(t, env, req)
+
+			e.POST("/admin/cities/:code/menus/import", NewAdminController(uc, nil, nil, nil).ImportMenus)
+			e.ServeHTTP(recorder, req)
+
+			tc.check(t, recorder)
+		})
+	}
+}
+
+func TestCreateDish(t *testing.T) {
+	menu := randomMenu(t)
+	dish := randomDish(t)
+
+	type body struct {
+		Name string `json:"name" validate:"required"`
+	}
+
+	testCases := []struct {
+		name      string
+		menuID    string
+		body      body
+		setUpKey  func(t *testing.T, env bootstrap.Env, req *http.Request)
+		buildStub func(uc *mocks.MockDishUsecase)
+		check     func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:   "OK",
+			menuID: menu.ID,
+			body: body{
+				Name: dish.Name,
+			},
+			setUpKey: createValidAdminToken,
+			buildStub: func(uc *mocks.MockDishUsecase) {
+				result := &domain.CreatedDishes{
+					Created: []*domain.Dish{},
+					Reused:  []*domain.Dish{dish},
+				}
+				uc.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(result, nil)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusCreated, recorder.Code)
+
+				var res domain.CreatedDishes
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+				require.Empty(t, res.Created)
+				require.Equal(t, []*domain.Dish{dish}, res.Reused)
+			},
+		},
+		{
+			name:   "Conflict",
+			menuID: menu.ID,
+			body: body{
+				Name: dish.Name,
+			},
+			setUpKey: createValidAdminToken,
+			buildStub: func(uc *mocks.MockDishUsecase) {
+				uc.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(nil, &mysql.MySQLError{Number: 1062})
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+		{
+			name:   "Bad Request - Invalid MenuID",
+			menuID: "invalid",
+			body: body{
+				Name: dish.Name,
+			},
+			setUpKey: createValidAdminToken,
+			buildStub: func(uc *mocks.MockDishUsecase) {
+				uc.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:   "Bad Request - Invalid Name",
+			menuID: menu.ID,
+			body: body{
+				Name: "",
+			},
+			setUpKey: createValidAdminToken,
+			buildStub: func(uc *mocks.MockDishUsecase) {
+				uc.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:   "Internal Server Error",
+			menuID: menu.ID,
+			body: body{
+				Name: dish.Name,
+			},
+			setUpKey: createValidAdminToken,
+			buildStub: func(uc *mocks.MockDishUsecase) {
+				uc.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrConnDone)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+		{
+			name:   "Bad Admin Key",
+			menuID: menu.ID,
+			body: body{
+				Name: dish.Name,
+			},
+			setUpKey: func(t *testing.T, env bootstrap.Env, req *http.Request) {
+				req.Header.Set(echo.HeaderAuthorization, "Bearer invalid")
+			},
+			buildStub: func(uc *mocks.MockDishUsecase) {
+				uc.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name:   "No Token",
+			menuID: menu.ID,
+			body: body{
+				Name: dish.Name,
+			},
+			setUpKey: func(t *testing.T, env bootstrap.Env, req *http.Request) {
+				// do nothing
+			},
+			buildStub: func(uc *mocks.MockDishUsecase) {
+				uc.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		uc := mocks.NewMockDishUsecase(ctrl)
+		tc.buildStub(uc)
+
+		recorder := httptest.NewRecorder()
+
+		jsonData, err := json.Marshal(tc.body)
+		reqBody := bytes.NewBuffer(jsonData)
+
+		require.NotNil(t, reqBody)
+		require.NoError(t, err)
+
+		url := fmt.Sprintf("/admin/menus/%s/dishes", tc.menuID)
+		req, err := http.NewRequest(http.MethodPost, url, reqBody)
+
+		require.NoError(t, err)
+
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+		e, env := newSetupAdminTestServer(t)
+		tc.setUpKey(t, env, req)
+
+		e.POST("/admin/menus/:id/dishes", NewAdminController(nil, uc, nil, nil).CreateDish)
+		e.ServeHTTP(recorder, req)
+
+		tc.check(t, recorder)
+	}
+}
+
+func TestCreateDishes(t *testing.T) {
+	menu := randomMenu(t)
+
+	dishes := make([]validator.Dish, 0, 3)
+	badDishes := make([]validator.Dish, 0, 3)
+
+	for i := 0; i < 3; i++ {
+		d := randomDish(t)
+		dishes = append(dishes, validator.Dish{
+			Name: d.Name,
+		})
+
+		if i == 0 {
+			d.Name = ""
+		}
+
+		badDishes = append(badDishes, validator.Dish{
+			Name: d.Name,
+		})
+	}
+
+	type body struct {
+		Dishes []validator.Dish `json:"dishes" validate:"required,dishes"`
+	}
+
+	testCases := []struct {
+		name      string
+		menuID    string
+		body      body
+		setUpKey  func(t *testing.T, env bootstrap.Env, req *http.Request)
+		buildStub func(uc *mocks.MockDishUsecase)
+		check     func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:   "OK",
+			menuID: menu.ID,
+			body: body{
+				Dishes: dishes,
+			},
+			setUpKey: createValidAdminToken,
+			buildStub: func(uc *mocks.MockDishUsecase) {
+
+				uc.EXPECT().CreateMany(gomock.Any(), gomock.Any(), gomock.Eq(menu.ID)).Times(1).Return(&domain.CreatedDishes{}, nil)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusCreated, recorder.Code)
+			},
+		},
+		{
+			name:   "Bad Request - Invalid MenuID",
+			menuID: "invalid",
+			body: body{
+				Dishes: dishes,
+			},
+			setUpKey: createValidAdminToken,
+			buildStub: func(uc *mocks.MockDishUsecase) {
+				uc.EXPECT().CreateMany(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:   "Bad Request - Invalid Dishes",
+			menuID: menu.ID,
+			body: body{
+				Dishes: badDishes,
+			},
+			setUpKey: createValidAdminToken,
+			buildStub: func(uc *mocks.MockDishUsecase) {
+				uc.EXPECT().CreateMany(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:   "Internal Server Error",
+			menuID: menu.ID,
+			body: body{
+				Dishes: dishes,
+			},
+			setUpKey: createValidAdminToken,
+			buildStub: func(uc *mocks.MockDishUsecase) {
+				uc.EXPECT().CreateMany(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrConnDone)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+		{
+			name:   "Bad Admin Key",
+			menuID: menu.ID,
+			body: body{
+				Dishes: dishes,
+			},
+			setUpKey: func(t *testing.T, env bootstrap.Env, req *http.Request) {
+				req.Header.Set(echo.HeaderAuthorization, "Bearer invalid")
+			},
+			buildStub: func(uc *mocks.MockDishUsecase) {
+				uc.EXPECT().CreateMany(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name:   "No Token",
+			menuID: menu.ID,
+			body: body{
+				Dishes: dishes,
+			},
+			setUpKey: func(t *testing.T, env bootstrap.Env, req *http.Request) {
+				// do nothing
+			},
+			buildStub: func(uc *mocks.MockDishUsecase) {
+				uc.EXPECT().CreateMany(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc := mocks.NewMockDishUsecase(ctrl)
+			tc.buildStub(uc)
+
+			recorder := httptest.NewRecorder()
+
+			jsonData, err := json.Marshal(tc.body)
+			reqBody := bytes.NewBuffer(jsonData)
+
+			require.NotNil(t, reqBody)
+			require.NoError(t, err)
+
+			url := fmt.Sprintf("/admin/menus/%s/dishes/bulk", tc.menuID)
+			req, err := http.NewRequest(http.MethodPost, url, reqBody)
+
+			require.NoError(t, err)
+
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+			e, env := newSetupAdminTestServer(t)
+			tc.setUpKey(t, env, req)
+
+			e.POST("/admin/menus/:id/dishes/bulk", NewAdminController(nil, uc, nil, nil).CreateDishes)
+			e.ServeHTTP(recorder, req)
+
+			tc.check(t, recorder)
+		})
+	}
+}
+
+func TestUpdateDish(t *testing.T) {
+	dish := randomDish(t)
+
+	testCases := []struct {
+		name      string
+		id        string
+		body      map[string]any
+		buildStub func(uc *mocks.MockDishUsecase)
+		check     func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			id:   dish.ID,
+			body: map[string]any{"name": dish.Name},
+			buildStub: func(uc *mocks.MockDishUsecase) {
+				uc.EXPECT().Update(gomock.Any(), gomock.Eq(dish)).Times(1).Return(nil)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "Bad Request - Empty Name",
+			id:   dish.ID,
+			body: map[string]any{"name": ""},
+			buildStub: func(uc *mocks.MockDishUsecase) {
+				uc.EXPECT().Update(gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Not Found",
+			id:   dish.ID,
+			body: map[string]any{"name": dish.Name},
+			buildStub: func(uc *mocks.MockDishUsecase) {
+				uc.EXPECT().Update(gomock.Any(), gomock.Any()).Times(1).Return(sql.ErrNoRows)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name: "Conflict",
+			id:   dish.ID,
+			body: map[string]any{"name": dish.Name},
+			buildStub: func(uc *mocks.MockDishUsecase) {
+				uc.EXPECT().Update(gomock.Any(), gomock.Any()).Times(1).Return(&mysql.MySQLError{Number: 1062})
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc := mocks.NewMockDishUsecase(ctrl)
+			tc.buildStub(uc)
+
+			recorder := httptest.NewRecorder()
+
+			jsonData, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := fmt.Sprintf("/admin/dishes/%s", tc.id)
+			req, err := http.NewRequest(http.MethodPut, url, bytes.NewBuffer(jsonData))
+			require.NoError(t, err)
+
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+			e, env := newSetupAdminTestServer(t)
+			createValidAdminToken(t, env, req)
+
+			e.PUT("/admin/dishes/:id", NewAdminController(nil, uc, nil, nil).UpdateDish)
+			e.ServeHTTP(recorder, req)
+
+			tc.check(t, recorder)
+		})
+	}
+}
+
+func TestDetachDish(t *testing.T) {
+	dish := randomDish(t)
+	menu := randomMenu(t)
+
+	testCases := []struct {
+		name      string
+		dishID    string
+		buildStub func(uc *mocks.MockDishUsecase)
+		check     func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:   "OK",
+			dishID: dish.ID,
+			buildStub: func(uc *mocks.MockDishUsecase) {
+				uc.EXPECT().DetachFromMenu(gomock.Any(), gomock.Eq(dish.ID), gomock.Eq(menu.ID)).Times(1).Return(nil)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNoContent, recorder.Code)
+			},
+		},
+		{
+			name:   "Bad Request - Invalid DishID",
+			dishID: "invalid",
+			buildStub: func(uc *mocks.MockDishUsecase) {
+				uc.EXPECT().DetachFromMenu(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:   "Not Found",
+			dishID: dish.ID,
+			buildStub: func(uc *mocks.MockDishUsecase) {
+				uc.EXPECT().DetachFromMenu(gomock.Any(), gomock.Eq(dish.ID), gomock.Eq(menu.ID)).Times(1).Return(sql.ErrNoRows)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc := mocks.NewMockDishUsecase(ctrl)
+			tc.buildStub(uc)
+
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/admin/menus/%s/dishes/%s", menu.ID, tc.dishID)
+			req, err := http.NewRequest(http.MethodDelete, url, nil)
+			require.NoError(t, err)
+
+			e, env := newSetupAdminTestServer(t)
+			createValidAdminToken(t, env, req)
+
+			e.DELETE("/admin/menus/:id/dishes/:dishID", NewAdminController(nil, uc, nil, nil).DetachDish)
+			e.ServeHTTP(recorder, req)
+
+			tc.check(t, recorder)
+		})
+	}
+}
+
+func TestDeleteDish(t *testing.T) {
+	dish := randomDish(t)
+	deleted := &domain.DeletedDish{
+		ID:      dish.ID,
+		MenuIDs: []string{randomMenu(t).ID},
+	}
+
+	testCases := []struct {
+		name      string
+		query     string
+		buildStub func(uc *mocks.MockDishUsecase)
+		check     func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:  "OK",
+			query: "?force=true",
+			buildStub: func(uc *mocks.MockDishUsecase) {
+				uc.EXPECT().Delete(gomock.Any(), gomock.Eq(dish.ID), gomock.Eq(true)).Times(1).Return(deleted, nil)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var res domain.DeletedDish
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+				require.Equal(t, *deleted, res)
+			},
+		},
+		{
+			name:  "Conflict - Dish In Use",
+			query: "",
+			buildStub: func(uc *mocks.MockDishUsecase) {
+				uc.EXPECT().Delete(gomock.Any(), gomock.Eq(dish.ID), gomock.Eq(false)).Times(1).Return(nil, domain.ErrDishInUse)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+		{
+			name:  "Bad Request - Invalid Force",
+			query: "?force=invalid",
+			buildStub: func(uc *mocks.MockDishUsecase) {
+				uc.EXPECT().Delete(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:  "Not Found",
+			query: "",
+			buildStub: func(uc *mocks.MockDishUsecase) {
+				uc.EXPECT().Delete(gomock.Any(), gomock.Eq(dish.ID), gomock.Eq(false)).Times(1).Return(nil, sql.ErrNoRows)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc := mocks.NewMockDishUsecase(ctrl)
+			tc.buildStub(uc)
+
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/admin/dishes/%s%s", dish.ID, tc.query)
+			req, err := http.NewRequest(http.MethodDelete, url, nil)
+			require.NoError(t, err)
+
+			e, env := newSetupAdminTestServer(t)
+			createValidAdminToken(t, env, req)
+
+			e.DELETE("/admin/dishes/:id", NewAdminController(nil, uc, nil, nil).DeleteDish)
+			e.ServeHTTP(recorder, req)
+
+			tc.check(t, recorder)
+		})
+	}
+}
+
+func TestCreateAllergen(t *testing.T) {
+	allergen := randomAllergen(t)
+
+	testCases := []struct {
+		name      string
+		body      createAllergenRequest
+		setUpKey  func(t *testing.T, env bootstrap.Env, req *http.Request)
+		buildStub func(uc *mocks.MockAllergenUsecase)
+		check     func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:     "OK",
+			body:     createAllergenRequest{Name: allergen.Name},
+			setUpKey: createValidAdminToken,
+			buildStub: func(uc *mocks.MockAllergenUsecase) {
+				uc.EXPECT().Create(gomock.Any(), gomock.Eq(allergen.Name)).Times(1).Return(allergen, nil)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusCreated, recorder.Code)
+
+				var res domain.Allergen
+				err := json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				require.Equal(t, allergen.ID, res.ID)
+				require.Equal(t, allergen.Name, res.Name)
+			},
+		},
+		{
+			name:     "Bad Request - Empty Name",
+			body:     createAllergenRequest{Name: ""},
+			setUpKey: createValidAdminToken,
+			buildStub: func(uc *mocks.MockAllergenUsecase) {
+				uc.EXPECT().Create(gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:     "Conflict",
+			body:     createAllergenRequest{Name: allergen.Name},
+			setUpKey: createValidAdminToken,
+			buildStub: func(uc *mocks.MockAllergenUsecase) {
+				uc.EXPECT().Create(gomock.Any(), gomock.Eq(allergen.Name)).Times(1).Return(nil, &mysql.MySQLError{Number: 1062})
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+		{
+			name:     "Internal Server Error",
+			body:     createAllergenRequest{Name: allergen.Name},
+			setUpKey: createValidAdminToken,
+			buildStub: func(uc *mocks.MockAllergenUsecase) {
+				uc.EXPECT().Create(gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrConnDone)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+		{
+			name: "Bad Admin Key",
+			body: createAllergenRequest{Name: allergen.Name},
+			setUpKey: func(t *testing.T, env bootstrap.Env, req *http.Request) {
+				req.Header.Set(echo.HeaderAuthorization, "Bearer invalid")
+			},
+			buildStub: func(uc *mocks.MockAllergenUsecase) {
+				uc.EXPECT().Create(gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc := mocks.NewMockAllergenUsecase(ctrl)
+			tc.buildStub(uc)
+
+			recorder := httptest.NewRecorder()
+
+			jsonData, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := "/admin/allergens"
+			req, err := http.NewRequest(http.MethodPost, url, bytes.NewBuffer(jsonData))
+			require.NoError(t, err)
+
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+			e, env := newSetupAdminTestServer(t)
+			tc.setUpKey(t, env, req)
+
+			e.POST(url, NewAdminController(nil, nil, uc, nil).CreateAllergen)
+			e.ServeHTTP(recorder, req)
+
+			tc.check(t, recorder)
+		})
+	}
+}
+
+func TestCreateDishAllergens(t *testing.T) {
+	dish := randomDish(t)
+
+	type body struct {
+		Allergens []dishAllergenRequest `json:"allergens"`
+	}
+
+	allergens := []dishAllergenRequest{
+		{ID: 1, Category: domain.AllergenCategoryContains},
+		{ID: 2, Category: domain.AllergenCategoryMayContain},
+	}
+
+	testCases := []struct {
+		name      string
+		dishID    string
+		body      body
+		buildStub func(uc *mocks.MockAllergenUsecase)
+		check     func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:   "OK",
+			dishID: dish.ID,
+			body:   body{Allergens: allergens},
+			buildStub: func(uc *mocks.MockAllergenUsecase) {
+				arg := []*domain.Allergen{
+					domain.ReNewAllergen(1, "", "", domain.AllergenCategoryContains),
+					domain.ReNewAllergen(2, "", "", domain.AllergenCategoryMayContain),
+				}
+				uc.EXPECT().AttachToDish(gomock.Any(), gomock.Eq(dish.ID), gomock.Eq(arg)).Times(1).Return(nil)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusCreated, recorder.Code)
+			},
+		},
+		{
+			name:   "Bad Request - Invalid DishID",
+			dishID: "invalid",
+			body:   body{Allergens: allergens},
+			buildStub: func(uc *mocks.MockAllergenUsecase) {
+				uc.EXPECT().AttachToDish(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:   "Bad Request - Empty Allergens",
+			dishID: dish.ID,
+			body:   body{Allergens: []dishAllergenRequest{}},
+			buildStub: func(uc *mocks.MockAllergenUsecase) {
+				uc.EXPECT().AttachToDish(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:   "Bad Request - Invalid Allergen ID",
+			dishID: dish.ID,
+			body:   body{Allergens: []dishAllergenRequest{{ID: 0, Category: 0}}},
+			buildStub: func(uc *mocks.MockAllergenUsecase) {
+				uc.EXPECT().AttachToDish(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:   "Bad Request - Invalid Category",
+			dishID: dish.ID,
+			body:   body{Allergens: []dishAllergenRequest{{ID: 1, Category: 2}}},
+			buildStub: func(uc *mocks.MockAllergenUsecase) {
+				uc.EXPECT().AttachToDish(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:   "Allergen Not Found",
+			dishID: dish.ID,
+			body:   body{Allergens: allergens},
+			buildStub: func(uc *mocks.MockAllergenUsecase) {
+				uc.EXPECT().AttachToDish(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(sql.ErrNoRows)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name:   "Conflict",
+			dishID: dish.ID,
+			body:   body{Allergens: allergens},
+			buildStub: func(uc *mocks.MockAllergenUsecase) {
+				uc.EXPECT().AttachToDish(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(&mysql.MySQLError{Number: 1062})
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+		{
+			name:   "Internal Server Error",
+			dishID: dish.ID,
+			body:   body{Allergens: allergens},
+			buildStub: func(uc *mocks.MockAllergenUsecase) {
+				uc.EXPECT().AttachToDish(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(sql.ErrConnDone)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc := mocks.NewMockAllergenUsecase(ctrl)
+			tc.buildStub(uc)
+
+			recorder := httptest.NewRecorder()
+
+			jsonData, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := fmt.Sprintf("/admin/dishes/%s/allergens", tc.dishID)
+			req, err := http.NewRequest(http.MethodPost, url, bytes.NewBuffer(jsonData))
+			require.NoError(t, err)
+
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+			e, env := newSetupAdminTestServer(t)
+			createValidAdminToken(t, env, req)
+
+			e.POST("/admin/dishes/:id/allergens", NewAdminController(nil, nil, uc, nil).CreateDishAllergens)
+			e.ServeHTTP(recorder, req)
+
+			tc.check(t, recorder)
+		})
+	}
+}
+
+func TestDeleteDishAllergen(t *testing.T) {
+	dish := randomDish(t)
+
+	testCases := []struct {
+		name       string
+		dishID     string
+		allergenID string
+		category   string
+		buildStub  func(uc *mocks.MockAllergenUsecase)
+		check      func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:       "OK",
+			dishID:     dish.ID,
+			allergenID: "1",
+			category:   "1",
+			buildStub: func(uc *mocks.MockAllergenUsecase) {
+				uc.EXPECT().DetachFromDish(gomock.Any(), gomock.Eq(dish.ID), gomock.Eq(int32(1)), gomock.Eq(domain.AllergenCategoryMayContain)).Times(1).Return(nil)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNoContent, recorder.Code)
+			},
+		},
+		{
+			name:       "Bad Request - Invalid AllergenID",
+			dishID:     dish.ID,
+			allergenID: "invalid",
+			category:   "1",
+			buildStub: func(uc *mocks.MockAllergenUsecase) {
+				uc.EXPECT().DetachFromDish(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:       "Bad Request - Invalid Category",
+			dishID:     dish.ID,
+			allergenID: "1",
+			category:   "2",
+			buildStub: func(uc *mocks.MockAllergenUsecase) {
+				uc.EXPECT().DetachFromDish(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:       "Not Found",
+			dishID:     dish.ID,
+			allergenID: "1",
+			category:   "1",
+			buildStub: func(uc *mocks.MockAllergenUsecase) {
+				uc.EXPECT().DetachFromDish(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(sql.ErrNoRows)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name:       "Internal Server Error",
+			dishID:     dish.ID,
+			allergenID: "1",
+			category:   "1",
+			buildStub: func(uc *mocks.MockAllergenUsecase) {
+				uc.EXPECT().DetachFromDish(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(sql.ErrConnDone)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc := mocks.NewMockAllergenUsecase(ctrl)
+			tc.buildStub(uc)
+
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/admin/dishes/%s/allergens/%s?category=%s", tc.dishID, tc.allergenID, tc.category)
+			req, err := http.NewRequest(http.MethodDelete, url, nil)
+			require.NoError(t, err)
+
+			e, env := newSetupAdminTestServer(t)
+			createValidAdminToken(t, env, req)
+
+			e.DELETE("/admin/dishes/:id/allergens/:allergenID", NewAdminController(nil, nil, uc, nil).DeleteDishAllergen)
+			e.ServeHTTP(recorder, req)
+
+			tc.check(t, recorder)
+		})
+	}
+}
+
+func TestCreateCity(t *testing.T) {
+	city := randomCity()
+
+	testCases := []struct {
+		name      string
+		body      createCityRequest
+		buildStub func(uc *mocks.MockCityUsecase)
+		check     func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			body: createCityRequest{
+				CityCode:       city.CityCode,
+				CityName:       city.CityName,
+				PrefectureCode: city.PrefectureCode,
+				PrefectureName: city.PrefectureName,
+			},
+			buildStub: func(uc *mocks.MockCityUsecase) {
+				uc.EXPECT().Create(gomock.Any(), gomock.Eq(city)).Times(1).Return(nil)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusCreated, recorder.Code)
+			},
+		},
+		{
+			name: "Bad Request - Invalid CityCode",
+			body: createCityRequest{
+				CityCode:       0,
+				CityName:       city.CityName,
+				PrefectureCode: city.PrefectureCode,
+				PrefectureName: city.PrefectureName,
+			},
+			buildStub: func(uc *mocks.MockCityUsecase) {
+				uc.EXPECT().Create(gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Bad Request - Prefecture Code Out Of Range",
+			body: createCityRequest{
+				CityCode:       city.CityCode,
+				CityName:       city.CityName,
+				PrefectureCode: 48,
+				PrefectureName: city.PrefectureName,
+			},
+			buildStub: func(uc *mocks.MockCityUsecase) {
+				uc.EXPECT().Create(gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Bad Request - Prefecture Code Mismatch",
+			body: createCityRequest{
+				CityCode:       city.CityCode,
+				CityName:       city.CityName,
+				PrefectureCode: city.PrefectureCode%47 + 1,
+				PrefectureName: city.PrefectureName,
+			},
+			buildStub: func(uc *mocks.MockCityUsecase) {
+				uc.EXPECT().Create(gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Conflict",
+			body: createCityRequest{
+				CityCode:       city.CityCode,
+				CityName:       city.CityName,
+				PrefectureCode: city.PrefectureCode,
+				PrefectureName: city.PrefectureName,
+			},
+			buildStub: func(uc *mocks.MockCityUsecase) {
+				uc.EXPECT().Create(gomock.Any(), gomock.Any()).Times(1).Return(&mysql.MySQLError{Number: 1062})
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+		{
+			name: "Internal Server Error",
+			body: createCityRequest{
+				CityCode:       city.CityCode,
+				CityName:       city.CityName,
+				PrefectureCode: city.PrefectureCode,
+				PrefectureName: city.PrefectureName,
+			},
+			buildStub: func(uc *mocks.MockCityUsecase) {
+				uc.EXPECT().Create(gomock.Any(), gomock.Any()).Times(1).Return(sql.ErrConnDone)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc := mocks.NewMockCityUsecase(ctrl)
+			tc.buildStub(uc)
+
+			recorder := httptest.NewRecorder()
+
+			jsonData, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := "/admin/cities"
+			req, err := http.NewRequest(http.MethodPost, url, bytes.NewBuffer(jsonData))
+			require.NoError(t, err)
+
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+			e, env := newSetupAdminTestServer(t)
+			createValidAdminToken(t, env, req)
+
+			e.POST(url, NewAdminController(nil, nil, nil, uc).CreateCity)
+			e.ServeHTTP(recorder, req)
+
+			tc.check(t, recorder)
+		})
+	}
+}
+
+func TestUpdateCity(t *testing.T) {
+	city := randomCity()
+	city.SchoolLunchInfoAvailable = true
+
+	newBody := func(available *bool) map[string]any {
+		body := map[string]any{
+			"city_name":       city.CityName,
+			"prefecture_code": city.PrefectureCode,
+			"prefecture_name": city.PrefectureName,
+		}
+
+		if available != nil {
+			body["school_lunch_info_available"] = *available
+		}
+
+		return body
+	}
+
+	available := true
+	unavailable := false
+
+	testCases := []struct {
+		name      string
+		code      string
+		body      map[string]any
+		buildStub func(uc *mocks.MockCityUsecase)
+		check     func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			code: fmt.Sprint(city.CityCode),
+			body: newBody(&available),
+			buildStub: func(uc *mocks.MockCityUsecase) {
+				uc.EXPECT().GetByCityCode(gomock.Any(), gomock.Any()).Times(0)
+				uc.EXPECT().Update(gomock.Any(), gomock.Eq(city)).Times(1).Return(nil)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "OK - Set Unavailable",
+			code: fmt.Sprint(city.CityCode),
+			body: newBody(&unavailable),
+			buildStub: func(uc *mocks.MockCityUsecase) {
+				expected := *city
+				expected.SchoolLunchInfoAvailable = false
+
+				uc.EXPECT().Update(gomock.Any(), gomock.Eq(&expected)).Times(1).Return(nil)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "OK - Keep Available",
+			code: fmt.Sprint(city.CityCode),
+			body: newBody(nil),
+			buildStub: func(uc *mocks.MockCityUsecase) {
+				uc.EXPECT().GetByCityCode(gomock.Any(), gomock.Eq(city.CityCode)).Times(1).Return(city, nil)
+				uc.EXPECT().Update(gomock.Any(), gomock.Eq(city)).Times(1).Return(nil)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "Bad Request - Invalid Code",
+			code: "invalid",
+			body: newBody(&available),
+			buildStub: func(uc *mocks.MockCityUsecase) {
+				uc.EXPECT().Update(gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Bad Request - Prefecture Code Mismatch",
+			code: fmt.Sprint(city.CityCode),
+			body: map[string]any{
+				"city_name":       city.CityName,
+				"prefecture_code": city.PrefectureCode%47 + 1,
+				"prefecture_name": city.PrefectureName,
+			},
+			buildStub: func(uc *mocks.MockCityUsecase) {
+				uc.EXPECT().Update(gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Not Found",
+			code: fmt.Sprint(city.CityCode),
+			body: newBody(&available),
+			buildStub: func(uc *mocks.MockCityUsecase) {
+				uc.EXPECT().Update(gomock.Any(), gomock.Any()).Times(1).Return(sql.ErrNoRows)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name: "Not Found - Keep Available",
+			code: fmt.Sprint(city.CityCode),
+			body: newBody(nil),
+			buildStub: func(uc *mocks.MockCityUsecase) {
+				uc.EXPECT().GetByCityCode(gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrNoRows)
+				uc.EXPECT().Update(gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc := mocks.NewMockCityUsecase(ctrl)
+			tc.buildStub(uc)
+
+			recorder := httptest.NewRecorder()
+
+			jsonData, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := fmt.Sprintf("/admin/cities/%s", tc.code)
+			req, err := http.NewRequest(http.MethodPut, url, bytes.NewBuffer(jsonData))
+			require.NoError(t, err)
+
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+			e, env := newSetupAdminTestServer(t)
+			createValidAdminToken(t, env, req)
 
-			e.POST("/admin/menus/:id/dishes/bulk", NewAdminController(nil, uc).CreateDishes)
+			e.PUT("/admin/cities/:code", NewAdminController(nil, nil, nil, uc).UpdateCity)
 			e.ServeHTTP(recorder, req)
 
 			tc.check(t, recorder)
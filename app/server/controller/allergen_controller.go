@@ -67,3 +67,15 @@ func (ac *allergenController) FetchByMenuID(c echo.Context) error {
 
 	return c.JSON(200, allergens)
 }
+
+func (ac *allergenController) FetchStandard(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	allergens, err := ac.au.FetchStandard(ctx)
+
+	if err != nil {
+		return c.JSON(errors.NewInternalServerError(err))
+	}
+
+	return c.JSON(200, allergens)
+}
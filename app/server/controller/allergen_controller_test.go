@@ -164,12 +164,89 @@ func TestFetchAllergensByMenuID(t *testing.T) {
 	}
 }
 
+func TestFetchStandardAllergens(t *testing.T) {
+	allergens := randomStandardAllergens(t, 5)
+
+	testCases := []struct {
+		name       string
+		buildStubs func(u *mocks.MockAllergenUsecase)
+		check      func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(au *mocks.MockAllergenUsecase) {
+				au.EXPECT().FetchStandard(gomock.Any()).Times(1).Return(allergens, nil)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var got []*domain.StandardAllergen
+				err := json.Unmarshal(recorder.Body.Bytes(), &got)
+				require.NoError(t, err)
+				require.Equal(t, allergens, got)
+			},
+		},
+		{
+			name: "Internal Server Error",
+			buildStubs: func(au *mocks.MockAllergenUsecase) {
+				au.EXPECT().FetchStandard(gomock.Any()).Times(1).Return(nil, sql.ErrConnDone)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			au := mocks.NewMockAllergenUsecase(ctrl)
+			tc.buildStubs(au)
+			recorder := httptest.NewRecorder()
+
+			req, err := http.NewRequest("GET", "/allergens", nil)
+
+			require.NoError(t, err)
+
+			e := newSetUpTestServer()
+			e.GET("/allergens", NewAllergenController(au).FetchStandard)
+
+			e.ServeHTTP(recorder, req)
+
+			tc.check(t, recorder)
+		})
+	}
+}
+
 func randomAllergen(t *testing.T) *domain.Allergen {
-	return &domain.Allergen{
-		ID:   util.RandomInt32(),
-		Name: util.RandomString(50),
+	return domain.ReNewAllergen(
+		util.RandomInt32(),
+		util.RandomString(50),
+		domain.AllergenKindRecommended,
+		domain.AllergenCategoryMayContain,
+	)
+}
+
+func randomStandardAllergens(t *testing.T, length int) []*domain.StandardAllergen {
+
+	allergens := make([]*domain.StandardAllergen, 0, length)
+
+	for i := 0; i < length; i++ {
+		allergen := domain.ReNewStandardAllergen(
+			util.RandomInt32(),
+			util.RandomString(10),
+			util.RandomString(10),
+			domain.AllergenKindSpecified,
+		)
+
+		allergens = append(allergens, allergen)
 	}
+
+	return allergens
 }
+
 func randomAllergens(t *testing.T, length int) []*domain.Allergen {
 
 	allergens := make([]*domain.Allergen, 0, length)
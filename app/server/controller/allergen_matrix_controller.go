@@ -0,0 +1,104 @@
+package controller
+
+import (
+	"database/sql"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ogurilab/school-lunch-api/domain"
+	"github.com/ogurilab/school-lunch-api/domain/errors"
+	"github.com/ogurilab/school-lunch-api/util"
+)
+
+/************************
+ * AllergenMatrixController
+ ************************/
+
+type allergenMatrixController struct {
+	mu domain.AllergenMatrixUsecase
+}
+
+func NewAllergenMatrixController(mu domain.AllergenMatrixUsecase) domain.AllergenMatrixController {
+	return &allergenMatrixController{
+		mu: mu,
+	}
+}
+
+type fetchAllergenMatrixRequest struct {
+	CityCode string `param:"code" validate:"required,city_code"`
+	Month    string `query:"month" validate:"omitempty,YYYY-MM"`
+	Format   string `query:"format" validate:"omitempty,oneof=json csv pdf"`
+}
+
+// FetchByCity は1か月分のアレルギー一覧表を JSON、CSV または PDF で返す
+// 月を指定しない場合は今月、形式を指定しない場合は JSON を返す
+func (mc *allergenMatrixController) FetchByCity(c echo.Context) error {
+	var req fetchAllergenMatrixRequest
+
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	cityCode, err := util.ParseCityCode(req.CityCode)
+
+	if err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	now := time.Now()
+	month := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+
+	if req.Month != "" {
+		if month, err = util.ParseMonth(req.Month); err != nil {
+			return c.JSON(errors.NewBadRequestError(err))
+		}
+	}
+
+	ctx := c.Request().Context()
+	filename := fmt.Sprintf("allergens-%d-%s", cityCode, month.Format("2006-01"))
+
+	switch req.Format {
+	case "csv":
+		body, err := mc.mu.ExportCSVByCity(ctx, month, cityCode)
+
+		if err != nil {
+			return allergenMatrixError(c, err)
+		}
+
+		c.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf(`attachment; filename="%s.csv"`, filename))
+
+		return blobWithETag(c, domain.ExportFormatCSV.ContentType(), body, sheetMaxAge)
+	case "pdf":
+		body, err := mc.mu.ExportPDFByCity(ctx, month, cityCode)
+
+		if err != nil {
+			return allergenMatrixError(c, err)
+		}
+
+		c.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf(`inline; filename="%s.pdf"`, filename))
+
+		return blobWithETag(c, domain.PDFContentType, body, sheetMaxAge)
+	}
+
+	matrix, err := mc.mu.FetchByCity(ctx, month, cityCode)
+
+	if err != nil {
+		return allergenMatrixError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, matrix)
+}
+
+func allergenMatrixError(c echo.Context, err error) error {
+	if err == sql.ErrNoRows {
+		return c.JSON(errors.NewNotFoundError(err))
+	}
+
+	return c.JSON(errors.NewInternalServerError(err))
+}
@@ -0,0 +1,223 @@
+package controller
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ogurilab/school-lunch-api/domain"
+	"github.com/ogurilab/school-lunch-api/domain/mocks"
+	"github.com/ogurilab/school-lunch-api/util"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestFetchAllergenMatrixByCity(t *testing.T) {
+	city := util.RandomCityCode()
+	month := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+
+	matrix := &domain.AllergenMatrix{
+		City:  &domain.City{CityCode: city, CityName: "浜松市", PrefectureCode: 22, PrefectureName: "静岡県"},
+		Month: month,
+		Allergens: []*domain.AllergenMatrixColumn{
+			{ID: 1, Name: "卵", Kind: domain.AllergenKindSpecified, KindLabel: domain.AllergenKindSpecified.Label()},
+		},
+		Rows: []*domain.AllergenMatrixRow{
+			{MenuID: util.NewUlid(), OfferedAt: month, DishID: util.NewUlid(), DishName: "オムレツ", Contains: []int32{}, MayContain: []int32{1}},
+		},
+	}
+
+	csvBody := []byte("\ufeff提供日,料理,卵\r\n2024-05-01,オムレツ,△\r\n")
+	pdfBody := []byte("%PDF-1.4\n%%EOF\n")
+
+	type req struct {
+		cityCode string
+		month    string
+		format   string
+	}
+
+	testCases := []struct {
+		name      string
+		req       req
+		buildStub func(mu *mocks.MockAllergenMatrixUsecase)
+		check     func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK - JSON",
+			req: req{
+				cityCode: fmt.Sprintf("%d", city),
+				month:    "2024-05",
+			},
+			buildStub: func(mu *mocks.MockAllergenMatrixUsecase) {
+				mu.EXPECT().FetchByCity(gomock.Any(), gomock.Eq(month), gomock.Eq(city)).Times(1).Return(matrix, nil)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var got domain.AllergenMatrix
+
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &got))
+				require.Equal(t, matrix.Allergens, got.Allergens)
+				require.Equal(t, matrix.Rows[0].DishName, got.Rows[0].DishName)
+				require.Equal(t, []int32{1}, got.Rows[0].MayContain)
+				require.Contains(t, recorder.Body.String(), `"contains":[]`)
+			},
+		},
+		{
+			name: "OK - Default Month",
+			req: req{
+				cityCode: fmt.Sprintf("%d", city),
+			},
+			buildStub: func(mu *mocks.MockAllergenMatrixUsecase) {
+				now := time.Now()
+				month := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+				mu.EXPECT().FetchByCity(gomock.Any(), gomock.Eq(month), gomock.Eq(city)).Times(1).Return(matrix, nil)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "OK - CSV",
+			req: req{
+				cityCode: fmt.Sprintf("%d", city),
+				month:    "2024-05",
+				format:   "csv",
+			},
+			buildStub: func(mu *mocks.MockAllergenMatrixUsecase) {
+				mu.EXPECT().ExportCSVByCity(gomock.Any(), gomock.Eq(month), gomock.Eq(city)).Times(1).Return(csvBody, nil)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Equal(t, domain.ExportFormatCSV.ContentType(), recorder.Header().Get(echo.HeaderContentType))
+				require.Equal(t, fmt.Sprintf(`attachment; filename="allergens-%d-2024-05.csv"`, city), recorder.Header().Get(echo.HeaderContentDisposition))
+				require.NotEmpty(t, recorder.Header().Get("ETag"))
+				require.Equal(t, csvBody, recorder.Body.Bytes())
+			},
+		},
+		{
+			name: "OK - PDF",
+			req: req{
+				cityCode: fmt.Sprintf("%d", city),
+				month:    "2024-05",
+				format:   "pdf",
+			},
+			buildStub: func(mu *mocks.MockAllergenMatrixUsecase) {
+				mu.EXPECT().ExportPDFByCity(gomock.Any(), gomock.Eq(month), gomock.Eq(city)).Times(1).Return(pdfBody, nil)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Equal(t, domain.PDFContentType, recorder.Header().Get(echo.HeaderContentType))
+				require.Equal(t, fmt.Sprintf(`inline; filename="allergens-%d-2024-05.pdf"`, city), recorder.Header().Get(echo.HeaderContentDisposition))
+				require.Equal(t, pdfBody, recorder.Body.Bytes())
+			},
+		},
+		{
+			name: "Invalid City Code",
+			req: req{
+				cityCode: "invalid",
+			},
+			buildStub: func(mu *mocks.MockAllergenMatrixUsecase) {
+				mu.EXPECT().FetchByCity(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Invalid Month",
+			req: req{
+				cityCode: fmt.Sprintf("%d", city),
+				month:    "2024-13",
+			},
+			buildStub: func(mu *mocks.MockAllergenMatrixUsecase) {
+				mu.EXPECT().FetchByCity(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Invalid Format",
+			req: req{
+				cityCode: fmt.Sprintf("%d", city),
+				format:   "xlsx",
+			},
+			buildStub: func(mu *mocks.MockAllergenMatrixUsecase) {
+				mu.EXPECT().FetchByCity(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				mu.EXPECT().ExportCSVByCity(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				mu.EXPECT().ExportPDFByCity(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "City Not Found",
+			req: req{
+				cityCode: fmt.Sprintf("%d", city),
+				format:   "pdf",
+			},
+			buildStub: func(mu *mocks.MockAllergenMatrixUsecase) {
+				mu.EXPECT().ExportPDFByCity(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrNoRows)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+				require.Empty(t, recorder.Header().Get(echo.HeaderContentDisposition))
+			},
+		},
+		{
+			name: "Internal Server Error",
+			req: req{
+				cityCode: fmt.Sprintf("%d", city),
+			},
+			buildStub: func(mu *mocks.MockAllergenMatrixUsecase) {
+				mu.EXPECT().FetchByCity(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrConnDone)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mu := mocks.NewMockAllergenMatrixUsecase(ctrl)
+			tc.buildStub(mu)
+
+			q := make(url.Values)
+
+			if tc.req.month != "" {
+				q.Set("month", tc.req.month)
+			}
+
+			if tc.req.format != "" {
+				q.Set("format", tc.req.format)
+			}
+
+			url := fmt.Sprintf("/cities/%s/menus/allergen-matrix?%s", tc.req.cityCode, q.Encode())
+			req, err := http.NewRequest(http.MethodGet, url, nil)
+
+			require.NoError(t, err)
+
+			recorder := httptest.NewRecorder()
+			e := newSetUpTestServer()
+
+			// 同じ階層の /cities/:code/menus/:id と衝突しないことを確認する
+			e.GET("/cities/:code/menus/allergen-matrix", NewAllergenMatrixController(mu).FetchByCity)
+			e.GET("/cities/:code/menus/:id", func(c echo.Context) error { return c.NoContent(http.StatusTeapot) })
+			e.ServeHTTP(recorder, req)
+
+			tc.check(t, recorder)
+		})
+	}
+}
@@ -0,0 +1,149 @@
+package controller
+
+import (
+	"database/sql"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ogurilab/school-lunch-api/domain"
+	"github.com/ogurilab/school-lunch-api/domain/errors"
+)
+
+type apiKeyController struct {
+	ku domain.APIKeyUsecase
+}
+
+func NewAPIKeyController(ku domain.APIKeyUsecase) domain.APIKeyController {
+	return &apiKeyController{
+		ku: ku,
+	}
+}
+
+type createAPIKeyRequest struct {
+	Name       string `json:"name" validate:"required,max=100"`
+	RateLimit  int32  `json:"rate_limit" validate:"required,gt=0"`
+	Burst      int32  `json:"burst" validate:"required,gt=0"`
+	DailyQuota int32  `json:"daily_quota" validate:"required,gt=0"`
+}
+
+// Create はキーを発行する
+// キーはこのレスポンスでのみ返すため、利用者に安全に伝える必要がある
+func (kc *apiKeyController) Create(c echo.Context) error {
+	var req createAPIKeyRequest
+
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	ctx := c.Request().Context()
+
+	key := domain.NewAPIKey(
+		req.Name,
+		req.RateLimit,
+		req.Burst,
+		req.DailyQuota,
+	)
+
+	issued, err := kc.ku.Create(ctx, key)
+
+	if err != nil {
+		return c.JSON(errors.NewInternalServerError(err))
+	}
+
+	return c.JSON(http.StatusCreated, issued)
+}
+
+type getAPIKeyRequest struct {
+	ID int32 `param:"id" validate:"required,gt=0"`
+}
+
+func (kc *apiKeyController) GetByID(c echo.Context) error {
+	var req getAPIKeyRequest
+
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	ctx := c.Request().Context()
+
+	key, err := kc.ku.GetByID(ctx, req.ID)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return c.JSON(errors.NewNotFoundError(err))
+		}
+
+		return c.JSON(errors.NewInternalServerError(err))
+	}
+
+	return c.JSON(http.StatusOK, key)
+}
+
+type fetchAPIKeyRequest struct {
+	Limit  int32 `query:"limit" validate:"gt=0"`
+	Offset int32 `query:"offset" validate:"gte=0"`
+}
+
+func (kc *apiKeyController) Fetch(c echo.Context) error {
+	var req fetchAPIKeyRequest
+
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	if req.Limit == 0 {
+		req.Limit = domain.DEFAULT_LIMIT
+	}
+
+	if req.Limit > domain.MAX_LIMIT {
+		return c.JSON(errors.NewMaxLimitError())
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	ctx := c.Request().Context()
+
+	keys, err := kc.ku.Fetch(ctx, req.Limit, req.Offset)
+
+	if err != nil {
+		return c.JSON(errors.NewInternalServerError(err))
+	}
+
+	return c.JSON(http.StatusOK, keys)
+}
+
+// Revoke はキーを無効化する
+// 無効化したキーは利用履歴を残すため削除しない
+func (kc *apiKeyController) Revoke(c echo.Context) error {
+	var req getAPIKeyRequest
+
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	ctx := c.Request().Context()
+
+	if err := kc.ku.Revoke(ctx, req.ID); err != nil {
+		if err == sql.ErrNoRows {
+			return c.JSON(errors.NewNotFoundError(err))
+		}
+
+		return c.JSON(errors.NewInternalServerError(err))
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
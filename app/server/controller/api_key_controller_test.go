@@ -0,0 +1,243 @@
+package controller
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ogurilab/school-lunch-api/domain"
+	"github.com/ogurilab/school-lunch-api/domain/mocks"
+	"github.com/ogurilab/school-lunch-api/util"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCreateAPIKey(t *testing.T) {
+	key := randomAPIKey()
+
+	testCases := []struct {
+		name      string
+		body      echo.Map
+		buildStub func(ku *mocks.MockAPIKeyUsecase)
+		check     func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			body: echo.Map{
+				"name":        key.Name,
+				"rate_limit":  key.RateLimit,
+				"burst":       key.Burst,
+				"daily_quota": key.DailyQuota,
+			},
+			buildStub: func(ku *mocks.MockAPIKeyUsecase) {
+				arg := domain.NewAPIKey(key.Name, key.RateLimit, key.Burst, key.DailyQuota)
+				issued := &domain.IssuedAPIKey{
+					APIKey: key,
+					Key:    "slk_raw",
+				}
+				ku.EXPECT().Create(gomock.Any(), gomock.Eq(arg)).Times(1).Return(issued, nil)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusCreated, recorder.Code)
+
+				var res map[string]any
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+				require.Equal(t, "slk_raw", res["key"])
+				require.Equal(t, key.Prefix, res["prefix"])
+
+				// ハッシュ化したキーはレスポンスに含めない
+				require.NotContains(t, recorder.Body.String(), key.HashedKey)
+			},
+		},
+		{
+			name: "Bad Request",
+			body: echo.Map{
+				"name":       key.Name,
+				"rate_limit": 0,
+			},
+			buildStub: func(ku *mocks.MockAPIKeyUsecase) {
+				ku.EXPECT().Create(gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Internal Server Error",
+			body: echo.Map{
+				"name":        key.Name,
+				"rate_limit":  key.RateLimit,
+				"burst":       key.Burst,
+				"daily_quota": key.DailyQuota,
+			},
+			buildStub: func(ku *mocks.MockAPIKeyUsecase) {
+				ku.EXPECT().Create(gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrConnDone)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ku := mocks.NewMockAPIKeyUsecase(ctrl)
+			tc.buildStub(ku)
+
+			recorder := httptest.NewRecorder()
+
+			body, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			req, err := http.NewRequest(http.MethodPost, "/admin/api-keys", bytes.NewReader(body))
+			require.NoError(t, err)
+
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+			e := newSetUpTestServer()
+			e.POST("/admin/api-keys", NewAPIKeyController(ku).Create)
+			e.ServeHTTP(recorder, req)
+
+			tc.check(t, recorder)
+		})
+	}
+}
+
+func TestGetAPIKey(t *testing.T) {
+	key := randomAPIKey()
+
+	testCases := []struct {
+		name      string
+		id        string
+		buildStub func(ku *mocks.MockAPIKeyUsecase)
+		check     func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			id:   fmt.Sprint(key.ID),
+			buildStub: func(ku *mocks.MockAPIKeyUsecase) {
+				ku.EXPECT().GetByID(gomock.Any(), gomock.Eq(key.ID)).Times(1).Return(key, nil)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.NotContains(t, recorder.Body.String(), key.HashedKey)
+			},
+		},
+		{
+			name: "Not Found",
+			id:   fmt.Sprint(key.ID),
+			buildStub: func(ku *mocks.MockAPIKeyUsecase) {
+				ku.EXPECT().GetByID(gomock.Any(), gomock.Eq(key.ID)).Times(1).Return(nil, sql.ErrNoRows)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name: "Bad Request",
+			id:   "invalid",
+			buildStub: func(ku *mocks.MockAPIKeyUsecase) {
+				ku.EXPECT().GetByID(gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ku := mocks.NewMockAPIKeyUsecase(ctrl)
+			tc.buildStub(ku)
+
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/admin/api-keys/%s", tc.id)
+			req, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			e := newSetUpTestServer()
+			e.GET("/admin/api-keys/:id", NewAPIKeyController(ku).GetByID)
+			e.ServeHTTP(recorder, req)
+
+			tc.check(t, recorder)
+		})
+	}
+}
+
+func TestRevokeAPIKey(t *testing.T) {
+	key := randomAPIKey()
+
+	testCases := []struct {
+		name      string
+		buildStub func(ku *mocks.MockAPIKeyUsecase)
+		check     func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			buildStub: func(ku *mocks.MockAPIKeyUsecase) {
+				ku.EXPECT().Revoke(gomock.Any(), gomock.Eq(key.ID)).Times(1).Return(nil)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNoContent, recorder.Code)
+			},
+		},
+		{
+			name: "Not Found",
+			buildStub: func(ku *mocks.MockAPIKeyUsecase) {
+				ku.EXPECT().Revoke(gomock.Any(), gomock.Eq(key.ID)).Times(1).Return(sql.ErrNoRows)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ku := mocks.NewMockAPIKeyUsecase(ctrl)
+			tc.buildStub(ku)
+
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/admin/api-keys/%d", key.ID)
+			req, err := http.NewRequest(http.MethodDelete, url, nil)
+			require.NoError(t, err)
+
+			e := newSetUpTestServer()
+			e.DELETE("/admin/api-keys/:id", NewAPIKeyController(ku).Revoke)
+			e.ServeHTTP(recorder, req)
+
+			tc.check(t, recorder)
+		})
+	}
+}
+
+func randomAPIKey() *domain.APIKey {
+	return domain.ReNewAPIKey(
+		util.RandomInt32()+1,
+		util.RandomString(10),
+		"slk_"+util.RandomString(8),
+		util.HashAPIKey(util.RandomString(32)),
+		60,
+		10,
+		1000,
+		false,
+		time.Now().UTC().Truncate(time.Second),
+	)
+}
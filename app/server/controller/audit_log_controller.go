@@ -0,0 +1,99 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ogurilab/school-lunch-api/domain"
+	"github.com/ogurilab/school-lunch-api/domain/errors"
+	"github.com/ogurilab/school-lunch-api/util"
+)
+
+type auditLogController struct {
+	lu domain.AuditLogUsecase
+}
+
+func NewAuditLogController(lu domain.AuditLogUsecase) domain.AuditLogController {
+	return &auditLogController{
+		lu: lu,
+	}
+}
+
+// from と to は YYYY-MM-DD 形式で、to の日付も含めて検索する
+type fetchAuditLogRequest struct {
+	CityCode   string `query:"city_code" validate:"omitempty,city_code"`
+	EntityType string `query:"entity_type" validate:"omitempty,max=50"`
+	EntityID   string `query:"entity_id" validate:"omitempty,max=255"`
+	From       string `query:"from" validate:"omitempty,YYYY-MM-DD"`
+	To         string `query:"to" validate:"omitempty,YYYY-MM-DD"`
+	Limit      int32  `query:"limit" validate:"gt=0"`
+	Offset     int32  `query:"offset" validate:"gte=0"`
+}
+
+func (lc *auditLogController) Fetch(c echo.Context) error {
+	var req fetchAuditLogRequest
+
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	if req.Limit == 0 {
+		req.Limit = domain.DEFAULT_LIMIT
+	}
+
+	if req.Limit > domain.MAX_LIMIT {
+		return c.JSON(errors.NewMaxLimitError())
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	filter := &domain.AuditLogFilter{
+		EntityType: req.EntityType,
+		EntityID:   req.EntityID,
+		Limit:      req.Limit,
+		Offset:     req.Offset,
+	}
+
+	if req.CityCode != "" {
+		cityCode, err := util.ParseCityCode(req.CityCode)
+
+		if err != nil {
+			return c.JSON(errors.NewBadRequestError(err))
+		}
+
+		filter.CityCode = &cityCode
+	}
+
+	if req.From != "" {
+		from, err := util.ParseDate(req.From)
+
+		if err != nil {
+			return c.JSON(errors.NewBadRequestError(err))
+		}
+
+		filter.From = &from
+	}
+
+	if req.To != "" {
+		to, err := util.ParseDate(req.To)
+
+		if err != nil {
+			return c.JSON(errors.NewBadRequestError(err))
+		}
+
+		to = to.AddDate(0, 0, 1)
+		filter.To = &to
+	}
+
+	ctx := c.Request().Context()
+
+	logs, err := lc.lu.Fetch(ctx, filter)
+
+	if err != nil {
+		return c.JSON(errors.NewInternalServerError(err))
+	}
+
+	return c.JSON(http.StatusOK, logs)
+}
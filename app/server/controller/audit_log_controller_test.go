@@ -0,0 +1,133 @@
+package controller
+
+import (
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ogurilab/school-lunch-api/domain"
+	"github.com/ogurilab/school-lunch-api/domain/mocks"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestFetchAuditLogs(t *testing.T) {
+	cityCode := int32(23205)
+	logs := []*domain.AuditLog{
+		{
+			ID:            1,
+			ActorID:       1,
+			ActorUsername: "admin",
+			Action:        domain.AuditActionUpdate,
+			EntityType:    domain.AuditEntityMenu,
+			EntityID:      "menu",
+			CityCode:      &cityCode,
+			Before:        json.RawMessage(`{"id": "menu"}`),
+			After:         json.RawMessage(`{"id": "menu"}`),
+			RequestID:     "request",
+			CreatedAt:     time.Now().UTC().Truncate(time.Second),
+		},
+	}
+
+	testCases := []struct {
+		name      string
+		query     string
+		buildStub func(lu *mocks.MockAuditLogUsecase)
+		check     func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:  "OK",
+			query: "?city_code=232050&entity_type=menu&entity_id=menu&from=2024-01-01&to=2024-01-31",
+			buildStub: func(lu *mocks.MockAuditLogUsecase) {
+				from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+				to := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+				filter := &domain.AuditLogFilter{
+					CityCode:   &cityCode,
+					EntityType: domain.AuditEntityMenu,
+					EntityID:   "menu",
+					From:       &from,
+					To:         &to,
+					Limit:      domain.DEFAULT_LIMIT,
+					Offset:     0,
+				}
+				lu.EXPECT().Fetch(gomock.Any(), gomock.Eq(filter)).Times(1).Return(logs, nil)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var res []*domain.AuditLog
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+				require.Len(t, res, 1)
+				require.Equal(t, logs[0].EntityID, res[0].EntityID)
+				require.JSONEq(t, string(logs[0].Before), string(res[0].Before))
+			},
+		},
+		{
+			name:  "No Filter",
+			query: "",
+			buildStub: func(lu *mocks.MockAuditLogUsecase) {
+				filter := &domain.AuditLogFilter{
+					Limit: domain.DEFAULT_LIMIT,
+				}
+				lu.EXPECT().Fetch(gomock.Any(), gomock.Eq(filter)).Times(1).Return(logs, nil)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:  "Bad Request - Invalid Date",
+			query: "?from=2024/01/01",
+			buildStub: func(lu *mocks.MockAuditLogUsecase) {
+				lu.EXPECT().Fetch(gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:  "Bad Request - Invalid City Code",
+			query: "?city_code=99999",
+			buildStub: func(lu *mocks.MockAuditLogUsecase) {
+				lu.EXPECT().Fetch(gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:  "Internal Server Error",
+			query: "",
+			buildStub: func(lu *mocks.MockAuditLogUsecase) {
+				lu.EXPECT().Fetch(gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrConnDone)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			lu := mocks.NewMockAuditLogUsecase(ctrl)
+			tc.buildStub(lu)
+
+			recorder := httptest.NewRecorder()
+
+			req, err := http.NewRequest(http.MethodGet, "/admin/audit-logs"+tc.query, nil)
+			require.NoError(t, err)
+
+			e := newSetUpTestServer()
+			e.GET("/admin/audit-logs", NewAuditLogController(lu).Fetch)
+			e.ServeHTTP(recorder, req)
+
+			tc.check(t, recorder)
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/labstack/echo/v4"
 	"github.com/ogurilab/school-lunch-api/domain"
 	"github.com/ogurilab/school-lunch-api/domain/errors"
+	"github.com/ogurilab/school-lunch-api/util"
 )
 
 type cityController struct {
@@ -19,7 +20,7 @@ func NewCityController(cu domain.CityUsecase) domain.CityController {
 }
 
 type getCityRequest struct {
-	CityCode int32 `param:"code" validate:"required,gt=0"`
+	CityCode string `param:"code" validate:"required,city_code"`
 }
 
 func (cc *cityController) GetByCityCode(c echo.Context) error {
@@ -35,9 +36,15 @@ func (cc *cityController) GetByCityCode(c echo.Context) error {
 		return c.JSON(errors.NewBadRequestError(err))
 	}
 
+	cityCode, err := util.ParseCityCode(req.CityCode)
+
+	if err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
 	ctx := c.Request().Context()
 
-	city, err := cc.cityUsecase.GetByCityCode(ctx, req.CityCode)
+	city, err := cc.cityUsecase.GetByCityCode(ctx, cityCode)
 
 	if err != nil {
 
@@ -27,7 +27,7 @@ func TestGetByCityCode(t *testing.T) {
 
 	type args struct {
 		c    echo.Context
-		code int32
+		code string
 	}
 
 	tests := []struct {
@@ -40,7 +40,7 @@ func TestGetByCityCode(t *testing.T) {
 			name: "OK",
 			args: args{
 				c:    ctx,
-				code: city.CityCode,
+				code: fmt.Sprint(city.CityCode),
 			},
 			buildStub: func(uc *mocks.MockCityUsecase) {
 
@@ -55,7 +55,7 @@ func TestGetByCityCode(t *testing.T) {
 			name: "Bad Request",
 			args: args{
 				c:    ctx,
-				code: int32(-1),
+				code: "-1",
 			},
 			buildStub: func(uc *mocks.MockCityUsecase) {
 				uc.EXPECT().GetByCityCode(context.Background(), -1).Times(0)
@@ -64,11 +64,51 @@ func TestGetByCityCode(t *testing.T) {
 				require.Equal(t, http.StatusBadRequest, recorder.Code)
 			},
 		},
+		{
+			name: "OK - 6 digit code",
+			args: args{
+				c:    ctx,
+				code: fmt.Sprintf("%06d", city.CityCode*10+util.CityCodeCheckDigit(city.CityCode)),
+			},
+			buildStub: func(uc *mocks.MockCityUsecase) {
+				uc.EXPECT().GetByCityCode(context.Background(), city.CityCode).Times(1).Return(city, nil)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchCity(t, recorder.Body, city)
+			},
+		},
+		{
+			name: "Bad Request - Invalid Check Digit",
+			args: args{
+				c:    ctx,
+				code: fmt.Sprintf("%06d", city.CityCode*10+(util.CityCodeCheckDigit(city.CityCode)+1)%10),
+			},
+			buildStub: func(uc *mocks.MockCityUsecase) {
+				uc.EXPECT().GetByCityCode(gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Bad Request - Invalid Prefecture",
+			args: args{
+				c:    ctx,
+				code: "48201",
+			},
+			buildStub: func(uc *mocks.MockCityUsecase) {
+				uc.EXPECT().GetByCityCode(gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
 		{
 			name: "Not Found",
 			args: args{
 				c:    ctx,
-				code: city.CityCode,
+				code: fmt.Sprint(city.CityCode),
 			},
 			buildStub: func(uc *mocks.MockCityUsecase) {
 				uc.EXPECT().GetByCityCode(context.Background(), city.CityCode).Times(1).Return(nil, sql.ErrNoRows)
@@ -81,7 +121,7 @@ func TestGetByCityCode(t *testing.T) {
 			name: "Internal Server Error",
 			args: args{
 				c:    ctx,
-				code: city.CityCode,
+				code: fmt.Sprint(city.CityCode),
 			},
 			buildStub: func(uc *mocks.MockCityUsecase) {
 				uc.EXPECT().GetByCityCode(context.Background(), city.CityCode).Times(1).Return(nil, sql.ErrConnDone)
@@ -102,7 +142,7 @@ func TestGetByCityCode(t *testing.T) {
 
 			recorder := httptest.NewRecorder()
 
-			url := fmt.Sprintf("/cities/%d", tc.args.code)
+			url := fmt.Sprintf("/cities/%s", tc.args.code)
 			req, err := http.NewRequest(http.MethodGet, url, nil)
 
 			require.NoError(t, err)
@@ -481,10 +521,12 @@ func TestFetchByPrefectureCode(t *testing.T) {
 }
 
 func randomCity() *domain.City {
+	code := util.RandomCityCode()
+
 	return domain.NewCity(
-		util.RandomCityCode(),
+		code,
 		util.RandomString(10),
-		util.RandomInt32(),
+		util.CityPrefectureCode(code),
 		util.RandomString(10),
 	)
 }
@@ -4,6 +4,7 @@ import (
 	"github.com/labstack/echo/v4"
 	"github.com/ogurilab/school-lunch-api/domain"
 	"github.com/ogurilab/school-lunch-api/domain/errors"
+	"github.com/ogurilab/school-lunch-api/util"
 )
 
 type dishController struct {
@@ -86,7 +87,7 @@ type getDishInCityRequest struct {
 	ID       string `param:"id" validate:"required,ulid"`
 	Limit    int32  `query:"limit" validate:"gt=0"`
 	Offset   int32  `query:"offset" validate:"gte=0"`
-	CityCode int32  `param:"code" validate:"required,gte=0"`
+	CityCode string `param:"code" validate:"required,city_code"`
 }
 
 func (dc *dishController) GetByIdInCity(c echo.Context) error {
@@ -112,9 +113,15 @@ func (dc *dishController) GetByIdInCity(c echo.Context) error {
 		return c.JSON(errors.NewBadRequestError(err))
 	}
 
+	cityCode, err := util.ParseCityCode(req.CityCode)
+
+	if err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
 	ctx := c.Request().Context()
 
-	dish, err := dc.du.GetByIdInCity(ctx, req.ID, req.Limit, req.Offset, req.CityCode)
+	dish, err := dc.du.GetByIdInCity(ctx, req.ID, req.Limit, req.Offset, cityCode)
 
 	if err != nil {
 		return c.JSON(errors.NewInternalServerError(err))
@@ -124,9 +131,10 @@ func (dc *dishController) GetByIdInCity(c echo.Context) error {
 }
 
 type fetchDishRequest struct {
-	Limit  int32  `query:"limit" validate:"gt=0"`
-	Offset int32  `query:"offset" validate:"gte=0"`
-	Search string `query:"search"`
+	Limit            int32   `query:"limit" validate:"gt=0"`
+	Offset           int32   `query:"offset" validate:"gte=0"`
+	Search           string  `query:"search"`
+	ExcludeAllergens []int32 `query:"exclude_allergens" validate:"dive,gt=0"`
 }
 
 func (dc *dishController) Fetch(c echo.Context) error {
@@ -154,7 +162,71 @@ func (dc *dishController) Fetch(c echo.Context) error {
 
 	ctx := c.Request().Context()
 
-	dishes, err := dc.du.Fetch(ctx, req.Search, req.Limit, req.Offset)
+	var dishes []*domain.Dish
+	var err error
+
+	if len(req.ExcludeAllergens) > 0 {
+		dishes, err = dc.du.FetchExcludingAllergens(ctx, req.Search, req.ExcludeAllergens, req.Limit, req.Offset)
+	} else {
+		dishes, err = dc.du.Fetch(ctx, req.Search, req.Limit, req.Offset)
+	}
+
+	if err != nil {
+		return c.JSON(errors.NewInternalServerError(err))
+	}
+
+	return c.JSON(200, dishes)
+}
+
+type fetchDishByAllergenIDRequest struct {
+	AllergenID int32  `param:"id" validate:"required,gt=0"`
+	CityCode   string `query:"city_code" validate:"omitempty,city_code"`
+	Limit      int32  `query:"limit" validate:"gt=0"`
+	Offset     int32  `query:"offset" validate:"gte=0"`
+}
+
+func (dc *dishController) FetchByAllergenID(c echo.Context) error {
+	var req fetchDishByAllergenIDRequest
+
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	if req.Limit > domain.MAX_LIMIT {
+		return c.JSON(errors.NewMaxLimitError())
+	}
+
+	if req.Limit == 0 {
+		req.Limit = domain.DEFAULT_LIMIT
+	}
+
+	if req.Offset == 0 {
+		req.Offset = domain.DEFAULT_OFFSET
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	ctx := c.Request().Context()
+
+	if req.CityCode == "" {
+		dishes, err := dc.du.FetchByAllergenID(ctx, req.AllergenID, req.Limit, req.Offset)
+
+		if err != nil {
+			return c.JSON(errors.NewInternalServerError(err))
+		}
+
+		return c.JSON(200, dishes)
+	}
+
+	cityCode, err := util.ParseCityCode(req.CityCode)
+
+	if err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	dishes, err := dc.du.FetchByAllergenIDInCity(ctx, req.AllergenID, req.Limit, req.Offset, cityCode)
 
 	if err != nil {
 		return c.JSON(errors.NewInternalServerError(err))
@@ -305,7 +305,7 @@ func TestGetDishByID(t *testing.T) {
 
 func TestGetDishByIDInCity(t *testing.T) {
 	dish := randomDishWithMenuIDs(t)
-	cityCode := util.RandomInt32()
+	cityCode := util.RandomCityCode()
 
 	type req struct {
 		id     string
@@ -536,9 +536,10 @@ func TestFetchDish(t *testing.T) {
 	}
 
 	type req struct {
-		limit  sql.NullInt32
-		offset sql.NullInt32
-		search sql.NullString
+		limit            sql.NullInt32
+		offset           sql.NullInt32
+		search           sql.NullString
+		excludeAllergens []int32
 	}
 
 	testCases := []struct {
@@ -663,6 +664,37 @@ func TestFetchDish(t *testing.T) {
 				require.Equal(t, http.StatusInternalServerError, recorder.Code)
 			},
 		},
+		{
+			name: "OK with exclude_allergens",
+			req: req{
+				limit:            sql.NullInt32{Int32: 10, Valid: true},
+				offset:           sql.NullInt32{Int32: 0, Valid: true},
+				search:           sql.NullString{String: "dish", Valid: true},
+				excludeAllergens: []int32{1, 2},
+			},
+			buildStub: func(du *mocks.MockDishUsecase) {
+				du.EXPECT().Fetch(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				du.EXPECT().FetchExcludingAllergens(gomock.Any(), gomock.Eq("dish"), gomock.Eq([]int32{1, 2}), gomock.Eq(int32(10)), gomock.Eq(int32(0))).Times(1).Return(dishes, nil)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder, dishes []*domain.Dish) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchDishes(t, recorder.Body, dishes)
+			},
+		},
+		{
+			name: "Invalid exclude_allergens",
+			req: req{
+				limit:            sql.NullInt32{Int32: 10, Valid: true},
+				offset:           sql.NullInt32{Int32: 0, Valid: true},
+				excludeAllergens: []int32{0},
+			},
+			buildStub: func(du *mocks.MockDishUsecase) {
+				du.EXPECT().FetchExcludingAllergens(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder, dishes []*domain.Dish) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -687,6 +719,10 @@ func TestFetchDish(t *testing.T) {
 				q.Set("search", tc.req.search.String)
 			}
 
+			for _, id := range tc.req.excludeAllergens {
+				q.Add("exclude_allergens", fmt.Sprintf("%d", id))
+			}
+
 			url := fmt.Sprintf("/dishes?%s", q.Encode())
 			req, err := http.NewRequest(http.MethodGet, url, nil)
 
@@ -702,6 +738,123 @@ func TestFetchDish(t *testing.T) {
 	}
 }
 
+func TestFetchDishByAllergenID(t *testing.T) {
+	var dishes []*domain.Dish
+
+	for i := 0; i < 10; i++ {
+		dishes = append(dishes, randomDish(t))
+	}
+
+	city := util.RandomCityCode()
+
+	type req struct {
+		allergenID string
+		cityCode   sql.NullString
+	}
+
+	testCases := []struct {
+		name      string
+		req       req
+		buildStub func(du *mocks.MockDishUsecase)
+		check     func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			req: req{
+				allergenID: "1",
+			},
+			buildStub: func(du *mocks.MockDishUsecase) {
+				du.EXPECT().FetchByAllergenID(gomock.Any(), gomock.Eq(int32(1)), gomock.Eq(domain.DEFAULT_LIMIT), gomock.Eq(domain.DEFAULT_OFFSET)).Times(1).Return(dishes, nil)
+				du.EXPECT().FetchByAllergenIDInCity(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchDishes(t, recorder.Body, dishes)
+			},
+		},
+		{
+			name: "OK with city_code",
+			req: req{
+				allergenID: "1",
+				cityCode:   sql.NullString{String: fmt.Sprintf("%d", city), Valid: true},
+			},
+			buildStub: func(du *mocks.MockDishUsecase) {
+				du.EXPECT().FetchByAllergenID(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				du.EXPECT().FetchByAllergenIDInCity(gomock.Any(), gomock.Eq(int32(1)), gomock.Eq(domain.DEFAULT_LIMIT), gomock.Eq(domain.DEFAULT_OFFSET), gomock.Eq(city)).Times(1).Return(dishes, nil)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchDishes(t, recorder.Body, dishes)
+			},
+		},
+		{
+			name: "Invalid allergen id",
+			req: req{
+				allergenID: "0",
+			},
+			buildStub: func(du *mocks.MockDishUsecase) {
+				du.EXPECT().FetchByAllergenID(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Invalid city_code",
+			req: req{
+				allergenID: "1",
+				cityCode:   sql.NullString{String: "invalid", Valid: true},
+			},
+			buildStub: func(du *mocks.MockDishUsecase) {
+				du.EXPECT().FetchByAllergenIDInCity(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Internal Server Error",
+			req: req{
+				allergenID: "1",
+			},
+			buildStub: func(du *mocks.MockDishUsecase) {
+				du.EXPECT().FetchByAllergenID(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrConnDone)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			du := mocks.NewMockDishUsecase(ctrl)
+			tc.buildStub(du)
+
+			q := make(url.Values)
+
+			if tc.req.cityCode.Valid {
+				q.Set("city_code", tc.req.cityCode.String)
+			}
+
+			url := fmt.Sprintf("/allergens/%s/dishes?%s", tc.req.allergenID, q.Encode())
+			req, err := http.NewRequest(http.MethodGet, url, nil)
+
+			require.NoError(t, err)
+
+			recorder := httptest.NewRecorder()
+			e := newSetUpTestServer()
+			e.GET("/allergens/:id/dishes", NewDishController(du).FetchByAllergenID)
+			e.ServeHTTP(recorder, req)
+
+			tc.check(t, recorder)
+		})
+	}
+}
+
 func requireBodyMatchDishWithMenuIDs(t *testing.T, body *bytes.Buffer, dish *domain.DishWithMenuIDs) {
 	data, err := io.ReadAll(body)
 
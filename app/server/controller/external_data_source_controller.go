@@ -0,0 +1,241 @@
+package controller
+
+import (
+	"database/sql"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ogurilab/school-lunch-api/domain"
+	"github.com/ogurilab/school-lunch-api/domain/errors"
+	"github.com/ogurilab/school-lunch-api/util"
+)
+
+type externalDataSourceController struct {
+	su domain.ExternalDataSourceUsecase
+}
+
+func NewExternalDataSourceController(su domain.ExternalDataSourceUsecase) domain.ExternalDataSourceController {
+	return &externalDataSourceController{
+		su: su,
+	}
+}
+
+type createExternalDataSourceRequest struct {
+	CityCode    int32  `json:"city_code" validate:"required,city_code"`
+	DatasetID   string `json:"dataset_id" validate:"required,url,max=255"`
+	Year        int32  `json:"year" validate:"required,gte=2000,lte=2100"`
+	Status      string `json:"status" validate:"omitempty,oneof=Active Inactive"`
+	Category    string `json:"category" validate:"required,oneof=menu dish allergens"`
+	Description string `json:"description"`
+}
+
+func (ec *externalDataSourceController) Create(c echo.Context) error {
+	var req createExternalDataSourceRequest
+
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	if req.Status == "" {
+		req.Status = domain.DataSourceStatusInactive
+	}
+
+	ctx := c.Request().Context()
+
+	source, err := ec.su.Create(ctx, domain.NewExternalDataSource(
+		req.CityCode,
+		req.DatasetID,
+		req.Year,
+		req.Status,
+		req.Category,
+		sql.NullString{String: req.Description, Valid: req.Description != ""},
+	))
+
+	if err != nil {
+		if util.IsDuplicateEntry(err) {
+			return c.JSON(errors.NewConflictError(err))
+		}
+
+		return c.JSON(errors.NewInternalServerError(err))
+	}
+
+	return c.JSON(http.StatusCreated, source)
+}
+
+type getExternalDataSourceRequest struct {
+	ID int32 `param:"id" validate:"required,gt=0"`
+}
+
+func (ec *externalDataSourceController) GetByID(c echo.Context) error {
+	var req getExternalDataSourceRequest
+
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	ctx := c.Request().Context()
+
+	source, err := ec.su.GetByID(ctx, req.ID)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return c.JSON(errors.NewNotFoundError(err))
+		}
+
+		return c.JSON(errors.NewInternalServerError(err))
+	}
+
+	return c.JSON(http.StatusOK, source)
+}
+
+type fetchExternalDataSourceRequest struct {
+	Limit  int32 `query:"limit" validate:"gt=0"`
+	Offset int32 `query:"offset" validate:"gte=0"`
+}
+
+func (ec *externalDataSourceController) Fetch(c echo.Context) error {
+	var req fetchExternalDataSourceRequest
+
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	if req.Limit == 0 {
+		req.Limit = domain.DEFAULT_LIMIT
+	}
+
+	if req.Limit > domain.MAX_LIMIT {
+		return c.JSON(errors.NewMaxLimitError())
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	ctx := c.Request().Context()
+
+	sources, err := ec.su.Fetch(ctx, req.Limit, req.Offset)
+
+	if err != nil {
+		return c.JSON(errors.NewInternalServerError(err))
+	}
+
+	return c.JSON(http.StatusOK, sources)
+}
+
+type updateExternalDataSourceRequest struct {
+	ID int32 `param:"id" validate:"required,gt=0"`
+	createExternalDataSourceRequest
+}
+
+func (ec *externalDataSourceController) Update(c echo.Context) error {
+	var req updateExternalDataSourceRequest
+
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	if req.Status == "" {
+		req.Status = domain.DataSourceStatusInactive
+	}
+
+	ctx := c.Request().Context()
+
+	source := domain.NewExternalDataSource(
+		req.CityCode,
+		req.DatasetID,
+		req.Year,
+		req.Status,
+		req.Category,
+		sql.NullString{String: req.Description, Valid: req.Description != ""},
+	)
+	source.ID = req.ID
+
+	if err := ec.su.Update(ctx, source); err != nil {
+		if err == sql.ErrNoRows {
+			return c.JSON(errors.NewNotFoundError(err))
+		}
+
+		if util.IsDuplicateEntry(err) {
+			return c.JSON(errors.NewConflictError(err))
+		}
+
+		return c.JSON(errors.NewInternalServerError(err))
+	}
+
+	updated, err := ec.su.GetByID(ctx, req.ID)
+
+	if err != nil {
+		return c.JSON(errors.NewInternalServerError(err))
+	}
+
+	return c.JSON(http.StatusOK, updated)
+}
+
+func (ec *externalDataSourceController) Delete(c echo.Context) error {
+	var req getExternalDataSourceRequest
+
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	ctx := c.Request().Context()
+
+	if err := ec.su.Delete(ctx, req.ID); err != nil {
+		if err == sql.ErrNoRows {
+			return c.JSON(errors.NewNotFoundError(err))
+		}
+
+		return c.JSON(errors.NewInternalServerError(err))
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// Sync はデータソースを即座に同期する
+// 同期自体が失敗した場合もステータスは Error として記録され、結果は200で返す
+func (ec *externalDataSourceController) Sync(c echo.Context) error {
+	var req getExternalDataSourceRequest
+
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	ctx := c.Request().Context()
+
+	result, err := ec.su.Sync(ctx, req.ID)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return c.JSON(errors.NewNotFoundError(err))
+		}
+
+		if err == domain.ErrDataSourceUpdating {
+			return c.JSON(errors.NewConflictError(err))
+		}
+
+		return c.JSON(errors.NewInternalServerError(err))
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
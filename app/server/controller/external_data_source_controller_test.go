@@ -0,0 +1,500 @@
+package controller
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/labstack/echo/v4"
+	"github.com/ogurilab/school-lunch-api/domain"
+	"github.com/ogurilab/school-lunch-api/domain/mocks"
+	"github.com/ogurilab/school-lunch-api/util"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCreateExternalDataSource(t *testing.T) {
+	source := randomExternalDataSource()
+
+	testCases := []struct {
+		name      string
+		body      echo.Map
+		buildStub func(uc *mocks.MockExternalDataSourceUsecase)
+		check     func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			body: echo.Map{
+				"city_code":  source.CityCode,
+				"dataset_id": source.DatasetID,
+				"year":       source.Year,
+				"category":   source.Category,
+			},
+			buildStub: func(uc *mocks.MockExternalDataSourceUsecase) {
+				uc.EXPECT().Create(gomock.Any(), gomock.Any()).Times(1).
+					DoAndReturn(func(_ any, s *domain.ExternalDataSource) (*domain.ExternalDataSource, error) {
+						require.Equal(t, source.CityCode, s.CityCode)
+						require.Equal(t, source.DatasetID, s.DatasetID)
+						// ステータスを指定しない場合は Inactive で登録される
+						require.Equal(t, domain.DataSourceStatusInactive, s.Status)
+						require.False(t, s.Description.Valid)
+
+						return source, nil
+					})
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusCreated, recorder.Code)
+				requireBodyMatchExternalDataSource(t, recorder.Body, source)
+			},
+		},
+		{
+			name: "Bad Request - Invalid Category",
+			body: echo.Map{
+				"city_code":  source.CityCode,
+				"dataset_id": source.DatasetID,
+				"year":       source.Year,
+				"category":   "unknown",
+			},
+			buildStub: func(uc *mocks.MockExternalDataSourceUsecase) {
+				uc.EXPECT().Create(gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Bad Request - Invalid Status",
+			body: echo.Map{
+				"city_code":  source.CityCode,
+				"dataset_id": source.DatasetID,
+				"year":       source.Year,
+				"category":   source.Category,
+				"status":     domain.DataSourceStatusUpdating,
+			},
+			buildStub: func(uc *mocks.MockExternalDataSourceUsecase) {
+				uc.EXPECT().Create(gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Bad Request - Invalid DatasetID",
+			body: echo.Map{
+				"city_code":  source.CityCode,
+				"dataset_id": "not-url",
+				"year":       source.Year,
+				"category":   source.Category,
+			},
+			buildStub: func(uc *mocks.MockExternalDataSourceUsecase) {
+				uc.EXPECT().Create(gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Conflict",
+			body: echo.Map{
+				"city_code":  source.CityCode,
+				"dataset_id": source.DatasetID,
+				"year":       source.Year,
+				"category":   source.Category,
+			},
+			buildStub: func(uc *mocks.MockExternalDataSourceUsecase) {
+				uc.EXPECT().Create(gomock.Any(), gomock.Any()).Times(1).Return(nil, &mysql.MySQLError{Number: 1062})
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc := mocks.NewMockExternalDataSourceUsecase(ctrl)
+			tc.buildStub(uc)
+
+			recorder := httptest.NewRecorder()
+
+			body, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			req, err := http.NewRequest(http.MethodPost, "/admin/data-sources", bytes.NewReader(body))
+			require.NoError(t, err)
+
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+			e, env := newSetupAdminTestServer(t)
+			createValidAdminToken(t, env, req)
+
+			e.POST("/admin/data-sources", NewExternalDataSourceController(uc).Create)
+			e.ServeHTTP(recorder, req)
+
+			tc.check(t, recorder)
+		})
+	}
+}
+
+func TestGetExternalDataSource(t *testing.T) {
+	source := randomExternalDataSource()
+
+	testCases := []struct {
+		name      string
+		id        string
+		buildStub func(uc *mocks.MockExternalDataSourceUsecase)
+		check     func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			id:   fmt.Sprint(source.ID),
+			buildStub: func(uc *mocks.MockExternalDataSourceUsecase) {
+				uc.EXPECT().GetByID(gomock.Any(), gomock.Eq(source.ID)).Times(1).Return(source, nil)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchExternalDataSource(t, recorder.Body, source)
+			},
+		},
+		{
+			name: "Bad Request",
+			id:   "0",
+			buildStub: func(uc *mocks.MockExternalDataSourceUsecase) {
+				uc.EXPECT().GetByID(gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Not Found",
+			id:   fmt.Sprint(source.ID),
+			buildStub: func(uc *mocks.MockExternalDataSourceUsecase) {
+				uc.EXPECT().GetByID(gomock.Any(), gomock.Eq(source.ID)).Times(1).Return(nil, sql.ErrNoRows)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc := mocks.NewMockExternalDataSourceUsecase(ctrl)
+			tc.buildStub(uc)
+
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/admin/data-sources/%s", tc.id)
+			req, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			e, env := newSetupAdminTestServer(t)
+			createValidAdminToken(t, env, req)
+
+			e.GET("/admin/data-sources/:id", NewExternalDataSourceController(uc).GetByID)
+			e.ServeHTTP(recorder, req)
+
+			tc.check(t, recorder)
+		})
+	}
+}
+
+func TestFetchExternalDataSources(t *testing.T) {
+	sources := []*domain.ExternalDataSource{randomExternalDataSource(), randomExternalDataSource()}
+
+	testCases := []struct {
+		name      string
+		query     string
+		buildStub func(uc *mocks.MockExternalDataSourceUsecase)
+		check     func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:  "OK",
+			query: "",
+			buildStub: func(uc *mocks.MockExternalDataSourceUsecase) {
+				uc.EXPECT().Fetch(gomock.Any(), gomock.Eq(int32(domain.DEFAULT_LIMIT)), gomock.Eq(int32(0))).Times(1).Return(sources, nil)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var res []json.RawMessage
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+				require.Len(t, res, len(sources))
+			},
+		},
+		{
+			name:  "Max Limit",
+			query: fmt.Sprintf("?limit=%d", domain.MAX_LIMIT+1),
+			buildStub: func(uc *mocks.MockExternalDataSourceUsecase) {
+				uc.EXPECT().Fetch(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc := mocks.NewMockExternalDataSourceUsecase(ctrl)
+			tc.buildStub(uc)
+
+			recorder := httptest.NewRecorder()
+
+			req, err := http.NewRequest(http.MethodGet, "/admin/data-sources"+tc.query, nil)
+			require.NoError(t, err)
+
+			e, env := newSetupAdminTestServer(t)
+			createValidAdminToken(t, env, req)
+
+			e.GET("/admin/data-sources", NewExternalDataSourceController(uc).Fetch)
+			e.ServeHTTP(recorder, req)
+
+			tc.check(t, recorder)
+		})
+	}
+}
+
+func TestUpdateExternalDataSource(t *testing.T) {
+	source := randomExternalDataSource()
+
+	body := echo.Map{
+		"city_code":   source.CityCode,
+		"dataset_id":  source.DatasetID,
+		"year":        source.Year,
+		"category":    source.Category,
+		"status":      domain.DataSourceStatusActive,
+		"description": "半田市の献立",
+	}
+
+	testCases := []struct {
+		name      string
+		buildStub func(uc *mocks.MockExternalDataSourceUsecase)
+		check     func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			buildStub: func(uc *mocks.MockExternalDataSourceUsecase) {
+				uc.EXPECT().Update(gomock.Any(), gomock.Any()).Times(1).
+					DoAndReturn(func(_ any, s *domain.ExternalDataSource) error {
+						require.Equal(t, source.ID, s.ID)
+						require.Equal(t, domain.DataSourceStatusActive, s.Status)
+						require.Equal(t, "半田市の献立", s.Description.String)
+
+						return nil
+					})
+				uc.EXPECT().GetByID(gomock.Any(), gomock.Eq(source.ID)).Times(1).Return(source, nil)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "Not Found",
+			buildStub: func(uc *mocks.MockExternalDataSourceUsecase) {
+				uc.EXPECT().Update(gomock.Any(), gomock.Any()).Times(1).Return(sql.ErrNoRows)
+				uc.EXPECT().GetByID(gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc := mocks.NewMockExternalDataSourceUsecase(ctrl)
+			tc.buildStub(uc)
+
+			recorder := httptest.NewRecorder()
+
+			b, err := json.Marshal(body)
+			require.NoError(t, err)
+
+			url := fmt.Sprintf("/admin/data-sources/%d", source.ID)
+			req, err := http.NewRequest(http.MethodPut, url, bytes.NewReader(b))
+			require.NoError(t, err)
+
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+			e, env := newSetupAdminTestServer(t)
+			createValidAdminToken(t, env, req)
+
+			e.PUT("/admin/data-sources/:id", NewExternalDataSourceController(uc).Update)
+			e.ServeHTTP(recorder, req)
+
+			tc.check(t, recorder)
+		})
+	}
+}
+
+func TestDeleteExternalDataSource(t *testing.T) {
+	source := randomExternalDataSource()
+
+	testCases := []struct {
+		name      string
+		buildStub func(uc *mocks.MockExternalDataSourceUsecase)
+		check     func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			buildStub: func(uc *mocks.MockExternalDataSourceUsecase) {
+				uc.EXPECT().Delete(gomock.Any(), gomock.Eq(source.ID)).Times(1).Return(nil)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNoContent, recorder.Code)
+			},
+		},
+		{
+			name: "Not Found",
+			buildStub: func(uc *mocks.MockExternalDataSourceUsecase) {
+				uc.EXPECT().Delete(gomock.Any(), gomock.Eq(source.ID)).Times(1).Return(sql.ErrNoRows)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc := mocks.NewMockExternalDataSourceUsecase(ctrl)
+			tc.buildStub(uc)
+
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/admin/data-sources/%d", source.ID)
+			req, err := http.NewRequest(http.MethodDelete, url, nil)
+			require.NoError(t, err)
+
+			e, env := newSetupAdminTestServer(t)
+			createValidAdminToken(t, env, req)
+
+			e.DELETE("/admin/data-sources/:id", NewExternalDataSourceController(uc).Delete)
+			e.ServeHTTP(recorder, req)
+
+			tc.check(t, recorder)
+		})
+	}
+}
+
+func TestSyncExternalDataSource(t *testing.T) {
+	source := randomExternalDataSource()
+
+	testCases := []struct {
+		name      string
+		buildStub func(uc *mocks.MockExternalDataSourceUsecase)
+		check     func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			buildStub: func(uc *mocks.MockExternalDataSourceUsecase) {
+				result := &domain.DataSourceSyncResult{
+					SourceID: source.ID,
+					Status:   domain.DataSourceStatusActive,
+					Imported: 20,
+				}
+				uc.EXPECT().Sync(gomock.Any(), gomock.Eq(source.ID)).Times(1).Return(result, nil)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var res domain.DataSourceSyncResult
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+				require.Equal(t, domain.DataSourceStatusActive, res.Status)
+				require.Equal(t, 20, res.Imported)
+			},
+		},
+		{
+			name: "Conflict",
+			buildStub: func(uc *mocks.MockExternalDataSourceUsecase) {
+				uc.EXPECT().Sync(gomock.Any(), gomock.Eq(source.ID)).Times(1).Return(nil, domain.ErrDataSourceUpdating)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+		{
+			name: "Not Found",
+			buildStub: func(uc *mocks.MockExternalDataSourceUsecase) {
+				uc.EXPECT().Sync(gomock.Any(), gomock.Eq(source.ID)).Times(1).Return(nil, sql.ErrNoRows)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc := mocks.NewMockExternalDataSourceUsecase(ctrl)
+			tc.buildStub(uc)
+
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/admin/data-sources/%d/sync", source.ID)
+			req, err := http.NewRequest(http.MethodPost, url, nil)
+			require.NoError(t, err)
+
+			e, env := newSetupAdminTestServer(t)
+			createValidAdminToken(t, env, req)
+
+			e.POST("/admin/data-sources/:id/sync", NewExternalDataSourceController(uc).Sync)
+			e.ServeHTTP(recorder, req)
+
+			tc.check(t, recorder)
+		})
+	}
+}
+
+func randomExternalDataSource() *domain.ExternalDataSource {
+	return domain.ReNewExternalDataSource(
+		util.RandomInt32()+1,
+		util.RandomCityCode(),
+		util.RandomURL(),
+		int32(util.RandomInt(2000, 2100)),
+		time.Now().UTC().Truncate(time.Second),
+		domain.DataSourceStatusActive,
+		domain.DataSourceCategoryMenu,
+		sql.NullString{},
+	)
+}
+
+func requireBodyMatchExternalDataSource(t *testing.T, body *bytes.Buffer, source *domain.ExternalDataSource) {
+	var res domain.ExternalDataSource
+
+	require.NoError(t, json.Unmarshal(body.Bytes(), &res))
+
+	require.Equal(t, source.ID, res.ID)
+	require.Equal(t, source.CityCode, res.CityCode)
+	require.Equal(t, source.DatasetID, res.DatasetID)
+	require.Equal(t, source.Year, res.Year)
+	require.Equal(t, source.Status, res.Status)
+	require.Equal(t, source.Category, res.Category)
+	require.True(t, source.UpdatedAt.Equal(res.UpdatedAt))
+}
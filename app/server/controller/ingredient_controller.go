@@ -0,0 +1,308 @@
+package controller
+
+import (
+	"database/sql"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ogurilab/school-lunch-api/domain"
+	"github.com/ogurilab/school-lunch-api/domain/errors"
+	"github.com/ogurilab/school-lunch-api/util"
+)
+
+type ingredientController struct {
+	iu domain.IngredientUsecase
+}
+
+func NewIngredientController(iu domain.IngredientUsecase) domain.IngredientController {
+	return &ingredientController{
+		iu: iu,
+	}
+}
+
+type createIngredientRequest struct {
+	Name string `json:"name" validate:"required,max=255"`
+}
+
+func (ic *ingredientController) Create(c echo.Context) error {
+	var req createIngredientRequest
+
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	ctx := c.Request().Context()
+
+	ingredient, err := ic.iu.Create(ctx, req.Name)
+
+	if err != nil {
+		if util.IsDuplicateEntry(err) {
+			return c.JSON(errors.NewConflictError(err))
+		}
+
+		return c.JSON(errors.NewInternalServerError(err))
+	}
+
+	return c.JSON(http.StatusCreated, ingredient)
+}
+
+type fetchIngredientRequest struct {
+	Limit  int32 `query:"limit" validate:"gt=0"`
+	Offset int32 `query:"offset" validate:"gte=0"`
+}
+
+func (ic *ingredientController) Fetch(c echo.Context) error {
+	var req fetchIngredientRequest
+
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	if req.Limit == 0 {
+		req.Limit = domain.DEFAULT_LIMIT
+	}
+
+	if req.Offset == 0 {
+		req.Offset = domain.DEFAULT_OFFSET
+	}
+
+	if req.Limit > domain.MAX_LIMIT {
+		return c.JSON(errors.NewMaxLimitError())
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	ctx := c.Request().Context()
+
+	ingredients, err := ic.iu.Fetch(ctx, req.Limit, req.Offset)
+
+	if err != nil {
+		return c.JSON(errors.NewInternalServerError(err))
+	}
+
+	return c.JSON(http.StatusOK, ingredients)
+}
+
+type getIngredientRequest struct {
+	ID int32 `param:"id" validate:"required,gt=0"`
+}
+
+func (ic *ingredientController) GetByID(c echo.Context) error {
+	var req getIngredientRequest
+
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	ctx := c.Request().Context()
+
+	ingredient, err := ic.iu.GetByID(ctx, req.ID)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return c.JSON(errors.NewNotFoundError(err))
+		}
+
+		return c.JSON(errors.NewInternalServerError(err))
+	}
+
+	return c.JSON(http.StatusOK, ingredient)
+}
+
+type updateIngredientRequest struct {
+	ID   int32  `param:"id" validate:"required,gt=0"`
+	Name string `json:"name" validate:"required,max=255"`
+}
+
+func (ic *ingredientController) Update(c echo.Context) error {
+	var req updateIngredientRequest
+
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	ctx := c.Request().Context()
+
+	ingredient := domain.ReNewIngredient(req.ID, req.Name)
+
+	if err := ic.iu.Update(ctx, ingredient); err != nil {
+		if err == sql.ErrNoRows {
+			return c.JSON(errors.NewNotFoundError(err))
+		}
+
+		if util.IsDuplicateEntry(err) {
+			return c.JSON(errors.NewConflictError(err))
+		}
+
+		return c.JSON(errors.NewInternalServerError(err))
+	}
+
+	return c.JSON(http.StatusOK, ingredient)
+}
+
+type deleteIngredientRequest struct {
+	ID int32 `param:"id" validate:"required,gt=0"`
+}
+
+// Delete は食材と料理との紐付けをまとめて削除する
+func (ic *ingredientController) Delete(c echo.Context) error {
+	var req deleteIngredientRequest
+
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	ctx := c.Request().Context()
+
+	if err := ic.iu.Delete(ctx, req.ID); err != nil {
+		if err == sql.ErrNoRows {
+			return c.JSON(errors.NewNotFoundError(err))
+		}
+
+		return c.JSON(errors.NewInternalServerError(err))
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+type dishIngredientRequest struct {
+	ID                   int32    `json:"id" validate:"required,gt=0"`
+	Quantity             *float64 `json:"quantity" validate:"omitempty,gte=0"`
+	OriginPrefectureCode *int32   `json:"origin_prefecture_code" validate:"omitempty,min=1,max=47"`
+}
+
+type attachDishIngredientsRequest struct {
+	DishID      string                  `param:"id" validate:"required,ulid"`
+	Ingredients []dishIngredientRequest `json:"ingredients" validate:"required,min=1,dive"`
+}
+
+// AttachToDish は料理に食材を紐付ける
+// 既に紐付いている食材は使用量と産地を上書きする
+func (ic *ingredientController) AttachToDish(c echo.Context) error {
+	var req attachDishIngredientsRequest
+
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	ctx := c.Request().Context()
+
+	ingredients := make([]*domain.DishIngredient, 0, len(req.Ingredients))
+
+	for _, i := range req.Ingredients {
+		ingredients = append(ingredients, domain.ReNewDishIngredient(i.ID, "", req.DishID, i.Quantity, i.OriginPrefectureCode))
+	}
+
+	if err := ic.iu.AttachToDish(ctx, req.DishID, ingredients); err != nil {
+		if err == sql.ErrNoRows {
+			return c.JSON(errors.NewNotFoundError(err))
+		}
+
+		return c.JSON(errors.NewInternalServerError(err))
+	}
+
+	return c.NoContent(http.StatusCreated)
+}
+
+type detachDishIngredientRequest struct {
+	DishID       string `param:"id" validate:"required,ulid"`
+	IngredientID int32  `param:"ingredientID" validate:"required,gt=0"`
+}
+
+func (ic *ingredientController) DetachFromDish(c echo.Context) error {
+	var req detachDishIngredientRequest
+
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	ctx := c.Request().Context()
+
+	if err := ic.iu.DetachFromDish(ctx, req.DishID, req.IngredientID); err != nil {
+		if err == sql.ErrNoRows {
+			return c.JSON(errors.NewNotFoundError(err))
+		}
+
+		return c.JSON(errors.NewInternalServerError(err))
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+type fetchIngredientByDishIDRequest struct {
+	DishID string `param:"id" validate:"required,ulid"`
+}
+
+func (ic *ingredientController) FetchByDishID(c echo.Context) error {
+	var req fetchIngredientByDishIDRequest
+
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	ctx := c.Request().Context()
+
+	ingredients, err := ic.iu.FetchByDishID(ctx, req.DishID)
+
+	if err != nil {
+		return c.JSON(errors.NewInternalServerError(err))
+	}
+
+	return c.JSON(http.StatusOK, ingredients)
+}
+
+type fetchIngredientByMenuIDRequest struct {
+	MenuID string `param:"id" validate:"required,ulid"`
+}
+
+func (ic *ingredientController) FetchByMenuID(c echo.Context) error {
+	var req fetchIngredientByMenuIDRequest
+
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	ctx := c.Request().Context()
+
+	ingredients, err := ic.iu.FetchByMenuID(ctx, req.MenuID)
+
+	if err != nil {
+		return c.JSON(errors.NewInternalServerError(err))
+	}
+
+	return c.JSON(http.StatusOK, ingredients)
+}
@@ -0,0 +1,530 @@
+package controller
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/labstack/echo/v4"
+	"github.com/ogurilab/school-lunch-api/domain"
+	"github.com/ogurilab/school-lunch-api/domain/mocks"
+	"github.com/ogurilab/school-lunch-api/util"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestFetchIngredientsByDishID(t *testing.T) {
+	dish := randomDish(t)
+	quantity := 40.0
+	origin := int32(23)
+	ingredients := []*domain.DishIngredient{
+		domain.ReNewDishIngredient(util.RandomInt32()+1, "玉ねぎ", dish.ID, &quantity, &origin),
+		domain.ReNewDishIngredient(util.RandomInt32()+1, "豚肉", dish.ID, nil, nil),
+	}
+
+	testCases := []struct {
+		name      string
+		dishID    string
+		buildStub func(uc *mocks.MockIngredientUsecase)
+		check     func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:   "OK",
+			dishID: dish.ID,
+			buildStub: func(uc *mocks.MockIngredientUsecase) {
+				uc.EXPECT().FetchByDishID(gomock.Any(), gomock.Eq(dish.ID)).Times(1).Return(ingredients, nil)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var res []map[string]any
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+				require.Len(t, res, 2)
+				require.Equal(t, 40.0, res[0]["quantity"])
+				require.Equal(t, map[string]any{"code": 23.0, "name": "愛知県"}, res[0]["origin"])
+				require.Nil(t, res[1]["quantity"])
+				require.Nil(t, res[1]["origin"])
+			},
+		},
+		{
+			name:   "Bad Request",
+			dishID: "invalid",
+			buildStub: func(uc *mocks.MockIngredientUsecase) {
+				uc.EXPECT().FetchByDishID(gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:   "Internal Server Error",
+			dishID: dish.ID,
+			buildStub: func(uc *mocks.MockIngredientUsecase) {
+				uc.EXPECT().FetchByDishID(gomock.Any(), gomock.Eq(dish.ID)).Times(1).Return(nil, sql.ErrConnDone)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc := mocks.NewMockIngredientUsecase(ctrl)
+			tc.buildStub(uc)
+
+			recorder := httptest.NewRecorder()
+			url := fmt.Sprintf("/dishes/%s/ingredients", tc.dishID)
+
+			req, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			e := newSetUpTestServer()
+			e.GET("/dishes/:id/ingredients", NewIngredientController(uc).FetchByDishID)
+			e.ServeHTTP(recorder, req)
+
+			tc.check(t, recorder)
+		})
+	}
+}
+
+func TestFetchIngredientsByMenuID(t *testing.T) {
+	menu := randomMenu(t)
+
+	testCases := []struct {
+		name      string
+		menuID    string
+		buildStub func(uc *mocks.MockIngredientUsecase)
+		check     func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:   "OK",
+			menuID: menu.ID,
+			buildStub: func(uc *mocks.MockIngredientUsecase) {
+				uc.EXPECT().FetchByMenuID(gomock.Any(), gomock.Eq(menu.ID)).Times(1).Return([]*domain.DishIngredient{}, nil)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.JSONEq(t, `[]`, recorder.Body.String())
+			},
+		},
+		{
+			name:   "Bad Request",
+			menuID: "invalid",
+			buildStub: func(uc *mocks.MockIngredientUsecase) {
+				uc.EXPECT().FetchByMenuID(gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:   "Internal Server Error",
+			menuID: menu.ID,
+			buildStub: func(uc *mocks.MockIngredientUsecase) {
+				uc.EXPECT().FetchByMenuID(gomock.Any(), gomock.Eq(menu.ID)).Times(1).Return(nil, sql.ErrConnDone)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc := mocks.NewMockIngredientUsecase(ctrl)
+			tc.buildStub(uc)
+
+			recorder := httptest.NewRecorder()
+			url := fmt.Sprintf("/menus/%s/ingredients", tc.menuID)
+
+			req, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			e := newSetUpTestServer()
+			e.GET("/menus/:id/ingredients", NewIngredientController(uc).FetchByMenuID)
+			e.ServeHTTP(recorder, req)
+
+			tc.check(t, recorder)
+		})
+	}
+}
+
+func TestCreateIngredient(t *testing.T) {
+	ingredient := domain.ReNewIngredient(util.RandomInt32()+1, "知多牛")
+
+	testCases := []struct {
+		name      string
+		body      string
+		buildStub func(uc *mocks.MockIngredientUsecase)
+		check     func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			body: `{"name":"知多牛"}`,
+			buildStub: func(uc *mocks.MockIngredientUsecase) {
+				uc.EXPECT().Create(gomock.Any(), gomock.Eq(ingredient.Name)).Times(1).Return(ingredient, nil)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusCreated, recorder.Code)
+
+				var res domain.Ingredient
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+				require.Equal(t, *ingredient, res)
+			},
+		},
+		{
+			name: "Bad Request",
+			body: `{"name":""}`,
+			buildStub: func(uc *mocks.MockIngredientUsecase) {
+				uc.EXPECT().Create(gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Conflict",
+			body: `{"name":"知多牛"}`,
+			buildStub: func(uc *mocks.MockIngredientUsecase) {
+				uc.EXPECT().Create(gomock.Any(), gomock.Any()).Times(1).Return(nil, &mysql.MySQLError{Number: 1062})
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc := mocks.NewMockIngredientUsecase(ctrl)
+			tc.buildStub(uc)
+
+			recorder := httptest.NewRecorder()
+
+			req, err := http.NewRequest(http.MethodPost, "/admin/ingredients", bytes.NewBufferString(tc.body))
+			require.NoError(t, err)
+
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+			e, env := newSetupAdminTestServer(t)
+			createValidAdminToken(t, env, req)
+
+			e.POST("/admin/ingredients", NewIngredientController(uc).Create)
+			e.ServeHTTP(recorder, req)
+
+			tc.check(t, recorder)
+		})
+	}
+}
+
+func TestUpdateIngredient(t *testing.T) {
+	ingredient := domain.ReNewIngredient(util.RandomInt32()+1, "玉ねぎ")
+
+	testCases := []struct {
+		name      string
+		id        string
+		buildStub func(uc *mocks.MockIngredientUsecase)
+		check     func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			id:   fmt.Sprint(ingredient.ID),
+			buildStub: func(uc *mocks.MockIngredientUsecase) {
+				uc.EXPECT().Update(gomock.Any(), gomock.Eq(ingredient)).Times(1).Return(nil)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "Bad Request",
+			id:   "0",
+			buildStub: func(uc *mocks.MockIngredientUsecase) {
+				uc.EXPECT().Update(gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Not Found",
+			id:   fmt.Sprint(ingredient.ID),
+			buildStub: func(uc *mocks.MockIngredientUsecase) {
+				uc.EXPECT().Update(gomock.Any(), gomock.Any()).Times(1).Return(sql.ErrNoRows)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name: "Conflict",
+			id:   fmt.Sprint(ingredient.ID),
+			buildStub: func(uc *mocks.MockIngredientUsecase) {
+				uc.EXPECT().Update(gomock.Any(), gomock.Any()).Times(1).Return(&mysql.MySQLError{Number: 1062})
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc := mocks.NewMockIngredientUsecase(ctrl)
+			tc.buildStub(uc)
+
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/admin/ingredients/%s", tc.id)
+			req, err := http.NewRequest(http.MethodPut, url, bytes.NewBufferString(`{"name":"玉ねぎ"}`))
+			require.NoError(t, err)
+
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+			e, env := newSetupAdminTestServer(t)
+			createValidAdminToken(t, env, req)
+
+			e.PUT("/admin/ingredients/:id", NewIngredientController(uc).Update)
+			e.ServeHTTP(recorder, req)
+
+			tc.check(t, recorder)
+		})
+	}
+}
+
+func TestDeleteIngredient(t *testing.T) {
+	id := util.RandomInt32() + 1
+
+	testCases := []struct {
+		name      string
+		buildStub func(uc *mocks.MockIngredientUsecase)
+		check     func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			buildStub: func(uc *mocks.MockIngredientUsecase) {
+				uc.EXPECT().Delete(gomock.Any(), gomock.Eq(id)).Times(1).Return(nil)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNoContent, recorder.Code)
+			},
+		},
+		{
+			name: "Not Found",
+			buildStub: func(uc *mocks.MockIngredientUsecase) {
+				uc.EXPECT().Delete(gomock.Any(), gomock.Eq(id)).Times(1).Return(sql.ErrNoRows)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc := mocks.NewMockIngredientUsecase(ctrl)
+			tc.buildStub(uc)
+
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/admin/ingredients/%d", id)
+			req, err := http.NewRequest(http.MethodDelete, url, nil)
+			require.NoError(t, err)
+
+			e, env := newSetupAdminTestServer(t)
+			createValidAdminToken(t, env, req)
+
+			e.DELETE("/admin/ingredients/:id", NewIngredientController(uc).Delete)
+			e.ServeHTTP(recorder, req)
+
+			tc.check(t, recorder)
+		})
+	}
+}
+
+func TestAttachIngredientsToDish(t *testing.T) {
+	dish := randomDish(t)
+	quantity := 35.0
+	origin := int32(23)
+	ingredients := []*domain.DishIngredient{
+		domain.ReNewDishIngredient(1, "", dish.ID, &quantity, &origin),
+		domain.ReNewDishIngredient(2, "", dish.ID, nil, nil),
+	}
+
+	testCases := []struct {
+		name      string
+		dishID    string
+		body      string
+		buildStub func(uc *mocks.MockIngredientUsecase)
+		check     func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:   "OK",
+			dishID: dish.ID,
+			body:   `{"ingredients":[{"id":1,"quantity":35,"origin_prefecture_code":23},{"id":2}]}`,
+			buildStub: func(uc *mocks.MockIngredientUsecase) {
+				uc.EXPECT().AttachToDish(gomock.Any(), gomock.Eq(dish.ID), gomock.Eq(ingredients)).Times(1).Return(nil)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusCreated, recorder.Code)
+			},
+		},
+		{
+			name:   "Bad Request - Invalid Prefecture",
+			dishID: dish.ID,
+			body:   `{"ingredients":[{"id":1,"origin_prefecture_code":48}]}`,
+			buildStub: func(uc *mocks.MockIngredientUsecase) {
+				uc.EXPECT().AttachToDish(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:   "Bad Request - Negative Quantity",
+			dishID: dish.ID,
+			body:   `{"ingredients":[{"id":1,"quantity":-1}]}`,
+			buildStub: func(uc *mocks.MockIngredientUsecase) {
+				uc.EXPECT().AttachToDish(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:   "Bad Request - Empty",
+			dishID: dish.ID,
+			body:   `{"ingredients":[]}`,
+			buildStub: func(uc *mocks.MockIngredientUsecase) {
+				uc.EXPECT().AttachToDish(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:   "Not Found",
+			dishID: dish.ID,
+			body:   `{"ingredients":[{"id":1}]}`,
+			buildStub: func(uc *mocks.MockIngredientUsecase) {
+				uc.EXPECT().AttachToDish(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(sql.ErrNoRows)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc := mocks.NewMockIngredientUsecase(ctrl)
+			tc.buildStub(uc)
+
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/admin/dishes/%s/ingredients", tc.dishID)
+			req, err := http.NewRequest(http.MethodPost, url, bytes.NewBufferString(tc.body))
+			require.NoError(t, err)
+
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+			e, env := newSetupAdminTestServer(t)
+			createValidAdminToken(t, env, req)
+
+			e.POST("/admin/dishes/:id/ingredients", NewIngredientController(uc).AttachToDish)
+			e.ServeHTTP(recorder, req)
+
+			tc.check(t, recorder)
+		})
+	}
+}
+
+func TestDetachIngredientFromDish(t *testing.T) {
+	dish := randomDish(t)
+
+	testCases := []struct {
+		name         string
+		ingredientID string
+		buildStub    func(uc *mocks.MockIngredientUsecase)
+		check        func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:         "OK",
+			ingredientID: "3",
+			buildStub: func(uc *mocks.MockIngredientUsecase) {
+				uc.EXPECT().DetachFromDish(gomock.Any(), gomock.Eq(dish.ID), gomock.Eq(int32(3))).Times(1).Return(nil)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNoContent, recorder.Code)
+			},
+		},
+		{
+			name:         "Bad Request",
+			ingredientID: "abc",
+			buildStub: func(uc *mocks.MockIngredientUsecase) {
+				uc.EXPECT().DetachFromDish(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:         "Not Found",
+			ingredientID: "3",
+			buildStub: func(uc *mocks.MockIngredientUsecase) {
+				uc.EXPECT().DetachFromDish(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(sql.ErrNoRows)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc := mocks.NewMockIngredientUsecase(ctrl)
+			tc.buildStub(uc)
+
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/admin/dishes/%s/ingredients/%s", dish.ID, tc.ingredientID)
+			req, err := http.NewRequest(http.MethodDelete, url, nil)
+			require.NoError(t, err)
+
+			e, env := newSetupAdminTestServer(t)
+			createValidAdminToken(t, env, req)
+
+			e.DELETE("/admin/dishes/:id/ingredients/:ingredientID", NewIngredientController(uc).DetachFromDish)
+			e.ServeHTTP(recorder, req)
+
+			tc.check(t, recorder)
+		})
+	}
+}
@@ -1,13 +1,18 @@
 package controller
 
 import (
+	"context"
 	"testing"
 
 	"github.com/labstack/echo/v4"
 	"github.com/ogurilab/school-lunch-api/bootstrap"
+	"github.com/ogurilab/school-lunch-api/domain"
+	"github.com/ogurilab/school-lunch-api/domain/mocks"
+	"github.com/ogurilab/school-lunch-api/infrastructure/token"
 	"github.com/ogurilab/school-lunch-api/server/middleware"
 	"github.com/ogurilab/school-lunch-api/server/validator"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
 )
 
 func newSetUpTestServer() *echo.Echo {
@@ -18,14 +23,26 @@ func newSetUpTestServer() *echo.Echo {
 	return e
 }
 
+// newSetupAdminTestServer はトークン認証を行うテスト用のサーバーを返す
+// セッションの確認は行わず、トークンの署名と有効期限のみを検証する
 func newSetupAdminTestServer(t *testing.T) (*echo.Echo, bootstrap.Env) {
 
 	env, err := bootstrap.NewEnv("../../")
 
 	require.NoError(t, err)
 
+	maker, err := token.NewJWTMaker(env.TokenSymmetricKey)
+
+	require.NoError(t, err)
+
+	uu := mocks.NewMockUserUsecase(gomock.NewController(t))
+	uu.EXPECT().Authenticate(gomock.Any(), gomock.Any()).AnyTimes().
+		DoAndReturn(func(_ context.Context, token string) (*domain.TokenPayload, error) {
+			return maker.VerifyToken(token)
+		})
+
 	e := newSetUpTestServer()
-	e.Use(middleware.KeyAuth(env))
+	e.Use(middleware.TokenAuth(uu))
 
 	return e, env
 }
@@ -0,0 +1,117 @@
+package controller
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ogurilab/school-lunch-api/domain"
+	"github.com/ogurilab/school-lunch-api/domain/errors"
+	"github.com/ogurilab/school-lunch-api/util"
+)
+
+/************************
+ * MenuCalendarController
+ ************************/
+
+// calendarMaxAge はカレンダーをキャッシュしてよい秒数
+const calendarMaxAge = 60 * 60
+
+type menuCalendarController struct {
+	cu domain.MenuCalendarUsecase
+}
+
+func NewMenuCalendarController(cu domain.MenuCalendarUsecase) domain.MenuCalendarController {
+	return &menuCalendarController{
+		cu: cu,
+	}
+}
+
+type exportMenuCalendarRequest struct {
+	CityCode         string  `param:"code" validate:"required,city_code"`
+	From             string  `query:"from" validate:"omitempty,YYYY-MM-DD"`
+	To               string  `query:"to" validate:"omitempty,YYYY-MM-DD"`
+	ExcludeAllergens []int32 `query:"exclude_allergens" validate:"dive,gt=0"`
+}
+
+// ExportByCity は献立を iCalendar 形式で返す
+// 期間を指定しない場合は前月の1日から翌月の末日までを返す
+func (cc *menuCalendarController) ExportByCity(c echo.Context) error {
+	var req exportMenuCalendarRequest
+
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	cityCode, err := util.ParseCityCode(req.CityCode)
+
+	if err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	now := time.Now()
+	from := time.Date(now.Year(), now.Month()-1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(now.Year(), now.Month()+2, 0, 0, 0, 0, 0, time.UTC)
+
+	if req.From != "" {
+		if from, err = util.ParseDate(req.From); err != nil {
+			return c.JSON(errors.NewBadRequestError(err))
+		}
+	}
+
+	if req.To != "" {
+		if to, err = util.ParseDate(req.To); err != nil {
+			return c.JSON(errors.NewBadRequestError(err))
+		}
+	}
+
+	if to.Before(from) || to.Sub(from) >= time.Duration(domain.MaxCalendarDays)*24*time.Hour {
+		return c.JSON(errors.NewBadRequestError(domain.ErrInvalidCalendarRange))
+	}
+
+	ctx := c.Request().Context()
+
+	body, err := cc.cu.ExportByCity(ctx, from, to, cityCode, req.ExcludeAllergens)
+
+	if err != nil {
+		return c.JSON(errors.NewInternalServerError(err))
+	}
+
+	return blobWithETag(c, domain.CalendarContentType, body, calendarMaxAge)
+}
+
+// blobWithETag は body のハッシュを ETag として返す
+// If-None-Match が一致する場合は 304 を返す
+func blobWithETag(c echo.Context, contentType string, body []byte, maxAge int) error {
+	etag := fmt.Sprintf(`"%x"`, sha256.Sum256(body))
+
+	c.Response().Header().Set("ETag", etag)
+	c.Response().Header().Set("Cache-Control", fmt.Sprintf("public, max-age=%d", maxAge))
+
+	if matchETag(c.Request().Header.Get("If-None-Match"), etag) {
+		return c.NoContent(http.StatusNotModified)
+	}
+
+	return c.Blob(http.StatusOK, contentType, body)
+}
+
+// matchETag は If-None-Match に etag が含まれるかどうかを返す
+// 弱い比較を行うため W/ は無視する
+func matchETag(header string, etag string) bool {
+	for _, v := range strings.Split(header, ",") {
+		v = strings.TrimPrefix(strings.TrimSpace(v), "W/")
+
+		if v == etag || v == "*" {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,241 @@
+package controller
+
+import (
+	"crypto/sha256"
+	"database/sql"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ogurilab/school-lunch-api/domain"
+	"github.com/ogurilab/school-lunch-api/domain/mocks"
+	"github.com/ogurilab/school-lunch-api/util"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestExportMenuCalendarByCity(t *testing.T) {
+	city := util.RandomCityCode()
+	body := []byte("BEGIN:VCALENDAR\r\nEND:VCALENDAR\r\n")
+	etag := fmt.Sprintf(`"%x"`, sha256.Sum256(body))
+
+	type req struct {
+		cityCode         string
+		from             string
+		to               string
+		excludeAllergens []int32
+		ifNoneMatch      string
+	}
+
+	testCases := []struct {
+		name      string
+		req       req
+		buildStub func(cu *mocks.MockMenuCalendarUsecase)
+		check     func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			req: req{
+				cityCode:         fmt.Sprintf("%d", city),
+				from:             "2024-04-01",
+				to:               "2024-04-30",
+				excludeAllergens: []int32{1, 2},
+			},
+			buildStub: func(cu *mocks.MockMenuCalendarUsecase) {
+				from := time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
+				to := time.Date(2024, 4, 30, 0, 0, 0, 0, time.UTC)
+				cu.EXPECT().ExportByCity(gomock.Any(), gomock.Eq(from), gomock.Eq(to), gomock.Eq(city), gomock.Eq([]int32{1, 2})).Times(1).Return(body, nil)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Equal(t, domain.CalendarContentType, recorder.Header().Get("Content-Type"))
+				require.Equal(t, etag, recorder.Header().Get("ETag"))
+				require.NotEmpty(t, recorder.Header().Get("Cache-Control"))
+				require.Equal(t, body, recorder.Body.Bytes())
+			},
+		},
+		{
+			name: "OK - Default Range",
+			req: req{
+				cityCode: fmt.Sprintf("%d", city),
+			},
+			buildStub: func(cu *mocks.MockMenuCalendarUsecase) {
+				now := time.Now()
+				from := time.Date(now.Year(), now.Month()-1, 1, 0, 0, 0, 0, time.UTC)
+				to := time.Date(now.Year(), now.Month()+2, 0, 0, 0, 0, 0, time.UTC)
+				cu.EXPECT().ExportByCity(gomock.Any(), gomock.Eq(from), gomock.Eq(to), gomock.Eq(city), gomock.Any()).Times(1).Return(body, nil)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "Not Modified",
+			req: req{
+				cityCode:    fmt.Sprintf("%d", city),
+				from:        "2024-04-01",
+				to:          "2024-04-30",
+				ifNoneMatch: etag,
+			},
+			buildStub: func(cu *mocks.MockMenuCalendarUsecase) {
+				cu.EXPECT().ExportByCity(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(body, nil)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotModified, recorder.Code)
+				require.Empty(t, recorder.Body.Bytes())
+			},
+		},
+		{
+			name: "Modified",
+			req: req{
+				cityCode:    fmt.Sprintf("%d", city),
+				from:        "2024-04-01",
+				to:          "2024-04-30",
+				ifNoneMatch: `"stale"`,
+			},
+			buildStub: func(cu *mocks.MockMenuCalendarUsecase) {
+				cu.EXPECT().ExportByCity(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(body, nil)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Equal(t, etag, recorder.Header().Get("ETag"))
+			},
+		},
+		{
+			name: "Invalid City Code",
+			req: req{
+				cityCode: "invalid",
+			},
+			buildStub: func(cu *mocks.MockMenuCalendarUsecase) {
+				cu.EXPECT().ExportByCity(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Invalid Date",
+			req: req{
+				cityCode: fmt.Sprintf("%d", city),
+				from:     "2024/04/01",
+			},
+			buildStub: func(cu *mocks.MockMenuCalendarUsecase) {
+				cu.EXPECT().ExportByCity(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "To Before From",
+			req: req{
+				cityCode: fmt.Sprintf("%d", city),
+				from:     "2024-04-30",
+				to:       "2024-04-01",
+			},
+			buildStub: func(cu *mocks.MockMenuCalendarUsecase) {
+				cu.EXPECT().ExportByCity(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Range Too Long",
+			req: req{
+				cityCode: fmt.Sprintf("%d", city),
+				from:     "2024-01-01",
+				to:       "2025-01-01",
+			},
+			buildStub: func(cu *mocks.MockMenuCalendarUsecase) {
+				cu.EXPECT().ExportByCity(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Invalid Exclude Allergens",
+			req: req{
+				cityCode:         fmt.Sprintf("%d", city),
+				excludeAllergens: []int32{0},
+			},
+			buildStub: func(cu *mocks.MockMenuCalendarUsecase) {
+				cu.EXPECT().ExportByCity(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Internal Server Error",
+			req: req{
+				cityCode: fmt.Sprintf("%d", city),
+			},
+			buildStub: func(cu *mocks.MockMenuCalendarUsecase) {
+				cu.EXPECT().ExportByCity(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrConnDone)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			cu := mocks.NewMockMenuCalendarUsecase(ctrl)
+			tc.buildStub(cu)
+
+			q := make(url.Values)
+
+			if tc.req.from != "" {
+				q.Set("from", tc.req.from)
+			}
+
+			if tc.req.to != "" {
+				q.Set("to", tc.req.to)
+			}
+
+			for _, id := range tc.req.excludeAllergens {
+				q.Add("exclude_allergens", fmt.Sprintf("%d", id))
+			}
+
+			url := fmt.Sprintf("/cities/%s/menus.ics?%s", tc.req.cityCode, q.Encode())
+			req, err := http.NewRequest(http.MethodGet, url, nil)
+
+			require.NoError(t, err)
+
+			if tc.req.ifNoneMatch != "" {
+				req.Header.Set("If-None-Match", tc.req.ifNoneMatch)
+			}
+
+			recorder := httptest.NewRecorder()
+			e := newSetUpTestServer()
+
+			// 同じ階層の /cities/:code/menus と衝突しないことを確認する
+			e.GET("/cities/:code/menus.ics", NewMenuCalendarController(cu).ExportByCity)
+			e.GET("/cities/:code/menus", func(c echo.Context) error { return c.NoContent(http.StatusTeapot) })
+			e.ServeHTTP(recorder, req)
+
+			tc.check(t, recorder)
+		})
+	}
+}
+
+func TestMatchETag(t *testing.T) {
+	etag := `"abc"`
+
+	require.True(t, matchETag(`"abc"`, etag))
+	require.True(t, matchETag(`W/"abc"`, etag))
+	require.True(t, matchETag(`"xyz", "abc"`, etag))
+	require.True(t, matchETag(`*`, etag))
+	require.False(t, matchETag(`"xyz"`, etag))
+	require.False(t, matchETag(``, etag))
+}
@@ -21,7 +21,7 @@ func NewMenuController(mu domain.MenuUsecase) domain.MenuController {
 
 type getMenuRequest struct {
 	ID       string `param:"id" validate:"required,ulid"`
-	CityCode int32  `param:"code" validate:"required,gt=0"`
+	CityCode string `param:"code" validate:"required,city_code"`
 }
 
 func (mc *menuController) GetByID(c echo.Context) error {
@@ -35,9 +35,15 @@ func (mc *menuController) GetByID(c echo.Context) error {
 		return c.JSON(errors.NewBadRequestError(err))
 	}
 
+	cityCode, err := util.ParseCityCode(req.CityCode)
+
+	if err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
 	ctx := c.Request().Context()
 
-	menu, err := mc.mu.GetByID(ctx, req.ID, req.CityCode)
+	menu, err := mc.mu.GetByID(ctx, req.ID, cityCode)
 
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -51,10 +57,11 @@ func (mc *menuController) GetByID(c echo.Context) error {
 }
 
 type fetchMenuRequestByCity struct {
-	CityCode int32  `param:"code" validate:"required,gt=0"`
-	Limit    int32  `query:"limit" validate:"gt=0"`
-	Offset   int32  `query:"offset" validate:"gte=0"`
-	Offered  string `query:"offered" validate:"YYYY-MM-DD,required"`
+	CityCode         string  `param:"code" validate:"required,city_code"`
+	Limit            int32   `query:"limit" validate:"gt=0"`
+	Offset           int32   `query:"offset" validate:"gte=0"`
+	Offered          string  `query:"offered" validate:"YYYY-MM-DD,required"`
+	ExcludeAllergens []int32 `query:"exclude_allergens" validate:"dive,gt=0"`
 }
 
 type fetchMenuResponse struct {
@@ -85,6 +92,12 @@ func (mc *menuController) FetchByCity(c echo.Context) error {
 		return c.JSON(errors.NewBadRequestError(err))
 	}
 
+	cityCode, err := util.ParseCityCode(req.CityCode)
+
+	if err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
 	parsedDate, err := util.ParseDate(req.Offered)
 
 	if err != nil {
@@ -93,13 +106,26 @@ func (mc *menuController) FetchByCity(c echo.Context) error {
 
 	ctx := c.Request().Context()
 
-	menus, err := mc.mu.FetchByCity(
-		ctx,
-		req.Limit,
-		req.Offset,
-		parsedDate,
-		req.CityCode,
-	)
+	var menus []*domain.Menu
+
+	if len(req.ExcludeAllergens) > 0 {
+		menus, err = mc.mu.FetchByCityExcludingAllergens(
+			ctx,
+			req.Limit,
+			req.Offset,
+			parsedDate,
+			cityCode,
+			req.ExcludeAllergens,
+		)
+	} else {
+		menus, err = mc.mu.FetchByCity(
+			ctx,
+			req.Limit,
+			req.Offset,
+			parsedDate,
+			cityCode,
+		)
+	}
 
 	if err != nil {
 		return c.JSON(errors.NewInternalServerError(err))
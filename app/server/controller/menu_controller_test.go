@@ -32,7 +32,7 @@ func TestGetMenuByID(t *testing.T) {
 			name: "OK",
 			req: req{
 				ID:       menu.ID,
-				CityCode: menu.CityCode,
+				CityCode: fmt.Sprint(menu.CityCode),
 			},
 			buildStub: func(uc *mocks.MockMenuUsecase) {
 				uc.EXPECT().GetByID(gomock.Any(), gomock.Eq(menu.ID), gomock.Eq(menu.CityCode)).Times(1).Return(menu, nil)
@@ -46,7 +46,7 @@ func TestGetMenuByID(t *testing.T) {
 			name: "Bad Request - Invalid ID",
 			req: req{
 				ID:       "invalid-id",
-				CityCode: menu.CityCode,
+				CityCode: fmt.Sprint(menu.CityCode),
 			},
 			buildStub: func(uc *mocks.MockMenuUsecase) {
 				uc.EXPECT().GetByID(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
@@ -59,7 +59,7 @@ func TestGetMenuByID(t *testing.T) {
 			name: "Bad Request - Invalid CityCode",
 			req: req{
 				ID:       menu.ID,
-				CityCode: -1,
+				CityCode: "-1",
 			},
 			buildStub: func(uc *mocks.MockMenuUsecase) {
 				uc.EXPECT().GetByID(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
@@ -72,7 +72,7 @@ func TestGetMenuByID(t *testing.T) {
 			name: "Not Found",
 			req: req{
 				ID:       menu.ID,
-				CityCode: menu.CityCode,
+				CityCode: fmt.Sprint(menu.CityCode),
 			},
 			buildStub: func(uc *mocks.MockMenuUsecase) {
 				uc.EXPECT().GetByID(gomock.Any(), gomock.Eq(menu.ID), gomock.Eq(menu.CityCode)).Times(1).Return(nil, sql.ErrNoRows)
@@ -85,7 +85,7 @@ func TestGetMenuByID(t *testing.T) {
 			name: "Internal Server Error",
 			req: req{
 				ID:       menu.ID,
-				CityCode: menu.CityCode,
+				CityCode: fmt.Sprint(menu.CityCode),
 			},
 			buildStub: func(uc *mocks.MockMenuUsecase) {
 				uc.EXPECT().GetByID(gomock.Any(), gomock.Eq(menu.ID), gomock.Eq(menu.CityCode)).Times(1).Return(nil, sql.ErrConnDone)
@@ -105,7 +105,7 @@ func TestGetMenuByID(t *testing.T) {
 
 		recorder := httptest.NewRecorder()
 
-		url := fmt.Sprintf("/cities/%d/menus/%s", tc.req.CityCode, tc.req.ID)
+		url := fmt.Sprintf("/cities/%s/menus/%s", tc.req.CityCode, tc.req.ID)
 		req, err := http.NewRequest(http.MethodGet, url, nil)
 
 		require.NoError(t, err)
@@ -135,6 +135,7 @@ func TestFetchMenuByCity(t *testing.T) {
 		Limit    sql.NullInt32
 		Offset   sql.NullInt32
 		Offered  string
+		Exclude  []string
 	}
 
 	testCases := []struct {
@@ -163,6 +164,45 @@ func TestFetchMenuByCity(t *testing.T) {
 				requireBodyMatchMenus(t, recorder.Body, menus)
 			},
 		},
+		{
+			name: "OK - exclude_allergens",
+			req: req{
+				CityCode: cityCode,
+				Limit:    sql.NullInt32{Int32: limit, Valid: true},
+				Offset:   sql.NullInt32{Int32: offset, Valid: true},
+				Offered:  offered,
+				Exclude:  []string{"1", "2"},
+			},
+			buildStub: func(uc *mocks.MockMenuUsecase) {
+				parsedOffered, err := util.ParseDate(offered)
+
+				require.NoError(t, err)
+
+				uc.EXPECT().FetchByCity(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				uc.EXPECT().FetchByCityExcludingAllergens(gomock.Any(), gomock.Eq(limit), gomock.Eq(offset), gomock.Eq(parsedOffered), gomock.Eq(cityCode), gomock.Eq([]int32{1, 2})).Times(1).Return(menus, nil)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder, menus []*domain.Menu) {
+				require.Equal(t, 200, recorder.Code)
+				requireBodyMatchMenus(t, recorder.Body, menus)
+			},
+		},
+		{
+			name: "Bad Request - Invalid exclude_allergens",
+			req: req{
+				CityCode: cityCode,
+				Limit:    sql.NullInt32{Int32: limit, Valid: true},
+				Offset:   sql.NullInt32{Int32: offset, Valid: true},
+				Offered:  offered,
+				Exclude:  []string{"0"},
+			},
+			buildStub: func(uc *mocks.MockMenuUsecase) {
+				uc.EXPECT().FetchByCity(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				uc.EXPECT().FetchByCityExcludingAllergens(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder, menus []*domain.Menu) {
+				require.Equal(t, 400, recorder.Code)
+			},
+		},
 		{
 			name: "Bad Request - Invalid CityCode",
 			req: req{
@@ -363,6 +403,10 @@ func TestFetchMenuByCity(t *testing.T) {
 
 		q.Set("offered", tc.req.Offered)
 
+		for _, id := range tc.req.Exclude {
+			q.Add("exclude_allergens", id)
+		}
+
 		url := fmt.Sprintf("/cities/%d/menus/basic?%s", tc.req.CityCode, q.Encode())
 		req, err := http.NewRequest(http.MethodGet, url, nil)
 
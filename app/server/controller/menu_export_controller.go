@@ -0,0 +1,88 @@
+package controller
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ogurilab/school-lunch-api/domain"
+	"github.com/ogurilab/school-lunch-api/domain/errors"
+	"github.com/ogurilab/school-lunch-api/util"
+)
+
+/************************
+ * MenuExportController
+ ************************/
+
+type menuExportController struct {
+	eu domain.MenuExportUsecase
+}
+
+func NewMenuExportController(eu domain.MenuExportUsecase) domain.MenuExportController {
+	return &menuExportController{
+		eu: eu,
+	}
+}
+
+type exportMenusRequest struct {
+	CityCode string `param:"code" validate:"required,city_code"`
+	Month    string `query:"month" validate:"omitempty,YYYY-MM"`
+	Format   string `query:"format" validate:"omitempty,oneof=csv xlsx"`
+}
+
+// ExportByCity は1か月分の献立を CSV または XLSX で返す
+// 月を指定しない場合は今月、形式を指定しない場合は CSV を返す
+func (ec *menuExportController) ExportByCity(c echo.Context) error {
+	var req exportMenusRequest
+
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	cityCode, err := util.ParseCityCode(req.CityCode)
+
+	if err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	now := time.Now()
+	month := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+
+	if req.Month != "" {
+		if month, err = util.ParseMonth(req.Month); err != nil {
+			return c.JSON(errors.NewBadRequestError(err))
+		}
+	}
+
+	format := domain.ExportFormatCSV
+
+	if req.Format != "" {
+		format = domain.ExportFormat(req.Format)
+	}
+
+	filename := fmt.Sprintf("menus-%d-%s.%s", cityCode, month.Format("2006-01"), format)
+
+	header := c.Response().Header()
+	header.Set(echo.HeaderContentType, format.ContentType())
+	header.Set(echo.HeaderContentDisposition, fmt.Sprintf(`attachment; filename="%s"`, filename))
+
+	ctx := c.Request().Context()
+
+	// 献立を取得しながらレスポンスに直接書き出す
+	if err := ec.eu.ExportByCity(ctx, c.Response(), format, month, cityCode); err != nil {
+		// 書き出しを始めた後はステータスを変えられないため、エラーを返して接続を閉じる
+		if c.Response().Committed {
+			return err
+		}
+
+		header.Del(echo.HeaderContentDisposition)
+
+		return c.JSON(errors.NewInternalServerError(err))
+	}
+
+	return nil
+}
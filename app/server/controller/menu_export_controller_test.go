@@ -0,0 +1,201 @@
+package controller
+
+import (
+	"database/sql"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ogurilab/school-lunch-api/domain"
+	"github.com/ogurilab/school-lunch-api/domain/mocks"
+	"github.com/ogurilab/school-lunch-api/util"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestExportMenusByCity(t *testing.T) {
+	city := util.RandomCityCode()
+	body := "提供日,献立\r\n"
+
+	type req struct {
+		cityCode string
+		month    string
+		format   string
+	}
+
+	testCases := []struct {
+		name      string
+		req       req
+		buildStub func(eu *mocks.MockMenuExportUsecase)
+		check     func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK - CSV",
+			req: req{
+				cityCode: fmt.Sprintf("%d", city),
+				month:    "2024-05",
+				format:   "csv",
+			},
+			buildStub: func(eu *mocks.MockMenuExportUsecase) {
+				month := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+				eu.EXPECT().ExportByCity(gomock.Any(), gomock.Any(), gomock.Eq(domain.ExportFormatCSV), gomock.Eq(month), gomock.Eq(city)).Times(1).DoAndReturn(
+					func(_ interface{}, w io.Writer, _ domain.ExportFormat, _ time.Time, _ int32) error {
+						_, err := io.WriteString(w, body)
+						return err
+					})
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Equal(t, domain.ExportFormatCSV.ContentType(), recorder.Header().Get(echo.HeaderContentType))
+				require.Equal(t, fmt.Sprintf(`attachment; filename="menus-%d-2024-05.csv"`, city), recorder.Header().Get(echo.HeaderContentDisposition))
+				require.Equal(t, body, recorder.Body.String())
+			},
+		},
+		{
+			name: "OK - XLSX",
+			req: req{
+				cityCode: fmt.Sprintf("%d", city),
+				month:    "2024-05",
+				format:   "xlsx",
+			},
+			buildStub: func(eu *mocks.MockMenuExportUsecase) {
+				eu.EXPECT().ExportByCity(gomock.Any(), gomock.Any(), gomock.Eq(domain.ExportFormatXLSX), gomock.Any(), gomock.Eq(city)).Times(1).DoAndReturn(
+					func(_ interface{}, w io.Writer, _ domain.ExportFormat, _ time.Time, _ int32) error {
+						_, err := io.WriteString(w, "PK")
+						return err
+					})
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Equal(t, domain.ExportFormatXLSX.ContentType(), recorder.Header().Get(echo.HeaderContentType))
+				require.Contains(t, recorder.Header().Get(echo.HeaderContentDisposition), ".xlsx")
+			},
+		},
+		{
+			name: "OK - Default Month And Format",
+			req: req{
+				cityCode: fmt.Sprintf("%d", city),
+			},
+			buildStub: func(eu *mocks.MockMenuExportUsecase) {
+				now := time.Now()
+				month := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+				eu.EXPECT().ExportByCity(gomock.Any(), gomock.Any(), gomock.Eq(domain.ExportFormatCSV), gomock.Eq(month), gomock.Eq(city)).Times(1).Return(nil)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "Invalid City Code",
+			req: req{
+				cityCode: "invalid",
+			},
+			buildStub: func(eu *mocks.MockMenuExportUsecase) {
+				eu.EXPECT().ExportByCity(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Invalid Month",
+			req: req{
+				cityCode: fmt.Sprintf("%d", city),
+				month:    "2024-05-01",
+			},
+			buildStub: func(eu *mocks.MockMenuExportUsecase) {
+				eu.EXPECT().ExportByCity(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Invalid Format",
+			req: req{
+				cityCode: fmt.Sprintf("%d", city),
+				format:   "pdf",
+			},
+			buildStub: func(eu *mocks.MockMenuExportUsecase) {
+				eu.EXPECT().ExportByCity(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Internal Server Error",
+			req: req{
+				cityCode: fmt.Sprintf("%d", city),
+			},
+			buildStub: func(eu *mocks.MockMenuExportUsecase) {
+				eu.EXPECT().ExportByCity(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(sql.ErrConnDone)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+				require.Empty(t, recorder.Header().Get(echo.HeaderContentDisposition))
+			},
+		},
+		{
+			name: "Error After Streaming Started",
+			req: req{
+				cityCode: fmt.Sprintf("%d", city),
+			},
+			buildStub: func(eu *mocks.MockMenuExportUsecase) {
+				eu.EXPECT().ExportByCity(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+					func(_ interface{}, w io.Writer, _ domain.ExportFormat, _ time.Time, _ int32) error {
+						if _, err := io.WriteString(w, body); err != nil {
+							return err
+						}
+
+						return sql.ErrConnDone
+					})
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				// 書き出しを始めた後はステータスを変えない
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Equal(t, body, recorder.Body.String())
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			eu := mocks.NewMockMenuExportUsecase(ctrl)
+			tc.buildStub(eu)
+
+			q := make(url.Values)
+
+			if tc.req.month != "" {
+				q.Set("month", tc.req.month)
+			}
+
+			if tc.req.format != "" {
+				q.Set("format", tc.req.format)
+			}
+
+			url := fmt.Sprintf("/cities/%s/menus/export?%s", tc.req.cityCode, q.Encode())
+			req, err := http.NewRequest(http.MethodGet, url, nil)
+
+			require.NoError(t, err)
+
+			recorder := httptest.NewRecorder()
+			e := newSetUpTestServer()
+
+			// 同じ階層の /cities/:code/menus/:id と衝突しないことを確認する
+			e.GET("/cities/:code/menus/export", NewMenuExportController(eu).ExportByCity)
+			e.GET("/cities/:code/menus/:id", func(c echo.Context) error { return c.NoContent(http.StatusTeapot) })
+			e.ServeHTTP(recorder, req)
+
+			tc.check(t, recorder)
+		})
+	}
+}
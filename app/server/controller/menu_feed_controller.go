@@ -0,0 +1,85 @@
+package controller
+
+import (
+	"database/sql"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ogurilab/school-lunch-api/domain"
+	"github.com/ogurilab/school-lunch-api/domain/errors"
+	"github.com/ogurilab/school-lunch-api/util"
+)
+
+/************************
+ * MenuFeedController
+ ************************/
+
+// feedMaxAge はフィードをキャッシュしてよい秒数
+const feedMaxAge = 15 * 60
+
+type menuFeedController struct {
+	fu      domain.MenuFeedUsecase
+	baseURL string
+}
+
+// NewMenuFeedController は baseURL を元にフィードのリンクを作る MenuFeedController を返す
+// フィードは共有キャッシュに載るため、リクエストの Host ヘッダーではなく設定した URL を使う
+// 例: https://example.com/v1
+func NewMenuFeedController(fu domain.MenuFeedUsecase, baseURL string) domain.MenuFeedController {
+	return &menuFeedController{
+		fu:      fu,
+		baseURL: baseURL,
+	}
+}
+
+type fetchMenuFeedRequest struct {
+	CityCode string `param:"code" validate:"required,city_code"`
+	Limit    int32  `query:"limit" validate:"gt=0"`
+}
+
+func (fc *menuFeedController) FetchAtomByCity(c echo.Context) error {
+	return fc.fetchByCity(c, domain.FeedFormatAtom)
+}
+
+func (fc *menuFeedController) FetchRSSByCity(c echo.Context) error {
+	return fc.fetchByCity(c, domain.FeedFormatRSS)
+}
+
+func (fc *menuFeedController) fetchByCity(c echo.Context, format domain.FeedFormat) error {
+	var req fetchMenuFeedRequest
+
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	if req.Limit > domain.MAX_LIMIT {
+		return c.JSON(errors.NewMaxLimitError())
+	}
+
+	if req.Limit == 0 {
+		req.Limit = domain.DEFAULT_LIMIT
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	cityCode, err := util.ParseCityCode(req.CityCode)
+
+	if err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	ctx := c.Request().Context()
+
+	body, err := fc.fu.ExportByCity(ctx, format, req.Limit, cityCode, fc.baseURL)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return c.JSON(errors.NewNotFoundError(err))
+		}
+
+		return c.JSON(errors.NewInternalServerError(err))
+	}
+
+	return blobWithETag(c, format.ContentType(), body, feedMaxAge)
+}
@@ -0,0 +1,153 @@
+package controller
+
+import (
+	"crypto/sha256"
+	"database/sql"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ogurilab/school-lunch-api/domain"
+	"github.com/ogurilab/school-lunch-api/domain/mocks"
+	"github.com/ogurilab/school-lunch-api/util"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestFetchMenuFeedByCity(t *testing.T) {
+	city := util.RandomCityCode()
+	baseURL := "https://api.example.com/v1"
+	body := []byte("<feed/>")
+	etag := fmt.Sprintf(`"%x"`, sha256.Sum256(body))
+
+	type req struct {
+		path        string
+		ifNoneMatch string
+	}
+
+	testCases := []struct {
+		name      string
+		req       req
+		buildStub func(fu *mocks.MockMenuFeedUsecase)
+		check     func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK - Atom",
+			req: req{
+				path: fmt.Sprintf("/v1/cities/%d/feed.atom", city),
+			},
+			buildStub: func(fu *mocks.MockMenuFeedUsecase) {
+				fu.EXPECT().ExportByCity(gomock.Any(), gomock.Eq(domain.FeedFormatAtom), gomock.Eq(domain.DEFAULT_LIMIT), gomock.Eq(city), gomock.Eq(baseURL)).Times(1).Return(body, nil)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Equal(t, domain.FeedFormatAtom.ContentType(), recorder.Header().Get("Content-Type"))
+				require.Equal(t, etag, recorder.Header().Get("ETag"))
+				require.Equal(t, body, recorder.Body.Bytes())
+			},
+		},
+		{
+			name: "OK - RSS",
+			req: req{
+				path: fmt.Sprintf("/v1/cities/%d/feed.rss?limit=5", city),
+			},
+			buildStub: func(fu *mocks.MockMenuFeedUsecase) {
+				fu.EXPECT().ExportByCity(gomock.Any(), gomock.Eq(domain.FeedFormatRSS), gomock.Eq(int32(5)), gomock.Eq(city), gomock.Eq(baseURL)).Times(1).Return(body, nil)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Equal(t, domain.FeedFormatRSS.ContentType(), recorder.Header().Get("Content-Type"))
+			},
+		},
+		{
+			name: "Not Modified",
+			req: req{
+				path:        fmt.Sprintf("/v1/cities/%d/feed.atom", city),
+				ifNoneMatch: etag,
+			},
+			buildStub: func(fu *mocks.MockMenuFeedUsecase) {
+				fu.EXPECT().ExportByCity(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(body, nil)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotModified, recorder.Code)
+			},
+		},
+		{
+			name: "Invalid City Code",
+			req: req{
+				path: "/v1/cities/invalid/feed.atom",
+			},
+			buildStub: func(fu *mocks.MockMenuFeedUsecase) {
+				fu.EXPECT().ExportByCity(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Max Limit",
+			req: req{
+				path: fmt.Sprintf("/v1/cities/%d/feed.atom?limit=%d", city, domain.MAX_LIMIT+1),
+			},
+			buildStub: func(fu *mocks.MockMenuFeedUsecase) {
+				fu.EXPECT().ExportByCity(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Not Found",
+			req: req{
+				path: fmt.Sprintf("/v1/cities/%d/feed.atom", city),
+			},
+			buildStub: func(fu *mocks.MockMenuFeedUsecase) {
+				fu.EXPECT().ExportByCity(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrNoRows)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name: "Internal Server Error",
+			req: req{
+				path: fmt.Sprintf("/v1/cities/%d/feed.rss", city),
+			},
+			buildStub: func(fu *mocks.MockMenuFeedUsecase) {
+				fu.EXPECT().ExportByCity(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrConnDone)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			fu := mocks.NewMockMenuFeedUsecase(ctrl)
+			tc.buildStub(fu)
+
+			// Host ヘッダーが異なってもリンクには設定した URL を使う
+			req := httptest.NewRequest(http.MethodGet, "http://attacker.example"+tc.req.path, nil)
+
+			if tc.req.ifNoneMatch != "" {
+				req.Header.Set("If-None-Match", tc.req.ifNoneMatch)
+			}
+
+			recorder := httptest.NewRecorder()
+			e := newSetUpTestServer()
+
+			fc := NewMenuFeedController(fu, baseURL)
+			v1 := e.Group("/v1")
+			v1.GET("/cities/:code/feed.atom", fc.FetchAtomByCity)
+			v1.GET("/cities/:code/feed.rss", fc.FetchRSSByCity)
+			e.ServeHTTP(recorder, req)
+
+			tc.check(t, recorder)
+		})
+	}
+}
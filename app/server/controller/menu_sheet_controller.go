@@ -0,0 +1,80 @@
+package controller
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ogurilab/school-lunch-api/domain"
+	"github.com/ogurilab/school-lunch-api/domain/errors"
+	"github.com/ogurilab/school-lunch-api/util"
+)
+
+/************************
+ * MenuSheetController
+ ************************/
+
+// sheetMaxAge は献立表をキャッシュしてよい秒数
+const sheetMaxAge = 60 * 60
+
+type menuSheetController struct {
+	su domain.MenuSheetUsecase
+}
+
+func NewMenuSheetController(su domain.MenuSheetUsecase) domain.MenuSheetController {
+	return &menuSheetController{
+		su: su,
+	}
+}
+
+type exportMenuSheetRequest struct {
+	CityCode string `param:"code" validate:"required,city_code"`
+	Month    string `query:"month" validate:"omitempty,YYYY-MM"`
+}
+
+// ExportByCity は1か月分の献立表を PDF で返す
+// 月を指定しない場合は今月の献立表を返す
+func (sc *menuSheetController) ExportByCity(c echo.Context) error {
+	var req exportMenuSheetRequest
+
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	cityCode, err := util.ParseCityCode(req.CityCode)
+
+	if err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	now := time.Now()
+	month := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+
+	if req.Month != "" {
+		if month, err = util.ParseMonth(req.Month); err != nil {
+			return c.JSON(errors.NewBadRequestError(err))
+		}
+	}
+
+	ctx := c.Request().Context()
+
+	body, err := sc.su.ExportByCity(ctx, month, cityCode)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return c.JSON(errors.NewNotFoundError(err))
+		}
+
+		return c.JSON(errors.NewInternalServerError(err))
+	}
+
+	filename := fmt.Sprintf("menus-%d-%s.pdf", cityCode, month.Format("2006-01"))
+	c.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf(`inline; filename="%s"`, filename))
+
+	return blobWithETag(c, domain.PDFContentType, body, sheetMaxAge)
+}
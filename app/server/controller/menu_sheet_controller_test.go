@@ -0,0 +1,170 @@
+package controller
+
+import (
+	"crypto/sha256"
+	"database/sql"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ogurilab/school-lunch-api/domain"
+	"github.com/ogurilab/school-lunch-api/domain/mocks"
+	"github.com/ogurilab/school-lunch-api/util"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestExportMenuSheetByCity(t *testing.T) {
+	city := util.RandomCityCode()
+	body := []byte("%PDF-1.4\n%%EOF\n")
+	etag := fmt.Sprintf(`"%x"`, sha256.Sum256(body))
+
+	type req struct {
+		cityCode    string
+		month       string
+		ifNoneMatch string
+	}
+
+	testCases := []struct {
+		name      string
+		req       req
+		buildStub func(su *mocks.MockMenuSheetUsecase)
+		check     func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			req: req{
+				cityCode: fmt.Sprintf("%d", city),
+				month:    "2024-05",
+			},
+			buildStub: func(su *mocks.MockMenuSheetUsecase) {
+				month := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+				su.EXPECT().ExportByCity(gomock.Any(), gomock.Eq(month), gomock.Eq(city)).Times(1).Return(body, nil)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Equal(t, domain.PDFContentType, recorder.Header().Get(echo.HeaderContentType))
+				require.Equal(t, fmt.Sprintf(`inline; filename="menus-%d-2024-05.pdf"`, city), recorder.Header().Get(echo.HeaderContentDisposition))
+				require.Equal(t, etag, recorder.Header().Get("ETag"))
+				require.Equal(t, body, recorder.Body.Bytes())
+			},
+		},
+		{
+			name: "OK - Default Month",
+			req: req{
+				cityCode: fmt.Sprintf("%d", city),
+			},
+			buildStub: func(su *mocks.MockMenuSheetUsecase) {
+				now := time.Now()
+				month := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+				su.EXPECT().ExportByCity(gomock.Any(), gomock.Eq(month), gomock.Eq(city)).Times(1).Return(body, nil)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "Not Modified",
+			req: req{
+				cityCode:    fmt.Sprintf("%d", city),
+				month:       "2024-05",
+				ifNoneMatch: etag,
+			},
+			buildStub: func(su *mocks.MockMenuSheetUsecase) {
+				su.EXPECT().ExportByCity(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(body, nil)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotModified, recorder.Code)
+				require.Empty(t, recorder.Body.Bytes())
+			},
+		},
+		{
+			name: "Invalid City Code",
+			req: req{
+				cityCode: "invalid",
+			},
+			buildStub: func(su *mocks.MockMenuSheetUsecase) {
+				su.EXPECT().ExportByCity(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Invalid Month",
+			req: req{
+				cityCode: fmt.Sprintf("%d", city),
+				month:    "2024-13",
+			},
+			buildStub: func(su *mocks.MockMenuSheetUsecase) {
+				su.EXPECT().ExportByCity(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "City Not Found",
+			req: req{
+				cityCode: fmt.Sprintf("%d", city),
+			},
+			buildStub: func(su *mocks.MockMenuSheetUsecase) {
+				su.EXPECT().ExportByCity(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrNoRows)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name: "Internal Server Error",
+			req: req{
+				cityCode: fmt.Sprintf("%d", city),
+			},
+			buildStub: func(su *mocks.MockMenuSheetUsecase) {
+				su.EXPECT().ExportByCity(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrConnDone)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			su := mocks.NewMockMenuSheetUsecase(ctrl)
+			tc.buildStub(su)
+
+			q := make(url.Values)
+
+			if tc.req.month != "" {
+				q.Set("month", tc.req.month)
+			}
+
+			url := fmt.Sprintf("/cities/%s/menus/sheet.pdf?%s", tc.req.cityCode, q.Encode())
+			req, err := http.NewRequest(http.MethodGet, url, nil)
+
+			require.NoError(t, err)
+
+			if tc.req.ifNoneMatch != "" {
+				req.Header.Set("If-None-Match", tc.req.ifNoneMatch)
+			}
+
+			recorder := httptest.NewRecorder()
+			e := newSetUpTestServer()
+
+			// 同じ階層の /cities/:code/menus/:id と衝突しないことを確認する
+			e.GET("/cities/:code/menus/sheet.pdf", NewMenuSheetController(su).ExportByCity)
+			e.GET("/cities/:code/menus/:id", func(c echo.Context) error { return c.NoContent(http.StatusTeapot) })
+			e.ServeHTTP(recorder, req)
+
+			tc.check(t, recorder)
+		})
+	}
+}
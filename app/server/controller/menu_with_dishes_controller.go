@@ -25,7 +25,7 @@ func NewMenuWithDishesController(mu domain.MenuWithDishesUsecase) domain.MenuWit
 
 type getMenuWithDishesRequest struct {
 	ID       string `param:"id" validate:"required,ulid"`
-	CityCode int32  `param:"code" validate:"required,gt=0"`
+	CityCode string `param:"code" validate:"required,city_code"`
 }
 
 func (mc *menuWithDishesController) GetByID(c echo.Context) error {
@@ -39,9 +39,15 @@ func (mc *menuWithDishesController) GetByID(c echo.Context) error {
 		return c.JSON(errors.NewBadRequestError(err))
 	}
 
+	cityCode, err := util.ParseCityCode(req.CityCode)
+
+	if err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
 	ctx := c.Request().Context()
 
-	menu, err := mc.mu.GetByID(ctx, req.ID, req.CityCode)
+	menu, err := mc.mu.GetByID(ctx, req.ID, cityCode)
 
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -55,10 +61,11 @@ func (mc *menuWithDishesController) GetByID(c echo.Context) error {
 }
 
 type fetchMenuWithDishesByCityRequest struct {
-	CityCode int32  `param:"code" validate:"required,gt=0"`
-	Limit    int32  `query:"limit" validate:"gt=0"`
-	Offset   int32  `query:"offset" validate:"gte=0"`
-	Offered  string `query:"offered" validate:"YYYY-MM-DD,required"`
+	CityCode         string  `param:"code" validate:"required,city_code"`
+	Limit            int32   `query:"limit" validate:"gt=0"`
+	Offset           int32   `query:"offset" validate:"gte=0"`
+	Offered          string  `query:"offered" validate:"YYYY-MM-DD,required"`
+	ExcludeAllergens []int32 `query:"exclude_allergens" validate:"dive,gt=0"`
 }
 
 type fetchMenuWithDishesResponse struct {
@@ -89,6 +96,12 @@ func (mc *menuWithDishesController) FetchByCity(c echo.Context) error {
 		return c.JSON(errors.NewBadRequestError(err))
 	}
 
+	cityCode, err := util.ParseCityCode(req.CityCode)
+
+	if err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
 	parsedDate, err := util.ParseDate(req.Offered)
 
 	if err != nil {
@@ -97,13 +110,26 @@ func (mc *menuWithDishesController) FetchByCity(c echo.Context) error {
 
 	ctx := c.Request().Context()
 
-	menus, err := mc.mu.FetchByCity(
-		ctx,
-		req.Limit,
-		req.Offset,
-		parsedDate,
-		req.CityCode,
-	)
+	var menus []*domain.MenuWithDishes
+
+	if len(req.ExcludeAllergens) > 0 {
+		menus, err = mc.mu.FetchByCityExcludingAllergens(
+			ctx,
+			req.Limit,
+			req.Offset,
+			parsedDate,
+			cityCode,
+			req.ExcludeAllergens,
+		)
+	} else {
+		menus, err = mc.mu.FetchByCity(
+			ctx,
+			req.Limit,
+			req.Offset,
+			parsedDate,
+			cityCode,
+		)
+	}
 
 	if err != nil {
 		return c.JSON(errors.NewInternalServerError(err))
@@ -183,3 +209,78 @@ func (mc *menuWithDishesController) Fetch(c echo.Context) error {
 	}
 	return c.JSON(200, res)
 }
+
+type fetchAllergenRisksByCityRequest struct {
+	CityCode         string  `param:"code" validate:"required,city_code"`
+	Limit            int32   `query:"limit" validate:"gt=0"`
+	Offset           int32   `query:"offset" validate:"gte=0"`
+	Offered          string  `query:"offered" validate:"YYYY-MM-DD,required"`
+	ExcludeAllergens []int32 `query:"exclude_allergens" validate:"required,min=1,dive,gt=0"`
+}
+
+type fetchAllergenRisksResponse struct {
+	Days []*domain.MenuAllergenRisk `json:"days"`
+	Next string                     `json:"next"`
+}
+
+func (mc *menuWithDishesController) FetchAllergenRisksByCity(c echo.Context) error {
+	var req fetchAllergenRisksByCityRequest
+
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	if req.Limit > domain.MAX_LIMIT {
+		return c.JSON(errors.NewMaxLimitError())
+	}
+
+	if req.Limit == 0 {
+		req.Limit = domain.DEFAULT_LIMIT
+	}
+
+	if req.Offset == 0 {
+		req.Offset = domain.DEFAULT_OFFSET
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	cityCode, err := util.ParseCityCode(req.CityCode)
+
+	if err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	parsedDate, err := util.ParseDate(req.Offered)
+
+	if err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	ctx := c.Request().Context()
+
+	risks, err := mc.mu.FetchAllergenRisksByCity(
+		ctx,
+		req.Limit,
+		req.Offset,
+		parsedDate,
+		cityCode,
+		req.ExcludeAllergens,
+	)
+
+	if err != nil {
+		return c.JSON(errors.NewInternalServerError(err))
+	}
+
+	if len(risks) == 0 {
+		return c.JSON(200, fetchAllergenRisksResponse{Days: []*domain.MenuAllergenRisk{}, Next: ""})
+	}
+
+	res := fetchAllergenRisksResponse{
+		Days: risks,
+		Next: risks[len(risks)-1].OfferedAt,
+	}
+
+	return c.JSON(200, res)
+}
@@ -32,7 +32,7 @@ func TestGetMenuWithDishesByID(t *testing.T) {
 			name: "OK",
 			req: req{
 				ID:       menu.ID,
-				CityCode: menu.CityCode,
+				CityCode: fmt.Sprint(menu.CityCode),
 			},
 			buildStub: func(uc *mocks.MockMenuWithDishesUsecase) {
 
@@ -47,7 +47,7 @@ func TestGetMenuWithDishesByID(t *testing.T) {
 			name: "Bad Request - Invalid ID",
 			req: req{
 				ID:       "invalid-id",
-				CityCode: menu.CityCode,
+				CityCode: fmt.Sprint(menu.CityCode),
 			},
 			buildStub: func(uc *mocks.MockMenuWithDishesUsecase) {
 				uc.EXPECT().GetByID(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
@@ -60,7 +60,7 @@ func TestGetMenuWithDishesByID(t *testing.T) {
 			name: "Bad Request - Invalid CityCode",
 			req: req{
 				ID:       menu.ID,
-				CityCode: -1,
+				CityCode: "-1",
 			},
 			buildStub: func(uc *mocks.MockMenuWithDishesUsecase) {
 				uc.EXPECT().GetByID(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
@@ -73,7 +73,7 @@ func TestGetMenuWithDishesByID(t *testing.T) {
 			name: "Not Found",
 			req: req{
 				ID:       menu.ID,
-				CityCode: menu.CityCode,
+				CityCode: fmt.Sprint(menu.CityCode),
 			},
 			buildStub: func(uc *mocks.MockMenuWithDishesUsecase) {
 				uc.EXPECT().GetByID(gomock.Any(), gomock.Eq(menu.ID), gomock.Eq(menu.CityCode)).Times(1).Return(nil, sql.ErrNoRows)
@@ -86,7 +86,7 @@ func TestGetMenuWithDishesByID(t *testing.T) {
 			name: "Internal Server Error",
 			req: req{
 				ID:       menu.ID,
-				CityCode: menu.CityCode,
+				CityCode: fmt.Sprint(menu.CityCode),
 			},
 			buildStub: func(uc *mocks.MockMenuWithDishesUsecase) {
 				uc.EXPECT().GetByID(gomock.Any(), gomock.Eq(menu.ID), gomock.Eq(menu.CityCode)).Times(1).Return(nil, sql.ErrConnDone)
@@ -106,7 +106,7 @@ func TestGetMenuWithDishesByID(t *testing.T) {
 
 		recorder := httptest.NewRecorder()
 
-		url := fmt.Sprintf("/cities/%d/menus/%s", tc.req.CityCode, tc.req.ID)
+		url := fmt.Sprintf("/cities/%s/menus/%s", tc.req.CityCode, tc.req.ID)
 		req, err := http.NewRequest(http.MethodGet, url, nil)
 
 		require.NoError(t, err)
@@ -367,6 +367,7 @@ func TestFetchMenuWithDishesByCity(t *testing.T) {
 		Limit    sql.NullInt32
 		Offset   sql.NullInt32
 		Offered  string
+		Exclude  []string
 	}
 
 	testCases := []struct {
@@ -395,6 +396,45 @@ func TestFetchMenuWithDishesByCity(t *testing.T) {
 				requireBodyMatchMenuWithDishesList(t, recorder.Body, menus)
 			},
 		},
+		{
+			name: "OK - exclude_allergens",
+			req: req{
+				CityCode: cityCode,
+				Limit:    sql.NullInt32{Int32: limit, Valid: true},
+				Offset:   sql.NullInt32{Int32: offset, Valid: true},
+				Offered:  offered,
+				Exclude:  []string{"1", "2"},
+			},
+			buildStub: func(uc *mocks.MockMenuWithDishesUsecase) {
+				parsedOffered, err := util.ParseDate(offered)
+
+				require.NoError(t, err)
+
+				uc.EXPECT().FetchByCity(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				uc.EXPECT().FetchByCityExcludingAllergens(gomock.Any(), gomock.Eq(limit), gomock.Eq(offset), gomock.Eq(parsedOffered), gomock.Eq(cityCode), gomock.Eq([]int32{1, 2})).Times(1).Return(menus, nil)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder, menus []*domain.MenuWithDishes) {
+				require.Equal(t, 200, recorder.Code)
+				requireBodyMatchMenuWithDishesList(t, recorder.Body, menus)
+			},
+		},
+		{
+			name: "Bad Request - Invalid exclude_allergens",
+			req: req{
+				CityCode: cityCode,
+				Limit:    sql.NullInt32{Int32: limit, Valid: true},
+				Offset:   sql.NullInt32{Int32: offset, Valid: true},
+				Offered:  offered,
+				Exclude:  []string{"0"},
+			},
+			buildStub: func(uc *mocks.MockMenuWithDishesUsecase) {
+				uc.EXPECT().FetchByCity(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				uc.EXPECT().FetchByCityExcludingAllergens(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder, menus []*domain.MenuWithDishes) {
+				require.Equal(t, 400, recorder.Code)
+			},
+		},
 		{
 			name: "Bad Request - Invalid CityCode",
 			req: req{
@@ -594,6 +634,10 @@ func TestFetchMenuWithDishesByCity(t *testing.T) {
 
 		q.Set("offered", tc.req.Offered)
 
+		for _, id := range tc.req.Exclude {
+			q.Add("exclude_allergens", id)
+		}
+
 		url := fmt.Sprintf("/cities/%d/menus?%s", tc.req.CityCode, q.Encode())
 		req, err := http.NewRequest(http.MethodGet, url, nil)
 
@@ -608,6 +652,122 @@ func TestFetchMenuWithDishesByCity(t *testing.T) {
 	}
 }
 
+func TestFetchAllergenRisksByCity(t *testing.T) {
+	menu := randomMenuWithDishes(t)
+	offered := util.FormatDate(menu.OfferedAt)
+	risks := []*domain.MenuAllergenRisk{
+		{
+			MenuID:    menu.ID,
+			OfferedAt: offered,
+			Flagged:   true,
+			Dishes: []*domain.DishAllergenRisk{
+				{
+					ID:   menu.Dishes[0].ID,
+					Name: menu.Dishes[0].Name,
+					Allergens: []*domain.Allergen{
+						domain.ReNewAllergen(1, "卵", domain.AllergenKindSpecified, domain.AllergenCategoryContains),
+					},
+				},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name      string
+		query     string
+		buildStub func(uc *mocks.MockMenuWithDishesUsecase)
+		check     func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:  "OK",
+			query: fmt.Sprintf("offered=%s&exclude_allergens=1&exclude_allergens=2", offered),
+			buildStub: func(uc *mocks.MockMenuWithDishesUsecase) {
+				uc.EXPECT().FetchAllergenRisksByCity(gomock.Any(), gomock.Eq(domain.DEFAULT_LIMIT), gomock.Eq(domain.DEFAULT_OFFSET), gomock.Eq(menu.OfferedAt), gomock.Eq(menu.CityCode), gomock.Eq([]int32{1, 2})).Times(1).Return(risks, nil)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var res fetchAllergenRisksResponse
+				err := json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+
+				require.Equal(t, risks, res.Days)
+				require.Equal(t, offered, res.Next)
+			},
+		},
+		{
+			name:  "OK - Empty",
+			query: fmt.Sprintf("offered=%s&exclude_allergens=1", offered),
+			buildStub: func(uc *mocks.MockMenuWithDishesUsecase) {
+				uc.EXPECT().FetchAllergenRisksByCity(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return([]*domain.MenuAllergenRisk{}, nil)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var res fetchAllergenRisksResponse
+				err := json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+
+				require.NotNil(t, res.Days)
+				require.Empty(t, res.Days)
+				require.Equal(t, "", res.Next)
+			},
+		},
+		{
+			name:  "Bad Request - Missing exclude_allergens",
+			query: fmt.Sprintf("offered=%s", offered),
+			buildStub: func(uc *mocks.MockMenuWithDishesUsecase) {
+				uc.EXPECT().FetchAllergenRisksByCity(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:  "Bad Request - Missing Offered",
+			query: "exclude_allergens=1",
+			buildStub: func(uc *mocks.MockMenuWithDishesUsecase) {
+				uc.EXPECT().FetchAllergenRisksByCity(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:  "Internal Server Error",
+			query: fmt.Sprintf("offered=%s&exclude_allergens=1", offered),
+			buildStub: func(uc *mocks.MockMenuWithDishesUsecase) {
+				uc.EXPECT().FetchAllergenRisksByCity(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrConnDone)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc := mocks.NewMockMenuWithDishesUsecase(ctrl)
+			tc.buildStub(uc)
+
+			url := fmt.Sprintf("/cities/%d/menus/allergen-risks?%s", menu.CityCode, tc.query)
+			req, err := http.NewRequest(http.MethodGet, url, nil)
+
+			require.NoError(t, err)
+
+			recorder := httptest.NewRecorder()
+			e := newSetUpTestServer()
+			e.GET("/cities/:code/menus/allergen-risks", NewMenuWithDishesController(uc).FetchAllergenRisksByCity)
+			e.ServeHTTP(recorder, req)
+
+			tc.check(t, recorder)
+		})
+	}
+}
+
 func requireBodyMatchMenuWithDishes(t *testing.T, body *bytes.Buffer, menu *domain.MenuWithDishes) {
 
 	data, err := io.ReadAll(body)
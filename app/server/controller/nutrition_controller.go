@@ -0,0 +1,103 @@
+package controller
+
+import (
+	"database/sql"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ogurilab/school-lunch-api/domain"
+	"github.com/ogurilab/school-lunch-api/domain/errors"
+)
+
+type nutritionController struct {
+	nu domain.NutritionUsecase
+}
+
+func NewNutritionController(nu domain.NutritionUsecase) domain.NutritionController {
+	return &nutritionController{
+		nu: nu,
+	}
+}
+
+type upsertNutritionRequest struct {
+	MenuID       string   `param:"id" validate:"required,ulid"`
+	SchoolLevel  string   `param:"level" validate:"required,oneof=elementary junior_high"`
+	Protein      *float64 `json:"protein" validate:"omitempty,gte=0"`
+	Fat          *float64 `json:"fat" validate:"omitempty,gte=0"`
+	Carbohydrate *float64 `json:"carbohydrate" validate:"omitempty,gte=0"`
+	Salt         *float64 `json:"salt" validate:"omitempty,gte=0"`
+	Calcium      *float64 `json:"calcium" validate:"omitempty,gte=0"`
+	Iron         *float64 `json:"iron" validate:"omitempty,gte=0"`
+	VitaminA     *float64 `json:"vitamin_a" validate:"omitempty,gte=0"`
+	VitaminB1    *float64 `json:"vitamin_b1" validate:"omitempty,gte=0"`
+	VitaminB2    *float64 `json:"vitamin_b2" validate:"omitempty,gte=0"`
+	VitaminC     *float64 `json:"vitamin_c" validate:"omitempty,gte=0"`
+}
+
+// Upsert は献立の学校種別の栄養価を登録する
+// 献立表に記載されていない項目は省略または null にする
+func (nc *nutritionController) Upsert(c echo.Context) error {
+	var req upsertNutritionRequest
+
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	ctx := c.Request().Context()
+
+	nutrition, err := nc.nu.Upsert(ctx, req.MenuID, domain.SchoolLevel(req.SchoolLevel), &domain.NutritionFacts{
+		Protein:      req.Protein,
+		Fat:          req.Fat,
+		Carbohydrate: req.Carbohydrate,
+		Salt:         req.Salt,
+		Calcium:      req.Calcium,
+		Iron:         req.Iron,
+		VitaminA:     req.VitaminA,
+		VitaminB1:    req.VitaminB1,
+		VitaminB2:    req.VitaminB2,
+		VitaminC:     req.VitaminC,
+	})
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return c.JSON(errors.NewNotFoundError(err))
+		}
+
+		return c.JSON(errors.NewInternalServerError(err))
+	}
+
+	return c.JSON(http.StatusOK, nutrition)
+}
+
+type deleteNutritionRequest struct {
+	MenuID      string `param:"id" validate:"required,ulid"`
+	SchoolLevel string `param:"level" validate:"required,oneof=elementary junior_high"`
+}
+
+func (nc *nutritionController) Delete(c echo.Context) error {
+	var req deleteNutritionRequest
+
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	ctx := c.Request().Context()
+
+	if err := nc.nu.Delete(ctx, req.MenuID, domain.SchoolLevel(req.SchoolLevel)); err != nil {
+		if err == sql.ErrNoRows {
+			return c.JSON(errors.NewNotFoundError(err))
+		}
+
+		return c.JSON(errors.NewInternalServerError(err))
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,204 @@
+package controller
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ogurilab/school-lunch-api/domain"
+	"github.com/ogurilab/school-lunch-api/domain/mocks"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestUpsertNutrition(t *testing.T) {
+	menu := randomMenu(t)
+	protein := 25.5
+	salt := 2.3
+	facts := &domain.NutritionFacts{Protein: &protein, Salt: &salt}
+
+	testCases := []struct {
+		name      string
+		menuID    string
+		level     string
+		body      string
+		buildStub func(uc *mocks.MockNutritionUsecase)
+		check     func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:   "OK",
+			menuID: menu.ID,
+			level:  "elementary",
+			body:   `{"protein":25.5,"salt":2.3,"fat":null}`,
+			buildStub: func(uc *mocks.MockNutritionUsecase) {
+				uc.EXPECT().Upsert(gomock.Any(), gomock.Eq(menu.ID), gomock.Eq(domain.SchoolLevelElementary), gomock.Eq(facts)).Times(1).
+					Return(&domain.MenuNutrition{Elementary: facts}, nil)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var res domain.MenuNutrition
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+				require.Equal(t, facts, res.Elementary)
+				require.Nil(t, res.JuniorHigh)
+			},
+		},
+		{
+			name:   "Bad Request - Invalid Level",
+			menuID: menu.ID,
+			level:  "high_school",
+			body:   `{"protein":25.5}`,
+			buildStub: func(uc *mocks.MockNutritionUsecase) {
+				uc.EXPECT().Upsert(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:   "Bad Request - Negative Value",
+			menuID: menu.ID,
+			level:  "junior_high",
+			body:   `{"salt":-1}`,
+			buildStub: func(uc *mocks.MockNutritionUsecase) {
+				uc.EXPECT().Upsert(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:   "Bad Request - Invalid ID",
+			menuID: "invalid",
+			level:  "elementary",
+			body:   `{}`,
+			buildStub: func(uc *mocks.MockNutritionUsecase) {
+				uc.EXPECT().Upsert(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:   "Not Found",
+			menuID: menu.ID,
+			level:  "elementary",
+			body:   `{}`,
+			buildStub: func(uc *mocks.MockNutritionUsecase) {
+				uc.EXPECT().Upsert(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrNoRows)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name:   "Internal Server Error",
+			menuID: menu.ID,
+			level:  "elementary",
+			body:   `{}`,
+			buildStub: func(uc *mocks.MockNutritionUsecase) {
+				uc.EXPECT().Upsert(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrConnDone)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc := mocks.NewMockNutritionUsecase(ctrl)
+			tc.buildStub(uc)
+
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/admin/menus/%s/nutrition/%s", tc.menuID, tc.level)
+			req, err := http.NewRequest(http.MethodPut, url, bytes.NewBufferString(tc.body))
+			require.NoError(t, err)
+
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+			e, env := newSetupAdminTestServer(t)
+			createValidAdminToken(t, env, req)
+
+			e.PUT("/admin/menus/:id/nutrition/:level", NewNutritionController(uc).Upsert)
+			e.ServeHTTP(recorder, req)
+
+			tc.check(t, recorder)
+		})
+	}
+}
+
+func TestDeleteNutrition(t *testing.T) {
+	menu := randomMenu(t)
+
+	testCases := []struct {
+		name      string
+		level     string
+		buildStub func(uc *mocks.MockNutritionUsecase)
+		check     func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:  "OK",
+			level: "junior_high",
+			buildStub: func(uc *mocks.MockNutritionUsecase) {
+				uc.EXPECT().Delete(gomock.Any(), gomock.Eq(menu.ID), gomock.Eq(domain.SchoolLevelJuniorHigh)).Times(1).Return(nil)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNoContent, recorder.Code)
+			},
+		},
+		{
+			name:  "Bad Request - Invalid Level",
+			level: "kindergarten",
+			buildStub: func(uc *mocks.MockNutritionUsecase) {
+				uc.EXPECT().Delete(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:  "Not Found",
+			level: "elementary",
+			buildStub: func(uc *mocks.MockNutritionUsecase) {
+				uc.EXPECT().Delete(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(sql.ErrNoRows)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc := mocks.NewMockNutritionUsecase(ctrl)
+			tc.buildStub(uc)
+
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/admin/menus/%s/nutrition/%s", menu.ID, tc.level)
+			req, err := http.NewRequest(http.MethodDelete, url, nil)
+			require.NoError(t, err)
+
+			e, env := newSetupAdminTestServer(t)
+			createValidAdminToken(t, env, req)
+
+			e.DELETE("/admin/menus/:id/nutrition/:level", NewNutritionController(uc).Delete)
+			e.ServeHTTP(recorder, req)
+
+			tc.check(t, recorder)
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package controller
+
+import (
+	"database/sql"
+	"io"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ogurilab/school-lunch-api/domain"
+	"github.com/ogurilab/school-lunch-api/domain/errors"
+)
+
+type photoController struct {
+	pu domain.PhotoUsecase
+}
+
+func NewPhotoController(pu domain.PhotoUsecase) domain.PhotoController {
+	return &photoController{
+		pu: pu,
+	}
+}
+
+type uploadMenuPhotoRequest struct {
+	ID      string `param:"id" validate:"required,ulid"`
+	Publish bool   `form:"publish"`
+	Author  string `form:"author" validate:"max=255"`
+}
+
+// UploadMenuPhoto は multipart の file フィールドで受け取った写真を献立の写真として保存する
+// publish が true の場合は Wikimedia Commons にも公開し、author を撮影者として記載する
+func (pc *photoController) UploadMenuPhoto(c echo.Context) error {
+	var req uploadMenuPhotoRequest
+
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	file, err := c.FormFile("file")
+
+	if err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	if file.Size > domain.MaxPhotoSize {
+		return c.JSON(errors.NewBadRequestError(domain.ErrPhotoTooLarge))
+	}
+
+	src, err := file.Open()
+
+	if err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	defer src.Close()
+
+	body, err := io.ReadAll(io.LimitReader(src, domain.MaxPhotoSize+1))
+
+	if err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	ctx := c.Request().Context()
+
+	menu, err := pc.pu.UploadMenuPhoto(ctx, &domain.MenuPhotoUpload{
+		MenuID:  req.ID,
+		Body:    body,
+		Publish: req.Publish,
+		Author:  req.Author,
+	})
+
+	if err != nil {
+		if err == domain.ErrUnsupportedPhotoType || err == domain.ErrPhotoTooLarge || err == domain.ErrPhotoPublishDisabled {
+			return c.JSON(errors.NewBadRequestError(err))
+		}
+
+		if domain.IsInvalidPhoto(err) {
+			return c.JSON(errors.NewBadRequestError(err))
+		}
+
+		if domain.IsPhotoPublishFailed(err) {
+			return c.JSON(errors.NewBadGatewayError(err))
+		}
+
+		if err == sql.ErrNoRows {
+			return c.JSON(errors.NewNotFoundError(err))
+		}
+
+		return c.JSON(errors.NewInternalServerError(err))
+	}
+
+	return c.JSON(http.StatusOK, menu)
+}
@@ -0,0 +1,230 @@
+package controller
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ogurilab/school-lunch-api/domain"
+	"github.com/ogurilab/school-lunch-api/domain/mocks"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestUploadMenuPhoto(t *testing.T) {
+	menu := randomMenu(t)
+	photo := []byte("\x89PNG\r\n\x1a\nphoto")
+
+	testCases := []struct {
+		name      string
+		menuID    string
+		field     string
+		body      []byte
+		fields    map[string]string
+		buildStub func(uc *mocks.MockPhotoUsecase)
+		check     func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:   "OK",
+			menuID: menu.ID,
+			field:  "file",
+			body:   photo,
+			buildStub: func(uc *mocks.MockPhotoUsecase) {
+				uc.EXPECT().UploadMenuPhoto(gomock.Any(), gomock.Eq(&domain.MenuPhotoUpload{MenuID: menu.ID, Body: photo})).Times(1).Return(menu, nil)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var res domain.Menu
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+				require.Equal(t, menu.ID, res.ID)
+				require.Equal(t, menu.PhotoUrl, res.PhotoUrl)
+			},
+		},
+		{
+			name:   "OK - Publish",
+			menuID: menu.ID,
+			field:  "file",
+			body:   photo,
+			fields: map[string]string{"publish": "true", "author": "半田市"},
+			buildStub: func(uc *mocks.MockPhotoUsecase) {
+				upload := &domain.MenuPhotoUpload{MenuID: menu.ID, Body: photo, Publish: true, Author: "半田市"}
+				uc.EXPECT().UploadMenuPhoto(gomock.Any(), gomock.Eq(upload)).Times(1).Return(menu, nil)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:   "Bad Request - Invalid Publish",
+			menuID: menu.ID,
+			field:  "file",
+			body:   photo,
+			fields: map[string]string{"publish": "yes please"},
+			buildStub: func(uc *mocks.MockPhotoUsecase) {
+				uc.EXPECT().UploadMenuPhoto(gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:   "Bad Request - Publish Disabled",
+			menuID: menu.ID,
+			field:  "file",
+			body:   photo,
+			fields: map[string]string{"publish": "true"},
+			buildStub: func(uc *mocks.MockPhotoUsecase) {
+				uc.EXPECT().UploadMenuPhoto(gomock.Any(), gomock.Any()).Times(1).Return(nil, domain.ErrPhotoPublishDisabled)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:   "Bad Gateway - Publish Failed",
+			menuID: menu.ID,
+			field:  "file",
+			body:   photo,
+			fields: map[string]string{"publish": "true"},
+			buildStub: func(uc *mocks.MockPhotoUsecase) {
+				uc.EXPECT().UploadMenuPhoto(gomock.Any(), gomock.Any()).Times(1).
+					Return(nil, fmt.Errorf("%w: login failed", domain.ErrPhotoPublishFailed))
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadGateway, recorder.Code)
+			},
+		},
+		{
+			name:   "Bad Request - Invalid ID",
+			menuID: "invalid",
+			field:  "file",
+			body:   photo,
+			buildStub: func(uc *mocks.MockPhotoUsecase) {
+				uc.EXPECT().UploadMenuPhoto(gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:   "Bad Request - No File",
+			menuID: menu.ID,
+			field:  "photo",
+			body:   photo,
+			buildStub: func(uc *mocks.MockPhotoUsecase) {
+				uc.EXPECT().UploadMenuPhoto(gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:   "Bad Request - Too Large",
+			menuID: menu.ID,
+			field:  "file",
+			body:   make([]byte, domain.MaxPhotoSize+1),
+			buildStub: func(uc *mocks.MockPhotoUsecase) {
+				uc.EXPECT().UploadMenuPhoto(gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:   "Bad Request - Unsupported Type",
+			menuID: menu.ID,
+			field:  "file",
+			body:   []byte("not a photo"),
+			buildStub: func(uc *mocks.MockPhotoUsecase) {
+				uc.EXPECT().UploadMenuPhoto(gomock.Any(), gomock.Any()).Times(1).Return(nil, domain.ErrUnsupportedPhotoType)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:   "Bad Request - Invalid Photo",
+			menuID: menu.ID,
+			field:  "file",
+			body:   photo,
+			buildStub: func(uc *mocks.MockPhotoUsecase) {
+				uc.EXPECT().UploadMenuPhoto(gomock.Any(), gomock.Any()).Times(1).
+					Return(nil, fmt.Errorf("%w: unexpected EOF", domain.ErrInvalidPhoto))
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:   "Not Found",
+			menuID: menu.ID,
+			field:  "file",
+			body:   photo,
+			buildStub: func(uc *mocks.MockPhotoUsecase) {
+				uc.EXPECT().UploadMenuPhoto(gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrNoRows)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name:   "Internal Server Error",
+			menuID: menu.ID,
+			field:  "file",
+			body:   photo,
+			buildStub: func(uc *mocks.MockPhotoUsecase) {
+				uc.EXPECT().UploadMenuPhoto(gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrConnDone)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc := mocks.NewMockPhotoUsecase(ctrl)
+			tc.buildStub(uc)
+
+			recorder := httptest.NewRecorder()
+
+			body := new(bytes.Buffer)
+			writer := multipart.NewWriter(body)
+
+			for name, value := range tc.fields {
+				require.NoError(t, writer.WriteField(name, value))
+			}
+
+			part, err := writer.CreateFormFile(tc.field, "photo.png")
+			require.NoError(t, err)
+
+			_, err = part.Write(tc.body)
+			require.NoError(t, err)
+			require.NoError(t, writer.Close())
+
+			url := fmt.Sprintf("/admin/menus/%s/photo", tc.menuID)
+			req, err := http.NewRequest(http.MethodPost, url, body)
+			require.NoError(t, err)
+
+			req.Header.Set(echo.HeaderContentType, writer.FormDataContentType())
+
+			e, env := newSetupAdminTestServer(t)
+			createValidAdminToken(t, env, req)
+
+			e.POST("/admin/menus/:id/photo", NewPhotoController(uc).UploadMenuPhoto)
+			e.ServeHTTP(recorder, req)
+
+			tc.check(t, recorder)
+		})
+	}
+}
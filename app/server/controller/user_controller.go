@@ -0,0 +1,209 @@
+package controller
+
+import (
+	"database/sql"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ogurilab/school-lunch-api/domain"
+	"github.com/ogurilab/school-lunch-api/domain/errors"
+	"github.com/ogurilab/school-lunch-api/util"
+)
+
+type userController struct {
+	uu domain.UserUsecase
+}
+
+func NewUserController(uu domain.UserUsecase) domain.UserController {
+	return &userController{
+		uu: uu,
+	}
+}
+
+type loginRequest struct {
+	Username string `json:"username" validate:"required"`
+	Password string `json:"password" validate:"required"`
+}
+
+func (uc *userController) Login(c echo.Context) error {
+	var req loginRequest
+
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	ctx := c.Request().Context()
+
+	result, err := uc.uu.Login(ctx, req.Username, req.Password)
+
+	if err != nil {
+		if err == domain.ErrInvalidCredentials {
+			return c.JSON(errors.NewUnauthorizedError(err))
+		}
+
+		return c.JSON(errors.NewInternalServerError(err))
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
+// bcrypt は72バイトを超えるパスワードを扱えないため上限を設ける
+type createUserRequest struct {
+	Username string `json:"username" validate:"required,max=50"`
+	Email    string `json:"email" validate:"required,email,max=100"`
+	Password string `json:"password" validate:"required,min=8,max=72"`
+	Role     string `json:"role" validate:"required,oneof=admin municipality guest"`
+	CityCode int32  `json:"city_code" validate:"required_if=Role municipality,omitempty,city_code"`
+}
+
+func (uc *userController) Create(c echo.Context) error {
+	var req createUserRequest
+
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	ctx := c.Request().Context()
+
+	user := domain.NewUser(
+		req.Username,
+		req.Email,
+		req.Role,
+		req.CityCode,
+	)
+
+	created, err := uc.uu.Create(ctx, user, req.Password)
+
+	if err != nil {
+		if util.IsDuplicateEntry(err) {
+			return c.JSON(errors.NewConflictError(err))
+		}
+
+		return c.JSON(errors.NewInternalServerError(err))
+	}
+
+	return c.JSON(http.StatusCreated, created)
+}
+
+type getUserRequest struct {
+	ID int32 `param:"id" validate:"required,gt=0"`
+}
+
+func (uc *userController) GetByID(c echo.Context) error {
+	var req getUserRequest
+
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	ctx := c.Request().Context()
+
+	user, err := uc.uu.GetByID(ctx, req.ID)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return c.JSON(errors.NewNotFoundError(err))
+		}
+
+		return c.JSON(errors.NewInternalServerError(err))
+	}
+
+	return c.JSON(http.StatusOK, user)
+}
+
+type fetchUserRequest struct {
+	Limit  int32 `query:"limit" validate:"gt=0"`
+	Offset int32 `query:"offset" validate:"gte=0"`
+}
+
+func (uc *userController) Fetch(c echo.Context) error {
+	var req fetchUserRequest
+
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	if req.Limit == 0 {
+		req.Limit = domain.DEFAULT_LIMIT
+	}
+
+	if req.Limit > domain.MAX_LIMIT {
+		return c.JSON(errors.NewMaxLimitError())
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	ctx := c.Request().Context()
+
+	users, err := uc.uu.Fetch(ctx, req.Limit, req.Offset)
+
+	if err != nil {
+		return c.JSON(errors.NewInternalServerError(err))
+	}
+
+	return c.JSON(http.StatusOK, users)
+}
+
+func (uc *userController) Delete(c echo.Context) error {
+	var req getUserRequest
+
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	ctx := c.Request().Context()
+
+	if err := uc.uu.Delete(ctx, req.ID); err != nil {
+		if err == sql.ErrNoRows {
+			return c.JSON(errors.NewNotFoundError(err))
+		}
+
+		return c.JSON(errors.NewInternalServerError(err))
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// RevokeSessions はユーザーに発行済みの全てのトークンを無効化する
+// ユーザー自体は残るため、再度ログインすれば新しいトークンを取得できる
+func (uc *userController) RevokeSessions(c echo.Context) error {
+	var req getUserRequest
+
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(errors.NewBadRequestError(err))
+	}
+
+	ctx := c.Request().Context()
+
+	if err := uc.uu.RevokeSessions(ctx, req.ID); err != nil {
+		if err == sql.ErrNoRows {
+			return c.JSON(errors.NewNotFoundError(err))
+		}
+
+		return c.JSON(errors.NewInternalServerError(err))
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,436 @@
+package controller
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/labstack/echo/v4"
+	"github.com/ogurilab/school-lunch-api/domain"
+	"github.com/ogurilab/school-lunch-api/domain/mocks"
+	"github.com/ogurilab/school-lunch-api/util"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestLogin(t *testing.T) {
+	user := randomUser()
+
+	testCases := []struct {
+		name      string
+		body      echo.Map
+		buildStub func(uu *mocks.MockUserUsecase)
+		check     func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			body: echo.Map{
+				"username": user.Username,
+				"password": "password",
+			},
+			buildStub: func(uu *mocks.MockUserUsecase) {
+				result := &domain.LoginResult{
+					AccessToken:          "token",
+					AccessTokenExpiresAt: time.Now().Add(time.Hour),
+					User:                 user,
+				}
+				uu.EXPECT().Login(gomock.Any(), gomock.Eq(user.Username), gomock.Eq("password")).Times(1).Return(result, nil)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var res map[string]any
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+				require.Equal(t, "token", res["access_token"])
+
+				// ハッシュ化したパスワードもレスポンスには含めない
+				require.NotContains(t, recorder.Body.String(), user.HashedPassword)
+			},
+		},
+		{
+			name: "Unauthorized",
+			body: echo.Map{
+				"username": user.Username,
+				"password": "wrong",
+			},
+			buildStub: func(uu *mocks.MockUserUsecase) {
+				uu.EXPECT().Login(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(nil, domain.ErrInvalidCredentials)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name: "Bad Request",
+			body: echo.Map{
+				"username": user.Username,
+			},
+			buildStub: func(uu *mocks.MockUserUsecase) {
+				uu.EXPECT().Login(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Internal Server Error",
+			body: echo.Map{
+				"username": user.Username,
+				"password": "password",
+			},
+			buildStub: func(uu *mocks.MockUserUsecase) {
+				uu.EXPECT().Login(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrConnDone)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uu := mocks.NewMockUserUsecase(ctrl)
+			tc.buildStub(uu)
+
+			recorder := httptest.NewRecorder()
+
+			body, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			req, err := http.NewRequest(http.MethodPost, "/auth/login", bytes.NewReader(body))
+			require.NoError(t, err)
+
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+			e := newSetUpTestServer()
+			e.POST("/auth/login", NewUserController(uu).Login)
+			e.ServeHTTP(recorder, req)
+
+			tc.check(t, recorder)
+		})
+	}
+}
+
+func TestCreateUser(t *testing.T) {
+	user := randomUser()
+
+	testCases := []struct {
+		name      string
+		body      echo.Map
+		buildStub func(uu *mocks.MockUserUsecase)
+		check     func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			body: echo.Map{
+				"username":  user.Username,
+				"email":     user.Email,
+				"password":  "password",
+				"role":      user.Role,
+				"city_code": user.CityCode,
+			},
+			buildStub: func(uu *mocks.MockUserUsecase) {
+				uu.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Eq("password")).Times(1).
+					DoAndReturn(func(_ any, u *domain.User, _ string) (*domain.User, error) {
+						require.Equal(t, user.Username, u.Username)
+						require.Equal(t, user.Email, u.Email)
+						require.Equal(t, user.Role, u.Role)
+						require.Equal(t, user.CityCode, u.CityCode)
+
+						return user, nil
+					})
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusCreated, recorder.Code)
+				require.NotContains(t, recorder.Body.String(), "hashed_password")
+			},
+		},
+		{
+			name: "Bad Request - Municipality Without City Code",
+			body: echo.Map{
+				"username": user.Username,
+				"email":    user.Email,
+				"password": "password",
+				"role":     domain.UserRoleMunicipality,
+			},
+			buildStub: func(uu *mocks.MockUserUsecase) {
+				uu.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Bad Request - Short Password",
+			body: echo.Map{
+				"username": user.Username,
+				"email":    user.Email,
+				"password": "short",
+				"role":     domain.UserRoleAdmin,
+			},
+			buildStub: func(uu *mocks.MockUserUsecase) {
+				uu.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Bad Request - Invalid Role",
+			body: echo.Map{
+				"username": user.Username,
+				"email":    user.Email,
+				"password": "password",
+				"role":     "root",
+			},
+			buildStub: func(uu *mocks.MockUserUsecase) {
+				uu.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Conflict",
+			body: echo.Map{
+				"username": user.Username,
+				"email":    user.Email,
+				"password": "password",
+				"role":     domain.UserRoleAdmin,
+			},
+			buildStub: func(uu *mocks.MockUserUsecase) {
+				uu.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(nil, &mysql.MySQLError{Number: 1062})
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uu := mocks.NewMockUserUsecase(ctrl)
+			tc.buildStub(uu)
+
+			recorder := httptest.NewRecorder()
+
+			body, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			req, err := http.NewRequest(http.MethodPost, "/admin/users", bytes.NewReader(body))
+			require.NoError(t, err)
+
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+			e, env := newSetupAdminTestServer(t)
+			createValidAdminToken(t, env, req)
+
+			e.POST("/admin/users", NewUserController(uu).Create)
+			e.ServeHTTP(recorder, req)
+
+			tc.check(t, recorder)
+		})
+	}
+}
+
+func TestGetUser(t *testing.T) {
+	user := randomUser()
+
+	testCases := []struct {
+		name      string
+		id        string
+		buildStub func(uu *mocks.MockUserUsecase)
+		check     func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			id:   fmt.Sprint(user.ID),
+			buildStub: func(uu *mocks.MockUserUsecase) {
+				uu.EXPECT().GetByID(gomock.Any(), gomock.Eq(user.ID)).Times(1).Return(user, nil)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var res domain.User
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+				require.Equal(t, user.ID, res.ID)
+				require.Equal(t, user.Username, res.Username)
+				require.Empty(t, res.HashedPassword)
+			},
+		},
+		{
+			name: "Not Found",
+			id:   fmt.Sprint(user.ID),
+			buildStub: func(uu *mocks.MockUserUsecase) {
+				uu.EXPECT().GetByID(gomock.Any(), gomock.Eq(user.ID)).Times(1).Return(nil, sql.ErrNoRows)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name: "Bad Request",
+			id:   "0",
+			buildStub: func(uu *mocks.MockUserUsecase) {
+				uu.EXPECT().GetByID(gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uu := mocks.NewMockUserUsecase(ctrl)
+			tc.buildStub(uu)
+
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/admin/users/%s", tc.id)
+			req, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			e, env := newSetupAdminTestServer(t)
+			createValidAdminToken(t, env, req)
+
+			e.GET("/admin/users/:id", NewUserController(uu).GetByID)
+			e.ServeHTTP(recorder, req)
+
+			tc.check(t, recorder)
+		})
+	}
+}
+
+func TestDeleteUser(t *testing.T) {
+	user := randomUser()
+
+	testCases := []struct {
+		name      string
+		buildStub func(uu *mocks.MockUserUsecase)
+		check     func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			buildStub: func(uu *mocks.MockUserUsecase) {
+				uu.EXPECT().Delete(gomock.Any(), gomock.Eq(user.ID)).Times(1).Return(nil)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNoContent, recorder.Code)
+			},
+		},
+		{
+			name: "Not Found",
+			buildStub: func(uu *mocks.MockUserUsecase) {
+				uu.EXPECT().Delete(gomock.Any(), gomock.Eq(user.ID)).Times(1).Return(sql.ErrNoRows)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uu := mocks.NewMockUserUsecase(ctrl)
+			tc.buildStub(uu)
+
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/admin/users/%d", user.ID)
+			req, err := http.NewRequest(http.MethodDelete, url, nil)
+			require.NoError(t, err)
+
+			e, env := newSetupAdminTestServer(t)
+			createValidAdminToken(t, env, req)
+
+			e.DELETE("/admin/users/:id", NewUserController(uu).Delete)
+			e.ServeHTTP(recorder, req)
+
+			tc.check(t, recorder)
+		})
+	}
+}
+
+func TestRevokeUserSessions(t *testing.T) {
+	user := randomUser()
+
+	testCases := []struct {
+		name      string
+		buildStub func(uu *mocks.MockUserUsecase)
+		check     func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			buildStub: func(uu *mocks.MockUserUsecase) {
+				uu.EXPECT().RevokeSessions(gomock.Any(), gomock.Eq(user.ID)).Times(1).Return(nil)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNoContent, recorder.Code)
+			},
+		},
+		{
+			name: "Not Found",
+			buildStub: func(uu *mocks.MockUserUsecase) {
+				uu.EXPECT().RevokeSessions(gomock.Any(), gomock.Eq(user.ID)).Times(1).Return(sql.ErrNoRows)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uu := mocks.NewMockUserUsecase(ctrl)
+			tc.buildStub(uu)
+
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/admin/users/%d/revoke", user.ID)
+			req, err := http.NewRequest(http.MethodPost, url, nil)
+			require.NoError(t, err)
+
+			e, env := newSetupAdminTestServer(t)
+			createValidAdminToken(t, env, req)
+
+			e.POST("/admin/users/:id/revoke", NewUserController(uu).RevokeSessions)
+			e.ServeHTTP(recorder, req)
+
+			tc.check(t, recorder)
+		})
+	}
+}
+
+func randomUser() *domain.User {
+	return domain.ReNewUser(
+		util.RandomInt32()+1,
+		util.RandomString(10),
+		"$2a$10$"+util.RandomString(53),
+		util.RandomString(10)+"@example.com",
+		domain.UserRoleMunicipality,
+		util.RandomCityCode(),
+		time.Now().UTC().Truncate(time.Second),
+	)
+}
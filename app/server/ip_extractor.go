@@ -0,0 +1,37 @@
+package server
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+// newIPExtractor は c.RealIP で使う接続元の IP アドレスの取得方法を返す
+// trustedProxies が空の場合は X-Forwarded-For などのヘッダーを使わず、接続元の IP アドレスを使う
+// CIDR をカンマ区切りで指定した場合は、その範囲のプロキシが付けた X-Forwarded-For だけを信頼する
+func newIPExtractor(trustedProxies string) (echo.IPExtractor, error) {
+	if strings.TrimSpace(trustedProxies) == "" {
+		return echo.ExtractIPDirect(), nil
+	}
+
+	// echo の既定ではループバックやプライベートネットワークも信頼するため、指定した範囲だけに限定する
+	options := []echo.TrustOption{
+		echo.TrustLoopback(false),
+		echo.TrustLinkLocal(false),
+		echo.TrustPrivateNet(false),
+	}
+
+	for _, cidr := range strings.Split(trustedProxies, ",") {
+		_, ipNet, err := net.ParseCIDR(strings.TrimSpace(cidr))
+
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy %q: %w", cidr, err)
+		}
+
+		options = append(options, echo.TrustIPRange(ipNet))
+	}
+
+	return echo.ExtractIPFromXFFHeader(options...), nil
+}
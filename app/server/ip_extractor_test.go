@@ -0,0 +1,40 @@
+package server
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewIPExtractor(t *testing.T) {
+	newRequest := func(remoteAddr string, forwardedFor string) *http.Request {
+		req, err := http.NewRequest(http.MethodGet, "/", nil)
+		require.NoError(t, err)
+
+		req.RemoteAddr = remoteAddr
+		req.Header.Set("X-Forwarded-For", forwardedFor)
+		req.Header.Set("X-Real-IP", forwardedFor)
+
+		return req
+	}
+
+	// プロキシを指定しない場合はヘッダーを無視する
+	extract, err := newIPExtractor("")
+	require.NoError(t, err)
+	require.Equal(t, "203.0.113.1", extract(newRequest("203.0.113.1:1234", "198.51.100.7")))
+
+	extract, err = newIPExtractor("10.0.0.0/8, 192.0.2.0/24")
+	require.NoError(t, err)
+
+	// 信頼するプロキシからのリクエストはヘッダーの接続元を使う
+	require.Equal(t, "198.51.100.7", extract(newRequest("10.0.0.2:1234", "198.51.100.7")))
+	require.Equal(t, "198.51.100.7", extract(newRequest("192.0.2.5:1234", "198.51.100.7")))
+
+	// それ以外からのリクエストはヘッダーを偽装できない
+	require.Equal(t, "203.0.113.1", extract(newRequest("203.0.113.1:1234", "198.51.100.7")))
+	require.Equal(t, "127.0.0.1", extract(newRequest("127.0.0.1:1234", "198.51.100.7")))
+
+	_, err = newIPExtractor("10.0.0.0/8,invalid")
+	require.Error(t, err)
+}
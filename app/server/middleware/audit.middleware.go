@@ -0,0 +1,223 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ogurilab/school-lunch-api/domain"
+	"github.com/ogurilab/school-lunch-api/domain/errors"
+	"github.com/ogurilab/school-lunch-api/util"
+	"github.com/rs/zerolog/log"
+)
+
+// maxRequestIDLength は監査ログに保存できるリクエスト ID の長さ
+const maxRequestIDLength = 64
+
+// AuditTarget は監査ログに記録する操作の内容
+type AuditTarget struct {
+	Entity string
+	Action string
+	// IDParam は対象のIDを表すパスパラメータ
+	// 空の場合は作成した対象のIDをレスポンスの IDField から取得する
+	IDParam string
+	IDField string
+	// Snapshot は対象の現在の状態を返す
+	// nil の場合は変更後の状態としてレスポンスを保存する
+	Snapshot func(ctx context.Context, id string) (any, error)
+}
+
+// Audit は成功した更新操作を監査ログに記録する
+// 記録に失敗してもリクエストは失敗させず、ログに出力する
+// 操作は完了しているため、クライアントが切断しても記録は続ける
+func Audit(lu domain.AuditLogUsecase, target AuditTarget) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			payload, err := authPayload(c)
+
+			if err != nil {
+				return c.JSON(errors.NewUnauthorizedError(err))
+			}
+
+			ctx := c.Request().Context()
+
+			var id string
+
+			if target.IDParam != "" {
+				id = c.Param(target.IDParam)
+			}
+
+			var before json.RawMessage
+
+			if id != "" && target.Snapshot != nil {
+				before = snapshot(ctx, target, id)
+			}
+
+			recorder := &bodyRecorder{ResponseWriter: c.Response().Writer}
+			c.Response().Writer = recorder
+
+			if err := next(c); err != nil {
+				return err
+			}
+
+			status := c.Response().Status
+
+			if status < http.StatusOK || status >= http.StatusMultipleChoices {
+				return nil
+			}
+
+			ctx = context.WithoutCancel(ctx)
+
+			body := recorder.body.Bytes()
+
+			if id == "" {
+				id = idFromBody(body, target.IDField)
+			}
+
+			var after json.RawMessage
+
+			if target.Snapshot != nil {
+				if id != "" && target.Action != domain.AuditActionDelete {
+					after = snapshot(ctx, target, id)
+				}
+			} else if len(body) > 0 && json.Valid(body) {
+				after = append(json.RawMessage{}, body...)
+			}
+
+			entry := &domain.AuditLog{
+				ActorID:       payload.UserID,
+				ActorUsername: payload.Username,
+				Action:        target.Action,
+				EntityType:    target.Entity,
+				EntityID:      id,
+				CityCode:      auditCityCode(c, payload, after, before),
+				Before:        before,
+				After:         after,
+				RequestID:     requestID(c),
+			}
+
+			if err := lu.Record(ctx, entry); err != nil {
+				log.Error().Err(err).
+					Str("action", entry.Action).
+					Str("entity_type", entry.EntityType).
+					Str("entity_id", entry.EntityID).
+					Msg("failed to record audit log")
+			}
+
+			return nil
+		}
+	}
+}
+
+type bodyRecorder struct {
+	http.ResponseWriter
+	body bytes.Buffer
+}
+
+func (r *bodyRecorder) Write(b []byte) (int, error) {
+	r.body.Write(b)
+
+	return r.ResponseWriter.Write(b)
+}
+
+func snapshot(ctx context.Context, target AuditTarget, id string) json.RawMessage {
+	v, err := target.Snapshot(ctx, id)
+
+	if err != nil {
+		return nil
+	}
+
+	b, err := json.Marshal(v)
+
+	if err != nil {
+		return nil
+	}
+
+	return b
+}
+
+// idFromBody はレスポンスの JSON から作成した対象のIDを取り出す
+func idFromBody(body []byte, field string) string {
+	if field == "" {
+		field = "id"
+	}
+
+	var v map[string]json.RawMessage
+
+	if err := json.Unmarshal(body, &v); err != nil {
+		return ""
+	}
+
+	raw, ok := v[field]
+
+	if !ok {
+		return ""
+	}
+
+	var s string
+
+	if err := json.Unmarshal(raw, &s); err == nil {
+		return s
+	}
+
+	return string(raw)
+}
+
+// auditCityCode は対象の市区町村コードを、変更後・変更前の状態、パスパラメータ、操作したユーザーの順に探す
+func auditCityCode(c echo.Context, payload *domain.TokenPayload, states ...json.RawMessage) *int32 {
+	for _, state := range states {
+		var v struct {
+			CityCode int32 `json:"city_code"`
+		}
+
+		if err := json.Unmarshal(state, &v); err == nil && v.CityCode > 0 {
+			return &v.CityCode
+		}
+	}
+
+	if cityCode, err := util.ParseCityCode(c.Param("code")); err == nil {
+		return &cityCode
+	}
+
+	if payload.Role == domain.UserRoleMunicipality {
+		cityCode := payload.CityCode
+		return &cityCode
+	}
+
+	return nil
+}
+
+// requestID はレスポンスまたはリクエストの X-Request-ID を返す
+// X-Request-ID はクライアントが指定できるため、保存できない値の場合はサーバーで生成した ID を使う
+func requestID(c echo.Context) string {
+	for _, id := range []string{
+		c.Response().Header().Get(echo.HeaderXRequestID),
+		c.Request().Header.Get(echo.HeaderXRequestID),
+	} {
+		if isValidRequestID(id) {
+			return id
+		}
+	}
+
+	return util.NewUlid()
+}
+
+// isValidRequestID は id が maxRequestIDLength 以下の英数字と記号 (- _ . :) のみからなるかを返す
+func isValidRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+
+	for _, r := range id {
+		switch {
+		case 'a' <= r && r <= 'z', 'A' <= r && r <= 'Z', '0' <= r && r <= '9':
+		case r == '-', r == '_', r == '.', r == ':':
+		default:
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,345 @@
+package middleware
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ogurilab/school-lunch-api/domain"
+	"github.com/ogurilab/school-lunch-api/domain/mocks"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+type auditTestEntity struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	CityCode int32  `json:"city_code"`
+}
+
+func TestAuditMiddleware(t *testing.T) {
+	admin := &domain.TokenPayload{UserID: 1, Username: "admin", Role: domain.UserRoleAdmin}
+	municipality := &domain.TokenPayload{UserID: 2, Username: "handa", Role: domain.UserRoleMunicipality, CityCode: 23205}
+
+	testCases := []struct {
+		name      string
+		payload   *domain.TokenPayload
+		target    AuditTarget
+		method    string
+		path      string
+		route     string
+		handler   func(state map[string]*auditTestEntity) echo.HandlerFunc
+		buildStub func(lu *mocks.MockAuditLogUsecase)
+		check     func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:    "Update",
+			payload: admin,
+			target: AuditTarget{
+				Entity:  domain.AuditEntityMenu,
+				Action:  domain.AuditActionUpdate,
+				IDParam: "id",
+			},
+			method: http.MethodPut,
+			path:   "/menus/menu1",
+			route:  "/menus/:id",
+			handler: func(state map[string]*auditTestEntity) echo.HandlerFunc {
+				return func(c echo.Context) error {
+					state["menu1"].Name = "after"
+					return c.JSON(http.StatusOK, state["menu1"])
+				}
+			},
+			buildStub: func(lu *mocks.MockAuditLogUsecase) {
+				lu.EXPECT().Record(gomock.Any(), gomock.Any()).Times(1).
+					DoAndReturn(func(_ context.Context, log *domain.AuditLog) error {
+						require.Equal(t, int32(1), log.ActorID)
+						require.Equal(t, "admin", log.ActorUsername)
+						require.Equal(t, domain.AuditEntityMenu, log.EntityType)
+						require.Equal(t, domain.AuditActionUpdate, log.Action)
+						require.Equal(t, "menu1", log.EntityID)
+						require.Equal(t, "req-1", log.RequestID)
+						require.NotNil(t, log.CityCode)
+						require.Equal(t, int32(23205), *log.CityCode)
+
+						requireName(t, "before", log.Before)
+						requireName(t, "after", log.After)
+
+						return nil
+					})
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:    "Create",
+			payload: municipality,
+			target: AuditTarget{
+				Entity: domain.AuditEntityMenu,
+				Action: domain.AuditActionCreate,
+			},
+			method: http.MethodPost,
+			path:   "/menus",
+			route:  "/menus",
+			handler: func(state map[string]*auditTestEntity) echo.HandlerFunc {
+				return func(c echo.Context) error {
+					state["menu2"] = &auditTestEntity{ID: "menu2", Name: "created", CityCode: 23205}
+					return c.JSON(http.StatusCreated, state["menu2"])
+				}
+			},
+			buildStub: func(lu *mocks.MockAuditLogUsecase) {
+				lu.EXPECT().Record(gomock.Any(), gomock.Any()).Times(1).
+					DoAndReturn(func(_ context.Context, log *domain.AuditLog) error {
+						require.Equal(t, "menu2", log.EntityID)
+						require.Nil(t, log.Before)
+						requireName(t, "created", log.After)
+
+						return nil
+					})
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusCreated, recorder.Code)
+			},
+		},
+		{
+			name:    "Delete",
+			payload: municipality,
+			target: AuditTarget{
+				Entity:  domain.AuditEntityMenu,
+				Action:  domain.AuditActionDelete,
+				IDParam: "id",
+			},
+			method: http.MethodDelete,
+			path:   "/menus/menu1",
+			route:  "/menus/:id",
+			handler: func(state map[string]*auditTestEntity) echo.HandlerFunc {
+				return func(c echo.Context) error {
+					delete(state, "menu1")
+					return c.NoContent(http.StatusNoContent)
+				}
+			},
+			buildStub: func(lu *mocks.MockAuditLogUsecase) {
+				lu.EXPECT().Record(gomock.Any(), gomock.Any()).Times(1).
+					DoAndReturn(func(_ context.Context, log *domain.AuditLog) error {
+						requireName(t, "before", log.Before)
+						require.Nil(t, log.After)
+						require.Equal(t, int32(23205), *log.CityCode)
+
+						return nil
+					})
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNoContent, recorder.Code)
+			},
+		},
+		{
+			name:    "Failed Request",
+			payload: admin,
+			target: AuditTarget{
+				Entity:  domain.AuditEntityMenu,
+				Action:  domain.AuditActionUpdate,
+				IDParam: "id",
+			},
+			method: http.MethodPut,
+			path:   "/menus/menu1",
+			route:  "/menus/:id",
+			handler: func(state map[string]*auditTestEntity) echo.HandlerFunc {
+				return func(c echo.Context) error {
+					return c.JSON(http.StatusBadRequest, nil)
+				}
+			},
+			buildStub: func(lu *mocks.MockAuditLogUsecase) {
+				lu.EXPECT().Record(gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:    "Record Error",
+			payload: admin,
+			target: AuditTarget{
+				Entity:  domain.AuditEntityMenu,
+				Action:  domain.AuditActionUpdate,
+				IDParam: "id",
+			},
+			method: http.MethodPut,
+			path:   "/menus/menu1",
+			route:  "/menus/:id",
+			handler: func(state map[string]*auditTestEntity) echo.HandlerFunc {
+				return func(c echo.Context) error {
+					return c.JSON(http.StatusOK, state["menu1"])
+				}
+			},
+			buildStub: func(lu *mocks.MockAuditLogUsecase) {
+				lu.EXPECT().Record(gomock.Any(), gomock.Any()).Times(1).Return(sql.ErrConnDone)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				// 記録に失敗してもリクエストは成功させる
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			lu := mocks.NewMockAuditLogUsecase(ctrl)
+			tc.buildStub(lu)
+
+			state := map[string]*auditTestEntity{
+				"menu1": {ID: "menu1", Name: "before", CityCode: 23205},
+			}
+
+			target := tc.target
+			target.Snapshot = func(ctx context.Context, id string) (any, error) {
+				entity, ok := state[id]
+
+				if !ok {
+					return nil, sql.ErrNoRows
+				}
+
+				copied := *entity
+
+				return &copied, nil
+			}
+
+			e := echo.New()
+			e.Use(setPayload(tc.payload))
+			e.Add(tc.method, tc.route, tc.handler(state), Audit(lu, target))
+
+			recorder := httptest.NewRecorder()
+
+			req, err := http.NewRequest(tc.method, tc.path, nil)
+			require.NoError(t, err)
+
+			req.Header.Set(echo.HeaderXRequestID, "req-1")
+
+			e.ServeHTTP(recorder, req)
+
+			tc.check(t, recorder)
+		})
+	}
+}
+
+func TestAuditMiddlewareWithoutSnapshot(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	lu := mocks.NewMockAuditLogUsecase(ctrl)
+	lu.EXPECT().Record(gomock.Any(), gomock.Any()).Times(1).
+		DoAndReturn(func(_ context.Context, log *domain.AuditLog) error {
+			require.Equal(t, "3", log.EntityID)
+			require.Nil(t, log.CityCode)
+			require.JSONEq(t, `{"id": 3, "name": "えび"}`, string(log.After))
+
+			return nil
+		})
+
+	e := echo.New()
+	e.Use(setPayload(&domain.TokenPayload{UserID: 1, Username: "admin", Role: domain.UserRoleAdmin}))
+	e.POST("/allergens", func(c echo.Context) error {
+		return c.JSON(http.StatusCreated, echo.Map{"id": 3, "name": "えび"})
+	}, Audit(lu, AuditTarget{Entity: domain.AuditEntityAllergen, Action: domain.AuditActionCreate}))
+
+	recorder := httptest.NewRecorder()
+
+	req, err := http.NewRequest(http.MethodPost, "/allergens", nil)
+	require.NoError(t, err)
+
+	e.ServeHTTP(recorder, req)
+
+	require.Equal(t, http.StatusCreated, recorder.Code)
+	require.JSONEq(t, `{"id": 3, "name": "えび"}`, recorder.Body.String())
+}
+
+func TestAuditMiddlewareRequestID(t *testing.T) {
+	testCases := []struct {
+		name  string
+		id    string
+		check func(t *testing.T, id string)
+	}{
+		{
+			name: "OK",
+			id:   "01HMD4X0Q5-req.1:a_b",
+			check: func(t *testing.T, id string) {
+				require.Equal(t, "01HMD4X0Q5-req.1:a_b", id)
+			},
+		},
+		{
+			name: "Too Long",
+			id:   strings.Repeat("a", maxRequestIDLength+1),
+			check: func(t *testing.T, id string) {
+				require.Len(t, id, 26)
+			},
+		},
+		{
+			name: "Invalid Character",
+			id:   "req 1; drop",
+			check: func(t *testing.T, id string) {
+				require.Len(t, id, 26)
+			},
+		},
+		{
+			name: "Empty",
+			check: func(t *testing.T, id string) {
+				require.Len(t, id, 26)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			lu := mocks.NewMockAuditLogUsecase(ctrl)
+			lu.EXPECT().Record(gomock.Any(), gomock.Any()).Times(1).
+				DoAndReturn(func(ctx context.Context, log *domain.AuditLog) error {
+					// クライアントが切断しても記録する
+					require.NoError(t, ctx.Err())
+					tc.check(t, log.RequestID)
+
+					return nil
+				})
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			e := echo.New()
+			e.Use(setPayload(&domain.TokenPayload{UserID: 1, Username: "admin", Role: domain.UserRoleAdmin}))
+			e.POST("/allergens", func(c echo.Context) error {
+				cancel()
+
+				return c.JSON(http.StatusCreated, echo.Map{"id": 3})
+			}, Audit(lu, AuditTarget{Entity: domain.AuditEntityAllergen, Action: domain.AuditActionCreate}))
+
+			recorder := httptest.NewRecorder()
+
+			req, err := http.NewRequestWithContext(ctx, http.MethodPost, "/allergens", nil)
+			require.NoError(t, err)
+
+			if tc.id != "" {
+				req.Header.Set(echo.HeaderXRequestID, tc.id)
+			}
+
+			e.ServeHTTP(recorder, req)
+
+			require.Equal(t, http.StatusCreated, recorder.Code)
+		})
+	}
+}
+
+func requireName(t *testing.T, expected string, state json.RawMessage) {
+	var v auditTestEntity
+
+	require.NoError(t, json.Unmarshal(state, &v))
+	require.Equal(t, expected, v.Name)
+}
@@ -0,0 +1,188 @@
+package middleware
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ogurilab/school-lunch-api/domain"
+	"github.com/ogurilab/school-lunch-api/domain/errors"
+	"github.com/ogurilab/school-lunch-api/util"
+)
+
+// CityCodeResolver はリクエストから対象の市区町村コードを取り出す
+// 市区町村コードが含まれていない場合は false を返す
+type CityCodeResolver func(c echo.Context) (int32, bool)
+
+// ReadOnly は指定したロールのユーザーに参照系のメソッドのみを許可する
+func ReadOnly(roles ...string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			payload, err := authPayload(c)
+
+			if err != nil {
+				return c.JSON(errors.NewUnauthorizedError(err))
+			}
+
+			method := c.Request().Method
+
+			if slices.Contains(roles, payload.Role) && method != http.MethodGet && method != http.MethodHead {
+				return c.JSON(errors.NewForbiddenError(domain.ErrForbidden))
+			}
+
+			return next(c)
+		}
+	}
+}
+
+// RequireRoles は指定したロールのユーザーのみを許可する
+func RequireRoles(roles ...string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			payload, err := authPayload(c)
+
+			if err != nil {
+				return c.JSON(errors.NewUnauthorizedError(err))
+			}
+
+			if !slices.Contains(roles, payload.Role) {
+				return c.JSON(errors.NewForbiddenError(domain.ErrForbidden))
+			}
+
+			return next(c)
+		}
+	}
+}
+
+// AuthorizeCity は resolver で取り出した市区町村のデータを更新できるかを判定する
+// 市区町村コードが取り出せない場合の検証はコントローラーに任せる
+func AuthorizeCity(au domain.AuthorizationUsecase, resolver CityCodeResolver) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			payload, err := authPayload(c)
+
+			if err != nil {
+				return c.JSON(errors.NewUnauthorizedError(err))
+			}
+
+			cityCode, ok := resolver(c)
+
+			if !ok {
+				return next(c)
+			}
+
+			if err := au.AuthorizeCity(c.Request().Context(), payload, cityCode); err != nil {
+				return authorizationError(c, err)
+			}
+
+			return next(c)
+		}
+	}
+}
+
+// AuthorizeMenu はパスパラメータで指定された献立を更新できるかを判定する
+func AuthorizeMenu(au domain.AuthorizationUsecase, param string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			payload, err := authPayload(c)
+
+			if err != nil {
+				return c.JSON(errors.NewUnauthorizedError(err))
+			}
+
+			if err := au.AuthorizeMenu(c.Request().Context(), payload, c.Param(param)); err != nil {
+				return authorizationError(c, err)
+			}
+
+			return next(c)
+		}
+	}
+}
+
+// AuthorizeDish はパスパラメータで指定された料理を更新できるかを判定する
+func AuthorizeDish(au domain.AuthorizationUsecase, param string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			payload, err := authPayload(c)
+
+			if err != nil {
+				return c.JSON(errors.NewUnauthorizedError(err))
+			}
+
+			if err := au.AuthorizeDish(c.Request().Context(), payload, c.Param(param)); err != nil {
+				return authorizationError(c, err)
+			}
+
+			return next(c)
+		}
+	}
+}
+
+// CityCodeFromParam はパスパラメータから市区町村コードを取り出す
+func CityCodeFromParam(param string) CityCodeResolver {
+	return func(c echo.Context) (int32, bool) {
+		cityCode, err := util.ParseCityCode(c.Param(param))
+
+		if err != nil {
+			return 0, false
+		}
+
+		return cityCode, true
+	}
+}
+
+// CityCodeFromBody は JSON のリクエストボディの city_code を取り出す
+// ボディはコントローラーで再度読み込めるように元に戻す
+func CityCodeFromBody() CityCodeResolver {
+	return func(c echo.Context) (int32, bool) {
+		req := c.Request()
+
+		if req.Body == nil {
+			return 0, false
+		}
+
+		body, err := io.ReadAll(req.Body)
+
+		if err != nil {
+			return 0, false
+		}
+
+		req.Body = io.NopCloser(bytes.NewReader(body))
+
+		var v struct {
+			CityCode *int32 `json:"city_code"`
+		}
+
+		if err := json.Unmarshal(body, &v); err != nil || v.CityCode == nil {
+			return 0, false
+		}
+
+		return *v.CityCode, true
+	}
+}
+
+func authPayload(c echo.Context) (*domain.TokenPayload, error) {
+	payload, ok := c.Get(domain.AuthPayloadKey).(*domain.TokenPayload)
+
+	if !ok || payload == nil {
+		return nil, fmt.Errorf("missing auth payload")
+	}
+
+	return payload, nil
+}
+
+func authorizationError(c echo.Context, err error) error {
+	switch err {
+	case domain.ErrForbidden:
+		return c.JSON(errors.NewForbiddenError(err))
+	case sql.ErrNoRows:
+		return c.JSON(errors.NewNotFoundError(err))
+	default:
+		return c.JSON(errors.NewInternalServerError(err))
+	}
+}
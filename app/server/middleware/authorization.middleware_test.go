@@ -0,0 +1,334 @@
+package middleware
+
+import (
+	"database/sql"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ogurilab/school-lunch-api/domain"
+	"github.com/ogurilab/school-lunch-api/domain/mocks"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestReadOnlyMiddleware(t *testing.T) {
+	testCases := []struct {
+		name   string
+		role   string
+		method string
+		status int
+	}{
+		{
+			name:   "Guest GET",
+			role:   domain.UserRoleGuest,
+			method: http.MethodGet,
+			status: http.StatusOK,
+		},
+		{
+			name:   "Guest POST",
+			role:   domain.UserRoleGuest,
+			method: http.MethodPost,
+			status: http.StatusForbidden,
+		},
+		{
+			name:   "Guest DELETE",
+			role:   domain.UserRoleGuest,
+			method: http.MethodDelete,
+			status: http.StatusForbidden,
+		},
+		{
+			name:   "Admin POST",
+			role:   domain.UserRoleAdmin,
+			method: http.MethodPost,
+			status: http.StatusOK,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			e := echo.New()
+			e.Use(setPayload(&domain.TokenPayload{Role: tc.role}), ReadOnly(domain.UserRoleGuest))
+
+			e.Any("/test", func(c echo.Context) error {
+				return c.NoContent(http.StatusOK)
+			})
+
+			recorder := httptest.NewRecorder()
+
+			req, err := http.NewRequest(tc.method, "/test", nil)
+			require.NoError(t, err)
+
+			e.ServeHTTP(recorder, req)
+
+			require.Equal(t, tc.status, recorder.Code)
+		})
+	}
+}
+
+func TestRequireRolesMiddleware(t *testing.T) {
+	testCases := []struct {
+		name    string
+		payload *domain.TokenPayload
+		status  int
+	}{
+		{
+			name:    "Admin",
+			payload: &domain.TokenPayload{Role: domain.UserRoleAdmin},
+			status:  http.StatusOK,
+		},
+		{
+			name:    "Municipality",
+			payload: &domain.TokenPayload{Role: domain.UserRoleMunicipality},
+			status:  http.StatusForbidden,
+		},
+		{
+			name:    "No Payload",
+			payload: nil,
+			status:  http.StatusUnauthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			e := echo.New()
+
+			if tc.payload != nil {
+				e.Use(setPayload(tc.payload))
+			}
+
+			e.POST("/test", func(c echo.Context) error {
+				return c.NoContent(http.StatusOK)
+			}, RequireRoles(domain.UserRoleAdmin))
+
+			recorder := httptest.NewRecorder()
+
+			req, err := http.NewRequest(http.MethodPost, "/test", nil)
+			require.NoError(t, err)
+
+			e.ServeHTTP(recorder, req)
+
+			require.Equal(t, tc.status, recorder.Code)
+		})
+	}
+}
+
+func TestAuthorizeCityMiddleware(t *testing.T) {
+	payload := &domain.TokenPayload{Role: domain.UserRoleMunicipality, CityCode: 23205}
+
+	testCases := []struct {
+		name      string
+		body      string
+		buildStub func(au *mocks.MockAuthorizationUsecase)
+		check     func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			body: `{"city_code": 23205}`,
+			buildStub: func(au *mocks.MockAuthorizationUsecase) {
+				au.EXPECT().AuthorizeCity(gomock.Any(), gomock.Eq(payload), gomock.Eq(int32(23205))).Times(1).Return(nil)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Equal(t, `{"city_code": 23205}`, recorder.Body.String())
+			},
+		},
+		{
+			// 数値の6桁のコードは5桁に読み替えず、別の市区町村として扱う
+			name: "Six Digit Code",
+			body: `{"city_code": 232050}`,
+			buildStub: func(au *mocks.MockAuthorizationUsecase) {
+				au.EXPECT().AuthorizeCity(gomock.Any(), gomock.Eq(payload), gomock.Eq(int32(232050))).Times(1).Return(domain.ErrForbidden)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name: "Forbidden",
+			body: `{"city_code": 13101}`,
+			buildStub: func(au *mocks.MockAuthorizationUsecase) {
+				au.EXPECT().AuthorizeCity(gomock.Any(), gomock.Eq(payload), gomock.Eq(int32(13101))).Times(1).Return(domain.ErrForbidden)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name: "No City Code",
+			body: `{"name": "test"}`,
+			buildStub: func(au *mocks.MockAuthorizationUsecase) {
+				au.EXPECT().AuthorizeCity(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Equal(t, `{"name": "test"}`, recorder.Body.String())
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			au := mocks.NewMockAuthorizationUsecase(ctrl)
+			tc.buildStub(au)
+
+			e := echo.New()
+			e.Use(setPayload(payload))
+
+			e.POST("/test", func(c echo.Context) error {
+				body, err := io.ReadAll(c.Request().Body)
+
+				if err != nil {
+					return err
+				}
+
+				return c.String(http.StatusOK, string(body))
+			}, AuthorizeCity(au, CityCodeFromBody()))
+
+			recorder := httptest.NewRecorder()
+
+			req, err := http.NewRequest(http.MethodPost, "/test", strings.NewReader(tc.body))
+			require.NoError(t, err)
+
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+			e.ServeHTTP(recorder, req)
+
+			tc.check(t, recorder)
+		})
+	}
+}
+
+func TestAuthorizeMenuMiddleware(t *testing.T) {
+	payload := &domain.TokenPayload{Role: domain.UserRoleMunicipality, CityCode: 23205}
+	menuID := "menu"
+
+	testCases := []struct {
+		name      string
+		buildStub func(au *mocks.MockAuthorizationUsecase)
+		status    int
+	}{
+		{
+			name: "OK",
+			buildStub: func(au *mocks.MockAuthorizationUsecase) {
+				au.EXPECT().AuthorizeMenu(gomock.Any(), gomock.Eq(payload), gomock.Eq(menuID)).Times(1).Return(nil)
+			},
+			status: http.StatusOK,
+		},
+		{
+			name: "Forbidden",
+			buildStub: func(au *mocks.MockAuthorizationUsecase) {
+				au.EXPECT().AuthorizeMenu(gomock.Any(), gomock.Eq(payload), gomock.Eq(menuID)).Times(1).Return(domain.ErrForbidden)
+			},
+			status: http.StatusForbidden,
+		},
+		{
+			name: "Not Found",
+			buildStub: func(au *mocks.MockAuthorizationUsecase) {
+				au.EXPECT().AuthorizeMenu(gomock.Any(), gomock.Eq(payload), gomock.Eq(menuID)).Times(1).Return(sql.ErrNoRows)
+			},
+			status: http.StatusNotFound,
+		},
+		{
+			name: "Internal Server Error",
+			buildStub: func(au *mocks.MockAuthorizationUsecase) {
+				au.EXPECT().AuthorizeMenu(gomock.Any(), gomock.Eq(payload), gomock.Eq(menuID)).Times(1).Return(sql.ErrConnDone)
+			},
+			status: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			au := mocks.NewMockAuthorizationUsecase(ctrl)
+			tc.buildStub(au)
+
+			e := echo.New()
+			e.Use(setPayload(payload))
+
+			e.DELETE("/menus/:id", func(c echo.Context) error {
+				return c.NoContent(http.StatusOK)
+			}, AuthorizeMenu(au, "id"))
+
+			recorder := httptest.NewRecorder()
+
+			req, err := http.NewRequest(http.MethodDelete, "/menus/"+menuID, nil)
+			require.NoError(t, err)
+
+			e.ServeHTTP(recorder, req)
+
+			require.Equal(t, tc.status, recorder.Code)
+		})
+	}
+}
+
+func TestAuthorizeDishMiddleware(t *testing.T) {
+	payload := &domain.TokenPayload{Role: domain.UserRoleMunicipality, CityCode: 23205}
+	dishID := "dish"
+
+	testCases := []struct {
+		name      string
+		buildStub func(au *mocks.MockAuthorizationUsecase)
+		status    int
+	}{
+		{
+			name: "OK",
+			buildStub: func(au *mocks.MockAuthorizationUsecase) {
+				au.EXPECT().AuthorizeDish(gomock.Any(), gomock.Eq(payload), gomock.Eq(dishID)).Times(1).Return(nil)
+			},
+			status: http.StatusOK,
+		},
+		{
+			name: "Forbidden",
+			buildStub: func(au *mocks.MockAuthorizationUsecase) {
+				au.EXPECT().AuthorizeDish(gomock.Any(), gomock.Eq(payload), gomock.Eq(dishID)).Times(1).Return(domain.ErrForbidden)
+			},
+			status: http.StatusForbidden,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			au := mocks.NewMockAuthorizationUsecase(ctrl)
+			tc.buildStub(au)
+
+			e := echo.New()
+			e.Use(setPayload(payload))
+
+			e.PUT("/dishes/:id", func(c echo.Context) error {
+				return c.NoContent(http.StatusOK)
+			}, AuthorizeDish(au, "id"))
+
+			recorder := httptest.NewRecorder()
+
+			req, err := http.NewRequest(http.MethodPut, "/dishes/"+dishID, nil)
+			require.NoError(t, err)
+
+			e.ServeHTTP(recorder, req)
+
+			require.Equal(t, tc.status, recorder.Code)
+		})
+	}
+}
+
+func setPayload(payload *domain.TokenPayload) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			c.Set(domain.AuthPayloadKey, payload)
+
+			return next(c)
+		}
+	}
+}
@@ -31,6 +31,7 @@ func Logger() echo.MiddlewareFunc {
 			latency := end.Sub(start)
 
 			event.
+				Str("request_id", c.Response().Header().Get(echo.HeaderXRequestID)).
 				Str("method", c.Request().Method).
 				Str("path", c.Request().URL.Path).
 				Int("status", c.Response().Status).
@@ -0,0 +1,94 @@
+package middleware
+
+import (
+	"math"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ogurilab/school-lunch-api/domain"
+	"github.com/ogurilab/school-lunch-api/domain/errors"
+)
+
+const (
+	HeaderRateLimitLimit     = "X-RateLimit-Limit"
+	HeaderRateLimitRemaining = "X-RateLimit-Remaining"
+	HeaderRateLimitReset     = "X-RateLimit-Reset"
+)
+
+// RateLimit は X-API-Key ヘッダーのキーで利用者を識別し、キーごとにリクエストを制限する
+// キーがない場合は IP アドレスごとに anonymous の制限を適用する
+// anonymous の DailyQuota が0の場合はキーを必須とする
+func RateLimit(ku domain.APIKeyUsecase, limiter domain.RateLimiter, anonymous domain.RateLimit) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			key := c.Request().Header.Get(domain.APIKeyHeader)
+
+			var (
+				id    string
+				limit domain.RateLimit
+			)
+
+			if key == "" {
+				if anonymous.DailyQuota <= 0 {
+					return c.JSON(errors.NewUnauthorizedError(domain.ErrAPIKeyRequired))
+				}
+
+				id = "ip:" + c.RealIP()
+				limit = anonymous
+			} else {
+				apiKey, err := ku.Authenticate(c.Request().Context(), key)
+
+				if err != nil {
+					if err == domain.ErrInvalidAPIKey || err == domain.ErrAPIKeyRevoked {
+						return c.JSON(errors.NewUnauthorizedError(err))
+					}
+
+					return c.JSON(errors.NewInternalServerError(err))
+				}
+
+				c.Set(domain.APIKeyContextKey, apiKey)
+
+				id = "key:" + strconv.Itoa(int(apiKey.ID))
+				limit = apiKey.Limit()
+			}
+
+			// 設定の誤りを制限超過として返さないよう、サーバーのエラーにする
+			if err := limit.Validate(); err != nil {
+				return c.JSON(errors.NewInternalServerError(err))
+			}
+
+			result := limiter.Allow(id, limit)
+
+			header := c.Response().Header()
+			header.Set(HeaderRateLimitLimit, strconv.Itoa(int(result.Limit)))
+			header.Set(HeaderRateLimitRemaining, strconv.Itoa(int(result.Remaining)))
+			header.Set(HeaderRateLimitReset, strconv.FormatInt(result.Reset.Unix(), 10))
+
+			if !result.Allowed {
+				header.Set(echo.HeaderRetryAfter, strconv.Itoa(int(math.Ceil(result.RetryAfter.Seconds()))))
+
+				return c.JSON(errors.NewTooManyRequestsError(result.Err))
+			}
+
+			return next(c)
+		}
+	}
+}
+
+// LoginThrottle は IP アドレスごとにログインの試行回数を制限する
+// パスワードの総当たりで bcrypt の照合を繰り返させないよう、認証の前に適用する
+func LoginThrottle(limiter domain.RateLimiter, limit domain.RateLimit) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			result := limiter.Allow("login:"+c.RealIP(), limit)
+
+			if !result.Allowed {
+				c.Response().Header().Set(echo.HeaderRetryAfter, strconv.Itoa(int(math.Ceil(result.RetryAfter.Seconds()))))
+
+				return c.JSON(errors.NewTooManyRequestsError(result.Err))
+			}
+
+			return next(c)
+		}
+	}
+}
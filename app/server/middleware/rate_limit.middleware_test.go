@@ -0,0 +1,260 @@
+package middleware
+
+import (
+	"database/sql"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ogurilab/school-lunch-api/domain"
+	"github.com/ogurilab/school-lunch-api/domain/mocks"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRateLimitMiddleware(t *testing.T) {
+	key := domain.ReNewAPIKey(1, "line-bot", "slk_abcdefgh", "hashed", 60, 10, 1000, false, time.Now())
+	anonymous := domain.RateLimit{PerMinute: 30, Burst: 5, DailyQuota: 100}
+	reset := time.Date(2024, 1, 19, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name      string
+		anonymous domain.RateLimit
+		setUpKey  func(req *http.Request)
+		buildStub func(ku *mocks.MockAPIKeyUsecase, limiter *mocks.MockRateLimiter)
+		check     func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:      "OK",
+			anonymous: anonymous,
+			setUpKey: func(req *http.Request) {
+				req.Header.Set(domain.APIKeyHeader, "slk_valid")
+			},
+			buildStub: func(ku *mocks.MockAPIKeyUsecase, limiter *mocks.MockRateLimiter) {
+				ku.EXPECT().Authenticate(gomock.Any(), gomock.Eq("slk_valid")).Times(1).Return(key, nil)
+				limiter.EXPECT().Allow(gomock.Eq("key:1"), gomock.Eq(key.Limit())).Times(1).
+					Return(&domain.RateLimitResult{Allowed: true, Limit: 1000, Remaining: 999, Reset: reset})
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Equal(t, "1000", recorder.Header().Get(HeaderRateLimitLimit))
+				require.Equal(t, "999", recorder.Header().Get(HeaderRateLimitRemaining))
+				require.Equal(t, "1705622400", recorder.Header().Get(HeaderRateLimitReset))
+				require.Equal(t, "line-bot", recorder.Body.String())
+			},
+		},
+		{
+			name:      "Invalid Burst",
+			anonymous: anonymous,
+			setUpKey: func(req *http.Request) {
+				req.Header.Set(domain.APIKeyHeader, "slk_valid")
+			},
+			buildStub: func(ku *mocks.MockAPIKeyUsecase, limiter *mocks.MockRateLimiter) {
+				invalid := domain.ReNewAPIKey(2, "broken", "slk_ijklmnop", "hashed", 60, 0, 1000, false, time.Now())
+				ku.EXPECT().Authenticate(gomock.Any(), gomock.Eq("slk_valid")).Times(1).Return(invalid, nil)
+				limiter.EXPECT().Allow(gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+		{
+			name:      "Anonymous",
+			anonymous: anonymous,
+			setUpKey: func(req *http.Request) {
+				req.Header.Set(echo.HeaderXRealIP, "192.0.2.1")
+			},
+			buildStub: func(ku *mocks.MockAPIKeyUsecase, limiter *mocks.MockRateLimiter) {
+				ku.EXPECT().Authenticate(gomock.Any(), gomock.Any()).Times(0)
+				limiter.EXPECT().Allow(gomock.Eq("ip:192.0.2.1"), gomock.Eq(anonymous)).Times(1).
+					Return(&domain.RateLimitResult{Allowed: true, Limit: 100, Remaining: 99, Reset: reset})
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Equal(t, "100", recorder.Header().Get(HeaderRateLimitLimit))
+			},
+		},
+		{
+			name:      "Key Required",
+			anonymous: domain.RateLimit{},
+			setUpKey: func(req *http.Request) {
+				// do nothing
+			},
+			buildStub: func(ku *mocks.MockAPIKeyUsecase, limiter *mocks.MockRateLimiter) {
+				ku.EXPECT().Authenticate(gomock.Any(), gomock.Any()).Times(0)
+				limiter.EXPECT().Allow(gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name:      "Rate Limited",
+			anonymous: anonymous,
+			setUpKey: func(req *http.Request) {
+				req.Header.Set(domain.APIKeyHeader, "slk_valid")
+			},
+			buildStub: func(ku *mocks.MockAPIKeyUsecase, limiter *mocks.MockRateLimiter) {
+				ku.EXPECT().Authenticate(gomock.Any(), gomock.Eq("slk_valid")).Times(1).Return(key, nil)
+				limiter.EXPECT().Allow(gomock.Any(), gomock.Any()).Times(1).
+					Return(&domain.RateLimitResult{
+						Limit:      1000,
+						Remaining:  10,
+						Reset:      reset,
+						RetryAfter: 1500 * time.Millisecond,
+						Err:        domain.ErrRateLimited,
+					})
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusTooManyRequests, recorder.Code)
+				require.Equal(t, "2", recorder.Header().Get(echo.HeaderRetryAfter))
+				require.Equal(t, "10", recorder.Header().Get(HeaderRateLimitRemaining))
+			},
+		},
+		{
+			name:      "Quota Exceeded",
+			anonymous: anonymous,
+			setUpKey: func(req *http.Request) {
+				req.Header.Set(domain.APIKeyHeader, "slk_valid")
+			},
+			buildStub: func(ku *mocks.MockAPIKeyUsecase, limiter *mocks.MockRateLimiter) {
+				ku.EXPECT().Authenticate(gomock.Any(), gomock.Eq("slk_valid")).Times(1).Return(key, nil)
+				limiter.EXPECT().Allow(gomock.Any(), gomock.Any()).Times(1).
+					Return(&domain.RateLimitResult{
+						Limit:      1000,
+						Reset:      reset,
+						RetryAfter: time.Hour,
+						Err:        domain.ErrQuotaExceeded,
+					})
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusTooManyRequests, recorder.Code)
+				require.Equal(t, "3600", recorder.Header().Get(echo.HeaderRetryAfter))
+				require.Equal(t, "0", recorder.Header().Get(HeaderRateLimitRemaining))
+			},
+		},
+		{
+			name:      "Revoked Key",
+			anonymous: anonymous,
+			setUpKey: func(req *http.Request) {
+				req.Header.Set(domain.APIKeyHeader, "slk_revoked")
+			},
+			buildStub: func(ku *mocks.MockAPIKeyUsecase, limiter *mocks.MockRateLimiter) {
+				ku.EXPECT().Authenticate(gomock.Any(), gomock.Eq("slk_revoked")).Times(1).Return(nil, domain.ErrAPIKeyRevoked)
+				limiter.EXPECT().Allow(gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name:      "Internal Server Error",
+			anonymous: anonymous,
+			setUpKey: func(req *http.Request) {
+				req.Header.Set(domain.APIKeyHeader, "slk_valid")
+			},
+			buildStub: func(ku *mocks.MockAPIKeyUsecase, limiter *mocks.MockRateLimiter) {
+				ku.EXPECT().Authenticate(gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrConnDone)
+				limiter.EXPECT().Allow(gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ku := mocks.NewMockAPIKeyUsecase(ctrl)
+			limiter := mocks.NewMockRateLimiter(ctrl)
+			tc.buildStub(ku, limiter)
+
+			e := echo.New()
+			e.Use(RateLimit(ku, limiter, tc.anonymous))
+
+			e.GET("/test", func(c echo.Context) error {
+				name := ""
+
+				if key, ok := c.Get(domain.APIKeyContextKey).(*domain.APIKey); ok {
+					name = key.Name
+				}
+
+				return c.String(http.StatusOK, name)
+			})
+
+			recorder := httptest.NewRecorder()
+
+			req, err := http.NewRequest(http.MethodGet, "/test", nil)
+			require.NoError(t, err)
+
+			tc.setUpKey(req)
+
+			e.ServeHTTP(recorder, req)
+
+			tc.check(t, recorder)
+		})
+	}
+}
+
+func TestLoginThrottleMiddleware(t *testing.T) {
+	limit := domain.RateLimit{PerMinute: 5, Burst: 5, DailyQuota: 100}
+
+	testCases := []struct {
+		name      string
+		buildStub func(limiter *mocks.MockRateLimiter)
+		check     func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			buildStub: func(limiter *mocks.MockRateLimiter) {
+				limiter.EXPECT().Allow(gomock.Eq("login:192.0.2.1"), gomock.Eq(limit)).Times(1).
+					Return(&domain.RateLimitResult{Allowed: true})
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "Too Many Requests",
+			buildStub: func(limiter *mocks.MockRateLimiter) {
+				limiter.EXPECT().Allow(gomock.Eq("login:192.0.2.1"), gomock.Eq(limit)).Times(1).
+					Return(&domain.RateLimitResult{RetryAfter: 11500 * time.Millisecond, Err: domain.ErrRateLimited})
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusTooManyRequests, recorder.Code)
+				require.Equal(t, "12", recorder.Header().Get(echo.HeaderRetryAfter))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			limiter := mocks.NewMockRateLimiter(ctrl)
+			tc.buildStub(limiter)
+
+			e := echo.New()
+			e.POST("/auth/login", func(c echo.Context) error {
+				return c.NoContent(http.StatusOK)
+			}, LoginThrottle(limiter, limit))
+
+			recorder := httptest.NewRecorder()
+
+			req, err := http.NewRequest(http.MethodPost, "/auth/login", nil)
+			require.NoError(t, err)
+
+			req.RemoteAddr = "192.0.2.1:12345"
+
+			e.ServeHTTP(recorder, req)
+
+			tc.check(t, recorder)
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package middleware
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+	"github.com/ogurilab/school-lunch-api/domain"
+)
+
+// TokenAuth は Authorization: Bearer <token> ヘッダーのトークンを検証する
+// 検証に成功した場合、トークンの内容を domain.AuthPayloadKey で echo.Context に保存する
+func TokenAuth(uu domain.UserUsecase) echo.MiddlewareFunc {
+	return middleware.KeyAuthWithConfig(tokenAuthConfig(uu))
+}
+
+func tokenAuthConfig(uu domain.UserUsecase) middleware.KeyAuthConfig {
+	return middleware.KeyAuthConfig{
+		KeyLookup:  "header:" + echo.HeaderAuthorization,
+		AuthScheme: "Bearer",
+		Validator: func(token string, c echo.Context) (bool, error) {
+			payload, err := uu.Authenticate(c.Request().Context(), token)
+
+			if err != nil {
+				return false, err
+			}
+
+			c.Set(domain.AuthPayloadKey, payload)
+
+			return true, nil
+		},
+	}
+}
@@ -0,0 +1,114 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ogurilab/school-lunch-api/domain"
+	"github.com/ogurilab/school-lunch-api/domain/mocks"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestTokenAuthMiddleware(t *testing.T) {
+	payload := &domain.TokenPayload{
+		ID:        "session",
+		UserID:    1,
+		Username:  "admin",
+		Role:      domain.UserRoleAdmin,
+		IssuedAt:  time.Now(),
+		ExpiredAt: time.Now().Add(time.Minute),
+	}
+
+	testCases := []struct {
+		name      string
+		setUpAuth func(t *testing.T, req *http.Request)
+		buildStub func(uu *mocks.MockUserUsecase)
+		check     func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			setUpAuth: func(t *testing.T, req *http.Request) {
+				req.Header.Set(echo.HeaderAuthorization, "Bearer valid")
+			},
+			buildStub: func(uu *mocks.MockUserUsecase) {
+				uu.EXPECT().Authenticate(gomock.Any(), gomock.Eq("valid")).Times(1).Return(payload, nil)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Equal(t, payload.Username, recorder.Body.String())
+			},
+		},
+		{
+			name: "Revoked",
+			setUpAuth: func(t *testing.T, req *http.Request) {
+				req.Header.Set(echo.HeaderAuthorization, "Bearer revoked")
+			},
+			buildStub: func(uu *mocks.MockUserUsecase) {
+				uu.EXPECT().Authenticate(gomock.Any(), gomock.Eq("revoked")).Times(1).Return(nil, domain.ErrSessionRevoked)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name: "Invalid Scheme",
+			setUpAuth: func(t *testing.T, req *http.Request) {
+				req.Header.Set(echo.HeaderAuthorization, "Basic valid")
+			},
+			buildStub: func(uu *mocks.MockUserUsecase) {
+				uu.EXPECT().Authenticate(gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "No Token",
+			setUpAuth: func(t *testing.T, req *http.Request) {
+				// do nothing
+			},
+			buildStub: func(uu *mocks.MockUserUsecase) {
+				uu.EXPECT().Authenticate(gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uu := mocks.NewMockUserUsecase(ctrl)
+			tc.buildStub(uu)
+
+			e := echo.New()
+			e.Use(TokenAuth(uu))
+
+			recorder := httptest.NewRecorder()
+
+			url := "/test"
+
+			req, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			tc.setUpAuth(t, req)
+
+			e.GET(url, func(c echo.Context) error {
+				p := c.Get(domain.AuthPayloadKey).(*domain.TokenPayload)
+
+				return c.String(http.StatusOK, p.Username)
+			})
+
+			e.ServeHTTP(recorder, req)
+
+			tc.check(t, recorder)
+		})
+	}
+}
@@ -4,22 +4,112 @@ import (
 	"time"
 
 	"github.com/labstack/echo/v4"
+	"github.com/ogurilab/school-lunch-api/domain"
+	"github.com/ogurilab/school-lunch-api/infrastructure/dataset"
 	db "github.com/ogurilab/school-lunch-api/infrastructure/db/sqlc"
 	"github.com/ogurilab/school-lunch-api/infrastructure/repository"
 	"github.com/ogurilab/school-lunch-api/server/controller"
+	"github.com/ogurilab/school-lunch-api/server/middleware"
 	"github.com/ogurilab/school-lunch-api/usecase"
 )
 
-func NewAdminRouter(group *echo.Group, timeout time.Duration, query db.Query) {
+func NewAdminRouter(group *echo.Group, timeout time.Duration, query db.Query, lu domain.AuditLogUsecase) {
 	mr := repository.NewMenuRepository(query)
 	mu := usecase.NewMenuUsecase(mr, timeout)
 
 	dr := repository.NewDishRepository(query)
 	du := usecase.NewDishUsecase(dr, timeout)
 
-	ac := controller.NewAdminController(mu, du)
+	ar := repository.NewAllergenRepository(query)
+	au := usecase.NewAllergenUsecase(ar, dr, timeout)
 
-	group.POST("/menus", ac.CreateMenu)
-	group.POST("/menus/:id/dishes", ac.CreateDish)
-	group.POST("/menus/:id/dishes/bulk", ac.CreateDishes)
+	ir := repository.NewIngredientRepository(query)
+	iu := usecase.NewIngredientUsecase(ir, dr, timeout)
+
+	cr := repository.NewCityRepository(query)
+	cu := usecase.NewCityUsecase(cr, timeout)
+
+	ac := controller.NewAdminController(mu, du, au, cu)
+
+	sr := repository.NewExternalDataSourceRepository(query)
+	su := usecase.NewExternalDataSourceUsecase(sr, mr, dataset.NewHTTPFetcher(nil), timeout)
+
+	sc := controller.NewExternalDataSourceController(su)
+
+	ic := controller.NewIngredientController(iu)
+
+	authz := usecase.NewAuthorizationUsecase(mr, dr, timeout)
+
+	adminOnly := middleware.RequireRoles(domain.UserRoleAdmin)
+	bodyCity := middleware.AuthorizeCity(authz, middleware.CityCodeFromBody())
+	paramCity := middleware.AuthorizeCity(authz, middleware.CityCodeFromParam("code"))
+	menu := middleware.AuthorizeMenu(authz, "id")
+	dish := middleware.AuthorizeDish(authz, "id")
+
+	menuSnapshot := snapshotMenu(mu, du)
+	dishSnapshot := snapshotDish(du, au, iu)
+	ingredientSnapshot := snapshotIngredient(iu)
+	citySnapshot := snapshotCity(cu)
+	sourceSnapshot := snapshotDataSource(su)
+
+	group.POST("/menus", ac.CreateMenu, bodyCity,
+		audit(lu, domain.AuditEntityMenu, domain.AuditActionCreate, "", menuSnapshot))
+	group.PUT("/menus/:id", ac.UpdateMenu, menu, bodyCity,
+		audit(lu, domain.AuditEntityMenu, domain.AuditActionUpdate, "id", menuSnapshot))
+	group.PATCH("/menus/:id", ac.PatchMenu, menu, bodyCity,
+		audit(lu, domain.AuditEntityMenu, domain.AuditActionUpdate, "id", menuSnapshot))
+	group.DELETE("/menus/:id", ac.DeleteMenu, menu,
+		audit(lu, domain.AuditEntityMenu, domain.AuditActionDelete, "id", menuSnapshot))
+	group.POST("/menus/:id/dishes", ac.CreateDish, menu,
+		audit(lu, domain.AuditEntityMenu, domain.AuditActionAddDishes, "id", menuSnapshot))
+	group.POST("/menus/:id/dishes/bulk", ac.CreateDishes, menu,
+		audit(lu, domain.AuditEntityMenu, domain.AuditActionAddDishes, "id", menuSnapshot))
+	group.DELETE("/menus/:id/dishes/:dishID", ac.DetachDish, menu,
+		audit(lu, domain.AuditEntityMenu, domain.AuditActionDetachDish, "id", menuSnapshot))
+	group.PUT("/dishes/:id", ac.UpdateDish, dish,
+		audit(lu, domain.AuditEntityDish, domain.AuditActionUpdate, "id", dishSnapshot))
+	group.DELETE("/dishes/:id", ac.DeleteDish, dish,
+		audit(lu, domain.AuditEntityDish, domain.AuditActionDelete, "id", dishSnapshot))
+	// アレルゲンはすべての市区町村で共有するマスタのため、ロールの確認のみ行う
+	group.POST("/allergens", ac.CreateAllergen, middleware.RequireRoles(domain.UserRoleAdmin, domain.UserRoleMunicipality),
+		audit(lu, domain.AuditEntityAllergen, domain.AuditActionCreate, "", nil))
+	group.POST("/dishes/:id/allergens", ac.CreateDishAllergens, dish,
+		audit(lu, domain.AuditEntityDish, domain.AuditActionAddAllergens, "id", dishSnapshot))
+	group.DELETE("/dishes/:id/allergens/:allergenID", ac.DeleteDishAllergen, dish,
+		audit(lu, domain.AuditEntityDish, domain.AuditActionRemoveAllergen, "id", dishSnapshot))
+	// 食材もアレルゲンと同様に共有するマスタのため、変更と削除は管理者のみ行える
+	group.GET("/ingredients", ic.Fetch)
+	group.POST("/ingredients", ic.Create, middleware.RequireRoles(domain.UserRoleAdmin, domain.UserRoleMunicipality),
+		audit(lu, domain.AuditEntityIngredient, domain.AuditActionCreate, "", ingredientSnapshot))
+	group.GET("/ingredients/:id", ic.GetByID)
+	group.PUT("/ingredients/:id", ic.Update, adminOnly,
+		audit(lu, domain.AuditEntityIngredient, domain.AuditActionUpdate, "id", ingredientSnapshot))
+	group.DELETE("/ingredients/:id", ic.Delete, adminOnly,
+		audit(lu, domain.AuditEntityIngredient, domain.AuditActionDelete, "id", ingredientSnapshot))
+	group.POST("/dishes/:id/ingredients", ic.AttachToDish, dish,
+		audit(lu, domain.AuditEntityDish, domain.AuditActionAddIngredients, "id", dishSnapshot))
+	group.DELETE("/dishes/:id/ingredients/:ingredientID", ic.DetachFromDish, dish,
+		audit(lu, domain.AuditEntityDish, domain.AuditActionRemoveIngredient, "id", dishSnapshot))
+	group.POST("/cities", ac.CreateCity, adminOnly,
+		middleware.Audit(lu, middleware.AuditTarget{
+			Entity:   domain.AuditEntityCity,
+			Action:   domain.AuditActionCreate,
+			IDField:  "city_code",
+			Snapshot: citySnapshot,
+		}))
+	group.PUT("/cities/:code", ac.UpdateCity, adminOnly,
+		audit(lu, domain.AuditEntityCity, domain.AuditActionUpdate, "code", citySnapshot))
+	group.POST("/cities/:code/menus/import", ac.ImportMenus, paramCity,
+		audit(lu, domain.AuditEntityCity, domain.AuditActionImportMenus, "code", nil))
+
+	group.GET("/data-sources", sc.Fetch)
+	group.POST("/data-sources", sc.Create, adminOnly,
+		audit(lu, domain.AuditEntityDataSource, domain.AuditActionCreate, "", sourceSnapshot))
+	group.GET("/data-sources/:id", sc.GetByID)
+	group.PUT("/data-sources/:id", sc.Update, adminOnly,
+		audit(lu, domain.AuditEntityDataSource, domain.AuditActionUpdate, "id", sourceSnapshot))
+	group.DELETE("/data-sources/:id", sc.Delete, adminOnly,
+		audit(lu, domain.AuditEntityDataSource, domain.AuditActionDelete, "id", sourceSnapshot))
+	group.POST("/data-sources/:id/sync", sc.Sync, adminOnly,
+		audit(lu, domain.AuditEntityDataSource, domain.AuditActionSync, "id", sourceSnapshot))
 }
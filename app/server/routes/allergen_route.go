@@ -17,6 +17,7 @@ func NewAllergenRouter(group *echo.Group, timeout time.Duration, query db.Query)
 		usecase.NewAllergenUsecase(ar, dr, timeout),
 	)
 
+	group.GET("/allergens", ac.FetchStandard)
 	group.GET("/dishes/:id/allergens", ac.FetchByDishID)
 	group.GET("/menus/:id/allergens", ac.FetchByMenuID)
 }
@@ -0,0 +1,29 @@
+package routes
+
+import (
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ogurilab/school-lunch-api/domain"
+	db "github.com/ogurilab/school-lunch-api/infrastructure/db/sqlc"
+	"github.com/ogurilab/school-lunch-api/infrastructure/repository"
+	"github.com/ogurilab/school-lunch-api/server/controller"
+	"github.com/ogurilab/school-lunch-api/server/middleware"
+	"github.com/ogurilab/school-lunch-api/usecase"
+)
+
+func NewAPIKeyRouter(group *echo.Group, timeout time.Duration, query db.Query, lu domain.AuditLogUsecase) {
+	ku := usecase.NewAPIKeyUsecase(repository.NewAPIKeyRepository(query), timeout)
+	kc := controller.NewAPIKeyController(ku)
+
+	adminOnly := middleware.RequireRoles(domain.UserRoleAdmin)
+	keySnapshot := snapshotAPIKey(ku)
+
+	group.GET("/api-keys", kc.Fetch, adminOnly)
+	// 発行したキーが監査ログに残らないよう、レスポンスではなく保存した状態を記録する
+	group.POST("/api-keys", kc.Create, adminOnly,
+		audit(lu, domain.AuditEntityAPIKey, domain.AuditActionCreate, "", keySnapshot))
+	group.GET("/api-keys/:id", kc.GetByID, adminOnly)
+	group.DELETE("/api-keys/:id", kc.Revoke, adminOnly,
+		audit(lu, domain.AuditEntityAPIKey, domain.AuditActionRevoke, "id", keySnapshot))
+}
@@ -0,0 +1,139 @@
+package routes
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ogurilab/school-lunch-api/domain"
+	"github.com/ogurilab/school-lunch-api/server/controller"
+	"github.com/ogurilab/school-lunch-api/server/middleware"
+	"github.com/ogurilab/school-lunch-api/util"
+)
+
+type snapshotFunc = func(ctx context.Context, id string) (any, error)
+
+func NewAuditLogRouter(group *echo.Group, lu domain.AuditLogUsecase) {
+	lc := controller.NewAuditLogController(lu)
+
+	group.GET("/audit-logs", lc.Fetch, middleware.RequireRoles(domain.UserRoleAdmin))
+}
+
+// audit は snapshot で対象の変更前後の状態を記録するミドルウェアを返す
+func audit(lu domain.AuditLogUsecase, entity string, action string, idParam string, snapshot snapshotFunc) echo.MiddlewareFunc {
+	return middleware.Audit(lu, middleware.AuditTarget{
+		Entity:   entity,
+		Action:   action,
+		IDParam:  idParam,
+		Snapshot: snapshot,
+	})
+}
+
+func snapshotMenu(mu domain.MenuUsecase, du domain.DishUsecase) snapshotFunc {
+	return func(ctx context.Context, id string) (any, error) {
+		menu, err := mu.FindByID(ctx, id)
+
+		if err != nil {
+			return nil, err
+		}
+
+		dishes, err := du.FetchByMenuID(ctx, id)
+
+		if err != nil {
+			return nil, err
+		}
+
+		// domain.Menu の MarshalJSON が埋め込み先でも使われ dishes が失われるため MenuWithDishes で返す
+		return &domain.MenuWithDishes{Menu: *menu, Dishes: dishes}, nil
+	}
+}
+
+type dishSnapshot struct {
+	*domain.DishWithMenuIDs
+	Allergens   []*domain.Allergen       `json:"allergens"`
+	Ingredients []*domain.DishIngredient `json:"ingredients"`
+}
+
+func snapshotDish(du domain.DishUsecase, au domain.AllergenUsecase, iu domain.IngredientUsecase) snapshotFunc {
+	return func(ctx context.Context, id string) (any, error) {
+		dish, err := du.GetByID(ctx, id, domain.MAX_LIMIT, 0)
+
+		if err != nil {
+			return nil, err
+		}
+
+		allergens, err := au.FetchByDishID(ctx, id)
+
+		if err != nil {
+			return nil, err
+		}
+
+		ingredients, err := iu.FetchByDishID(ctx, id)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return &dishSnapshot{DishWithMenuIDs: dish, Allergens: allergens, Ingredients: ingredients}, nil
+	}
+}
+
+func snapshotIngredient(iu domain.IngredientUsecase) snapshotFunc {
+	return func(ctx context.Context, id string) (any, error) {
+		n, err := strconv.ParseInt(id, 10, 32)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return iu.GetByID(ctx, int32(n))
+	}
+}
+
+func snapshotCity(cu domain.CityUsecase) snapshotFunc {
+	return func(ctx context.Context, id string) (any, error) {
+		code, err := util.ParseCityCode(id)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return cu.GetByCityCode(ctx, code)
+	}
+}
+
+func snapshotDataSource(su domain.ExternalDataSourceUsecase) snapshotFunc {
+	return func(ctx context.Context, id string) (any, error) {
+		n, err := strconv.ParseInt(id, 10, 32)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return su.GetByID(ctx, int32(n))
+	}
+}
+
+func snapshotUser(uu domain.UserUsecase) snapshotFunc {
+	return func(ctx context.Context, id string) (any, error) {
+		n, err := strconv.ParseInt(id, 10, 32)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return uu.GetByID(ctx, int32(n))
+	}
+}
+
+func snapshotAPIKey(ku domain.APIKeyUsecase) snapshotFunc {
+	return func(ctx context.Context, id string) (any, error) {
+		n, err := strconv.ParseInt(id, 10, 32)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return ku.GetByID(ctx, int32(n))
+	}
+}
@@ -18,4 +18,5 @@ func NewDishRouter(group *echo.Group, timeout time.Duration, query db.Query) {
 	group.GET("/dishes/:id", dc.GetByID)
 	group.GET("/dishes", dc.Fetch)
 	group.GET("/cities/:code/dishes/:id", dc.GetByIdInCity)
+	group.GET("/allergens/:id/dishes", dc.FetchByAllergenID)
 }
@@ -0,0 +1,22 @@
+package routes
+
+import (
+	"time"
+
+	"github.com/labstack/echo/v4"
+	db "github.com/ogurilab/school-lunch-api/infrastructure/db/sqlc"
+	"github.com/ogurilab/school-lunch-api/infrastructure/repository"
+	"github.com/ogurilab/school-lunch-api/server/controller"
+	"github.com/ogurilab/school-lunch-api/usecase"
+)
+
+func NewIngredientRouter(group *echo.Group, timeout time.Duration, query db.Query) {
+	dr := repository.NewDishRepository(query)
+	ir := repository.NewIngredientRepository(query)
+	ic := controller.NewIngredientController(
+		usecase.NewIngredientUsecase(ir, dr, timeout),
+	)
+
+	group.GET("/dishes/:id/ingredients", ic.FetchByDishID)
+	group.GET("/menus/:id/ingredients", ic.FetchByMenuID)
+}
@@ -4,20 +4,66 @@ import (
 	"time"
 
 	"github.com/labstack/echo/v4"
+	"github.com/ogurilab/school-lunch-api/domain"
+	"github.com/ogurilab/school-lunch-api/infrastructure/calendar"
 	db "github.com/ogurilab/school-lunch-api/infrastructure/db/sqlc"
+	"github.com/ogurilab/school-lunch-api/infrastructure/feed"
+	"github.com/ogurilab/school-lunch-api/infrastructure/pdf"
 	"github.com/ogurilab/school-lunch-api/infrastructure/repository"
+	"github.com/ogurilab/school-lunch-api/infrastructure/spreadsheet"
 	"github.com/ogurilab/school-lunch-api/server/controller"
 	"github.com/ogurilab/school-lunch-api/usecase"
 )
 
-func NewMenuWithDishesRouter(group *echo.Group, timeout time.Duration, query db.Query) {
+func NewMenuWithDishesRouter(group *echo.Group, timeout time.Duration, query db.Query, baseURL string, s domain.Storage) {
 
 	mr := repository.NewMenuWithDishesRepository(query)
-	mc := controller.NewMenuWithDishesController(
-		usecase.NewMenuWithDishesUsecase(mr, timeout),
+	ar := repository.NewAllergenRepository(query)
+	mu := usecase.NewMenuWithDishesUsecase(mr, ar, timeout)
+	mc := controller.NewMenuWithDishesController(mu)
+
+	cc := controller.NewMenuCalendarController(
+		usecase.NewMenuCalendarUsecase(mu, ar, calendar.NewICalendarEncoder(), timeout),
+	)
+
+	cr := repository.NewCityRepository(query)
+
+	ec := controller.NewMenuExportController(
+		usecase.NewMenuExportUsecase(mr, ar, spreadsheet.NewEncoder(), timeout),
+	)
+
+	sc := controller.NewMenuSheetController(
+		usecase.NewMenuSheetUsecase(
+			mu,
+			usecase.NewAllergenUsecase(ar, repository.NewDishRepository(query), timeout),
+			usecase.NewCityUsecase(cr, timeout),
+			s,
+			pdf.NewMenuSheetRenderer(),
+			timeout,
+		),
+	)
+
+	amc := controller.NewAllergenMatrixController(
+		usecase.NewAllergenMatrixUsecase(ar, cr, spreadsheet.NewEncoder(), pdf.NewAllergenMatrixRenderer(), timeout),
 	)
 
+	group.GET("/cities/:code/menus.ics", cc.ExportByCity)
+	group.GET("/cities/:code/menus/export", ec.ExportByCity)
+	group.GET("/cities/:code/menus/sheet.pdf", sc.ExportByCity)
+	group.GET("/cities/:code/menus/allergen-matrix", amc.FetchByCity)
+	group.GET("/cities/:code/menus/allergen-risks", mc.FetchAllergenRisksByCity)
 	group.GET("/cities/:code/menus/:id", mc.GetByID)
 	group.GET("/cities/:code/menus", mc.FetchByCity)
 	group.GET("/menus", mc.Fetch)
+
+	// baseURL が空の場合はフィードのリンクを作れないため、フィードを公開しない
+	if baseURL != "" {
+		fc := controller.NewMenuFeedController(
+			usecase.NewMenuFeedUsecase(mr, cr, feed.NewXMLEncoder(), timeout),
+			baseURL,
+		)
+
+		group.GET("/cities/:code/feed.atom", fc.FetchAtomByCity)
+		group.GET("/cities/:code/feed.rss", fc.FetchRSSByCity)
+	}
 }
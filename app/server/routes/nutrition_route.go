@@ -0,0 +1,32 @@
+package routes
+
+import (
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ogurilab/school-lunch-api/domain"
+	db "github.com/ogurilab/school-lunch-api/infrastructure/db/sqlc"
+	"github.com/ogurilab/school-lunch-api/infrastructure/repository"
+	"github.com/ogurilab/school-lunch-api/server/controller"
+	"github.com/ogurilab/school-lunch-api/server/middleware"
+	"github.com/ogurilab/school-lunch-api/usecase"
+)
+
+func NewNutritionRouter(group *echo.Group, timeout time.Duration, query db.Query, lu domain.AuditLogUsecase) {
+	mr := repository.NewMenuRepository(query)
+	mu := usecase.NewMenuUsecase(mr, timeout)
+
+	dr := repository.NewDishRepository(query)
+	du := usecase.NewDishUsecase(dr, timeout)
+
+	nu := usecase.NewNutritionUsecase(repository.NewNutritionRepository(query), mr, timeout)
+	nc := controller.NewNutritionController(nu)
+
+	authz := usecase.NewAuthorizationUsecase(mr, dr, timeout)
+	menu := middleware.AuthorizeMenu(authz, "id")
+
+	group.PUT("/menus/:id/nutrition/:level", nc.Upsert, menu,
+		audit(lu, domain.AuditEntityMenu, domain.AuditActionUpdateNutrition, "id", snapshotMenu(mu, du)))
+	group.DELETE("/menus/:id/nutrition/:level", nc.Delete, menu,
+		audit(lu, domain.AuditEntityMenu, domain.AuditActionDeleteNutrition, "id", snapshotMenu(mu, du)))
+}
@@ -0,0 +1,84 @@
+package routes
+
+import (
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ogurilab/school-lunch-api/bootstrap"
+	"github.com/ogurilab/school-lunch-api/domain"
+	"github.com/ogurilab/school-lunch-api/infrastructure/commons"
+	db "github.com/ogurilab/school-lunch-api/infrastructure/db/sqlc"
+	"github.com/ogurilab/school-lunch-api/infrastructure/repository"
+	"github.com/ogurilab/school-lunch-api/infrastructure/storage"
+	"github.com/ogurilab/school-lunch-api/infrastructure/thumbnail"
+	"github.com/ogurilab/school-lunch-api/server/controller"
+	"github.com/ogurilab/school-lunch-api/server/middleware"
+	"github.com/ogurilab/school-lunch-api/usecase"
+)
+
+// LocalStoragePath はローカルに保存した写真を配信するパス
+const LocalStoragePath = "/storage"
+
+func NewPhotoRouter(group *echo.Group, timeout time.Duration, query db.Query, lu domain.AuditLogUsecase, s domain.Storage, p domain.PhotoPublisher) {
+	mr := repository.NewMenuRepository(query)
+	mu := usecase.NewMenuUsecase(mr, timeout)
+
+	dr := repository.NewDishRepository(query)
+	du := usecase.NewDishUsecase(dr, timeout)
+
+	resizer := thumbnail.NewJPEGResizer(thumbnail.DefaultQuality)
+
+	pu := usecase.NewPhotoUsecase(mr, s, resizer, p, timeout)
+	pc := controller.NewPhotoController(pu)
+
+	authz := usecase.NewAuthorizationUsecase(mr, dr, timeout)
+
+	group.POST("/menus/:id/photo", pc.UploadMenuPhoto, middleware.AuthorizeMenu(authz, "id"),
+		audit(lu, domain.AuditEntityMenu, domain.AuditActionUploadPhoto, "id", snapshotMenu(mu, du)))
+}
+
+// newStorage は STORAGE_DRIVER に応じて写真の保存先を返す
+// local の場合は保存したファイルを LocalStoragePath で配信する
+func newStorage(env bootstrap.Env, e *echo.Echo) domain.Storage {
+	if env.StorageDriver == "r2" {
+		return storage.NewS3Storage(nil, storage.S3Config{
+			Endpoint:        env.R2URL,
+			Bucket:          env.R2BucketName,
+			AccessKeyID:     env.R2AccessID,
+			SecretAccessKey: env.R2Secret,
+			PublicURL:       env.R2PublicURL,
+		})
+	}
+
+	// 未設定のまま作業ディレクトリを配信しないよう、既定の保存先を使う
+	dir := env.LocalStorageDir
+
+	if dir == "" {
+		dir = "storage"
+	}
+
+	baseURL := env.LocalStorageURL
+
+	if baseURL == "" {
+		baseURL = LocalStoragePath
+	}
+
+	e.Static(LocalStoragePath, dir)
+
+	return storage.NewLocalStorage(dir, baseURL)
+}
+
+// newPhotoPublisher は Wikimedia Commons へ公開する PhotoPublisher を返す
+// アカウントが設定されていない場合は公開しないため nil を返す
+func newPhotoPublisher(env bootstrap.Env) domain.PhotoPublisher {
+	if env.WikimediaUserName == "" || env.WikimediaPassword == "" {
+		return nil
+	}
+
+	return commons.NewMediaWikiPublisher(nil, commons.MediaWikiConfig{
+		APIURL:   env.WikimediaAPIURL,
+		Username: env.WikimediaUserName,
+		Password: env.WikimediaPassword,
+		License:  env.WikimediaLicense,
+	})
+}
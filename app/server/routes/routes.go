@@ -1,29 +1,75 @@
 package routes
 
 import (
+	"strings"
 	"time"
 
 	"github.com/labstack/echo/v4"
 	"github.com/ogurilab/school-lunch-api/bootstrap"
+	"github.com/ogurilab/school-lunch-api/domain"
 	db "github.com/ogurilab/school-lunch-api/infrastructure/db/sqlc"
+	"github.com/ogurilab/school-lunch-api/infrastructure/ratelimit"
+	"github.com/ogurilab/school-lunch-api/infrastructure/repository"
+	"github.com/ogurilab/school-lunch-api/infrastructure/token"
 	"github.com/ogurilab/school-lunch-api/server/middleware"
+	"github.com/ogurilab/school-lunch-api/usecase"
+	"github.com/rs/zerolog/log"
 )
 
 func InitRoutes(env bootstrap.Env, timeout time.Duration, e *echo.Echo, query db.Query) {
 
+	maker, err := token.NewJWTMaker(env.TokenSymmetricKey)
+
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to create token maker")
+	}
+
+	uu := usecase.NewUserUsecase(repository.NewUserRepository(query), maker, env.AccessTokenDuration, timeout)
+	lu := usecase.NewAuditLogUsecase(repository.NewAuditLogRepository(query), timeout)
+
 	NewDocumentRouter(e)
 
+	NewAuthRouter(e.Group("/auth"), uu)
+
 	admin := e.Group("/admin")
-	admin.Use(middleware.KeyAuth(env))
-	NewAdminRouter(admin, timeout, query)
+	admin.Use(middleware.TokenAuth(uu), middleware.ReadOnly(domain.UserRoleGuest))
+	s := newStorage(env, e)
+
+	NewAdminRouter(admin, timeout, query, lu)
+	NewUserRouter(admin, uu, lu)
+	NewAPIKeyRouter(admin, timeout, query, lu)
+	NewAuditLogRouter(admin, lu)
+	NewPhotoRouter(admin, timeout, query, lu, s, newPhotoPublisher(env))
+	NewNutritionRouter(admin, timeout, query, lu)
 
 	v1 := e.Group("/v1")
 
+	// ドキュメントは制限の対象外にするため、ミドルウェアより先に登録する
 	NewSwaggerRouter(v1)
+
+	ku := usecase.NewAPIKeyUsecase(repository.NewAPIKeyRepository(query), timeout)
+	anonymous := domain.RateLimit{
+		PerMinute:  env.AnonymousRateLimit,
+		Burst:      env.AnonymousBurst,
+		DailyQuota: env.AnonymousDailyQuota,
+	}
+
+	v1.Use(middleware.RateLimit(ku, ratelimit.NewMemoryLimiter(), anonymous))
+
 	NewCityRouter(v1, timeout, query)
 	NewMenuRouter(v1, timeout, query)
-	NewMenuWithDishesRouter(v1, timeout, query)
+	// フィードのリンクは PUBLIC_BASE_URL から作るため、未設定の場合はフィードだけを公開しない
+	var feedBaseURL string
+
+	if env.PublicBaseURL != "" {
+		feedBaseURL = strings.TrimRight(env.PublicBaseURL, "/") + "/v1"
+	} else {
+		log.Warn().Msg("PUBLIC_BASE_URL is not set; feed routes are disabled")
+	}
+
+	NewMenuWithDishesRouter(v1, timeout, query, feedBaseURL, s)
 	NewDishRouter(v1, timeout, query)
 	NewAllergenRouter(v1, timeout, query)
+	NewIngredientRouter(v1, timeout, query)
 
 }
@@ -0,0 +1,34 @@
+package routes
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/ogurilab/school-lunch-api/domain"
+	"github.com/ogurilab/school-lunch-api/infrastructure/ratelimit"
+	"github.com/ogurilab/school-lunch-api/server/controller"
+	"github.com/ogurilab/school-lunch-api/server/middleware"
+)
+
+// loginRateLimit は IP アドレスごとのログインの試行回数の制限
+var loginRateLimit = domain.RateLimit{PerMinute: 5, Burst: 5, DailyQuota: 100}
+
+func NewAuthRouter(group *echo.Group, uu domain.UserUsecase) {
+	uc := controller.NewUserController(uu)
+
+	group.POST("/login", uc.Login, middleware.LoginThrottle(ratelimit.NewMemoryLimiter(), loginRateLimit))
+}
+
+func NewUserRouter(group *echo.Group, uu domain.UserUsecase, lu domain.AuditLogUsecase) {
+	uc := controller.NewUserController(uu)
+
+	adminOnly := middleware.RequireRoles(domain.UserRoleAdmin)
+	userSnapshot := snapshotUser(uu)
+
+	group.GET("/users", uc.Fetch, adminOnly)
+	group.POST("/users", uc.Create, adminOnly,
+		audit(lu, domain.AuditEntityUser, domain.AuditActionCreate, "", userSnapshot))
+	group.GET("/users/:id", uc.GetByID, adminOnly)
+	group.DELETE("/users/:id", uc.Delete, adminOnly,
+		audit(lu, domain.AuditEntityUser, domain.AuditActionDelete, "id", userSnapshot))
+	group.POST("/users/:id/revoke", uc.RevokeSessions, adminOnly,
+		audit(lu, domain.AuditEntityUser, domain.AuditActionRevoke, "id", userSnapshot))
+}
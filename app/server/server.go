@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/labstack/echo/v4"
+	echomiddleware "github.com/labstack/echo/v4/middleware"
 	"github.com/rs/zerolog/log"
 
 	"github.com/ogurilab/school-lunch-api/bootstrap"
@@ -17,13 +18,21 @@ func Run(env bootstrap.Env, query db.Query) {
 	e := echo.New()
 	e.Validator = validator.NewCustomValidator()
 
+	ipExtractor, err := newIPExtractor(env.TrustedProxies)
+
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to configure trusted proxies")
+	}
+
+	e.IPExtractor = ipExtractor
+
 	timeout := time.Duration(env.ContextTimeout) * time.Second
 
-	e.Use(middleware.Logger())
+	e.Use(echomiddleware.RequestID(), middleware.Logger())
 
 	routes.InitRoutes(env, timeout, e, query)
 
-	err := e.Start(env.ServerAddress)
+	err = e.Start(env.ServerAddress)
 
 	if err != nil {
 		log.Fatal().Err(err).Msg("Failed to start server")
@@ -2,6 +2,7 @@ package validator
 
 import (
 	"net/http"
+	"reflect"
 	"time"
 
 	"github.com/go-playground/validator/v10"
@@ -18,8 +19,10 @@ func NewCustomValidator() *CustomValidator {
 	validator := validator.New()
 
 	validator.RegisterValidation("YYYY-MM-DD", ValidDateFormat)
+	validator.RegisterValidation("YYYY-MM", ValidMonthFormat)
 	validator.RegisterValidation("multipleULID", ValidMultipleULID)
 	validator.RegisterValidation("dishes", ValidDishes)
+	validator.RegisterValidation("city_code", ValidCityCode)
 
 	return &CustomValidator{validator: validator}
 }
@@ -41,6 +44,12 @@ func ValidDateFormat(fl validator.FieldLevel) bool {
 	return err == nil
 }
 
+func ValidMonthFormat(fl validator.FieldLevel) bool {
+	_, err := util.ParseMonth(fl.Field().String())
+
+	return err == nil
+}
+
 func ValidMultipleULID(fl validator.FieldLevel) bool {
 
 	ids, ok := fl.Field().Interface().([]string)
@@ -94,3 +103,20 @@ func ValidDishes(fl validator.FieldLevel) bool {
 	}
 	return true
 }
+
+func ValidCityCode(fl validator.FieldLevel) bool {
+	field := fl.Field()
+
+	switch field.Kind() {
+	case reflect.String:
+		_, err := util.ParseCityCode(field.String())
+
+		return err == nil
+	case reflect.Int, reflect.Int32, reflect.Int64:
+		code := field.Int()
+
+		return code > 0 && code < 100000 && util.IsValidCityCode(int32(code))
+	default:
+		return false
+	}
+}
@@ -113,6 +113,56 @@ func TestDateFormat(t *testing.T) {
 	}
 }
 
+func TestMonthFormat(t *testing.T) {
+	validator := NewCustomValidator()
+
+	type input struct {
+		Month string `validate:"required,YYYY-MM"`
+	}
+
+	testCases := []struct {
+		name  string
+		input input
+		check func(err error)
+	}{
+		{
+			name: "valid month",
+			input: input{
+				Month: "2021-01",
+			},
+			check: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "invalid month",
+			input: input{
+				Month: "2021-13",
+			},
+			check: func(err error) {
+				require.Error(t, err)
+			},
+		},
+		{
+			name: "date is not month",
+			input: input{
+				Month: "2021-01-01",
+			},
+			check: func(err error) {
+				require.Error(t, err)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validator.Validate(tc.input)
+
+			tc.check(err)
+		})
+	}
+}
+
 func TestValidateMultipleULIDWithEcho(t *testing.T) {
 	e := echo.New()
 	e.Validator = NewCustomValidator()
@@ -415,3 +465,98 @@ func TestDishes(t *testing.T) {
 		})
 	}
 }
+
+func TestCityCode(t *testing.T) {
+	validator := NewCustomValidator()
+
+	type input struct {
+		Code    string `validate:"required,city_code"`
+		CodeInt int32  `validate:"required,city_code"`
+	}
+
+	testCases := []struct {
+		name  string
+		input input
+		check func(err error)
+	}{
+		{
+			name: "valid 5 digit code",
+			input: input{
+				Code:    "23205",
+				CodeInt: 23205,
+			},
+			check: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "valid 6 digit code",
+			input: input{
+				Code:    "011002",
+				CodeInt: 23205,
+			},
+			check: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "invalid check digit",
+			input: input{
+				Code:    "232051",
+				CodeInt: 23205,
+			},
+			check: func(err error) {
+				require.Error(t, err)
+			},
+		},
+		{
+			// 数値では 011002 が 11002 になり、5桁のコードと区別できない
+			name: "6 digit code int",
+			input: input{
+				Code:    "23205",
+				CodeInt: 232050,
+			},
+			check: func(err error) {
+				require.Error(t, err)
+			},
+		},
+		{
+			name: "invalid prefecture",
+			input: input{
+				Code:    "48201",
+				CodeInt: 23205,
+			},
+			check: func(err error) {
+				require.Error(t, err)
+			},
+		},
+		{
+			name: "not a number",
+			input: input{
+				Code:    "2320a",
+				CodeInt: 23205,
+			},
+			check: func(err error) {
+				require.Error(t, err)
+			},
+		},
+		{
+			name: "too long",
+			input: input{
+				Code:    "2320500",
+				CodeInt: 23205,
+			},
+			check: func(err error) {
+				require.Error(t, err)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validator.Validate(tc.input)
+
+			tc.check(err)
+		})
+	}
+}
@@ -2,6 +2,7 @@ package usecase
 
 import (
 	"context"
+	"database/sql"
 	"time"
 
 	"github.com/ogurilab/school-lunch-api/domain"
@@ -21,6 +22,54 @@ func NewAllergenUsecase(ar domain.AllergenRepository, dr domain.DishRepository,
 	}
 }
 
+func (au *allergenUsecase) Create(ctx context.Context, name string) (*domain.Allergen, error) {
+	ctx, cancel := context.WithTimeout(ctx, au.contextTimeout)
+	defer cancel()
+
+	return au.allergenRepo.Create(ctx, name)
+}
+
+// AttachToDish は料理が存在しない場合や、登録されていないアレルゲンが含まれる場合は sql.ErrNoRows を返す
+func (au *allergenUsecase) AttachToDish(ctx context.Context, dishID string, allergens []*domain.Allergen) error {
+	ctx, cancel := context.WithTimeout(ctx, au.contextTimeout)
+	defer cancel()
+
+	if _, err := au.dishRepo.FindByID(ctx, dishID); err != nil {
+		return err
+	}
+
+	ids := make([]int32, 0, len(allergens))
+	seen := make(map[int32]bool, len(allergens))
+
+	for _, allergen := range allergens {
+		if seen[allergen.ID] {
+			continue
+		}
+
+		seen[allergen.ID] = true
+		ids = append(ids, allergen.ID)
+	}
+
+	found, err := au.allergenRepo.FetchByIDs(ctx, ids)
+
+	if err != nil {
+		return err
+	}
+
+	if len(found) != len(ids) {
+		return sql.ErrNoRows
+	}
+
+	return au.allergenRepo.AttachToDish(ctx, dishID, allergens)
+}
+
+func (au *allergenUsecase) DetachFromDish(ctx context.Context, dishID string, allergenID int32, category domain.AllergenCategory) error {
+	ctx, cancel := context.WithTimeout(ctx, au.contextTimeout)
+	defer cancel()
+
+	return au.allergenRepo.DetachFromDish(ctx, dishID, allergenID, category)
+}
+
 func (au *allergenUsecase) FetchByDishID(ctx context.Context, dishID string) ([]*domain.Allergen, error) {
 	ctx, cancel := context.WithTimeout(ctx, au.contextTimeout)
 	defer cancel()
@@ -38,6 +87,46 @@ func (au *allergenUsecase) FetchByDishID(ctx context.Context, dishID string) ([]
 	return allergens, nil
 }
 
+// FetchByDishIDs は料理IDごとのアレルゲンを返す
+// アレルゲンのない料理はキーを含まない
+func (au *allergenUsecase) FetchByDishIDs(ctx context.Context, dishIDs []string) (map[string][]*domain.Allergen, error) {
+	if len(dishIDs) == 0 {
+		return map[string][]*domain.Allergen{}, nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, au.contextTimeout)
+	defer cancel()
+
+	allergens, err := au.allergenRepo.FetchByDishIDs(ctx, dishIDs)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if allergens == nil {
+		return map[string][]*domain.Allergen{}, nil
+	}
+
+	return allergens, nil
+}
+
+func (au *allergenUsecase) FetchStandard(ctx context.Context) ([]*domain.StandardAllergen, error) {
+	ctx, cancel := context.WithTimeout(ctx, au.contextTimeout)
+	defer cancel()
+
+	allergens, err := au.allergenRepo.FetchStandard(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(allergens) == 0 {
+		return []*domain.StandardAllergen{}, nil
+	}
+
+	return allergens, nil
+}
+
 func (au *allergenUsecase) FetchByMenuID(ctx context.Context, menuID string) ([]*domain.Allergen, error) {
 	dishes, err := au.dishRepo.FetchByMenuID(ctx, menuID)
 
@@ -79,6 +79,74 @@ func TestFetchAllergensByDishID(t *testing.T) {
 	}
 }
 
+func TestFetchAllergensByDishIDs(t *testing.T) {
+	dishes := []*domain.Dish{randomDish(t), randomDish(t)}
+	dishIDs := []string{dishes[0].ID, dishes[1].ID}
+	timeout := time.Second * 10
+	ctx := context.Background()
+	results := map[string][]*domain.Allergen{
+		dishes[0].ID: randomAllergens(t, 3),
+	}
+
+	testCases := []struct {
+		name       string
+		dishIDs    []string
+		buildStubs func(r *mocks.MockAllergenRepository)
+		check      func(t *testing.T, allergens map[string][]*domain.Allergen, err error)
+	}{
+		{
+			name:    "OK",
+			dishIDs: dishIDs,
+			buildStubs: func(r *mocks.MockAllergenRepository) {
+				r.EXPECT().FetchByDishIDs(gomock.Any(), gomock.Eq(dishIDs)).Times(1).Return(results, nil)
+			},
+			check: func(t *testing.T, allergens map[string][]*domain.Allergen, err error) {
+				require.NoError(t, err)
+				require.Equal(t, results, allergens)
+			},
+		},
+		{
+			name:    "NG",
+			dishIDs: dishIDs,
+			buildStubs: func(r *mocks.MockAllergenRepository) {
+				r.EXPECT().FetchByDishIDs(gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrConnDone)
+			},
+			check: func(t *testing.T, allergens map[string][]*domain.Allergen, err error) {
+				require.Error(t, err)
+				require.Nil(t, allergens)
+			},
+		},
+		{
+			name:    "Empty Dish IDs",
+			dishIDs: []string{},
+			buildStubs: func(r *mocks.MockAllergenRepository) {
+				r.EXPECT().FetchByDishIDs(gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, allergens map[string][]*domain.Allergen, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, allergens)
+				require.Empty(t, allergens)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mocks.NewMockAllergenRepository(ctrl)
+			tc.buildStubs(repo)
+
+			au := NewAllergenUsecase(repo, nil, timeout)
+
+			allergens, err := au.FetchByDishIDs(ctx, tc.dishIDs)
+
+			tc.check(t, allergens, err)
+		})
+	}
+}
+
 func TestFetchAllergensByMenuID(t *testing.T) {
 	menu := randomMenu(t)
 
@@ -190,6 +258,69 @@ func TestFetchAllergensByMenuID(t *testing.T) {
 	}
 }
 
+func TestFetchStandardAllergens(t *testing.T) {
+	timeout := time.Second * 10
+	ctx := context.Background()
+	results := []*domain.StandardAllergen{
+		domain.ReNewStandardAllergen(1, "egg", "卵", domain.AllergenKindSpecified),
+		domain.ReNewStandardAllergen(2, "soybean", "大豆", domain.AllergenKindRecommended),
+	}
+
+	testCases := []struct {
+		name       string
+		buildStubs func(r *mocks.MockAllergenRepository)
+		check      func(t *testing.T, allergens []*domain.StandardAllergen, err error)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(r *mocks.MockAllergenRepository) {
+				r.EXPECT().FetchStandard(gomock.Any()).Times(1).Return(results, nil)
+			},
+			check: func(t *testing.T, allergens []*domain.StandardAllergen, err error) {
+				require.NoError(t, err)
+				require.Equal(t, results, allergens)
+			},
+		},
+		{
+			name: "NG",
+			buildStubs: func(r *mocks.MockAllergenRepository) {
+				r.EXPECT().FetchStandard(gomock.Any()).Times(1).Return(nil, sql.ErrConnDone)
+			},
+			check: func(t *testing.T, allergens []*domain.StandardAllergen, err error) {
+				require.Error(t, err)
+				require.Nil(t, allergens)
+			},
+		},
+		{
+			name: "Empty Result",
+			buildStubs: func(r *mocks.MockAllergenRepository) {
+				r.EXPECT().FetchStandard(gomock.Any()).Times(1).Return(nil, nil)
+			},
+			check: func(t *testing.T, allergens []*domain.StandardAllergen, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, allergens)
+				require.Empty(t, allergens)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mocks.NewMockAllergenRepository(ctrl)
+			tc.buildStubs(repo)
+
+			au := NewAllergenUsecase(repo, nil, timeout)
+
+			allergens, err := au.FetchStandard(ctx)
+
+			tc.check(t, allergens, err)
+		})
+	}
+}
+
 func randomAllergens(t *testing.T, length int) []*domain.Allergen {
 
 	allergens := make([]*domain.Allergen, 0, length)
@@ -198,7 +329,8 @@ func randomAllergens(t *testing.T, length int) []*domain.Allergen {
 		allergen := domain.ReNewAllergen(
 			util.RandomInt32(),
 			util.RandomString(10),
-			util.RandomInt32(),
+			domain.AllergenKindSpecified,
+			domain.AllergenCategoryContains,
 		)
 
 		allergens = append(allergens, allergen)
@@ -223,3 +355,197 @@ func createDishIds(dishes []*domain.Dish) []string {
 
 	return dishIds
 }
+
+func TestCreateAllergen(t *testing.T) {
+	timeout := time.Second * 10
+	ctx := context.Background()
+	allergen := randomAllergens(t, 1)[0]
+
+	testCases := []struct {
+		name       string
+		buildStubs func(r *mocks.MockAllergenRepository)
+		check      func(t *testing.T, result *domain.Allergen, err error)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(r *mocks.MockAllergenRepository) {
+				r.EXPECT().Create(gomock.Any(), gomock.Eq(allergen.Name)).Times(1).Return(allergen, nil)
+			},
+			check: func(t *testing.T, result *domain.Allergen, err error) {
+				require.NoError(t, err)
+				require.Equal(t, allergen, result)
+			},
+		},
+		{
+			name: "NG",
+			buildStubs: func(r *mocks.MockAllergenRepository) {
+				r.EXPECT().Create(gomock.Any(), gomock.Eq(allergen.Name)).Times(1).Return(nil, sql.ErrConnDone)
+			},
+			check: func(t *testing.T, result *domain.Allergen, err error) {
+				require.Error(t, err)
+				require.Nil(t, result)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mocks.NewMockAllergenRepository(ctrl)
+			tc.buildStubs(repo)
+
+			au := NewAllergenUsecase(repo, nil, timeout)
+
+			result, err := au.Create(ctx, allergen.Name)
+
+			tc.check(t, result, err)
+		})
+	}
+}
+
+func TestAttachAllergensToDish(t *testing.T) {
+	dish := randomDish(t)
+	timeout := time.Second * 10
+	ctx := context.Background()
+	allergens := randomAllergens(t, 5)
+
+	// 同じアレルゲンを含むものと混入の可能性があるものの両方で登録する
+	mayContain := domain.ReNewAllergen(allergens[0].ID, allergens[0].Name, allergens[0].Kind, domain.AllergenCategoryMayContain)
+	attached := append(append([]*domain.Allergen{}, allergens...), mayContain)
+
+	ids := make([]int32, 0, len(allergens))
+
+	for _, allergen := range allergens {
+		ids = append(ids, allergen.ID)
+	}
+
+	testCases := []struct {
+		name       string
+		buildStubs func(r *mocks.MockAllergenRepository, dr *mocks.MockDishRepository)
+		check      func(t *testing.T, err error)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(r *mocks.MockAllergenRepository, dr *mocks.MockDishRepository) {
+				dr.EXPECT().FindByID(gomock.Any(), gomock.Eq(dish.ID)).Times(1).Return(dish, nil)
+				r.EXPECT().FetchByIDs(gomock.Any(), gomock.Eq(ids)).Times(1).Return(allergens, nil)
+				r.EXPECT().AttachToDish(gomock.Any(), gomock.Eq(dish.ID), gomock.Eq(attached)).Times(1).Return(nil)
+			},
+			check: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "Dish Not Found",
+			buildStubs: func(r *mocks.MockAllergenRepository, dr *mocks.MockDishRepository) {
+				dr.EXPECT().FindByID(gomock.Any(), gomock.Eq(dish.ID)).Times(1).Return(nil, sql.ErrNoRows)
+				r.EXPECT().FetchByIDs(gomock.Any(), gomock.Any()).Times(0)
+				r.EXPECT().AttachToDish(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, err error) {
+				require.ErrorIs(t, err, sql.ErrNoRows)
+			},
+		},
+		{
+			name: "Allergen Not Found",
+			buildStubs: func(r *mocks.MockAllergenRepository, dr *mocks.MockDishRepository) {
+				dr.EXPECT().FindByID(gomock.Any(), gomock.Eq(dish.ID)).Times(1).Return(dish, nil)
+				r.EXPECT().FetchByIDs(gomock.Any(), gomock.Eq(ids)).Times(1).Return(allergens[1:], nil)
+				r.EXPECT().AttachToDish(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, err error) {
+				require.ErrorIs(t, err, sql.ErrNoRows)
+			},
+		},
+		{
+			name: "Fetch Error",
+			buildStubs: func(r *mocks.MockAllergenRepository, dr *mocks.MockDishRepository) {
+				dr.EXPECT().FindByID(gomock.Any(), gomock.Eq(dish.ID)).Times(1).Return(dish, nil)
+				r.EXPECT().FetchByIDs(gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrConnDone)
+				r.EXPECT().AttachToDish(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, err error) {
+				require.ErrorIs(t, err, sql.ErrConnDone)
+			},
+		},
+		{
+			name: "NG",
+			buildStubs: func(r *mocks.MockAllergenRepository, dr *mocks.MockDishRepository) {
+				dr.EXPECT().FindByID(gomock.Any(), gomock.Eq(dish.ID)).Times(1).Return(dish, nil)
+				r.EXPECT().FetchByIDs(gomock.Any(), gomock.Eq(ids)).Times(1).Return(allergens, nil)
+				r.EXPECT().AttachToDish(gomock.Any(), gomock.Eq(dish.ID), gomock.Eq(attached)).Times(1).Return(sql.ErrConnDone)
+			},
+			check: func(t *testing.T, err error) {
+				require.Error(t, err)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mocks.NewMockAllergenRepository(ctrl)
+			dishRepo := mocks.NewMockDishRepository(ctrl)
+			tc.buildStubs(repo, dishRepo)
+
+			au := NewAllergenUsecase(repo, dishRepo, timeout)
+
+			err := au.AttachToDish(ctx, dish.ID, attached)
+
+			tc.check(t, err)
+		})
+	}
+}
+
+func TestDetachAllergenFromDish(t *testing.T) {
+	dish := randomDish(t)
+	timeout := time.Second * 10
+	ctx := context.Background()
+	allergenID := util.RandomInt32()
+	category := domain.AllergenCategoryMayContain
+
+	testCases := []struct {
+		name       string
+		buildStubs func(r *mocks.MockAllergenRepository)
+		check      func(t *testing.T, err error)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(r *mocks.MockAllergenRepository) {
+				r.EXPECT().DetachFromDish(gomock.Any(), gomock.Eq(dish.ID), gomock.Eq(allergenID), gomock.Eq(category)).Times(1).Return(nil)
+			},
+			check: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "Not Found",
+			buildStubs: func(r *mocks.MockAllergenRepository) {
+				r.EXPECT().DetachFromDish(gomock.Any(), gomock.Eq(dish.ID), gomock.Eq(allergenID), gomock.Eq(category)).Times(1).Return(sql.ErrNoRows)
+			},
+			check: func(t *testing.T, err error) {
+				require.ErrorIs(t, err, sql.ErrNoRows)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mocks.NewMockAllergenRepository(ctrl)
+			tc.buildStubs(repo)
+
+			au := NewAllergenUsecase(repo, nil, timeout)
+
+			err := au.DetachFromDish(ctx, dish.ID, allergenID, category)
+
+			tc.check(t, err)
+		})
+	}
+}
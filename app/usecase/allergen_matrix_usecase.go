@@ -0,0 +1,221 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"time"
+
+	"github.com/ogurilab/school-lunch-api/domain"
+)
+
+// allergenMatrixCSVHeader はアレルゲンの列の前に置く列名
+var allergenMatrixCSVHeader = []string{"提供日", "料理"}
+
+type allergenMatrixUsecase struct {
+	allergenRepo   domain.AllergenRepository
+	cityRepo       domain.CityRepository
+	encoder        domain.SpreadsheetEncoder
+	renderer       domain.AllergenMatrixRenderer
+	contextTimeout time.Duration
+}
+
+func NewAllergenMatrixUsecase(ar domain.AllergenRepository, cr domain.CityRepository, encoder domain.SpreadsheetEncoder, renderer domain.AllergenMatrixRenderer, timeout time.Duration) domain.AllergenMatrixUsecase {
+	return &allergenMatrixUsecase{
+		allergenRepo:   ar,
+		cityRepo:       cr,
+		encoder:        encoder,
+		renderer:       renderer,
+		contextTimeout: timeout,
+	}
+}
+
+// FetchByCity は month のアレルギー一覧表を返す
+// 自治体が存在しない場合は sql.ErrNoRows を返す
+func (mu *allergenMatrixUsecase) FetchByCity(ctx context.Context, month time.Time, city int32) (*domain.AllergenMatrix, error) {
+
+	ctx, cancel := context.WithTimeout(ctx, mu.contextTimeout)
+	defer cancel()
+
+	c, err := mu.cityRepo.GetByCityCode(ctx, city)
+
+	if err != nil {
+		return nil, err
+	}
+
+	start := time.Date(month.Year(), month.Month(), 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 1, -1)
+
+	dishes, err := mu.allergenRepo.FetchMenuDishesByCity(ctx, start, end, city)
+
+	if err != nil {
+		return nil, err
+	}
+
+	standard, err := mu.allergenRepo.FetchStandard(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return newAllergenMatrix(c, start, standard, dishes), nil
+}
+
+// ExportCSVByCity は month のアレルギー一覧表を、料理を行、アレルゲンを列とする CSV で返す
+func (mu *allergenMatrixUsecase) ExportCSVByCity(ctx context.Context, month time.Time, city int32) ([]byte, error) {
+	matrix, err := mu.FetchByCity(ctx, month, city)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+
+	writer, err := mu.encoder.NewWriter(&buf, domain.ExportFormatCSV, matrix.Month.Format("2006-01"))
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, record := range formatAllergenMatrixCSV(matrix) {
+		if err := writer.WriteRow(record); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// ExportPDFByCity は month のアレルギー一覧表を印刷用の PDF で返す
+func (mu *allergenMatrixUsecase) ExportPDFByCity(ctx context.Context, month time.Time, city int32) ([]byte, error) {
+	matrix, err := mu.FetchByCity(ctx, month, city)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+
+	if err := mu.renderer.Render(&buf, matrix); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// newAllergenMatrix は料理ごとのアレルゲンから一覧表を作る
+// 列は全ての特定原材料と特定原材料に準ずるものに、月の料理に含まれるその他のアレルゲンを名前順で加える
+func newAllergenMatrix(city *domain.City, month time.Time, standard []*domain.StandardAllergen, dishes []*domain.MenuDishAllergens) *domain.AllergenMatrix {
+	columns := make([]*domain.AllergenMatrixColumn, 0, len(standard))
+	known := make(map[int32]bool, len(standard))
+
+	for _, allergen := range standard {
+		columns = append(columns, &domain.AllergenMatrixColumn{
+			ID:        allergen.ID,
+			Name:      allergen.Name,
+			Kind:      allergen.Kind,
+			KindLabel: allergen.KindLabel,
+		})
+
+		known[allergen.ID] = true
+	}
+
+	var others []*domain.AllergenMatrixColumn
+
+	rows := make([]*domain.AllergenMatrixRow, 0, len(dishes))
+
+	for _, dish := range dishes {
+		row := &domain.AllergenMatrixRow{
+			MenuID:     dish.MenuID,
+			OfferedAt:  dish.OfferedAt,
+			DishID:     dish.DishID,
+			DishName:   dish.DishName,
+			Contains:   make([]int32, 0),
+			MayContain: make([]int32, 0),
+		}
+
+		contains := make(map[int32]bool)
+
+		for _, allergen := range dish.Allergens {
+			if allergen.Category == domain.AllergenCategoryContains && !contains[allergen.ID] {
+				row.Contains = append(row.Contains, allergen.ID)
+				contains[allergen.ID] = true
+			}
+
+			if !known[allergen.ID] {
+				others = append(others, &domain.AllergenMatrixColumn{
+					ID:        allergen.ID,
+					Name:      allergen.Name,
+					Kind:      allergen.Kind,
+					KindLabel: allergen.KindLabel,
+				})
+
+				known[allergen.ID] = true
+			}
+		}
+
+		// 原材料として含むアレルゲンは、混入の可能性としては扱わない
+		for _, allergen := range dish.Allergens {
+			if allergen.Category == domain.AllergenCategoryMayContain && !contains[allergen.ID] {
+				row.MayContain = append(row.MayContain, allergen.ID)
+				contains[allergen.ID] = true
+			}
+		}
+
+		rows = append(rows, row)
+	}
+
+	sort.SliceStable(others, func(i, j int) bool {
+		if others[i].Name == others[j].Name {
+			return others[i].ID < others[j].ID
+		}
+
+		return others[i].Name < others[j].Name
+	})
+
+	return &domain.AllergenMatrix{
+		City:      city,
+		Month:     month,
+		Allergens: append(columns, others...),
+		Rows:      rows,
+	}
+}
+
+// formatAllergenMatrixCSV は一覧表をヘッダーを含む CSV の行にする
+// 含まれ方は AllergenCategory.Symbol の記号で示し、含まれないアレルゲンは空にする
+func formatAllergenMatrixCSV(matrix *domain.AllergenMatrix) [][]string {
+	records := make([][]string, 0, len(matrix.Rows)+1)
+
+	header := make([]string, 0, len(allergenMatrixCSVHeader)+len(matrix.Allergens))
+	header = append(header, allergenMatrixCSVHeader...)
+
+	for _, allergen := range matrix.Allergens {
+		header = append(header, allergen.Name)
+	}
+
+	records = append(records, header)
+
+	for _, row := range matrix.Rows {
+		record := make([]string, 0, len(header))
+		record = append(record, row.OfferedAt.Format("2006-01-02"), row.DishName)
+
+		for _, allergen := range matrix.Allergens {
+			category, ok := row.Category(allergen.ID)
+
+			if !ok {
+				record = append(record, "")
+				continue
+			}
+
+			record = append(record, category.Symbol())
+		}
+
+		records = append(records, record)
+	}
+
+	return records
+}
@@ -0,0 +1,269 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/ogurilab/school-lunch-api/domain"
+	"github.com/ogurilab/school-lunch-api/domain/mocks"
+	"github.com/ogurilab/school-lunch-api/util"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestFetchAllergenMatrixByCity(t *testing.T) {
+	city := randomCity()
+	month := time.Date(2024, 5, 15, 0, 0, 0, 0, time.UTC)
+	start := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 5, 31, 0, 0, 0, 0, time.UTC)
+	timeout := time.Second * 10
+
+	standard := []*domain.StandardAllergen{
+		domain.ReNewStandardAllergen(1, "egg", "卵", domain.AllergenKindSpecified),
+		domain.ReNewStandardAllergen(2, "milk", "乳", domain.AllergenKindSpecified),
+		domain.ReNewStandardAllergen(3, "soybean", "大豆", domain.AllergenKindRecommended),
+	}
+
+	menuID := util.NewUlid()
+	dishes := []*domain.MenuDishAllergens{
+		{
+			MenuID:    menuID,
+			OfferedAt: start,
+			DishID:    util.NewUlid(),
+			DishName:  "オムレツ",
+			Allergens: []*domain.Allergen{
+				domain.ReNewAllergen(1, "卵", domain.AllergenKindSpecified, domain.AllergenCategoryContains),
+				domain.ReNewAllergen(2, "乳", domain.AllergenKindSpecified, domain.AllergenCategoryContains),
+				domain.ReNewAllergen(2, "乳", domain.AllergenKindSpecified, domain.AllergenCategoryMayContain),
+				domain.ReNewAllergen(3, "大豆", domain.AllergenKindRecommended, domain.AllergenCategoryMayContain),
+			},
+		},
+		{
+			MenuID:    menuID,
+			OfferedAt: start,
+			DishID:    util.NewUlid(),
+			DishName:  "ごまあえ",
+			Allergens: []*domain.Allergen{
+				domain.ReNewAllergen(10, "ごま油", domain.AllergenKindOther, domain.AllergenCategoryMayContain),
+				domain.ReNewAllergen(9, "からし", domain.AllergenKindOther, domain.AllergenCategoryContains),
+			},
+		},
+		{
+			MenuID:    menuID,
+			OfferedAt: start,
+			DishID:    util.NewUlid(),
+			DishName:  "ごはん",
+			Allergens: []*domain.Allergen{},
+		},
+	}
+
+	testCases := []struct {
+		name       string
+		buildStubs func(ar *mocks.MockAllergenRepository, cr *mocks.MockCityRepository)
+		check      func(t *testing.T, matrix *domain.AllergenMatrix, err error)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(ar *mocks.MockAllergenRepository, cr *mocks.MockCityRepository) {
+				cr.EXPECT().GetByCityCode(gomock.Any(), gomock.Eq(city.CityCode)).Times(1).Return(city, nil)
+				ar.EXPECT().FetchMenuDishesByCity(gomock.Any(), gomock.Eq(start), gomock.Eq(end), gomock.Eq(city.CityCode)).Times(1).Return(dishes, nil)
+				ar.EXPECT().FetchStandard(gomock.Any()).Times(1).Return(standard, nil)
+			},
+			check: func(t *testing.T, matrix *domain.AllergenMatrix, err error) {
+				require.NoError(t, err)
+				require.Equal(t, city, matrix.City)
+				require.Equal(t, start, matrix.Month)
+
+				// 標準の品目の後に、その他のアレルゲンが名前順で続く
+				names := make([]string, 0, len(matrix.Allergens))
+
+				for _, allergen := range matrix.Allergens {
+					names = append(names, allergen.Name)
+				}
+
+				require.Equal(t, []string{"卵", "乳", "大豆", "からし", "ごま油"}, names)
+				require.Equal(t, "その他", matrix.Allergens[3].KindLabel)
+
+				require.Len(t, matrix.Rows, 3)
+				require.Equal(t, "オムレツ", matrix.Rows[0].DishName)
+
+				// 原材料として含む乳は、混入の可能性には入れない
+				require.Equal(t, []int32{1, 2}, matrix.Rows[0].Contains)
+				require.Equal(t, []int32{3}, matrix.Rows[0].MayContain)
+
+				require.Equal(t, []int32{9}, matrix.Rows[1].Contains)
+				require.Equal(t, []int32{10}, matrix.Rows[1].MayContain)
+
+				require.NotNil(t, matrix.Rows[2].Contains)
+				require.Empty(t, matrix.Rows[2].Contains)
+				require.Empty(t, matrix.Rows[2].MayContain)
+			},
+		},
+		{
+			name: "OK - Empty",
+			buildStubs: func(ar *mocks.MockAllergenRepository, cr *mocks.MockCityRepository) {
+				cr.EXPECT().GetByCityCode(gomock.Any(), gomock.Any()).Times(1).Return(city, nil)
+				ar.EXPECT().FetchMenuDishesByCity(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return([]*domain.MenuDishAllergens{}, nil)
+				ar.EXPECT().FetchStandard(gomock.Any()).Times(1).Return(standard, nil)
+			},
+			check: func(t *testing.T, matrix *domain.AllergenMatrix, err error) {
+				require.NoError(t, err)
+				require.Len(t, matrix.Allergens, len(standard))
+				require.NotNil(t, matrix.Rows)
+				require.Empty(t, matrix.Rows)
+			},
+		},
+		{
+			name: "City Not Found",
+			buildStubs: func(ar *mocks.MockAllergenRepository, cr *mocks.MockCityRepository) {
+				cr.EXPECT().GetByCityCode(gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrNoRows)
+				ar.EXPECT().FetchMenuDishesByCity(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, matrix *domain.AllergenMatrix, err error) {
+				require.ErrorIs(t, err, sql.ErrNoRows)
+				require.Nil(t, matrix)
+			},
+		},
+		{
+			name: "Dish Error",
+			buildStubs: func(ar *mocks.MockAllergenRepository, cr *mocks.MockCityRepository) {
+				cr.EXPECT().GetByCityCode(gomock.Any(), gomock.Any()).Times(1).Return(city, nil)
+				ar.EXPECT().FetchMenuDishesByCity(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrConnDone)
+				ar.EXPECT().FetchStandard(gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, matrix *domain.AllergenMatrix, err error) {
+				require.ErrorIs(t, err, sql.ErrConnDone)
+				require.Nil(t, matrix)
+			},
+		},
+		{
+			name: "Standard Error",
+			buildStubs: func(ar *mocks.MockAllergenRepository, cr *mocks.MockCityRepository) {
+				cr.EXPECT().GetByCityCode(gomock.Any(), gomock.Any()).Times(1).Return(city, nil)
+				ar.EXPECT().FetchMenuDishesByCity(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(dishes, nil)
+				ar.EXPECT().FetchStandard(gomock.Any()).Times(1).Return(nil, sql.ErrConnDone)
+			},
+			check: func(t *testing.T, matrix *domain.AllergenMatrix, err error) {
+				require.ErrorIs(t, err, sql.ErrConnDone)
+				require.Nil(t, matrix)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ar := mocks.NewMockAllergenRepository(ctrl)
+			cr := mocks.NewMockCityRepository(ctrl)
+			tc.buildStubs(ar, cr)
+
+			mu := NewAllergenMatrixUsecase(ar, cr, mocks.NewMockSpreadsheetEncoder(ctrl), mocks.NewMockAllergenMatrixRenderer(ctrl), timeout)
+
+			matrix, err := mu.FetchByCity(context.Background(), month, city.CityCode)
+
+			tc.check(t, matrix, err)
+		})
+	}
+}
+
+func TestExportAllergenMatrixByCity(t *testing.T) {
+	city := randomCity()
+	month := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	timeout := time.Second * 10
+
+	standard := []*domain.StandardAllergen{
+		domain.ReNewStandardAllergen(1, "egg", "卵", domain.AllergenKindSpecified),
+		domain.ReNewStandardAllergen(2, "milk", "乳", domain.AllergenKindSpecified),
+	}
+
+	dishes := []*domain.MenuDishAllergens{
+		{
+			MenuID:    util.NewUlid(),
+			OfferedAt: month,
+			DishID:    util.NewUlid(),
+			DishName:  "オムレツ",
+			Allergens: []*domain.Allergen{
+				domain.ReNewAllergen(1, "卵", domain.AllergenKindSpecified, domain.AllergenCategoryContains),
+				domain.ReNewAllergen(2, "乳", domain.AllergenKindSpecified, domain.AllergenCategoryMayContain),
+			},
+		},
+	}
+
+	buildStubs := func(ar *mocks.MockAllergenRepository, cr *mocks.MockCityRepository) {
+		cr.EXPECT().GetByCityCode(gomock.Any(), gomock.Eq(city.CityCode)).Times(1).Return(city, nil)
+		ar.EXPECT().FetchMenuDishesByCity(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(dishes, nil)
+		ar.EXPECT().FetchStandard(gomock.Any()).Times(1).Return(standard, nil)
+	}
+
+	t.Run("CSV", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ar := mocks.NewMockAllergenRepository(ctrl)
+		cr := mocks.NewMockCityRepository(ctrl)
+		encoder := mocks.NewMockSpreadsheetEncoder(ctrl)
+		writer := &recordingSpreadsheetWriter{}
+		buildStubs(ar, cr)
+
+		encoder.EXPECT().NewWriter(gomock.Any(), gomock.Eq(domain.ExportFormatCSV), gomock.Eq("2024-05")).Times(1).Return(writer, nil)
+
+		mu := NewAllergenMatrixUsecase(ar, cr, encoder, mocks.NewMockAllergenMatrixRenderer(ctrl), timeout)
+
+		_, err := mu.ExportCSVByCity(context.Background(), month, city.CityCode)
+
+		require.NoError(t, err)
+		require.True(t, writer.closed)
+		require.Equal(t, [][]string{
+			{"提供日", "料理", "卵", "乳"},
+			{"2024-05-01", "オムレツ", "●", "△"},
+		}, writer.rows)
+	})
+
+	t.Run("PDF", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ar := mocks.NewMockAllergenRepository(ctrl)
+		cr := mocks.NewMockCityRepository(ctrl)
+		renderer := mocks.NewMockAllergenMatrixRenderer(ctrl)
+		buildStubs(ar, cr)
+
+		renderer.EXPECT().Render(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(func(w io.Writer, matrix *domain.AllergenMatrix) error {
+			require.Equal(t, city, matrix.City)
+			require.Len(t, matrix.Rows, 1)
+
+			_, err := w.Write([]byte("%PDF-1.4"))
+			return err
+		})
+
+		mu := NewAllergenMatrixUsecase(ar, cr, mocks.NewMockSpreadsheetEncoder(ctrl), renderer, timeout)
+
+		body, err := mu.ExportPDFByCity(context.Background(), month, city.CityCode)
+
+		require.NoError(t, err)
+		require.Equal(t, "%PDF-1.4", string(body))
+	})
+
+	t.Run("City Not Found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		cr := mocks.NewMockCityRepository(ctrl)
+		renderer := mocks.NewMockAllergenMatrixRenderer(ctrl)
+
+		cr.EXPECT().GetByCityCode(gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrNoRows)
+		renderer.EXPECT().Render(gomock.Any(), gomock.Any()).Times(0)
+
+		mu := NewAllergenMatrixUsecase(mocks.NewMockAllergenRepository(ctrl), cr, mocks.NewMockSpreadsheetEncoder(ctrl), renderer, timeout)
+
+		body, err := mu.ExportPDFByCity(context.Background(), month, city.CityCode)
+
+		require.ErrorIs(t, err, sql.ErrNoRows)
+		require.Nil(t, body)
+	})
+}
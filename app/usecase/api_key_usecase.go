@@ -0,0 +1,92 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/ogurilab/school-lunch-api/domain"
+	"github.com/ogurilab/school-lunch-api/util"
+)
+
+type apiKeyUsecase struct {
+	apiKeyRepo     domain.APIKeyRepository
+	contextTimeout time.Duration
+}
+
+func NewAPIKeyUsecase(kr domain.APIKeyRepository, timeout time.Duration) domain.APIKeyUsecase {
+	return &apiKeyUsecase{
+		apiKeyRepo:     kr,
+		contextTimeout: timeout,
+	}
+}
+
+// Create はキーを生成し、ハッシュ化した値を保存する
+// 生成したキーはレスポンスでのみ返す
+func (u *apiKeyUsecase) Create(ctx context.Context, key *domain.APIKey) (*domain.IssuedAPIKey, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	raw, prefix, err := util.GenerateAPIKey()
+
+	if err != nil {
+		return nil, err
+	}
+
+	key.Prefix = prefix
+	key.HashedKey = util.HashAPIKey(raw)
+
+	created, err := u.apiKeyRepo.Create(ctx, key)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.IssuedAPIKey{
+		APIKey: created,
+		Key:    raw,
+	}, nil
+}
+
+func (u *apiKeyUsecase) GetByID(ctx context.Context, id int32) (*domain.APIKey, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	return u.apiKeyRepo.GetByID(ctx, id)
+}
+
+func (u *apiKeyUsecase) Fetch(ctx context.Context, limit int32, offset int32) ([]*domain.APIKey, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	return u.apiKeyRepo.Fetch(ctx, limit, offset)
+}
+
+func (u *apiKeyUsecase) Revoke(ctx context.Context, id int32) error {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	return u.apiKeyRepo.Revoke(ctx, id)
+}
+
+// Authenticate はリクエストで送られたキーに一致する有効なキーを返す
+func (u *apiKeyUsecase) Authenticate(ctx context.Context, key string) (*domain.APIKey, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	apiKey, err := u.apiKeyRepo.GetByHashedKey(ctx, util.HashAPIKey(key))
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domain.ErrInvalidAPIKey
+		}
+
+		return nil, err
+	}
+
+	if apiKey.Revoked {
+		return nil, domain.ErrAPIKeyRevoked
+	}
+
+	return apiKey, nil
+}
@@ -0,0 +1,113 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ogurilab/school-lunch-api/domain"
+	"github.com/ogurilab/school-lunch-api/domain/mocks"
+	"github.com/ogurilab/school-lunch-api/util"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCreateAPIKey(t *testing.T) {
+	key := domain.NewAPIKey(util.RandomString(10), 60, 10, 1000)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mocks.NewMockAPIKeyRepository(ctrl)
+	repo.EXPECT().Create(gomock.Any(), gomock.Any()).Times(1).
+		DoAndReturn(func(_ context.Context, k *domain.APIKey) (*domain.APIKey, error) {
+			require.NotEmpty(t, k.Prefix)
+			require.Len(t, k.HashedKey, 64)
+
+			return k, nil
+		})
+
+	uc := NewAPIKeyUsecase(repo, 10*time.Second)
+
+	issued, err := uc.Create(context.Background(), key)
+
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(issued.Key, issued.Prefix))
+
+	// 保存するのはハッシュ化した値のみ
+	require.NotEqual(t, issued.Key, issued.HashedKey)
+	require.Equal(t, util.HashAPIKey(issued.Key), issued.HashedKey)
+}
+
+func TestAuthenticateAPIKey(t *testing.T) {
+	raw := "slk_" + util.RandomString(48)
+	key := domain.ReNewAPIKey(1, util.RandomString(10), raw[:12], util.HashAPIKey(raw), 60, 10, 1000, false, time.Now())
+
+	revoked := *key
+	revoked.Revoked = true
+
+	testCases := []struct {
+		name      string
+		buildStub func(repo *mocks.MockAPIKeyRepository)
+		check     func(t *testing.T, res *domain.APIKey, err error)
+	}{
+		{
+			name: "OK",
+			buildStub: func(repo *mocks.MockAPIKeyRepository) {
+				repo.EXPECT().GetByHashedKey(gomock.Any(), gomock.Eq(key.HashedKey)).Times(1).Return(key, nil)
+			},
+			check: func(t *testing.T, res *domain.APIKey, err error) {
+				require.NoError(t, err)
+				require.Equal(t, key, res)
+			},
+		},
+		{
+			name: "Invalid Key",
+			buildStub: func(repo *mocks.MockAPIKeyRepository) {
+				repo.EXPECT().GetByHashedKey(gomock.Any(), gomock.Eq(key.HashedKey)).Times(1).Return(nil, sql.ErrNoRows)
+			},
+			check: func(t *testing.T, res *domain.APIKey, err error) {
+				require.ErrorIs(t, err, domain.ErrInvalidAPIKey)
+				require.Nil(t, res)
+			},
+		},
+		{
+			name: "Revoked",
+			buildStub: func(repo *mocks.MockAPIKeyRepository) {
+				repo.EXPECT().GetByHashedKey(gomock.Any(), gomock.Eq(key.HashedKey)).Times(1).Return(&revoked, nil)
+			},
+			check: func(t *testing.T, res *domain.APIKey, err error) {
+				require.ErrorIs(t, err, domain.ErrAPIKeyRevoked)
+				require.Nil(t, res)
+			},
+		},
+		{
+			name: "Internal Server Error",
+			buildStub: func(repo *mocks.MockAPIKeyRepository) {
+				repo.EXPECT().GetByHashedKey(gomock.Any(), gomock.Eq(key.HashedKey)).Times(1).Return(nil, sql.ErrConnDone)
+			},
+			check: func(t *testing.T, res *domain.APIKey, err error) {
+				require.ErrorIs(t, err, sql.ErrConnDone)
+				require.Nil(t, res)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mocks.NewMockAPIKeyRepository(ctrl)
+			tc.buildStub(repo)
+
+			uc := NewAPIKeyUsecase(repo, 10*time.Second)
+
+			res, err := uc.Authenticate(context.Background(), raw)
+
+			tc.check(t, res, err)
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/ogurilab/school-lunch-api/domain"
+)
+
+type auditLogUsecase struct {
+	auditLogRepo   domain.AuditLogRepository
+	contextTimeout time.Duration
+}
+
+func NewAuditLogUsecase(lr domain.AuditLogRepository, timeout time.Duration) domain.AuditLogUsecase {
+	return &auditLogUsecase{
+		auditLogRepo:   lr,
+		contextTimeout: timeout,
+	}
+}
+
+func (u *auditLogUsecase) Record(ctx context.Context, log *domain.AuditLog) error {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	return u.auditLogRepo.Create(ctx, log)
+}
+
+func (u *auditLogUsecase) Fetch(ctx context.Context, filter *domain.AuditLogFilter) ([]*domain.AuditLog, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	return u.auditLogRepo.Fetch(ctx, filter)
+}
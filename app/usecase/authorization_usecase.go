@@ -0,0 +1,97 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/ogurilab/school-lunch-api/domain"
+)
+
+type authorizationUsecase struct {
+	menuRepo       domain.MenuRepository
+	dishRepo       domain.DishRepository
+	contextTimeout time.Duration
+}
+
+func NewAuthorizationUsecase(
+	mr domain.MenuRepository,
+	dr domain.DishRepository,
+	timeout time.Duration,
+) domain.AuthorizationUsecase {
+	return &authorizationUsecase{
+		menuRepo:       mr,
+		dishRepo:       dr,
+		contextTimeout: timeout,
+	}
+}
+
+func (au *authorizationUsecase) AuthorizeCity(ctx context.Context, payload *domain.TokenPayload, cityCode int32) error {
+	switch payload.Role {
+	case domain.UserRoleAdmin:
+		return nil
+	case domain.UserRoleMunicipality:
+		if payload.CityCode == cityCode {
+			return nil
+		}
+	}
+
+	return domain.ErrForbidden
+}
+
+func (au *authorizationUsecase) AuthorizeMenu(ctx context.Context, payload *domain.TokenPayload, menuID string) error {
+	switch payload.Role {
+	case domain.UserRoleAdmin:
+		return nil
+	case domain.UserRoleMunicipality:
+	default:
+		return domain.ErrForbidden
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, au.contextTimeout)
+	defer cancel()
+
+	menu, err := au.menuRepo.FindByID(ctx, menuID)
+
+	if err != nil {
+		return err
+	}
+
+	return au.AuthorizeCity(ctx, payload, menu.CityCode)
+}
+
+// AuthorizeDish は料理を利用しているすべての献立が自分の市区町村のものである場合のみ許可する
+// 他の市区町村と共有している料理は admin のみ更新できる
+func (au *authorizationUsecase) AuthorizeDish(ctx context.Context, payload *domain.TokenPayload, dishID string) error {
+	switch payload.Role {
+	case domain.UserRoleAdmin:
+		return nil
+	case domain.UserRoleMunicipality:
+	default:
+		return domain.ErrForbidden
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, au.contextTimeout)
+	defer cancel()
+
+	if _, err := au.dishRepo.FindByID(ctx, dishID); err != nil {
+		return err
+	}
+
+	cityCodes, err := au.dishRepo.FetchCityCodes(ctx, dishID)
+
+	if err != nil {
+		return err
+	}
+
+	if len(cityCodes) == 0 {
+		return domain.ErrForbidden
+	}
+
+	for _, cityCode := range cityCodes {
+		if err := au.AuthorizeCity(ctx, payload, cityCode); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,250 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/ogurilab/school-lunch-api/domain"
+	"github.com/ogurilab/school-lunch-api/domain/mocks"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestAuthorizeCity(t *testing.T) {
+	cityCode := int32(23205)
+
+	testCases := []struct {
+		name     string
+		payload  *domain.TokenPayload
+		cityCode int32
+		check    func(t *testing.T, err error)
+	}{
+		{
+			name:     "Admin",
+			payload:  &domain.TokenPayload{Role: domain.UserRoleAdmin},
+			cityCode: cityCode,
+			check: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:     "Municipality Own City",
+			payload:  &domain.TokenPayload{Role: domain.UserRoleMunicipality, CityCode: cityCode},
+			cityCode: cityCode,
+			check: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:     "Municipality Other City",
+			payload:  &domain.TokenPayload{Role: domain.UserRoleMunicipality, CityCode: cityCode},
+			cityCode: cityCode + 1,
+			check: func(t *testing.T, err error) {
+				require.ErrorIs(t, err, domain.ErrForbidden)
+			},
+		},
+		{
+			name:     "Guest",
+			payload:  &domain.TokenPayload{Role: domain.UserRoleGuest, CityCode: cityCode},
+			cityCode: cityCode,
+			check: func(t *testing.T, err error) {
+				require.ErrorIs(t, err, domain.ErrForbidden)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mr := mocks.NewMockMenuRepository(ctrl)
+			dr := mocks.NewMockDishRepository(ctrl)
+
+			uc := NewAuthorizationUsecase(mr, dr, 10*time.Second)
+
+			err := uc.AuthorizeCity(context.Background(), tc.payload, tc.cityCode)
+
+			tc.check(t, err)
+		})
+	}
+}
+
+func TestAuthorizeMenu(t *testing.T) {
+	menu := randomMenu(t)
+
+	testCases := []struct {
+		name      string
+		payload   *domain.TokenPayload
+		buildStub func(mr *mocks.MockMenuRepository)
+		check     func(t *testing.T, err error)
+	}{
+		{
+			name:    "Admin",
+			payload: &domain.TokenPayload{Role: domain.UserRoleAdmin},
+			buildStub: func(mr *mocks.MockMenuRepository) {
+				mr.EXPECT().FindByID(gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:    "Municipality Own City",
+			payload: &domain.TokenPayload{Role: domain.UserRoleMunicipality, CityCode: menu.CityCode},
+			buildStub: func(mr *mocks.MockMenuRepository) {
+				mr.EXPECT().FindByID(gomock.Any(), gomock.Eq(menu.ID)).Times(1).Return(menu, nil)
+			},
+			check: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:    "Municipality Other City",
+			payload: &domain.TokenPayload{Role: domain.UserRoleMunicipality, CityCode: menu.CityCode + 1},
+			buildStub: func(mr *mocks.MockMenuRepository) {
+				mr.EXPECT().FindByID(gomock.Any(), gomock.Eq(menu.ID)).Times(1).Return(menu, nil)
+			},
+			check: func(t *testing.T, err error) {
+				require.ErrorIs(t, err, domain.ErrForbidden)
+			},
+		},
+		{
+			name:    "Not Found",
+			payload: &domain.TokenPayload{Role: domain.UserRoleMunicipality, CityCode: menu.CityCode},
+			buildStub: func(mr *mocks.MockMenuRepository) {
+				mr.EXPECT().FindByID(gomock.Any(), gomock.Eq(menu.ID)).Times(1).Return(nil, sql.ErrNoRows)
+			},
+			check: func(t *testing.T, err error) {
+				require.ErrorIs(t, err, sql.ErrNoRows)
+			},
+		},
+		{
+			name:    "Guest",
+			payload: &domain.TokenPayload{Role: domain.UserRoleGuest, CityCode: menu.CityCode},
+			buildStub: func(mr *mocks.MockMenuRepository) {
+				mr.EXPECT().FindByID(gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, err error) {
+				require.ErrorIs(t, err, domain.ErrForbidden)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mr := mocks.NewMockMenuRepository(ctrl)
+			dr := mocks.NewMockDishRepository(ctrl)
+
+			tc.buildStub(mr)
+
+			uc := NewAuthorizationUsecase(mr, dr, 10*time.Second)
+
+			err := uc.AuthorizeMenu(context.Background(), tc.payload, menu.ID)
+
+			tc.check(t, err)
+		})
+	}
+}
+
+func TestAuthorizeDish(t *testing.T) {
+	dish := randomDish(t)
+	cityCode := int32(23205)
+
+	testCases := []struct {
+		name      string
+		payload   *domain.TokenPayload
+		buildStub func(dr *mocks.MockDishRepository)
+		check     func(t *testing.T, err error)
+	}{
+		{
+			name:    "Admin",
+			payload: &domain.TokenPayload{Role: domain.UserRoleAdmin},
+			buildStub: func(dr *mocks.MockDishRepository) {
+				dr.EXPECT().FindByID(gomock.Any(), gomock.Any()).Times(0)
+				dr.EXPECT().FetchCityCodes(gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:    "Municipality Own City",
+			payload: &domain.TokenPayload{Role: domain.UserRoleMunicipality, CityCode: cityCode},
+			buildStub: func(dr *mocks.MockDishRepository) {
+				dr.EXPECT().FindByID(gomock.Any(), gomock.Eq(dish.ID)).Times(1).Return(dish, nil)
+				dr.EXPECT().FetchCityCodes(gomock.Any(), gomock.Eq(dish.ID)).Times(1).Return([]int32{cityCode}, nil)
+			},
+			check: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:    "Shared With Other City",
+			payload: &domain.TokenPayload{Role: domain.UserRoleMunicipality, CityCode: cityCode},
+			buildStub: func(dr *mocks.MockDishRepository) {
+				dr.EXPECT().FindByID(gomock.Any(), gomock.Eq(dish.ID)).Times(1).Return(dish, nil)
+				dr.EXPECT().FetchCityCodes(gomock.Any(), gomock.Eq(dish.ID)).Times(1).Return([]int32{cityCode, cityCode + 1}, nil)
+			},
+			check: func(t *testing.T, err error) {
+				require.ErrorIs(t, err, domain.ErrForbidden)
+			},
+		},
+		{
+			name:    "Not Linked To Any Menu",
+			payload: &domain.TokenPayload{Role: domain.UserRoleMunicipality, CityCode: cityCode},
+			buildStub: func(dr *mocks.MockDishRepository) {
+				dr.EXPECT().FindByID(gomock.Any(), gomock.Eq(dish.ID)).Times(1).Return(dish, nil)
+				dr.EXPECT().FetchCityCodes(gomock.Any(), gomock.Eq(dish.ID)).Times(1).Return([]int32{}, nil)
+			},
+			check: func(t *testing.T, err error) {
+				require.ErrorIs(t, err, domain.ErrForbidden)
+			},
+		},
+		{
+			name:    "Not Found",
+			payload: &domain.TokenPayload{Role: domain.UserRoleMunicipality, CityCode: cityCode},
+			buildStub: func(dr *mocks.MockDishRepository) {
+				dr.EXPECT().FindByID(gomock.Any(), gomock.Eq(dish.ID)).Times(1).Return(nil, sql.ErrNoRows)
+				dr.EXPECT().FetchCityCodes(gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, err error) {
+				require.ErrorIs(t, err, sql.ErrNoRows)
+			},
+		},
+		{
+			name:    "Guest",
+			payload: &domain.TokenPayload{Role: domain.UserRoleGuest, CityCode: cityCode},
+			buildStub: func(dr *mocks.MockDishRepository) {
+				dr.EXPECT().FindByID(gomock.Any(), gomock.Any()).Times(0)
+				dr.EXPECT().FetchCityCodes(gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, err error) {
+				require.ErrorIs(t, err, domain.ErrForbidden)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mr := mocks.NewMockMenuRepository(ctrl)
+			dr := mocks.NewMockDishRepository(ctrl)
+
+			tc.buildStub(dr)
+
+			uc := NewAuthorizationUsecase(mr, dr, 10*time.Second)
+
+			err := uc.AuthorizeDish(context.Background(), tc.payload, dish.ID)
+
+			tc.check(t, err)
+		})
+	}
+}
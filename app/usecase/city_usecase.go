@@ -79,3 +79,23 @@ func (cu *cityUsecase) FetchByPrefectureCode(ctx context.Context, limit int32, o
 
 	return r, nil
 }
+
+func (cu *cityUsecase) Create(ctx context.Context, city *domain.City) error {
+
+	ctx, cancel := context.WithTimeout(ctx, cu.contextTimeout)
+	defer cancel()
+
+	return cu.cityRepo.Create(ctx, city)
+}
+
+func (cu *cityUsecase) Update(ctx context.Context, city *domain.City) error {
+
+	ctx, cancel := context.WithTimeout(ctx, cu.contextTimeout)
+	defer cancel()
+
+	if _, err := cu.cityRepo.GetByCityCode(ctx, city.CityCode); err != nil {
+		return err
+	}
+
+	return cu.cityRepo.Update(ctx, city)
+}
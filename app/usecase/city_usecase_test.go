@@ -334,6 +334,98 @@ func TestFetchCityByPrefectureCode(t *testing.T) {
 	}
 }
 
+func TestCreateCity(t *testing.T) {
+	city := randomCity()
+
+	testCases := []struct {
+		name      string
+		buildStub func(repo *mocks.MockCityRepository)
+		check     func(t *testing.T, err error)
+	}{
+		{
+			name: "OK",
+			buildStub: func(repo *mocks.MockCityRepository) {
+				repo.EXPECT().Create(gomock.Any(), gomock.Eq(city)).Times(1).Return(nil)
+			},
+			check: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "NG",
+			buildStub: func(repo *mocks.MockCityRepository) {
+				repo.EXPECT().Create(gomock.Any(), gomock.Eq(city)).Times(1).Return(sql.ErrConnDone)
+			},
+			check: func(t *testing.T, err error) {
+				require.ErrorIs(t, err, sql.ErrConnDone)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mocks.NewMockCityRepository(ctrl)
+			tc.buildStub(repo)
+
+			uc := NewCityUsecase(repo, 0)
+
+			err := uc.Create(context.Background(), city)
+
+			tc.check(t, err)
+		})
+	}
+}
+
+func TestUpdateCity(t *testing.T) {
+	city := randomCity()
+
+	testCases := []struct {
+		name      string
+		buildStub func(repo *mocks.MockCityRepository)
+		check     func(t *testing.T, err error)
+	}{
+		{
+			name: "OK",
+			buildStub: func(repo *mocks.MockCityRepository) {
+				repo.EXPECT().GetByCityCode(gomock.Any(), gomock.Eq(city.CityCode)).Times(1).Return(city, nil)
+				repo.EXPECT().Update(gomock.Any(), gomock.Eq(city)).Times(1).Return(nil)
+			},
+			check: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "Not Found",
+			buildStub: func(repo *mocks.MockCityRepository) {
+				repo.EXPECT().GetByCityCode(gomock.Any(), gomock.Eq(city.CityCode)).Times(1).Return(nil, sql.ErrNoRows)
+				repo.EXPECT().Update(gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, err error) {
+				require.ErrorIs(t, err, sql.ErrNoRows)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mocks.NewMockCityRepository(ctrl)
+			tc.buildStub(repo)
+
+			uc := NewCityUsecase(repo, 0)
+
+			err := uc.Update(context.Background(), city)
+
+			tc.check(t, err)
+		})
+	}
+}
+
 func requireCityResults(t *testing.T, cities, mockData []*domain.City) {
 	require.NotNil(t, cities)
 	require.Equal(t, len(mockData), len(cities))
@@ -19,14 +19,14 @@ func NewDishUsecase(dr domain.DishRepository, timeout time.Duration) domain.Dish
 	}
 }
 
-func (du *dishUsecase) Create(ctx context.Context, dish *domain.Dish, menuID string) error {
+func (du *dishUsecase) Create(ctx context.Context, dish *domain.Dish, menuID string) (*domain.CreatedDishes, error) {
 	ctx, cancel := context.WithTimeout(ctx, du.contextTimeout)
 	defer cancel()
 
 	return du.dishRepo.Create(ctx, dish, menuID)
 }
 
-func (du *dishUsecase) CreateMany(ctx context.Context, dishes []*domain.Dish, menuID string) error {
+func (du *dishUsecase) CreateMany(ctx context.Context, dishes []*domain.Dish, menuID string) (*domain.CreatedDishes, error) {
 	ctx, cancel := context.WithTimeout(ctx, du.contextTimeout)
 	defer cancel()
 
@@ -95,3 +95,80 @@ func (du *dishUsecase) Fetch(ctx context.Context, search string, limit int32, of
 
 	return dishes, nil
 }
+
+func (du *dishUsecase) FetchExcludingAllergens(ctx context.Context, search string, allergenIDs []int32, limit int32, offset int32) ([]*domain.Dish, error) {
+	ctx, cancel := context.WithTimeout(ctx, du.contextTimeout)
+	defer cancel()
+
+	like := "%" + search + "%"
+	dishes, err := du.dishRepo.FetchByNameExcludingAllergens(ctx, like, allergenIDs, limit, offset)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(dishes) == 0 {
+		return []*domain.Dish{}, nil
+	}
+
+	return dishes, nil
+}
+
+func (du *dishUsecase) FetchByAllergenID(ctx context.Context, allergenID int32, limit int32, offset int32) ([]*domain.Dish, error) {
+	ctx, cancel := context.WithTimeout(ctx, du.contextTimeout)
+	defer cancel()
+
+	dishes, err := du.dishRepo.FetchByAllergenID(ctx, allergenID, limit, offset)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(dishes) == 0 {
+		return []*domain.Dish{}, nil
+	}
+
+	return dishes, nil
+}
+
+func (du *dishUsecase) FetchByAllergenIDInCity(ctx context.Context, allergenID int32, limit int32, offset int32, city int32) ([]*domain.Dish, error) {
+	ctx, cancel := context.WithTimeout(ctx, du.contextTimeout)
+	defer cancel()
+
+	dishes, err := du.dishRepo.FetchByAllergenIDInCity(ctx, allergenID, limit, offset, city)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(dishes) == 0 {
+		return []*domain.Dish{}, nil
+	}
+
+	return dishes, nil
+}
+
+func (du *dishUsecase) Update(ctx context.Context, dish *domain.Dish) error {
+	ctx, cancel := context.WithTimeout(ctx, du.contextTimeout)
+	defer cancel()
+
+	if _, err := du.dishRepo.FindByID(ctx, dish.ID); err != nil {
+		return err
+	}
+
+	return du.dishRepo.Update(ctx, dish)
+}
+
+func (du *dishUsecase) DetachFromMenu(ctx context.Context, id string, menuID string) error {
+	ctx, cancel := context.WithTimeout(ctx, du.contextTimeout)
+	defer cancel()
+
+	return du.dishRepo.DetachFromMenu(ctx, id, menuID)
+}
+
+func (du *dishUsecase) Delete(ctx context.Context, id string, force bool) (*domain.DeletedDish, error) {
+	ctx, cancel := context.WithTimeout(ctx, du.contextTimeout)
+	defer cancel()
+
+	return du.dishRepo.Delete(ctx, id, force)
+}
@@ -29,7 +29,7 @@ func TestCreateDish(t *testing.T) {
 			name: "OK",
 			dish: dish,
 			buildStubs: func(r *mocks.MockDishRepository) {
-				r.EXPECT().Create(gomock.Any(), gomock.Eq(dish), gomock.Eq(menu.ID)).Times(1).Return(nil)
+				r.EXPECT().Create(gomock.Any(), gomock.Eq(dish), gomock.Eq(menu.ID)).Times(1).Return(&domain.CreatedDishes{Created: []*domain.Dish{dish}}, nil)
 			},
 			check: func(err error) {
 				require.NoError(t, err)
@@ -39,7 +39,7 @@ func TestCreateDish(t *testing.T) {
 			name: "NG",
 			dish: dish,
 			buildStubs: func(r *mocks.MockDishRepository) {
-				r.EXPECT().Create(gomock.Any(), gomock.Eq(dish), gomock.Eq(menu.ID)).Times(1).Return(sql.ErrNoRows)
+				r.EXPECT().Create(gomock.Any(), gomock.Eq(dish), gomock.Eq(menu.ID)).Times(1).Return(nil, sql.ErrNoRows)
 			},
 			check: func(err error) {
 				require.Error(t, err)
@@ -58,7 +58,7 @@ func TestCreateDish(t *testing.T) {
 
 			du := NewDishUsecase(repo, timeout)
 
-			err := du.Create(ctx, tc.dish, menu.ID)
+			_, err := du.Create(ctx, tc.dish, menu.ID)
 
 			tc.check(err)
 		})
@@ -86,7 +86,7 @@ func TestCreateManyDish(t *testing.T) {
 			name:   "OK",
 			dishes: dishes,
 			buildStubs: func(r *mocks.MockDishRepository) {
-				r.EXPECT().CreateMany(gomock.Any(), gomock.Eq(dishes), gomock.Eq(menu.ID)).Times(1).Return(nil)
+				r.EXPECT().CreateMany(gomock.Any(), gomock.Eq(dishes), gomock.Eq(menu.ID)).Times(1).Return(&domain.CreatedDishes{Created: dishes}, nil)
 			},
 			check: func(err error) {
 				require.NoError(t, err)
@@ -96,7 +96,7 @@ func TestCreateManyDish(t *testing.T) {
 			name:   "NG",
 			dishes: dishes,
 			buildStubs: func(r *mocks.MockDishRepository) {
-				r.EXPECT().CreateMany(gomock.Any(), gomock.Eq(dishes), gomock.Eq(menu.ID)).Times(1).Return(sql.ErrNoRows)
+				r.EXPECT().CreateMany(gomock.Any(), gomock.Eq(dishes), gomock.Eq(menu.ID)).Times(1).Return(nil, sql.ErrNoRows)
 			},
 			check: func(err error) {
 				require.Error(t, err)
@@ -115,7 +115,7 @@ func TestCreateManyDish(t *testing.T) {
 
 			du := NewDishUsecase(repo, timeout)
 
-			err := du.CreateMany(ctx, tc.dishes, menu.ID)
+			_, err := du.CreateMany(ctx, tc.dishes, menu.ID)
 
 			tc.check(err)
 		})
@@ -488,6 +488,398 @@ func TestFetchDish(t *testing.T) {
 	}
 }
 
+func TestFetchDishExcludingAllergens(t *testing.T) {
+	var dishes []*domain.Dish
+
+	for i := 0; i < 10; i++ {
+		dishes = append(dishes, randomDish(t))
+	}
+
+	timeout := time.Second * 10
+	ctx := context.Background()
+	allergenIDs := []int32{1, 2}
+
+	type input struct {
+		search string
+		limit  int32
+		offset int32
+	}
+
+	testCases := []struct {
+		name       string
+		input      input
+		buildStubs func(r *mocks.MockDishRepository)
+		check      func(t *testing.T, result []*domain.Dish, err error)
+	}{
+		{
+			name: "OK with search",
+			input: input{
+				search: dishes[0].Name,
+				limit:  10,
+				offset: 0,
+			},
+			buildStubs: func(r *mocks.MockDishRepository) {
+				like := "%" + dishes[0].Name + "%"
+				r.EXPECT().FetchByNameExcludingAllergens(gomock.Any(), gomock.Eq(like), gomock.Eq(allergenIDs), gomock.Eq(int32(10)), gomock.Eq(int32(0))).Times(1).Return(dishes, nil)
+			},
+			check: func(t *testing.T, result []*domain.Dish, err error) {
+				require.NoError(t, err)
+				require.Equal(t, len(dishes), len(result))
+			},
+		},
+		{
+			name: "OK without search",
+			input: input{
+				search: "",
+				limit:  10,
+				offset: 0,
+			},
+			buildStubs: func(r *mocks.MockDishRepository) {
+				r.EXPECT().FetchByNameExcludingAllergens(gomock.Any(), gomock.Eq("%%"), gomock.Eq(allergenIDs), gomock.Eq(int32(10)), gomock.Eq(int32(0))).Times(1).Return(dishes, nil)
+			},
+			check: func(t *testing.T, result []*domain.Dish, err error) {
+				require.NoError(t, err)
+				require.Equal(t, len(dishes), len(result))
+			},
+		},
+		{
+			name: "NG",
+			input: input{
+				search: dishes[0].Name,
+				limit:  10,
+				offset: 0,
+			},
+			buildStubs: func(r *mocks.MockDishRepository) {
+				r.EXPECT().FetchByNameExcludingAllergens(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrConnDone)
+			},
+			check: func(t *testing.T, result []*domain.Dish, err error) {
+				require.Error(t, err)
+			},
+		},
+		{
+			name: "Empty",
+			input: input{
+				search: dishes[0].Name,
+				limit:  10,
+				offset: 0,
+			},
+			buildStubs: func(r *mocks.MockDishRepository) {
+				r.EXPECT().FetchByNameExcludingAllergens(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(nil, nil)
+			},
+			check: func(t *testing.T, result []*domain.Dish, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, result)
+				require.Empty(t, result)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mocks.NewMockDishRepository(ctrl)
+			tc.buildStubs(repo)
+
+			du := NewDishUsecase(repo, timeout)
+
+			result, err := du.FetchExcludingAllergens(ctx, tc.input.search, allergenIDs, tc.input.limit, tc.input.offset)
+
+			tc.check(t, result, err)
+		})
+	}
+}
+
+func TestFetchDishByAllergenID(t *testing.T) {
+	var dishes []*domain.Dish
+
+	for i := 0; i < 10; i++ {
+		dishes = append(dishes, randomDish(t))
+	}
+
+	timeout := time.Second * 10
+	ctx := context.Background()
+
+	testCases := []struct {
+		name       string
+		buildStubs func(r *mocks.MockDishRepository)
+		check      func(t *testing.T, result []*domain.Dish, err error)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(r *mocks.MockDishRepository) {
+				r.EXPECT().FetchByAllergenID(gomock.Any(), gomock.Eq(int32(1)), gomock.Eq(int32(10)), gomock.Eq(int32(0))).Times(1).Return(dishes, nil)
+			},
+			check: func(t *testing.T, result []*domain.Dish, err error) {
+				require.NoError(t, err)
+				require.Equal(t, len(dishes), len(result))
+			},
+		},
+		{
+			name: "NG",
+			buildStubs: func(r *mocks.MockDishRepository) {
+				r.EXPECT().FetchByAllergenID(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrConnDone)
+			},
+			check: func(t *testing.T, result []*domain.Dish, err error) {
+				require.Error(t, err)
+			},
+		},
+		{
+			name: "Empty",
+			buildStubs: func(r *mocks.MockDishRepository) {
+				r.EXPECT().FetchByAllergenID(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(nil, nil)
+			},
+			check: func(t *testing.T, result []*domain.Dish, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, result)
+				require.Empty(t, result)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mocks.NewMockDishRepository(ctrl)
+			tc.buildStubs(repo)
+
+			du := NewDishUsecase(repo, timeout)
+
+			result, err := du.FetchByAllergenID(ctx, 1, 10, 0)
+
+			tc.check(t, result, err)
+		})
+	}
+}
+
+func TestFetchDishByAllergenIDInCity(t *testing.T) {
+	var dishes []*domain.Dish
+
+	for i := 0; i < 10; i++ {
+		dishes = append(dishes, randomDish(t))
+	}
+
+	timeout := time.Second * 10
+	ctx := context.Background()
+	city := util.RandomCityCode()
+
+	testCases := []struct {
+		name       string
+		buildStubs func(r *mocks.MockDishRepository)
+		check      func(t *testing.T, result []*domain.Dish, err error)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(r *mocks.MockDishRepository) {
+				r.EXPECT().FetchByAllergenIDInCity(gomock.Any(), gomock.Eq(int32(1)), gomock.Eq(int32(10)), gomock.Eq(int32(0)), gomock.Eq(city)).Times(1).Return(dishes, nil)
+			},
+			check: func(t *testing.T, result []*domain.Dish, err error) {
+				require.NoError(t, err)
+				require.Equal(t, len(dishes), len(result))
+			},
+		},
+		{
+			name: "NG",
+			buildStubs: func(r *mocks.MockDishRepository) {
+				r.EXPECT().FetchByAllergenIDInCity(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrConnDone)
+			},
+			check: func(t *testing.T, result []*domain.Dish, err error) {
+				require.Error(t, err)
+			},
+		},
+		{
+			name: "Empty",
+			buildStubs: func(r *mocks.MockDishRepository) {
+				r.EXPECT().FetchByAllergenIDInCity(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(nil, nil)
+			},
+			check: func(t *testing.T, result []*domain.Dish, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, result)
+				require.Empty(t, result)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mocks.NewMockDishRepository(ctrl)
+			tc.buildStubs(repo)
+
+			du := NewDishUsecase(repo, timeout)
+
+			result, err := du.FetchByAllergenIDInCity(ctx, 1, 10, 0, city)
+
+			tc.check(t, result, err)
+		})
+	}
+}
+
+func TestUpdateDish(t *testing.T) {
+	dish := randomDish(t)
+	timeout := time.Second * 10
+	ctx := context.Background()
+
+	testCases := []struct {
+		name       string
+		buildStubs func(r *mocks.MockDishRepository)
+		check      func(err error)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(r *mocks.MockDishRepository) {
+				r.EXPECT().FindByID(gomock.Any(), gomock.Eq(dish.ID)).Times(1).Return(dish, nil)
+				r.EXPECT().Update(gomock.Any(), gomock.Eq(dish)).Times(1).Return(nil)
+			},
+			check: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "Not Found",
+			buildStubs: func(r *mocks.MockDishRepository) {
+				r.EXPECT().FindByID(gomock.Any(), gomock.Eq(dish.ID)).Times(1).Return(nil, sql.ErrNoRows)
+				r.EXPECT().Update(gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(err error) {
+				require.ErrorIs(t, err, sql.ErrNoRows)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mocks.NewMockDishRepository(ctrl)
+			tc.buildStubs(repo)
+
+			du := NewDishUsecase(repo, timeout)
+
+			err := du.Update(ctx, dish)
+
+			tc.check(err)
+		})
+	}
+}
+
+func TestDetachDishFromMenu(t *testing.T) {
+	dish := randomDish(t)
+	menu := randomMenu(t)
+	timeout := time.Second * 10
+	ctx := context.Background()
+
+	testCases := []struct {
+		name       string
+		buildStubs func(r *mocks.MockDishRepository)
+		check      func(err error)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(r *mocks.MockDishRepository) {
+				r.EXPECT().DetachFromMenu(gomock.Any(), gomock.Eq(dish.ID), gomock.Eq(menu.ID)).Times(1).Return(nil)
+			},
+			check: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "NG",
+			buildStubs: func(r *mocks.MockDishRepository) {
+				r.EXPECT().DetachFromMenu(gomock.Any(), gomock.Eq(dish.ID), gomock.Eq(menu.ID)).Times(1).Return(sql.ErrNoRows)
+			},
+			check: func(err error) {
+				require.ErrorIs(t, err, sql.ErrNoRows)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mocks.NewMockDishRepository(ctrl)
+			tc.buildStubs(repo)
+
+			du := NewDishUsecase(repo, timeout)
+
+			err := du.DetachFromMenu(ctx, dish.ID, menu.ID)
+
+			tc.check(err)
+		})
+	}
+}
+
+func TestDeleteDish(t *testing.T) {
+	dish := randomDish(t)
+	timeout := time.Second * 10
+	ctx := context.Background()
+
+	deleted := &domain.DeletedDish{
+		ID:      dish.ID,
+		MenuIDs: []string{util.NewUlid()},
+	}
+
+	testCases := []struct {
+		name       string
+		force      bool
+		buildStubs func(r *mocks.MockDishRepository)
+		check      func(result *domain.DeletedDish, err error)
+	}{
+		{
+			name:  "OK",
+			force: true,
+			buildStubs: func(r *mocks.MockDishRepository) {
+				r.EXPECT().Delete(gomock.Any(), gomock.Eq(dish.ID), gomock.Eq(true)).Times(1).Return(deleted, nil)
+			},
+			check: func(result *domain.DeletedDish, err error) {
+				require.NoError(t, err)
+				require.Equal(t, deleted, result)
+			},
+		},
+		{
+			name:  "In Use",
+			force: false,
+			buildStubs: func(r *mocks.MockDishRepository) {
+				r.EXPECT().Delete(gomock.Any(), gomock.Eq(dish.ID), gomock.Eq(false)).Times(1).Return(nil, domain.ErrDishInUse)
+			},
+			check: func(result *domain.DeletedDish, err error) {
+				require.ErrorIs(t, err, domain.ErrDishInUse)
+				require.Nil(t, result)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mocks.NewMockDishRepository(ctrl)
+			tc.buildStubs(repo)
+
+			du := NewDishUsecase(repo, timeout)
+
+			result, err := du.Delete(ctx, dish.ID, tc.force)
+
+			tc.check(result, err)
+		})
+	}
+}
+
 func randomDish(t *testing.T) *domain.Dish {
 	dish, err := domain.NewDish(
 		util.RandomString(10),
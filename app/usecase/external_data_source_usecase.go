@@ -0,0 +1,291 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ogurilab/school-lunch-api/domain"
+)
+
+// エラー内容として保存する行エラーの最大件数
+const maxSyncRowErrors = 5
+
+type externalDataSourceUsecase struct {
+	sourceRepo     domain.ExternalDataSourceRepository
+	menuRepo       domain.MenuRepository
+	fetcher        domain.DatasetFetcher
+	contextTimeout time.Duration
+}
+
+func NewExternalDataSourceUsecase(
+	sr domain.ExternalDataSourceRepository,
+	mr domain.MenuRepository,
+	fetcher domain.DatasetFetcher,
+	timeout time.Duration,
+) domain.ExternalDataSourceUsecase {
+	return &externalDataSourceUsecase{
+		sourceRepo:     sr,
+		menuRepo:       mr,
+		fetcher:        fetcher,
+		contextTimeout: timeout,
+	}
+}
+
+func (u *externalDataSourceUsecase) Create(ctx context.Context, source *domain.ExternalDataSource) (*domain.ExternalDataSource, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	return u.sourceRepo.Create(ctx, source)
+}
+
+func (u *externalDataSourceUsecase) GetByID(ctx context.Context, id int32) (*domain.ExternalDataSource, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	return u.sourceRepo.GetByID(ctx, id)
+}
+
+func (u *externalDataSourceUsecase) Fetch(ctx context.Context, limit int32, offset int32) ([]*domain.ExternalDataSource, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	return u.sourceRepo.Fetch(ctx, limit, offset)
+}
+
+func (u *externalDataSourceUsecase) Update(ctx context.Context, source *domain.ExternalDataSource) error {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	if _, err := u.sourceRepo.GetByID(ctx, source.ID); err != nil {
+		return err
+	}
+
+	return u.sourceRepo.Update(ctx, source)
+}
+
+func (u *externalDataSourceUsecase) Delete(ctx context.Context, id int32) error {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	return u.sourceRepo.Delete(ctx, id)
+}
+
+// Sync はデータセットを取得して献立・料理・アレルゲンを登録する
+// ステータスは Updating を経て、成功時は Active、失敗時は Error に更新される
+func (u *externalDataSourceUsecase) Sync(ctx context.Context, id int32) (*domain.DataSourceSyncResult, error) {
+	source, err := u.GetByID(ctx, id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.startSync(ctx, id); err != nil {
+		return nil, err
+	}
+
+	result := &domain.DataSourceSyncResult{
+		SourceID: id,
+		Status:   domain.DataSourceStatusActive,
+	}
+
+	imported, skipped, syncErr := u.syncRecovered(ctx, source)
+
+	result.Imported = imported
+	result.Skipped = skipped
+
+	description := sql.NullString{
+		String: fmt.Sprintf("imported %d menus, skipped %d existing menus", imported, skipped),
+		Valid:  true,
+	}
+
+	if syncErr != nil {
+		result.Status = domain.DataSourceStatusError
+		result.Error = syncErr.Error()
+		description.String = syncErr.Error()
+	}
+
+	// 同期がタイムアウトした場合でも Updating のまま残らないよう、キャンセルされないコンテキストで更新する
+	statusCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), u.contextTimeout)
+	defer cancel()
+
+	if err := u.sourceRepo.UpdateStatus(statusCtx, id, result.Status, description); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// SyncActive はステータスが Active の全てのデータソースを同期する
+func (u *externalDataSourceUsecase) SyncActive(ctx context.Context) ([]*domain.DataSourceSyncResult, error) {
+	sources, err := u.fetchByStatus(ctx, domain.DataSourceStatusActive)
+
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*domain.DataSourceSyncResult, 0, len(sources))
+
+	for _, source := range sources {
+		result, err := u.Sync(ctx, source.ID)
+
+		if err != nil {
+			result = &domain.DataSourceSyncResult{
+				SourceID: source.ID,
+				Status:   source.Status,
+				Error:    err.Error(),
+			}
+		}
+
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+func (u *externalDataSourceUsecase) startSync(ctx context.Context, id int32) error {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	return u.sourceRepo.StartSync(ctx, id)
+}
+
+func (u *externalDataSourceUsecase) fetchByStatus(ctx context.Context, status string) ([]*domain.ExternalDataSource, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	return u.sourceRepo.FetchByStatus(ctx, status)
+}
+
+// syncRecovered は sync で発生した panic をエラーとして返す
+// 外部のデータで panic しても、プロセスを止めずにデータソースを Error にする
+func (u *externalDataSourceUsecase) syncRecovered(ctx context.Context, source *domain.ExternalDataSource) (imported int, skipped int, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			imported, skipped, err = 0, 0, fmt.Errorf("sync panicked: %v", r)
+		}
+	}()
+
+	return u.sync(ctx, source)
+}
+
+// sync は献立を登録し、登録した件数と既に登録済みのため除外した件数を返す
+func (u *externalDataSourceUsecase) sync(ctx context.Context, source *domain.ExternalDataSource) (int, int, error) {
+	if source.Category != domain.DataSourceCategoryMenu {
+		return 0, 0, fmt.Errorf("%w: %s", domain.ErrUnsupportedDataSourceCategory, source.Category)
+	}
+
+	body, err := u.fetcher.Fetch(ctx, source.DatasetID)
+
+	if err != nil {
+		return 0, 0, err
+	}
+
+	defer body.Close()
+
+	rows, results, err := parseMenuCSV(body, source.CityCode, false)
+
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if err := joinRowErrors(results); err != nil {
+		return 0, 0, err
+	}
+
+	rows, err = u.excludeExistingMenus(ctx, source.CityCode, rows)
+
+	if err != nil {
+		return 0, 0, err
+	}
+
+	skipped := len(results) - len(rows)
+
+	if len(rows) == 0 {
+		return 0, skipped, nil
+	}
+
+	importCtx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	report, err := u.menuRepo.Import(importCtx, source.CityCode, rows)
+
+	if err != nil {
+		return 0, skipped, err
+	}
+
+	if err := joinRowErrors(report.Rows); err != nil {
+		return 0, skipped, err
+	}
+
+	return len(rows), skipped, nil
+}
+
+// excludeExistingMenus は既に同じ提供日の献立が登録されている行を除外する
+func (u *externalDataSourceUsecase) excludeExistingMenus(ctx context.Context, cityCode int32, rows []*domain.ImportMenuRow) ([]*domain.ImportMenuRow, error) {
+	if len(rows) == 0 {
+		return rows, nil
+	}
+
+	start, end := rows[0].Menu.OfferedAt, rows[0].Menu.OfferedAt
+
+	for _, row := range rows {
+		if row.Menu.OfferedAt.Before(start) {
+			start = row.Menu.OfferedAt
+		}
+
+		if row.Menu.OfferedAt.After(end) {
+			end = row.Menu.OfferedAt
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	offered, err := u.menuRepo.FetchOfferedAtByCity(ctx, cityCode, start, end)
+
+	if err != nil {
+		return nil, err
+	}
+
+	existing := make(map[string]bool, len(offered))
+
+	for _, o := range offered {
+		existing[o.Format("2006-01-02")] = true
+	}
+
+	filtered := make([]*domain.ImportMenuRow, 0, len(rows))
+
+	for _, row := range rows {
+		if !existing[row.Menu.OfferedAt.Format("2006-01-02")] {
+			filtered = append(filtered, row)
+		}
+	}
+
+	return filtered, nil
+}
+
+func joinRowErrors(results []*domain.ImportMenuResult) error {
+	var errs []string
+	failed := 0
+
+	for _, r := range results {
+		if len(r.Errors) == 0 {
+			continue
+		}
+
+		failed++
+
+		if len(errs) < maxSyncRowErrors {
+			errs = append(errs, fmt.Sprintf("line %d: %s", r.Line, strings.Join(r.Errors, ", ")))
+		}
+	}
+
+	if failed == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("%d rows failed: %s", failed, strings.Join(errs, "; "))
+}
@@ -0,0 +1,288 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ogurilab/school-lunch-api/domain"
+	"github.com/ogurilab/school-lunch-api/domain/mocks"
+	"github.com/ogurilab/school-lunch-api/util"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const syncCSV = "offered_at,dishes,allergens,elementary_school_calories,junior_high_school_calories\n" +
+	"2024-04-08,ごはん|牛乳,|乳,620,820\n" +
+	"2024-05-09,パン|牛乳,小麦|乳,600,800\n"
+
+func TestCreateExternalDataSource(t *testing.T) {
+	source := randomExternalDataSource()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mocks.NewMockExternalDataSourceRepository(ctrl)
+	repo.EXPECT().Create(gomock.Any(), gomock.Eq(source)).Times(1).Return(source, nil)
+
+	uc := NewExternalDataSourceUsecase(repo, nil, nil, 10*time.Second)
+
+	created, err := uc.Create(context.Background(), source)
+
+	require.NoError(t, err)
+	require.Equal(t, source, created)
+}
+
+func TestUpdateExternalDataSource(t *testing.T) {
+	source := randomExternalDataSource()
+
+	testCases := []struct {
+		name      string
+		buildStub func(repo *mocks.MockExternalDataSourceRepository)
+		check     func(t *testing.T, err error)
+	}{
+		{
+			name: "OK",
+			buildStub: func(repo *mocks.MockExternalDataSourceRepository) {
+				repo.EXPECT().GetByID(gomock.Any(), gomock.Eq(source.ID)).Times(1).Return(source, nil)
+				repo.EXPECT().Update(gomock.Any(), gomock.Eq(source)).Times(1).Return(nil)
+			},
+			check: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "Not Found",
+			buildStub: func(repo *mocks.MockExternalDataSourceRepository) {
+				repo.EXPECT().GetByID(gomock.Any(), gomock.Eq(source.ID)).Times(1).Return(nil, sql.ErrNoRows)
+				repo.EXPECT().Update(gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, err error) {
+				require.ErrorIs(t, err, sql.ErrNoRows)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mocks.NewMockExternalDataSourceRepository(ctrl)
+			tc.buildStub(repo)
+
+			uc := NewExternalDataSourceUsecase(repo, nil, nil, 10*time.Second)
+
+			err := uc.Update(context.Background(), source)
+
+			tc.check(t, err)
+		})
+	}
+}
+
+func TestSyncExternalDataSource(t *testing.T) {
+	source := randomExternalDataSource()
+
+	existing := time.Date(2024, 4, 8, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name      string
+		source    *domain.ExternalDataSource
+		buildStub func(repo *mocks.MockExternalDataSourceRepository, menuRepo *mocks.MockMenuRepository, fetcher *mocks.MockDatasetFetcher)
+		check     func(t *testing.T, result *domain.DataSourceSyncResult, err error)
+	}{
+		{
+			name:   "OK",
+			source: source,
+			buildStub: func(repo *mocks.MockExternalDataSourceRepository, menuRepo *mocks.MockMenuRepository, fetcher *mocks.MockDatasetFetcher) {
+				repo.EXPECT().GetByID(gomock.Any(), gomock.Eq(source.ID)).Times(1).Return(source, nil)
+				repo.EXPECT().StartSync(gomock.Any(), gomock.Eq(source.ID)).Times(1).Return(nil)
+				fetcher.EXPECT().Fetch(gomock.Any(), gomock.Eq(source.DatasetID)).Times(1).Return(io.NopCloser(strings.NewReader(syncCSV)), nil)
+				menuRepo.EXPECT().FetchOfferedAtByCity(gomock.Any(), gomock.Eq(source.CityCode), gomock.Any(), gomock.Any()).Times(1).Return([]time.Time{existing}, nil)
+				menuRepo.EXPECT().Import(gomock.Any(), gomock.Eq(source.CityCode), gomock.Len(1)).Times(1).
+					Return(&domain.ImportMenuReport{Imported: true, Rows: []*domain.ImportMenuResult{{Line: 3}}}, nil)
+				repo.EXPECT().UpdateStatus(gomock.Any(), gomock.Eq(source.ID), gomock.Eq(domain.DataSourceStatusActive), gomock.Any()).Times(1).Return(nil)
+			},
+			check: func(t *testing.T, result *domain.DataSourceSyncResult, err error) {
+				require.NoError(t, err)
+				require.Equal(t, domain.DataSourceStatusActive, result.Status)
+				require.Equal(t, 1, result.Imported)
+				require.Equal(t, 1, result.Skipped)
+				require.Empty(t, result.Error)
+			},
+		},
+		{
+			name:   "Fetch Error",
+			source: source,
+			buildStub: func(repo *mocks.MockExternalDataSourceRepository, menuRepo *mocks.MockMenuRepository, fetcher *mocks.MockDatasetFetcher) {
+				repo.EXPECT().GetByID(gomock.Any(), gomock.Eq(source.ID)).Times(1).Return(source, nil)
+				repo.EXPECT().StartSync(gomock.Any(), gomock.Eq(source.ID)).Times(1).Return(nil)
+				fetcher.EXPECT().Fetch(gomock.Any(), gomock.Eq(source.DatasetID)).Times(1).Return(nil, errors.New("404 Not Found"))
+				menuRepo.EXPECT().Import(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				repo.EXPECT().UpdateStatus(gomock.Any(), gomock.Eq(source.ID), gomock.Eq(domain.DataSourceStatusError), gomock.Eq(sql.NullString{String: "404 Not Found", Valid: true})).Times(1).Return(nil)
+			},
+			check: func(t *testing.T, result *domain.DataSourceSyncResult, err error) {
+				require.NoError(t, err)
+				require.Equal(t, domain.DataSourceStatusError, result.Status)
+				require.Equal(t, "404 Not Found", result.Error)
+			},
+		},
+		{
+			name:   "Import Error",
+			source: source,
+			buildStub: func(repo *mocks.MockExternalDataSourceRepository, menuRepo *mocks.MockMenuRepository, fetcher *mocks.MockDatasetFetcher) {
+				repo.EXPECT().GetByID(gomock.Any(), gomock.Eq(source.ID)).Times(1).Return(source, nil)
+				repo.EXPECT().StartSync(gomock.Any(), gomock.Eq(source.ID)).Times(1).Return(nil)
+				fetcher.EXPECT().Fetch(gomock.Any(), gomock.Eq(source.DatasetID)).Times(1).Return(io.NopCloser(strings.NewReader(syncCSV)), nil)
+				menuRepo.EXPECT().FetchOfferedAtByCity(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return([]time.Time{}, nil)
+				menuRepo.EXPECT().Import(gomock.Any(), gomock.Eq(source.CityCode), gomock.Len(2)).Times(1).
+					Return(&domain.ImportMenuReport{Imported: false, Rows: []*domain.ImportMenuResult{{Line: 2}, {Line: 3, Errors: []string{"duplicate entry"}}}}, nil)
+				repo.EXPECT().UpdateStatus(gomock.Any(), gomock.Eq(source.ID), gomock.Eq(domain.DataSourceStatusError), gomock.Any()).Times(1).Return(nil)
+			},
+			check: func(t *testing.T, result *domain.DataSourceSyncResult, err error) {
+				require.NoError(t, err)
+				require.Equal(t, domain.DataSourceStatusError, result.Status)
+				require.Contains(t, result.Error, "line 3: duplicate entry")
+			},
+		},
+		{
+			name:   "Panic",
+			source: source,
+			buildStub: func(repo *mocks.MockExternalDataSourceRepository, menuRepo *mocks.MockMenuRepository, fetcher *mocks.MockDatasetFetcher) {
+				repo.EXPECT().GetByID(gomock.Any(), gomock.Eq(source.ID)).Times(1).Return(source, nil)
+				repo.EXPECT().StartSync(gomock.Any(), gomock.Eq(source.ID)).Times(1).Return(nil)
+				fetcher.EXPECT().Fetch(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(func(_ context.Context, _ string) (io.ReadCloser, error) {
+					panic("malformed dataset")
+				})
+				menuRepo.EXPECT().Import(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+				// panic しても Updating のまま残さず Error にする
+				repo.EXPECT().UpdateStatus(gomock.Any(), gomock.Eq(source.ID), gomock.Eq(domain.DataSourceStatusError), gomock.Any()).Times(1).Return(nil)
+			},
+			check: func(t *testing.T, result *domain.DataSourceSyncResult, err error) {
+				require.NoError(t, err)
+				require.Equal(t, domain.DataSourceStatusError, result.Status)
+				require.Contains(t, result.Error, "malformed dataset")
+			},
+		},
+		{
+			name: "Unsupported Category",
+			source: domain.ReNewExternalDataSource(
+				source.ID, source.CityCode, source.DatasetID, source.Year, source.UpdatedAt,
+				source.Status, domain.DataSourceCategoryDish, source.Description,
+			),
+			buildStub: func(repo *mocks.MockExternalDataSourceRepository, menuRepo *mocks.MockMenuRepository, fetcher *mocks.MockDatasetFetcher) {
+				dish := domain.ReNewExternalDataSource(
+					source.ID, source.CityCode, source.DatasetID, source.Year, source.UpdatedAt,
+					source.Status, domain.DataSourceCategoryDish, source.Description,
+				)
+				repo.EXPECT().GetByID(gomock.Any(), gomock.Eq(source.ID)).Times(1).Return(dish, nil)
+				repo.EXPECT().StartSync(gomock.Any(), gomock.Eq(source.ID)).Times(1).Return(nil)
+				fetcher.EXPECT().Fetch(gomock.Any(), gomock.Any()).Times(0)
+				repo.EXPECT().UpdateStatus(gomock.Any(), gomock.Eq(source.ID), gomock.Eq(domain.DataSourceStatusError), gomock.Any()).Times(1).Return(nil)
+			},
+			check: func(t *testing.T, result *domain.DataSourceSyncResult, err error) {
+				require.NoError(t, err)
+				require.Equal(t, domain.DataSourceStatusError, result.Status)
+				require.Contains(t, result.Error, domain.ErrUnsupportedDataSourceCategory.Error())
+			},
+		},
+		{
+			name:   "Already Updating",
+			source: source,
+			buildStub: func(repo *mocks.MockExternalDataSourceRepository, menuRepo *mocks.MockMenuRepository, fetcher *mocks.MockDatasetFetcher) {
+				repo.EXPECT().GetByID(gomock.Any(), gomock.Eq(source.ID)).Times(1).Return(source, nil)
+				repo.EXPECT().StartSync(gomock.Any(), gomock.Eq(source.ID)).Times(1).Return(domain.ErrDataSourceUpdating)
+				fetcher.EXPECT().Fetch(gomock.Any(), gomock.Any()).Times(0)
+				repo.EXPECT().UpdateStatus(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, result *domain.DataSourceSyncResult, err error) {
+				require.ErrorIs(t, err, domain.ErrDataSourceUpdating)
+				require.Nil(t, result)
+			},
+		},
+		{
+			name:   "Not Found",
+			source: source,
+			buildStub: func(repo *mocks.MockExternalDataSourceRepository, menuRepo *mocks.MockMenuRepository, fetcher *mocks.MockDatasetFetcher) {
+				repo.EXPECT().GetByID(gomock.Any(), gomock.Eq(source.ID)).Times(1).Return(nil, sql.ErrNoRows)
+				repo.EXPECT().StartSync(gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, result *domain.DataSourceSyncResult, err error) {
+				require.ErrorIs(t, err, sql.ErrNoRows)
+				require.Nil(t, result)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mocks.NewMockExternalDataSourceRepository(ctrl)
+			menuRepo := mocks.NewMockMenuRepository(ctrl)
+			fetcher := mocks.NewMockDatasetFetcher(ctrl)
+			tc.buildStub(repo, menuRepo, fetcher)
+
+			uc := NewExternalDataSourceUsecase(repo, menuRepo, fetcher, 10*time.Second)
+
+			result, err := uc.Sync(context.Background(), tc.source.ID)
+
+			tc.check(t, result, err)
+		})
+	}
+}
+
+func TestSyncActiveExternalDataSources(t *testing.T) {
+	first := randomExternalDataSource()
+	second := randomExternalDataSource()
+	second.ID = first.ID + 1
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mocks.NewMockExternalDataSourceRepository(ctrl)
+	menuRepo := mocks.NewMockMenuRepository(ctrl)
+	fetcher := mocks.NewMockDatasetFetcher(ctrl)
+
+	repo.EXPECT().FetchByStatus(gomock.Any(), gomock.Eq(domain.DataSourceStatusActive)).Times(1).Return([]*domain.ExternalDataSource{first, second}, nil)
+
+	// 1件目は同期中のためスキップされ、2件目は同期される
+	repo.EXPECT().GetByID(gomock.Any(), gomock.Eq(first.ID)).Times(1).Return(first, nil)
+	repo.EXPECT().StartSync(gomock.Any(), gomock.Eq(first.ID)).Times(1).Return(domain.ErrDataSourceUpdating)
+
+	repo.EXPECT().GetByID(gomock.Any(), gomock.Eq(second.ID)).Times(1).Return(second, nil)
+	repo.EXPECT().StartSync(gomock.Any(), gomock.Eq(second.ID)).Times(1).Return(nil)
+	fetcher.EXPECT().Fetch(gomock.Any(), gomock.Eq(second.DatasetID)).Times(1).Return(io.NopCloser(strings.NewReader(syncCSV)), nil)
+	menuRepo.EXPECT().FetchOfferedAtByCity(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return([]time.Time{}, nil)
+	menuRepo.EXPECT().Import(gomock.Any(), gomock.Eq(second.CityCode), gomock.Len(2)).Times(1).Return(&domain.ImportMenuReport{Imported: true}, nil)
+	repo.EXPECT().UpdateStatus(gomock.Any(), gomock.Eq(second.ID), gomock.Eq(domain.DataSourceStatusActive), gomock.Any()).Times(1).Return(nil)
+
+	uc := NewExternalDataSourceUsecase(repo, menuRepo, fetcher, 10*time.Second)
+
+	results, err := uc.SyncActive(context.Background())
+
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	require.Equal(t, domain.ErrDataSourceUpdating.Error(), results[0].Error)
+	require.Equal(t, domain.DataSourceStatusActive, results[1].Status)
+	require.Equal(t, 2, results[1].Imported)
+}
+
+func randomExternalDataSource() *domain.ExternalDataSource {
+	return domain.ReNewExternalDataSource(
+		util.RandomInt32()+1,
+		util.RandomCityCode(),
+		util.RandomURL(),
+		int32(util.RandomInt(2000, 2100)),
+		time.Now(),
+		domain.DataSourceStatusActive,
+		domain.DataSourceCategoryMenu,
+		sql.NullString{},
+	)
+}
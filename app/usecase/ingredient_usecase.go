@@ -0,0 +1,160 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/ogurilab/school-lunch-api/domain"
+)
+
+type ingredientUsecase struct {
+	ingredientRepo domain.IngredientRepository
+	dishRepo       domain.DishRepository
+	contextTimeout time.Duration
+}
+
+func NewIngredientUsecase(ir domain.IngredientRepository, dr domain.DishRepository, timeout time.Duration) domain.IngredientUsecase {
+	return &ingredientUsecase{
+		ingredientRepo: ir,
+		dishRepo:       dr,
+		contextTimeout: timeout,
+	}
+}
+
+func (iu *ingredientUsecase) Create(ctx context.Context, name string) (*domain.Ingredient, error) {
+	ctx, cancel := context.WithTimeout(ctx, iu.contextTimeout)
+	defer cancel()
+
+	return iu.ingredientRepo.Create(ctx, name)
+}
+
+func (iu *ingredientUsecase) Fetch(ctx context.Context, limit int32, offset int32) ([]*domain.Ingredient, error) {
+	ctx, cancel := context.WithTimeout(ctx, iu.contextTimeout)
+	defer cancel()
+
+	ingredients, err := iu.ingredientRepo.Fetch(ctx, limit, offset)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(ingredients) == 0 {
+		return []*domain.Ingredient{}, nil
+	}
+
+	return ingredients, nil
+}
+
+func (iu *ingredientUsecase) GetByID(ctx context.Context, id int32) (*domain.Ingredient, error) {
+	ctx, cancel := context.WithTimeout(ctx, iu.contextTimeout)
+	defer cancel()
+
+	return iu.ingredientRepo.GetByID(ctx, id)
+}
+
+// Update は名前が変わらない場合も影響行数が0になるため、存在の確認を先に行う
+func (iu *ingredientUsecase) Update(ctx context.Context, ingredient *domain.Ingredient) error {
+	ctx, cancel := context.WithTimeout(ctx, iu.contextTimeout)
+	defer cancel()
+
+	if _, err := iu.ingredientRepo.GetByID(ctx, ingredient.ID); err != nil {
+		return err
+	}
+
+	return iu.ingredientRepo.Update(ctx, ingredient)
+}
+
+func (iu *ingredientUsecase) Delete(ctx context.Context, id int32) error {
+	ctx, cancel := context.WithTimeout(ctx, iu.contextTimeout)
+	defer cancel()
+
+	return iu.ingredientRepo.Delete(ctx, id)
+}
+
+// AttachToDish は登録されていない食材が含まれる場合は sql.ErrNoRows を返す
+func (iu *ingredientUsecase) AttachToDish(ctx context.Context, dishID string, ingredients []*domain.DishIngredient) error {
+	ctx, cancel := context.WithTimeout(ctx, iu.contextTimeout)
+	defer cancel()
+
+	ids := make([]int32, 0, len(ingredients))
+	seen := make(map[int32]bool, len(ingredients))
+
+	for _, ingredient := range ingredients {
+		if seen[ingredient.ID] {
+			continue
+		}
+
+		seen[ingredient.ID] = true
+		ids = append(ids, ingredient.ID)
+	}
+
+	found, err := iu.ingredientRepo.FetchByIDs(ctx, ids)
+
+	if err != nil {
+		return err
+	}
+
+	if len(found) != len(ids) {
+		return sql.ErrNoRows
+	}
+
+	return iu.ingredientRepo.AttachToDish(ctx, dishID, ingredients)
+}
+
+func (iu *ingredientUsecase) DetachFromDish(ctx context.Context, dishID string, ingredientID int32) error {
+	ctx, cancel := context.WithTimeout(ctx, iu.contextTimeout)
+	defer cancel()
+
+	return iu.ingredientRepo.DetachFromDish(ctx, dishID, ingredientID)
+}
+
+func (iu *ingredientUsecase) FetchByDishID(ctx context.Context, dishID string) ([]*domain.DishIngredient, error) {
+	ctx, cancel := context.WithTimeout(ctx, iu.contextTimeout)
+	defer cancel()
+
+	ingredients, err := iu.ingredientRepo.FetchByDishID(ctx, dishID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(ingredients) == 0 {
+		return []*domain.DishIngredient{}, nil
+	}
+
+	return ingredients, nil
+}
+
+func (iu *ingredientUsecase) FetchByMenuID(ctx context.Context, menuID string) ([]*domain.DishIngredient, error) {
+	ctx, cancel := context.WithTimeout(ctx, iu.contextTimeout)
+	defer cancel()
+
+	dishes, err := iu.dishRepo.FetchByMenuID(ctx, menuID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(dishes) == 0 {
+		return []*domain.DishIngredient{}, nil
+	}
+
+	dishIDs := make([]string, 0, len(dishes))
+
+	for _, dish := range dishes {
+		dishIDs = append(dishIDs, dish.ID)
+	}
+
+	ingredients, err := iu.ingredientRepo.FetchInDish(ctx, dishIDs)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(ingredients) == 0 {
+		return []*domain.DishIngredient{}, nil
+	}
+
+	return ingredients, nil
+}
@@ -0,0 +1,295 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/ogurilab/school-lunch-api/domain"
+	"github.com/ogurilab/school-lunch-api/domain/mocks"
+	"github.com/ogurilab/school-lunch-api/util"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestFetchIngredientsByDishID(t *testing.T) {
+	dish := randomDish(t)
+	timeout := time.Second * 10
+	results := randomDishIngredients(t, dish.ID, 5)
+
+	testCases := []struct {
+		name       string
+		buildStubs func(r *mocks.MockIngredientRepository)
+		check      func(t *testing.T, ingredients []*domain.DishIngredient, err error)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(r *mocks.MockIngredientRepository) {
+				r.EXPECT().FetchByDishID(gomock.Any(), gomock.Eq(dish.ID)).Times(1).Return(results, nil)
+			},
+			check: func(t *testing.T, ingredients []*domain.DishIngredient, err error) {
+				require.NoError(t, err)
+				require.Equal(t, results, ingredients)
+			},
+		},
+		{
+			name: "NG",
+			buildStubs: func(r *mocks.MockIngredientRepository) {
+				r.EXPECT().FetchByDishID(gomock.Any(), gomock.Eq(dish.ID)).Times(1).Return(nil, sql.ErrConnDone)
+			},
+			check: func(t *testing.T, ingredients []*domain.DishIngredient, err error) {
+				require.Error(t, err)
+				require.Nil(t, ingredients)
+			},
+		},
+		{
+			name: "Empty Result",
+			buildStubs: func(r *mocks.MockIngredientRepository) {
+				r.EXPECT().FetchByDishID(gomock.Any(), gomock.Eq(dish.ID)).Times(1).Return(nil, nil)
+			},
+			check: func(t *testing.T, ingredients []*domain.DishIngredient, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, ingredients)
+				require.Empty(t, ingredients)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ir := mocks.NewMockIngredientRepository(ctrl)
+			dr := mocks.NewMockDishRepository(ctrl)
+			tc.buildStubs(ir)
+
+			iu := NewIngredientUsecase(ir, dr, timeout)
+
+			ingredients, err := iu.FetchByDishID(context.Background(), dish.ID)
+
+			tc.check(t, ingredients, err)
+		})
+	}
+}
+
+func TestFetchIngredientsByMenuID(t *testing.T) {
+	menu := randomMenu(t)
+	timeout := time.Second * 10
+
+	dishes := []*domain.Dish{randomDish(t), randomDish(t)}
+	dishIDs := createDishIds(dishes)
+	results := randomDishIngredients(t, dishIDs[0], 3)
+
+	testCases := []struct {
+		name       string
+		buildStubs func(dr *mocks.MockDishRepository, ir *mocks.MockIngredientRepository)
+		check      func(t *testing.T, ingredients []*domain.DishIngredient, err error)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(dr *mocks.MockDishRepository, ir *mocks.MockIngredientRepository) {
+				dr.EXPECT().FetchByMenuID(gomock.Any(), gomock.Eq(menu.ID)).Times(1).Return(dishes, nil)
+				ir.EXPECT().FetchInDish(gomock.Any(), gomock.Eq(dishIDs)).Times(1).Return(results, nil)
+			},
+			check: func(t *testing.T, ingredients []*domain.DishIngredient, err error) {
+				require.NoError(t, err)
+				require.Equal(t, results, ingredients)
+			},
+		},
+		{
+			name: "NG - FetchByMenuID",
+			buildStubs: func(dr *mocks.MockDishRepository, ir *mocks.MockIngredientRepository) {
+				dr.EXPECT().FetchByMenuID(gomock.Any(), gomock.Eq(menu.ID)).Times(1).Return(nil, sql.ErrConnDone)
+				ir.EXPECT().FetchInDish(gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, ingredients []*domain.DishIngredient, err error) {
+				require.Error(t, err)
+				require.Nil(t, ingredients)
+			},
+		},
+		{
+			name: "Empty Result - FetchByMenuID",
+			buildStubs: func(dr *mocks.MockDishRepository, ir *mocks.MockIngredientRepository) {
+				dr.EXPECT().FetchByMenuID(gomock.Any(), gomock.Eq(menu.ID)).Times(1).Return([]*domain.Dish{}, nil)
+				ir.EXPECT().FetchInDish(gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, ingredients []*domain.DishIngredient, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, ingredients)
+				require.Empty(t, ingredients)
+			},
+		},
+		{
+			name: "NG - FetchInDish",
+			buildStubs: func(dr *mocks.MockDishRepository, ir *mocks.MockIngredientRepository) {
+				dr.EXPECT().FetchByMenuID(gomock.Any(), gomock.Eq(menu.ID)).Times(1).Return(dishes, nil)
+				ir.EXPECT().FetchInDish(gomock.Any(), gomock.Eq(dishIDs)).Times(1).Return(nil, sql.ErrConnDone)
+			},
+			check: func(t *testing.T, ingredients []*domain.DishIngredient, err error) {
+				require.Error(t, err)
+				require.Nil(t, ingredients)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			dr := mocks.NewMockDishRepository(ctrl)
+			ir := mocks.NewMockIngredientRepository(ctrl)
+			tc.buildStubs(dr, ir)
+
+			iu := NewIngredientUsecase(ir, dr, timeout)
+
+			ingredients, err := iu.FetchByMenuID(context.Background(), menu.ID)
+
+			tc.check(t, ingredients, err)
+		})
+	}
+}
+
+func TestUpdateIngredient(t *testing.T) {
+	ingredient := domain.ReNewIngredient(util.RandomInt32(), util.RandomString(10))
+	timeout := time.Second * 10
+
+	testCases := []struct {
+		name       string
+		buildStubs func(r *mocks.MockIngredientRepository)
+		check      func(t *testing.T, err error)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(r *mocks.MockIngredientRepository) {
+				r.EXPECT().GetByID(gomock.Any(), gomock.Eq(ingredient.ID)).Times(1).Return(ingredient, nil)
+				r.EXPECT().Update(gomock.Any(), gomock.Eq(ingredient)).Times(1).Return(nil)
+			},
+			check: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "Not Found",
+			buildStubs: func(r *mocks.MockIngredientRepository) {
+				r.EXPECT().GetByID(gomock.Any(), gomock.Eq(ingredient.ID)).Times(1).Return(nil, sql.ErrNoRows)
+				r.EXPECT().Update(gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, err error) {
+				require.ErrorIs(t, err, sql.ErrNoRows)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ir := mocks.NewMockIngredientRepository(ctrl)
+			dr := mocks.NewMockDishRepository(ctrl)
+			tc.buildStubs(ir)
+
+			iu := NewIngredientUsecase(ir, dr, timeout)
+
+			tc.check(t, iu.Update(context.Background(), ingredient))
+		})
+	}
+}
+
+func TestAttachIngredientsToDish(t *testing.T) {
+	dish := randomDish(t)
+	timeout := time.Second * 10
+
+	quantity := 12.5
+	origin := int32(23)
+	first := domain.ReNewDishIngredient(util.RandomInt32(), "", dish.ID, &quantity, &origin)
+	second := domain.ReNewDishIngredient(first.ID+1, "", dish.ID, nil, nil)
+	// 同じ食材が重複して指定された場合も存在の確認は一度だけ行う
+	ingredients := []*domain.DishIngredient{first, second, first}
+	ids := []int32{first.ID, second.ID}
+
+	testCases := []struct {
+		name       string
+		buildStubs func(r *mocks.MockIngredientRepository)
+		check      func(t *testing.T, err error)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(r *mocks.MockIngredientRepository) {
+				found := []*domain.Ingredient{
+					domain.ReNewIngredient(first.ID, util.RandomString(10)),
+					domain.ReNewIngredient(second.ID, util.RandomString(10)),
+				}
+
+				r.EXPECT().FetchByIDs(gomock.Any(), gomock.Eq(ids)).Times(1).Return(found, nil)
+				r.EXPECT().AttachToDish(gomock.Any(), gomock.Eq(dish.ID), gomock.Eq(ingredients)).Times(1).Return(nil)
+			},
+			check: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "Not Found - Ingredient",
+			buildStubs: func(r *mocks.MockIngredientRepository) {
+				found := []*domain.Ingredient{
+					domain.ReNewIngredient(first.ID, util.RandomString(10)),
+				}
+
+				r.EXPECT().FetchByIDs(gomock.Any(), gomock.Eq(ids)).Times(1).Return(found, nil)
+				r.EXPECT().AttachToDish(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, err error) {
+				require.ErrorIs(t, err, sql.ErrNoRows)
+			},
+		},
+		{
+			name: "NG",
+			buildStubs: func(r *mocks.MockIngredientRepository) {
+				r.EXPECT().FetchByIDs(gomock.Any(), gomock.Eq(ids)).Times(1).Return(nil, sql.ErrConnDone)
+				r.EXPECT().AttachToDish(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, err error) {
+				require.ErrorIs(t, err, sql.ErrConnDone)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ir := mocks.NewMockIngredientRepository(ctrl)
+			dr := mocks.NewMockDishRepository(ctrl)
+			tc.buildStubs(ir)
+
+			iu := NewIngredientUsecase(ir, dr, timeout)
+
+			tc.check(t, iu.AttachToDish(context.Background(), dish.ID, ingredients))
+		})
+	}
+}
+
+func randomDishIngredients(t *testing.T, dishID string, length int) []*domain.DishIngredient {
+	ingredients := make([]*domain.DishIngredient, 0, length)
+
+	for i := 0; i < length; i++ {
+		quantity := float64(util.RandomInt(1, 100))
+		origin := int32(util.RandomInt(1, 47))
+
+		ingredient := domain.ReNewDishIngredient(
+			util.RandomInt32(),
+			util.RandomString(10),
+			dishID,
+			&quantity,
+			&origin,
+		)
+
+		ingredients = append(ingredients, ingredient)
+	}
+
+	return ingredients
+}
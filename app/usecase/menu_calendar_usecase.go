@@ -0,0 +1,121 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"time"
+
+	"github.com/ogurilab/school-lunch-api/domain"
+)
+
+type menuCalendarUsecase struct {
+	menuUsecase    domain.MenuWithDishesUsecase
+	allergenRepo   domain.AllergenRepository
+	encoder        domain.MenuCalendarEncoder
+	contextTimeout time.Duration
+}
+
+func NewMenuCalendarUsecase(mu domain.MenuWithDishesUsecase, ar domain.AllergenRepository, encoder domain.MenuCalendarEncoder, timeout time.Duration) domain.MenuCalendarUsecase {
+	return &menuCalendarUsecase{
+		menuUsecase:    mu,
+		allergenRepo:   ar,
+		encoder:        encoder,
+		contextTimeout: timeout,
+	}
+}
+
+// ExportByCity は from から to までの献立を iCalendar 形式で返す
+// highlight に指定したアレルゲンを含む日は、カレンダー上で目立つように書き出す
+func (cu *menuCalendarUsecase) ExportByCity(ctx context.Context, from time.Time, to time.Time, city int32, highlight []int32) ([]byte, error) {
+
+	ctx, cancel := context.WithTimeout(ctx, cu.contextTimeout)
+	defer cancel()
+
+	// 1日に1つの献立として、期間の日数分を取得する
+	days := int32(to.Sub(from).Hours()/24) + 1
+
+	menus, err := cu.menuUsecase.FetchByCity(ctx, days, 0, to, city)
+
+	if err != nil {
+		return nil, err
+	}
+
+	inRange := make([]*domain.MenuWithDishes, 0, len(menus))
+	dishIDs := make([]string, 0, len(menus))
+
+	for _, menu := range menus {
+		if menu.OfferedAt.Before(from) {
+			continue
+		}
+
+		inRange = append(inRange, menu)
+
+		for _, dish := range menu.Dishes {
+			dishIDs = append(dishIDs, dish.ID)
+		}
+	}
+
+	// 同じ内容からは同じカレンダーを返すよう、提供日の昇順に並べる
+	sort.SliceStable(inRange, func(i, j int) bool {
+		if inRange[i].OfferedAt.Equal(inRange[j].OfferedAt) {
+			return inRange[i].ID < inRange[j].ID
+		}
+
+		return inRange[i].OfferedAt.Before(inRange[j].OfferedAt)
+	})
+
+	allergens := map[string][]*domain.Allergen{}
+
+	if len(dishIDs) > 0 {
+		allergens, err = cu.allergenRepo.FetchByDishIDs(ctx, dishIDs)
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	highlighted := make(map[int32]bool, len(highlight))
+
+	for _, id := range highlight {
+		highlighted[id] = true
+	}
+
+	calendar := &domain.MenuCalendar{
+		CityCode: city,
+		Days:     make([]*domain.MenuCalendarDay, 0, len(inRange)),
+	}
+
+	for _, menu := range inRange {
+		day := &domain.MenuCalendarDay{
+			Menu:        menu,
+			Allergens:   make(map[string][]*domain.Allergen, len(menu.Dishes)),
+			Highlighted: []*domain.Allergen{},
+		}
+
+		seen := make(map[int32]bool)
+
+		for _, dish := range menu.Dishes {
+			day.Allergens[dish.ID] = allergens[dish.ID]
+
+			for _, allergen := range allergens[dish.ID] {
+				if !highlighted[allergen.ID] || seen[allergen.ID] {
+					continue
+				}
+
+				seen[allergen.ID] = true
+				day.Highlighted = append(day.Highlighted, allergen)
+			}
+		}
+
+		calendar.Days = append(calendar.Days, day)
+	}
+
+	var buf bytes.Buffer
+
+	if err := cu.encoder.Encode(&buf, calendar); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
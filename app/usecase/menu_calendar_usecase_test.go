@@ -0,0 +1,141 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/ogurilab/school-lunch-api/domain"
+	"github.com/ogurilab/school-lunch-api/domain/mocks"
+	"github.com/ogurilab/school-lunch-api/util"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestExportMenuCalendarByCity(t *testing.T) {
+	city := util.RandomCityCode()
+	from := time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 4, 30, 0, 0, 0, 0, time.UTC)
+	timeout := time.Second * 10
+
+	// FetchByCity は提供日の降順に返し、期間より前の献立も含む
+	inRange := randomCalendarMenu(t, time.Date(2024, 4, 10, 0, 0, 0, 0, time.UTC), city)
+	first := randomCalendarMenu(t, from, city)
+	before := randomCalendarMenu(t, time.Date(2024, 3, 29, 0, 0, 0, 0, time.UTC), city)
+	menus := []*domain.MenuWithDishes{inRange, first, before}
+
+	milk := domain.ReNewAllergen(1, "乳", domain.AllergenKindSpecified, domain.AllergenCategoryContains)
+	egg := domain.ReNewAllergen(2, "卵", domain.AllergenKindSpecified, domain.AllergenCategoryContains)
+	allergens := map[string][]*domain.Allergen{
+		inRange.Dishes[0].ID: {milk, egg},
+		inRange.Dishes[1].ID: {milk},
+	}
+
+	testCases := []struct {
+		name       string
+		buildStubs func(mu *mocks.MockMenuWithDishesUsecase, ar *mocks.MockAllergenRepository, encoder *mocks.MockMenuCalendarEncoder)
+		check      func(t *testing.T, body []byte, err error)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(mu *mocks.MockMenuWithDishesUsecase, ar *mocks.MockAllergenRepository, encoder *mocks.MockMenuCalendarEncoder) {
+				mu.EXPECT().FetchByCity(gomock.Any(), gomock.Eq(int32(30)), gomock.Eq(int32(0)), gomock.Eq(to), gomock.Eq(city)).Times(1).Return(menus, nil)
+
+				dishIDs := []string{inRange.Dishes[0].ID, inRange.Dishes[1].ID, first.Dishes[0].ID, first.Dishes[1].ID}
+				ar.EXPECT().FetchByDishIDs(gomock.Any(), gomock.Eq(dishIDs)).Times(1).Return(allergens, nil)
+
+				encoder.EXPECT().Encode(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(func(w io.Writer, calendar *domain.MenuCalendar) error {
+					require.Equal(t, city, calendar.CityCode)
+					require.Len(t, calendar.Days, 2)
+
+					require.Equal(t, first.ID, calendar.Days[0].Menu.ID)
+					require.Empty(t, calendar.Days[0].Highlighted)
+
+					require.Equal(t, inRange.ID, calendar.Days[1].Menu.ID)
+					require.Equal(t, allergens[inRange.Dishes[0].ID], calendar.Days[1].Allergens[inRange.Dishes[0].ID])
+					require.Equal(t, []*domain.Allergen{milk}, calendar.Days[1].Highlighted)
+
+					_, err := w.Write([]byte("BEGIN:VCALENDAR"))
+					return err
+				})
+			},
+			check: func(t *testing.T, body []byte, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "BEGIN:VCALENDAR", string(body))
+			},
+		},
+		{
+			name: "OK - Empty",
+			buildStubs: func(mu *mocks.MockMenuWithDishesUsecase, ar *mocks.MockAllergenRepository, encoder *mocks.MockMenuCalendarEncoder) {
+				mu.EXPECT().FetchByCity(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return([]*domain.MenuWithDishes{}, nil)
+				ar.EXPECT().FetchByDishIDs(gomock.Any(), gomock.Any()).Times(0)
+				encoder.EXPECT().Encode(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(func(w io.Writer, calendar *domain.MenuCalendar) error {
+					require.Empty(t, calendar.Days)
+					return nil
+				})
+			},
+			check: func(t *testing.T, body []byte, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "NG - FetchByCity",
+			buildStubs: func(mu *mocks.MockMenuWithDishesUsecase, ar *mocks.MockAllergenRepository, encoder *mocks.MockMenuCalendarEncoder) {
+				mu.EXPECT().FetchByCity(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrConnDone)
+				encoder.EXPECT().Encode(gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, body []byte, err error) {
+				require.ErrorIs(t, err, sql.ErrConnDone)
+				require.Nil(t, body)
+			},
+		},
+		{
+			name: "NG - FetchByDishIDs",
+			buildStubs: func(mu *mocks.MockMenuWithDishesUsecase, ar *mocks.MockAllergenRepository, encoder *mocks.MockMenuCalendarEncoder) {
+				mu.EXPECT().FetchByCity(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(menus, nil)
+				ar.EXPECT().FetchByDishIDs(gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrConnDone)
+				encoder.EXPECT().Encode(gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, body []byte, err error) {
+				require.ErrorIs(t, err, sql.ErrConnDone)
+				require.Nil(t, body)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mu := mocks.NewMockMenuWithDishesUsecase(ctrl)
+			ar := mocks.NewMockAllergenRepository(ctrl)
+			encoder := mocks.NewMockMenuCalendarEncoder(ctrl)
+			tc.buildStubs(mu, ar, encoder)
+
+			cu := NewMenuCalendarUsecase(mu, ar, encoder, timeout)
+
+			body, err := cu.ExportByCity(context.Background(), from, to, city, []int32{milk.ID})
+
+			tc.check(t, body, err)
+		})
+	}
+}
+
+func randomCalendarMenu(t *testing.T, offeredAt time.Time, city int32) *domain.MenuWithDishes {
+	menu, err := domain.ReNewMenuWithDishes(
+		util.NewUlid(),
+		offeredAt,
+		util.RandomNullURL(),
+		util.RandomInt32(),
+		util.RandomInt32(),
+		city,
+		[]*domain.Dish{randomDish(t), randomDish(t)},
+	)
+
+	require.NoError(t, err)
+
+	return menu
+}
@@ -0,0 +1,403 @@
+package usecase
+
+import (
+	"database/sql"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ogurilab/school-lunch-api/domain"
+)
+
+/*
+献立CSVの形式
+
+1行目はヘッダーで、列の順序は問わない。photo_url 以外の列は必須。
+
+	offered_at,dishes,allergens,elementary_school_calories,junior_high_school_calories,photo_url
+	2024-04-08,ごはん|牛乳|肉じゃが,|乳|小麦;大豆,620,820,
+
+  - offered_at: 提供日 (YYYY-MM-DD または YYYY/MM/DD)。管理画面・コマンドからの登録では全ての行が同じ月である必要がある
+  - dishes: 料理名を "|" で区切る
+  - allergens: dishes と同じ順序で料理ごとのアレルゲンを "|" で区切り、1つの料理に複数ある場合は ";" で区切る。
+    製造工程で混入する可能性があるアレルゲンは名前の前に "△" を付ける (例: 小麦;△乳)。
+    アレルゲン名は登録済みのものに限り、未登録の名前を含む行はエラーになる
+  - elementary_school_calories, junior_high_school_calories: 小学校・中学校のエネルギー(kcal)
+
+献立の書き出しも同じ形式で、ヘッダーは menuCSVExportHeader の日本語の列名を使う。
+書き出したファイルはそのまま取り込みに使える。
+書き出しでは数式として実行されないよう、"=" などで始まるセルの先頭に "'" を付けるため、取り込みではこれを取り除く。
+*/
+
+const (
+	menuCSVListSeparator     = "|"
+	menuCSVAllergenSeparator = ";"
+	maxDishNameLength        = 255
+	menuCSVFormulaEscape     = "'"
+	menuCSVFormulaPrefixes   = "=+-@\t\r"
+)
+
+// menuCSVMayContainMark は製造工程で混入する可能性があるアレルゲンに付ける印
+var menuCSVMayContainMark = domain.AllergenCategoryMayContain.Symbol()
+
+var menuCSVColumns = map[string]string{
+	"offered_at":                  "offered_at",
+	"提供日":                         "offered_at",
+	"日付":                          "offered_at",
+	"dishes":                      "dishes",
+	"献立":                          "dishes",
+	"allergens":                   "allergens",
+	"アレルゲン":                       "allergens",
+	"elementary_school_calories":  "elementary_school_calories",
+	"小学校エネルギー":                    "elementary_school_calories",
+	"junior_high_school_calories": "junior_high_school_calories",
+	"中学校エネルギー":                    "junior_high_school_calories",
+	"photo_url":                   "photo_url",
+}
+
+// menuCSVExportHeader は献立を書き出すときのヘッダー
+var menuCSVExportHeader = []string{"提供日", "献立", "アレルゲン", "小学校エネルギー", "中学校エネルギー"}
+
+var menuCSVRequiredColumns = []string{
+	"offered_at",
+	"dishes",
+	"allergens",
+	"elementary_school_calories",
+	"junior_high_school_calories",
+}
+
+var menuCSVDateLayouts = []string{"2006-01-02", "2006/01/02", "2006/1/2"}
+
+// parseMenuCSV はCSVを読み込み、登録する献立と行ごとの検証結果を返す
+// sameMonth が true の場合、1行目と異なる月の行はエラーとする
+// ヘッダーが不正な場合など、CSV全体を読み込めない場合のみエラーを返す
+func parseMenuCSV(r io.Reader, cityCode int32, sameMonth bool) ([]*domain.ImportMenuRow, []*domain.ImportMenuResult, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+
+	if err == io.EOF {
+		return nil, nil, fmt.Errorf("%w: empty file", domain.ErrInvalidMenuCSV)
+	}
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %v", domain.ErrInvalidMenuCSV, err)
+	}
+
+	columns, err := parseMenuCSVHeader(header)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		rows    []*domain.ImportMenuRow
+		results []*domain.ImportMenuResult
+		month   string
+	)
+
+	offered := make(map[string]int)
+
+	for {
+		record, err := reader.Read()
+
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			pe, ok := err.(*csv.ParseError)
+
+			if !ok {
+				return nil, nil, err
+			}
+
+			// 読み取りに失敗した行では FieldPos を呼べないため、エラーの行番号を使う
+			results = append(results, &domain.ImportMenuResult{
+				Line:   pe.StartLine,
+				Errors: []string{err.Error()},
+			})
+
+			continue
+		}
+
+		line, _ := reader.FieldPos(0)
+
+		if isBlankRecord(record) {
+			continue
+		}
+
+		row, result := parseMenuCSVRecord(record, columns, cityCode)
+		result.Line = line
+
+		if row != nil {
+			row.Line = line
+
+			m := row.Menu.OfferedAt.Format("2006-01")
+
+			if month == "" {
+				month = m
+			}
+
+			if sameMonth && m != month {
+				result.Errors = append(result.Errors, fmt.Sprintf("offered_at must be in %s", month))
+			}
+
+			if first, ok := offered[result.OfferedAt]; ok {
+				result.Errors = append(result.Errors, fmt.Sprintf("offered_at is duplicated with line %d", first))
+			} else {
+				offered[result.OfferedAt] = line
+			}
+		}
+
+		results = append(results, result)
+
+		if len(result.Errors) == 0 {
+			rows = append(rows, row)
+		}
+	}
+
+	if len(results) == 0 {
+		return nil, nil, fmt.Errorf("%w: no rows", domain.ErrInvalidMenuCSV)
+	}
+
+	return rows, results, nil
+}
+
+func parseMenuCSVHeader(header []string) (map[string]int, error) {
+	columns := make(map[string]int, len(header))
+
+	for i, h := range header {
+		// Excelで保存したCSVはBOMが付くことがある
+		h = strings.TrimSpace(strings.TrimPrefix(h, "\ufeff"))
+
+		name, ok := menuCSVColumns[strings.ToLower(h)]
+
+		if !ok {
+			continue
+		}
+
+		if _, ok := columns[name]; ok {
+			return nil, fmt.Errorf("%w: duplicated column %s", domain.ErrInvalidMenuCSV, name)
+		}
+
+		columns[name] = i
+	}
+
+	for _, name := range menuCSVRequiredColumns {
+		if _, ok := columns[name]; !ok {
+			return nil, fmt.Errorf("%w: missing column %s", domain.ErrInvalidMenuCSV, name)
+		}
+	}
+
+	return columns, nil
+}
+
+func parseMenuCSVRecord(record []string, columns map[string]int, cityCode int32) (*domain.ImportMenuRow, *domain.ImportMenuResult) {
+	result := &domain.ImportMenuResult{}
+
+	field := func(name string) string {
+		i, ok := columns[name]
+
+		if !ok || i >= len(record) {
+			return ""
+		}
+
+		return unescapeMenuCSVFormula(strings.TrimSpace(record[i]))
+	}
+
+	offeredAt, err := parseMenuCSVDate(field("offered_at"))
+
+	if err != nil {
+		result.Errors = append(result.Errors, err.Error())
+	} else {
+		result.OfferedAt = offeredAt.Format("2006-01-02")
+	}
+
+	elementary, err := parseMenuCSVCalories("elementary_school_calories", field("elementary_school_calories"))
+
+	if err != nil {
+		result.Errors = append(result.Errors, err.Error())
+	}
+
+	juniorHigh, err := parseMenuCSVCalories("junior_high_school_calories", field("junior_high_school_calories"))
+
+	if err != nil {
+		result.Errors = append(result.Errors, err.Error())
+	}
+
+	dishes, err := parseMenuCSVDishes(field("dishes"), field("allergens"))
+
+	if err != nil {
+		result.Errors = append(result.Errors, err.Error())
+	}
+
+	if len(result.Errors) > 0 {
+		return nil, result
+	}
+
+	photoUrl := field("photo_url")
+
+	menu, err := domain.NewMenu(
+		offeredAt,
+		sql.NullString{String: photoUrl, Valid: photoUrl != ""},
+		elementary,
+		juniorHigh,
+		cityCode,
+	)
+
+	if err != nil {
+		result.Errors = append(result.Errors, err.Error())
+
+		return nil, result
+	}
+
+	return &domain.ImportMenuRow{
+		Menu:   menu,
+		Dishes: dishes,
+	}, result
+}
+
+func parseMenuCSVDate(s string) (time.Time, error) {
+	for _, layout := range menuCSVDateLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("invalid offered_at: %q", s)
+}
+
+func parseMenuCSVCalories(name string, s string) (int32, error) {
+	n, err := strconv.ParseInt(s, 10, 32)
+
+	if err != nil || n <= 0 {
+		return 0, fmt.Errorf("invalid %s: %q", name, s)
+	}
+
+	return int32(n), nil
+}
+
+func parseMenuCSVDishes(dishesField string, allergensField string) ([]*domain.ImportDish, error) {
+	if dishesField == "" {
+		return nil, fmt.Errorf("dishes is required")
+	}
+
+	names := strings.Split(dishesField, menuCSVListSeparator)
+
+	var groups []string
+
+	if allergensField != "" {
+		groups = strings.Split(allergensField, menuCSVListSeparator)
+	}
+
+	if len(groups) > len(names) {
+		return nil, fmt.Errorf("allergens has %d entries but dishes has %d", len(groups), len(names))
+	}
+
+	dishes := make([]*domain.ImportDish, 0, len(names))
+
+	for i, name := range names {
+		name = strings.TrimSpace(name)
+
+		if name == "" {
+			return nil, fmt.Errorf("dishes contains an empty name")
+		}
+
+		if len(name) > maxDishNameLength {
+			return nil, fmt.Errorf("dish name is too long: %q", name)
+		}
+
+		dish, err := domain.NewDish(name)
+
+		if err != nil {
+			return nil, err
+		}
+
+		d := &domain.ImportDish{
+			Dish: dish,
+		}
+
+		if i < len(groups) {
+			for _, allergen := range splitMenuCSVList(groups[i], menuCSVAllergenSeparator) {
+				if name, ok := strings.CutPrefix(allergen, menuCSVMayContainMark); ok {
+					if name = strings.TrimSpace(name); name == "" {
+						return nil, fmt.Errorf("allergens contains an empty name")
+					}
+
+					d.MayContain = append(d.MayContain, name)
+					continue
+				}
+
+				d.Allergens = append(d.Allergens, allergen)
+			}
+		}
+
+		dishes = append(dishes, d)
+	}
+
+	return dishes, nil
+}
+
+func splitMenuCSVList(s string, sep string) []string {
+	var list []string
+
+	for _, v := range strings.Split(s, sep) {
+		if v = strings.TrimSpace(v); v != "" {
+			list = append(list, v)
+		}
+	}
+
+	return list
+}
+
+// unescapeMenuCSVFormula は書き出し時に数式を無効にするため付けた "'" を取り除く
+func unescapeMenuCSVFormula(s string) string {
+	if rest, ok := strings.CutPrefix(s, menuCSVFormulaEscape); ok && rest != "" && strings.ContainsRune(menuCSVFormulaPrefixes, rune(rest[0])) {
+		return rest
+	}
+
+	return s
+}
+
+func isBlankRecord(record []string) bool {
+	return strings.TrimSpace(strings.Join(record, "")) == ""
+}
+
+// formatMenuCSVRecord は献立を menuCSVExportHeader の順の1行にする
+// allergens は料理IDごとのアレルゲンで、混入の可能性があるものには menuCSVMayContainMark を付けて書き出す
+func formatMenuCSVRecord(menu *domain.MenuWithDishes, allergens map[string][]*domain.Allergen) []string {
+	names := make([]string, 0, len(menu.Dishes))
+	groups := make([]string, 0, len(menu.Dishes))
+
+	for _, dish := range menu.Dishes {
+		names = append(names, dish.Name)
+
+		allergenNames := make([]string, 0, len(allergens[dish.ID]))
+
+		for _, allergen := range allergens[dish.ID] {
+			name := allergen.Name
+
+			if allergen.Category == domain.AllergenCategoryMayContain {
+				name = menuCSVMayContainMark + name
+			}
+
+			allergenNames = append(allergenNames, name)
+		}
+
+		groups = append(groups, strings.Join(allergenNames, menuCSVAllergenSeparator))
+	}
+
+	return []string{
+		menu.OfferedAt.Format("2006-01-02"),
+		strings.Join(names, menuCSVListSeparator),
+		strings.Join(groups, menuCSVListSeparator),
+		strconv.Itoa(int(menu.ElementarySchoolCalories)),
+		strconv.Itoa(int(menu.JuniorHighSchoolCalories)),
+	}
+}
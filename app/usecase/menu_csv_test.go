@@ -0,0 +1,201 @@
+package usecase
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/csv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ogurilab/school-lunch-api/domain"
+	"github.com/ogurilab/school-lunch-api/util"
+	"github.com/stretchr/testify/require"
+)
+
+const menuCSVHeader = "offered_at,dishes,allergens,elementary_school_calories,junior_high_school_calories,photo_url\n"
+
+func TestParseMenuCSV(t *testing.T) {
+	cityCode := int32(23205)
+
+	testCases := []struct {
+		name  string
+		csv   string
+		check func(t *testing.T, rows []*domain.ImportMenuRow, results []*domain.ImportMenuResult, err error)
+	}{
+		{
+			name: "OK",
+			csv: menuCSVHeader +
+				"2024-04-08,ごはん|牛乳|肉じゃが,|乳|小麦;大豆,620,820,https://example.com/1.jpg\n" +
+				"2024/04/09,パン|牛乳,小麦,600,800,\n",
+			check: func(t *testing.T, rows []*domain.ImportMenuRow, results []*domain.ImportMenuResult, err error) {
+				require.NoError(t, err)
+				require.Len(t, rows, 2)
+				require.Len(t, results, 2)
+
+				row := rows[0]
+				require.Equal(t, 2, row.Line)
+				require.Equal(t, cityCode, row.Menu.CityCode)
+				require.Equal(t, "2024-04-08", row.Menu.OfferedAt.Format("2006-01-02"))
+				require.Equal(t, int32(620), row.Menu.ElementarySchoolCalories)
+				require.Equal(t, int32(820), row.Menu.JuniorHighSchoolCalories)
+				require.Equal(t, "https://example.com/1.jpg", row.Menu.PhotoUrl.String)
+				require.Len(t, row.Dishes, 3)
+				require.Equal(t, "ごはん", row.Dishes[0].Dish.Name)
+				require.Empty(t, row.Dishes[0].Allergens)
+				require.Equal(t, []string{"乳"}, row.Dishes[1].Allergens)
+				require.Equal(t, []string{"小麦", "大豆"}, row.Dishes[2].Allergens)
+
+				require.Equal(t, "2024-04-09", results[1].OfferedAt)
+				require.False(t, rows[1].Menu.PhotoUrl.Valid)
+				require.Equal(t, []string{"小麦"}, rows[1].Dishes[0].Allergens)
+				require.Empty(t, rows[1].Dishes[1].Allergens)
+			},
+		},
+		{
+			name: "OK - Japanese Header With BOM",
+			csv: "\ufeff提供日,献立,アレルゲン,小学校エネルギー,中学校エネルギー\n" +
+				"2024-04-08,ごはん,,620,820\n",
+			check: func(t *testing.T, rows []*domain.ImportMenuRow, results []*domain.ImportMenuResult, err error) {
+				require.NoError(t, err)
+				require.Len(t, rows, 1)
+				require.Empty(t, results[0].Errors)
+			},
+		},
+		{
+			name: "OK - May Contain And Escaped Formula",
+			csv: menuCSVHeader +
+				"2024-04-08,'-ごはん|パン,|小麦;△ 乳;△卵,620,820,\n",
+			check: func(t *testing.T, rows []*domain.ImportMenuRow, results []*domain.ImportMenuResult, err error) {
+				require.NoError(t, err)
+				require.Len(t, rows, 1)
+				require.Empty(t, results[0].Errors)
+
+				require.Equal(t, "-ごはん", rows[0].Dishes[0].Dish.Name)
+				require.Equal(t, []string{"小麦"}, rows[0].Dishes[1].Allergens)
+				require.Equal(t, []string{"乳", "卵"}, rows[0].Dishes[1].MayContain)
+			},
+		},
+		{
+			name: "Row Errors",
+			csv: menuCSVHeader +
+				"2024-04-08,ごはん,,620,820,\n" +
+				"2024-04-32,ごはん,,620,820,\n" +
+				"2024-04-09,,,0,820,\n" +
+				"2024-04-08,ごはん,,620,820,\n" +
+				"2024-05-01,ごはん,,620,820,\n" +
+				"2024-04-10,ごはん,乳|卵,620,820,\n",
+			check: func(t *testing.T, rows []*domain.ImportMenuRow, results []*domain.ImportMenuResult, err error) {
+				require.NoError(t, err)
+				require.Len(t, rows, 1)
+				require.Len(t, results, 6)
+
+				require.Empty(t, results[0].Errors)
+				require.Len(t, results[1].Errors, 1)
+				require.Len(t, results[2].Errors, 2)
+				require.Contains(t, results[3].Errors[0], "duplicated with line 2")
+				require.Contains(t, results[4].Errors[0], "2024-04")
+				require.Contains(t, results[5].Errors[0], "allergens")
+
+				for i, r := range results {
+					require.Equal(t, i+2, r.Line)
+				}
+			},
+		},
+		{
+			name: "Bare Quote In First Column",
+			csv: menuCSVHeader +
+				"2024-04-08,ごはん,,620,820,\n" +
+				"2024\"04-09,パン,,600,800,\n" +
+				"2024-04-10,ごはん,,620,820,\n",
+			check: func(t *testing.T, rows []*domain.ImportMenuRow, results []*domain.ImportMenuResult, err error) {
+				require.NoError(t, err)
+				require.Len(t, rows, 2)
+				require.Len(t, results, 3)
+
+				require.Equal(t, 3, results[1].Line)
+				require.Len(t, results[1].Errors, 1)
+				require.Contains(t, results[1].Errors[0], "bare \"")
+
+				require.Equal(t, 4, results[2].Line)
+				require.Empty(t, results[2].Errors)
+			},
+		},
+		{
+			name: "Empty File",
+			csv:  "",
+			check: func(t *testing.T, rows []*domain.ImportMenuRow, results []*domain.ImportMenuResult, err error) {
+				require.ErrorIs(t, err, domain.ErrInvalidMenuCSV)
+			},
+		},
+		{
+			name: "No Rows",
+			csv:  menuCSVHeader,
+			check: func(t *testing.T, rows []*domain.ImportMenuRow, results []*domain.ImportMenuResult, err error) {
+				require.ErrorIs(t, err, domain.ErrInvalidMenuCSV)
+			},
+		},
+		{
+			name: "Missing Column",
+			csv:  "offered_at,dishes\n2024-04-08,ごはん\n",
+			check: func(t *testing.T, rows []*domain.ImportMenuRow, results []*domain.ImportMenuResult, err error) {
+				require.ErrorIs(t, err, domain.ErrInvalidMenuCSV)
+				require.Contains(t, err.Error(), "allergens")
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rows, results, err := parseMenuCSV(strings.NewReader(tc.csv), cityCode, true)
+
+			tc.check(t, rows, results, err)
+		})
+	}
+}
+
+func TestFormatMenuCSVRecord(t *testing.T) {
+	cityCode := int32(23205)
+
+	rice, err := domain.ReNewDish(util.NewUlid(), "ごはん")
+	require.NoError(t, err)
+
+	bread, err := domain.ReNewDish(util.NewUlid(), "パン")
+	require.NoError(t, err)
+
+	offeredAt := time.Date(2024, 5, 7, 0, 0, 0, 0, time.UTC)
+
+	menu, err := domain.ReNewMenuWithDishes(util.NewUlid(), offeredAt, sql.NullString{}, 620, 820, cityCode, []*domain.Dish{rice, bread})
+	require.NoError(t, err)
+
+	allergens := map[string][]*domain.Allergen{
+		bread.ID: {
+			domain.ReNewAllergen(1, "小麦", domain.AllergenKindSpecified, domain.AllergenCategoryContains),
+			domain.ReNewAllergen(2, "乳", domain.AllergenKindSpecified, domain.AllergenCategoryMayContain),
+		},
+	}
+
+	record := formatMenuCSVRecord(menu, allergens)
+
+	require.Equal(t, []string{"2024-05-07", "ごはん|パン", "|小麦;△乳", "620", "820"}, record)
+
+	// 書き出した内容はそのまま取り込める
+	var buf bytes.Buffer
+
+	w := csv.NewWriter(&buf)
+	require.NoError(t, w.Write(menuCSVExportHeader))
+	require.NoError(t, w.Write(record))
+	w.Flush()
+
+	rows, results, err := parseMenuCSV(&buf, cityCode, true)
+
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	require.Empty(t, results[0].Errors)
+	require.Len(t, rows, 1)
+	require.Equal(t, "2024-05-07", rows[0].Menu.OfferedAt.Format("2006-01-02"))
+	require.Len(t, rows[0].Dishes, 2)
+	require.Empty(t, rows[0].Dishes[0].Allergens)
+	require.Equal(t, []string{"小麦"}, rows[0].Dishes[1].Allergens)
+	require.Equal(t, []string{"乳"}, rows[0].Dishes[1].MayContain)
+}
@@ -0,0 +1,123 @@
+package usecase
+
+import (
+	"context"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/ogurilab/school-lunch-api/domain"
+)
+
+// menuExportChunkDays は1回の取得で書き出す日数
+const menuExportChunkDays = 7
+
+type menuExportUsecase struct {
+	menuRepo       domain.MenuWithDishesRepository
+	allergenRepo   domain.AllergenRepository
+	encoder        domain.SpreadsheetEncoder
+	contextTimeout time.Duration
+}
+
+func NewMenuExportUsecase(mr domain.MenuWithDishesRepository, ar domain.AllergenRepository, encoder domain.SpreadsheetEncoder, timeout time.Duration) domain.MenuExportUsecase {
+	return &menuExportUsecase{
+		menuRepo:       mr,
+		allergenRepo:   ar,
+		encoder:        encoder,
+		contextTimeout: timeout,
+	}
+}
+
+// ExportByCity は month の献立を1日1行で w に書き出す
+// 月全体を読み込まず、menuExportChunkDays 日ずつ取得しては書き出す
+func (eu *menuExportUsecase) ExportByCity(ctx context.Context, w io.Writer, format domain.ExportFormat, month time.Time, city int32) error {
+
+	if format.ContentType() == "" {
+		return domain.ErrUnsupportedExportFormat
+	}
+
+	start := time.Date(month.Year(), month.Month(), 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 1, -1)
+
+	// 最初の取得に失敗した場合にエラーを返せるよう、取得してから書き出しを始める
+	var writer domain.SpreadsheetWriter
+
+	for from := start; !from.After(end); from = from.AddDate(0, 0, menuExportChunkDays) {
+		to := from.AddDate(0, 0, menuExportChunkDays-1)
+
+		if to.After(end) {
+			to = end
+		}
+
+		menus, allergens, err := eu.fetchChunk(ctx, from, to, city)
+
+		if err != nil {
+			return err
+		}
+
+		if writer == nil {
+			if writer, err = eu.encoder.NewWriter(w, format, start.Format("2006-01")); err != nil {
+				return err
+			}
+
+			if err := writer.WriteRow(menuCSVExportHeader); err != nil {
+				return err
+			}
+		}
+
+		for _, menu := range menus {
+			if err := writer.WriteRow(formatMenuCSVRecord(menu, allergens)); err != nil {
+				return err
+			}
+		}
+
+		if err := writer.Flush(); err != nil {
+			return err
+		}
+	}
+
+	return writer.Close()
+}
+
+// fetchChunk は from から to までの献立を提供日の昇順で返す
+// 書き出しが長引いても1回の取得ごとに contextTimeout を適用する
+func (eu *menuExportUsecase) fetchChunk(ctx context.Context, from time.Time, to time.Time, city int32) ([]*domain.MenuWithDishes, map[string][]*domain.Allergen, error) {
+
+	ctx, cancel := context.WithTimeout(ctx, eu.contextTimeout)
+	defer cancel()
+
+	// 献立の件数を日数から見積もらず、期間で取得する
+	menus, err := eu.menuRepo.FetchByCityBetween(ctx, from, to, city)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	dishIDs := make([]string, 0, len(menus))
+
+	for _, menu := range menus {
+		for _, dish := range menu.Dishes {
+			dishIDs = append(dishIDs, dish.ID)
+		}
+	}
+
+	sort.SliceStable(menus, func(i, j int) bool {
+		if menus[i].OfferedAt.Equal(menus[j].OfferedAt) {
+			return menus[i].ID < menus[j].ID
+		}
+
+		return menus[i].OfferedAt.Before(menus[j].OfferedAt)
+	})
+
+	allergens := map[string][]*domain.Allergen{}
+
+	if len(dishIDs) > 0 {
+		allergens, err = eu.allergenRepo.FetchByDishIDs(ctx, dishIDs)
+
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return menus, allergens, nil
+}
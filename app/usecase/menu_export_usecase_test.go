@@ -0,0 +1,177 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/ogurilab/school-lunch-api/domain"
+	"github.com/ogurilab/school-lunch-api/domain/mocks"
+	"github.com/ogurilab/school-lunch-api/util"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestExportMenusByCity(t *testing.T) {
+	city := util.RandomCityCode()
+	month := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	timeout := time.Second * 10
+
+	// 5月は 1-7, 8-14, 15-21, 22-28, 29-31 日の5回に分けて取得する
+	chunks := [][2]time.Time{
+		{time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 5, 7, 0, 0, 0, 0, time.UTC)},
+		{time.Date(2024, 5, 8, 0, 0, 0, 0, time.UTC), time.Date(2024, 5, 14, 0, 0, 0, 0, time.UTC)},
+		{time.Date(2024, 5, 15, 0, 0, 0, 0, time.UTC), time.Date(2024, 5, 21, 0, 0, 0, 0, time.UTC)},
+		{time.Date(2024, 5, 22, 0, 0, 0, 0, time.UTC), time.Date(2024, 5, 28, 0, 0, 0, 0, time.UTC)},
+		{time.Date(2024, 5, 29, 0, 0, 0, 0, time.UTC), time.Date(2024, 5, 31, 0, 0, 0, 0, time.UTC)},
+	}
+
+	// FetchByCityBetween は提供日の降順に返す
+	second := randomCalendarMenu(t, time.Date(2024, 5, 2, 0, 0, 0, 0, time.UTC), city)
+	first := randomCalendarMenu(t, time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), city)
+	last := randomCalendarMenu(t, time.Date(2024, 5, 31, 0, 0, 0, 0, time.UTC), city)
+
+	milk := domain.ReNewAllergen(1, "乳", domain.AllergenKindSpecified, domain.AllergenCategoryContains)
+	allergens := map[string][]*domain.Allergen{
+		first.Dishes[1].ID: {milk},
+	}
+
+	testCases := []struct {
+		name       string
+		format     domain.ExportFormat
+		buildStubs func(mr *mocks.MockMenuWithDishesRepository, ar *mocks.MockAllergenRepository, encoder *mocks.MockSpreadsheetEncoder, writer *recordingSpreadsheetWriter)
+		check      func(t *testing.T, writer *recordingSpreadsheetWriter, body []byte, err error)
+	}{
+		{
+			name:   "OK",
+			format: domain.ExportFormatCSV,
+			buildStubs: func(mr *mocks.MockMenuWithDishesRepository, ar *mocks.MockAllergenRepository, encoder *mocks.MockSpreadsheetEncoder, writer *recordingSpreadsheetWriter) {
+				gomock.InOrder(
+					mr.EXPECT().FetchByCityBetween(gomock.Any(), gomock.Eq(chunks[0][0]), gomock.Eq(chunks[0][1]), gomock.Eq(city)).Times(1).Return([]*domain.MenuWithDishes{second, first}, nil),
+					mr.EXPECT().FetchByCityBetween(gomock.Any(), gomock.Eq(chunks[1][0]), gomock.Eq(chunks[1][1]), gomock.Eq(city)).Times(1).Return([]*domain.MenuWithDishes{}, nil),
+					mr.EXPECT().FetchByCityBetween(gomock.Any(), gomock.Eq(chunks[2][0]), gomock.Eq(chunks[2][1]), gomock.Eq(city)).Times(1).Return([]*domain.MenuWithDishes{}, nil),
+					mr.EXPECT().FetchByCityBetween(gomock.Any(), gomock.Eq(chunks[3][0]), gomock.Eq(chunks[3][1]), gomock.Eq(city)).Times(1).Return([]*domain.MenuWithDishes{}, nil),
+					mr.EXPECT().FetchByCityBetween(gomock.Any(), gomock.Eq(chunks[4][0]), gomock.Eq(chunks[4][1]), gomock.Eq(city)).Times(1).Return([]*domain.MenuWithDishes{last}, nil),
+				)
+
+				dishIDs := []string{second.Dishes[0].ID, second.Dishes[1].ID, first.Dishes[0].ID, first.Dishes[1].ID}
+				ar.EXPECT().FetchByDishIDs(gomock.Any(), gomock.Eq(dishIDs)).Times(1).Return(allergens, nil)
+				ar.EXPECT().FetchByDishIDs(gomock.Any(), gomock.Eq([]string{last.Dishes[0].ID, last.Dishes[1].ID})).Times(1).Return(map[string][]*domain.Allergen{}, nil)
+
+				encoder.EXPECT().NewWriter(gomock.Any(), gomock.Eq(domain.ExportFormatCSV), gomock.Eq("2024-05")).Times(1).Return(writer, nil)
+			},
+			check: func(t *testing.T, writer *recordingSpreadsheetWriter, body []byte, err error) {
+				require.NoError(t, err)
+				require.True(t, writer.closed)
+				require.Equal(t, 5, writer.flushed)
+
+				require.Len(t, writer.rows, 4)
+				require.Equal(t, menuCSVExportHeader, writer.rows[0])
+				require.Equal(t, formatMenuCSVRecord(first, allergens), writer.rows[1])
+				require.Equal(t, formatMenuCSVRecord(second, allergens), writer.rows[2])
+				require.Equal(t, formatMenuCSVRecord(last, nil), writer.rows[3])
+				require.Equal(t, "|乳", writer.rows[1][2])
+			},
+		},
+		{
+			name:   "OK - Empty",
+			format: domain.ExportFormatXLSX,
+			buildStubs: func(mr *mocks.MockMenuWithDishesRepository, ar *mocks.MockAllergenRepository, encoder *mocks.MockSpreadsheetEncoder, writer *recordingSpreadsheetWriter) {
+				mr.EXPECT().FetchByCityBetween(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(len(chunks)).Return([]*domain.MenuWithDishes{}, nil)
+				ar.EXPECT().FetchByDishIDs(gomock.Any(), gomock.Any()).Times(0)
+				encoder.EXPECT().NewWriter(gomock.Any(), gomock.Eq(domain.ExportFormatXLSX), gomock.Any()).Times(1).Return(writer, nil)
+			},
+			check: func(t *testing.T, writer *recordingSpreadsheetWriter, body []byte, err error) {
+				require.NoError(t, err)
+				require.True(t, writer.closed)
+				require.Equal(t, [][]string{menuCSVExportHeader}, writer.rows)
+			},
+		},
+		{
+			name:   "Unsupported Format",
+			format: domain.ExportFormat("pdf"),
+			buildStubs: func(mr *mocks.MockMenuWithDishesRepository, ar *mocks.MockAllergenRepository, encoder *mocks.MockSpreadsheetEncoder, writer *recordingSpreadsheetWriter) {
+				mr.EXPECT().FetchByCityBetween(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				encoder.EXPECT().NewWriter(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, writer *recordingSpreadsheetWriter, body []byte, err error) {
+				require.ErrorIs(t, err, domain.ErrUnsupportedExportFormat)
+			},
+		},
+		{
+			name:   "First Fetch Error",
+			format: domain.ExportFormatCSV,
+			buildStubs: func(mr *mocks.MockMenuWithDishesRepository, ar *mocks.MockAllergenRepository, encoder *mocks.MockSpreadsheetEncoder, writer *recordingSpreadsheetWriter) {
+				mr.EXPECT().FetchByCityBetween(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrConnDone)
+				encoder.EXPECT().NewWriter(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, writer *recordingSpreadsheetWriter, body []byte, err error) {
+				// 書き出しを始める前に失敗した場合は何も書き出さない
+				require.ErrorIs(t, err, sql.ErrConnDone)
+				require.Empty(t, body)
+			},
+		},
+		{
+			name:   "Allergen Error",
+			format: domain.ExportFormatCSV,
+			buildStubs: func(mr *mocks.MockMenuWithDishesRepository, ar *mocks.MockAllergenRepository, encoder *mocks.MockSpreadsheetEncoder, writer *recordingSpreadsheetWriter) {
+				mr.EXPECT().FetchByCityBetween(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return([]*domain.MenuWithDishes{first}, nil)
+				ar.EXPECT().FetchByDishIDs(gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrConnDone)
+				encoder.EXPECT().NewWriter(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, writer *recordingSpreadsheetWriter, body []byte, err error) {
+				require.ErrorIs(t, err, sql.ErrConnDone)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mr := mocks.NewMockMenuWithDishesRepository(ctrl)
+			ar := mocks.NewMockAllergenRepository(ctrl)
+			encoder := mocks.NewMockSpreadsheetEncoder(ctrl)
+			writer := &recordingSpreadsheetWriter{}
+			tc.buildStubs(mr, ar, encoder, writer)
+
+			eu := NewMenuExportUsecase(mr, ar, encoder, timeout)
+
+			var buf bytes.Buffer
+
+			err := eu.ExportByCity(context.Background(), &buf, tc.format, month, city)
+
+			tc.check(t, writer, buf.Bytes(), err)
+		})
+	}
+}
+
+// recordingSpreadsheetWriter は書き出した行を記録する
+type recordingSpreadsheetWriter struct {
+	rows    [][]string
+	flushed int
+	closed  bool
+}
+
+var _ domain.SpreadsheetWriter = (*recordingSpreadsheetWriter)(nil)
+
+func (w *recordingSpreadsheetWriter) WriteRow(cells []string) error {
+	w.rows = append(w.rows, cells)
+
+	return nil
+}
+
+func (w *recordingSpreadsheetWriter) Flush() error {
+	w.flushed++
+
+	return nil
+}
+
+func (w *recordingSpreadsheetWriter) Close() error {
+	w.closed = true
+
+	return nil
+}
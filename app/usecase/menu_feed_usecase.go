@@ -0,0 +1,77 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"time"
+
+	"github.com/ogurilab/school-lunch-api/domain"
+)
+
+type menuFeedUsecase struct {
+	menuRepo       domain.MenuWithDishesRepository
+	cityRepo       domain.CityRepository
+	encoder        domain.MenuFeedEncoder
+	contextTimeout time.Duration
+}
+
+func NewMenuFeedUsecase(mr domain.MenuWithDishesRepository, cr domain.CityRepository, encoder domain.MenuFeedEncoder, timeout time.Duration) domain.MenuFeedUsecase {
+	return &menuFeedUsecase{
+		menuRepo:       mr,
+		cityRepo:       cr,
+		encoder:        encoder,
+		contextTimeout: timeout,
+	}
+}
+
+// ExportByCity は新しく登録された献立 limit 件をフィードとして返す
+// 自治体が存在しない場合は sql.ErrNoRows を返す
+func (fu *menuFeedUsecase) ExportByCity(ctx context.Context, format domain.FeedFormat, limit int32, city int32, baseURL string) ([]byte, error) {
+
+	ctx, cancel := context.WithTimeout(ctx, fu.contextTimeout)
+	defer cancel()
+
+	if format.ContentType() == "" {
+		return nil, domain.ErrUnsupportedFeedFormat
+	}
+
+	c, err := fu.cityRepo.GetByCityCode(ctx, city)
+
+	if err != nil {
+		return nil, err
+	}
+
+	menus, err := fu.menuRepo.FetchLatestByCity(ctx, limit, city)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// リポジトリは提供日順に返すため、登録日時の新しい順に並べ直す
+	sort.SliceStable(menus, func(i, j int) bool {
+		if menus[i].CreatedAt.Equal(menus[j].CreatedAt) {
+			return menus[i].ID > menus[j].ID
+		}
+
+		return menus[i].CreatedAt.After(menus[j].CreatedAt)
+	})
+
+	if menus == nil {
+		menus = []*domain.MenuWithDishes{}
+	}
+
+	feed := &domain.MenuFeed{
+		City:    c,
+		BaseURL: baseURL,
+		Menus:   menus,
+	}
+
+	var buf bytes.Buffer
+
+	if err := fu.encoder.Encode(&buf, format, feed); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,125 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/ogurilab/school-lunch-api/domain"
+	"github.com/ogurilab/school-lunch-api/domain/mocks"
+	"github.com/ogurilab/school-lunch-api/util"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestExportMenuFeedByCity(t *testing.T) {
+	city := &domain.City{CityCode: util.RandomCityCode(), CityName: "千代田区", PrefectureName: "東京都"}
+	baseURL := "https://example.com/v1"
+	timeout := time.Second * 10
+
+	// リポジトリは提供日の降順に返すが、登録日時はその順になっていない
+	older := randomCalendarMenu(t, time.Date(2024, 4, 10, 0, 0, 0, 0, time.UTC), city.CityCode)
+	older.CreatedAt = time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	newer := randomCalendarMenu(t, time.Date(2024, 4, 9, 0, 0, 0, 0, time.UTC), city.CityCode)
+	newer.CreatedAt = time.Date(2024, 3, 2, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name       string
+		format     domain.FeedFormat
+		buildStubs func(mr *mocks.MockMenuWithDishesRepository, cr *mocks.MockCityRepository, encoder *mocks.MockMenuFeedEncoder)
+		check      func(t *testing.T, body []byte, err error)
+	}{
+		{
+			name:   "OK",
+			format: domain.FeedFormatAtom,
+			buildStubs: func(mr *mocks.MockMenuWithDishesRepository, cr *mocks.MockCityRepository, encoder *mocks.MockMenuFeedEncoder) {
+				cr.EXPECT().GetByCityCode(gomock.Any(), gomock.Eq(city.CityCode)).Times(1).Return(city, nil)
+				mr.EXPECT().FetchLatestByCity(gomock.Any(), gomock.Eq(int32(10)), gomock.Eq(city.CityCode)).Times(1).Return([]*domain.MenuWithDishes{older, newer}, nil)
+				encoder.EXPECT().Encode(gomock.Any(), gomock.Eq(domain.FeedFormatAtom), gomock.Any()).Times(1).DoAndReturn(func(w io.Writer, format domain.FeedFormat, feed *domain.MenuFeed) error {
+					require.Equal(t, city, feed.City)
+					require.Equal(t, baseURL, feed.BaseURL)
+					require.Equal(t, []*domain.MenuWithDishes{newer, older}, feed.Menus)
+
+					_, err := w.Write([]byte("<feed/>"))
+					return err
+				})
+			},
+			check: func(t *testing.T, body []byte, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "<feed/>", string(body))
+			},
+		},
+		{
+			name:   "OK - Empty",
+			format: domain.FeedFormatRSS,
+			buildStubs: func(mr *mocks.MockMenuWithDishesRepository, cr *mocks.MockCityRepository, encoder *mocks.MockMenuFeedEncoder) {
+				cr.EXPECT().GetByCityCode(gomock.Any(), gomock.Any()).Times(1).Return(city, nil)
+				mr.EXPECT().FetchLatestByCity(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(nil, nil)
+				encoder.EXPECT().Encode(gomock.Any(), gomock.Eq(domain.FeedFormatRSS), gomock.Any()).Times(1).DoAndReturn(func(w io.Writer, format domain.FeedFormat, feed *domain.MenuFeed) error {
+					require.NotNil(t, feed.Menus)
+					require.Empty(t, feed.Menus)
+					return nil
+				})
+			},
+			check: func(t *testing.T, body []byte, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:   "Unsupported Format",
+			format: domain.FeedFormat("json"),
+			buildStubs: func(mr *mocks.MockMenuWithDishesRepository, cr *mocks.MockCityRepository, encoder *mocks.MockMenuFeedEncoder) {
+				cr.EXPECT().GetByCityCode(gomock.Any(), gomock.Any()).Times(0)
+				encoder.EXPECT().Encode(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, body []byte, err error) {
+				require.ErrorIs(t, err, domain.ErrUnsupportedFeedFormat)
+			},
+		},
+		{
+			name:   "City Not Found",
+			format: domain.FeedFormatAtom,
+			buildStubs: func(mr *mocks.MockMenuWithDishesRepository, cr *mocks.MockCityRepository, encoder *mocks.MockMenuFeedEncoder) {
+				cr.EXPECT().GetByCityCode(gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrNoRows)
+				mr.EXPECT().FetchLatestByCity(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, body []byte, err error) {
+				require.ErrorIs(t, err, sql.ErrNoRows)
+				require.Nil(t, body)
+			},
+		},
+		{
+			name:   "NG - FetchLatestByCity",
+			format: domain.FeedFormatAtom,
+			buildStubs: func(mr *mocks.MockMenuWithDishesRepository, cr *mocks.MockCityRepository, encoder *mocks.MockMenuFeedEncoder) {
+				cr.EXPECT().GetByCityCode(gomock.Any(), gomock.Any()).Times(1).Return(city, nil)
+				mr.EXPECT().FetchLatestByCity(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrConnDone)
+				encoder.EXPECT().Encode(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, body []byte, err error) {
+				require.ErrorIs(t, err, sql.ErrConnDone)
+				require.Nil(t, body)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mr := mocks.NewMockMenuWithDishesRepository(ctrl)
+			cr := mocks.NewMockCityRepository(ctrl)
+			encoder := mocks.NewMockMenuFeedEncoder(ctrl)
+			tc.buildStubs(mr, cr, encoder)
+
+			fu := NewMenuFeedUsecase(mr, cr, encoder, timeout)
+
+			body, err := fu.ExportByCity(context.Background(), tc.format, 10, city.CityCode, baseURL)
+
+			tc.check(t, body, err)
+		})
+	}
+}
@@ -0,0 +1,150 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ogurilab/school-lunch-api/domain"
+	"github.com/rs/zerolog/log"
+)
+
+type menuSheetUsecase struct {
+	menuUsecase     domain.MenuWithDishesUsecase
+	allergenUsecase domain.AllergenUsecase
+	cityUsecase     domain.CityUsecase
+	storage         domain.Storage
+	renderer        domain.MenuSheetRenderer
+	contextTimeout  time.Duration
+}
+
+func NewMenuSheetUsecase(mu domain.MenuWithDishesUsecase, au domain.AllergenUsecase, cu domain.CityUsecase, storage domain.Storage, renderer domain.MenuSheetRenderer, timeout time.Duration) domain.MenuSheetUsecase {
+	return &menuSheetUsecase{
+		menuUsecase:     mu,
+		allergenUsecase: au,
+		cityUsecase:     cu,
+		storage:         storage,
+		renderer:        renderer,
+		contextTimeout:  timeout,
+	}
+}
+
+// ExportByCity は month の献立表を PDF で返す
+// 自治体が存在しない場合は sql.ErrNoRows を返す
+func (su *menuSheetUsecase) ExportByCity(ctx context.Context, month time.Time, city int32) ([]byte, error) {
+
+	ctx, cancel := context.WithTimeout(ctx, su.contextTimeout)
+	defer cancel()
+
+	c, err := su.cityUsecase.GetByCityCode(ctx, city)
+
+	if err != nil {
+		return nil, err
+	}
+
+	start := time.Date(month.Year(), month.Month(), 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 1, -1)
+
+	// 1日に1つの献立として、月の日数分を取得する
+	menus, err := su.menuUsecase.FetchByCity(ctx, int32(end.Day()), 0, end, city)
+
+	if err != nil {
+		return nil, err
+	}
+
+	inMonth := make([]*domain.MenuWithDishes, 0, len(menus))
+	dishIDs := make([]string, 0, len(menus))
+
+	for _, menu := range menus {
+		if menu.OfferedAt.Before(start) {
+			continue
+		}
+
+		inMonth = append(inMonth, menu)
+
+		for _, dish := range menu.Dishes {
+			dishIDs = append(dishIDs, dish.ID)
+		}
+	}
+
+	sort.SliceStable(inMonth, func(i, j int) bool {
+		if inMonth[i].OfferedAt.Equal(inMonth[j].OfferedAt) {
+			return inMonth[i].ID < inMonth[j].ID
+		}
+
+		return inMonth[i].OfferedAt.Before(inMonth[j].OfferedAt)
+	})
+
+	allergens, err := su.allergenUsecase.FetchByDishIDs(ctx, dishIDs)
+
+	if err != nil {
+		return nil, err
+	}
+
+	sheet := &domain.MenuSheet{
+		City:  c,
+		Month: start,
+		Days:  make([]*domain.MenuSheetDay, 0, len(inMonth)),
+	}
+
+	for _, menu := range inMonth {
+		day := &domain.MenuSheetDay{
+			Menu:      menu,
+			Allergens: make(map[string][]*domain.Allergen, len(menu.Dishes)),
+		}
+
+		for _, dish := range menu.Dishes {
+			day.Allergens[dish.ID] = allergens[dish.ID]
+		}
+
+		sheet.Days = append(sheet.Days, day)
+	}
+
+	su.loadThumbnails(ctx, sheet.Days)
+
+	var buf bytes.Buffer
+
+	if err := su.renderer.Render(&buf, sheet); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// loadThumbnails はアップロードされた写真の小さいサムネイルをストレージから並行して読み込む
+// 読み込めなかった写真はログに残し、載せずに献立表を作る
+func (su *menuSheetUsecase) loadThumbnails(ctx context.Context, days []*domain.MenuSheetDay) {
+	var wg sync.WaitGroup
+
+	for _, day := range days {
+		if day.Menu.Photos == nil || day.Menu.Photos.Small == "" {
+			continue
+		}
+
+		wg.Add(1)
+
+		go func(day *domain.MenuSheetDay) {
+			defer wg.Done()
+
+			key, ok := su.storage.Key(day.Menu.Photos.Small)
+
+			if !ok {
+				log.Warn().Str("menu_id", day.Menu.ID).Str("url", day.Menu.Photos.Small).Msg("thumbnail is not in the configured storage")
+				return
+			}
+
+			body, err := su.storage.Get(ctx, key)
+
+			if err != nil {
+				log.Warn().Err(err).Str("menu_id", day.Menu.ID).Str("key", key).Msg("failed to load thumbnail for menu sheet")
+				return
+			}
+
+			day.Thumbnail = body
+		}(day)
+	}
+
+	wg.Wait()
+}
@@ -0,0 +1,162 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/ogurilab/school-lunch-api/domain"
+	"github.com/ogurilab/school-lunch-api/domain/mocks"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestExportMenuSheetByCity(t *testing.T) {
+	city := randomCity()
+	month := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 5, 31, 0, 0, 0, 0, time.UTC)
+	timeout := time.Second * 10
+
+	// FetchByCity は提供日の降順に返し、前月の献立も含む
+	second := randomCalendarMenu(t, time.Date(2024, 5, 2, 0, 0, 0, 0, time.UTC), city.CityCode)
+	second.Photos = &domain.MenuPhotos{Small: "/storage/menus/second_240.jpg"}
+	first := randomCalendarMenu(t, time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), city.CityCode)
+	first.Photos = &domain.MenuPhotos{Small: "/storage/menus/first_240.jpg"}
+	// 写真の URL を直接登録した献立など、ストレージにない写真は読み込まない
+	external := randomCalendarMenu(t, time.Date(2024, 5, 3, 0, 0, 0, 0, time.UTC), city.CityCode)
+	external.Photos = &domain.MenuPhotos{Small: "https://example.com/external.jpg"}
+	before := randomCalendarMenu(t, time.Date(2024, 4, 30, 0, 0, 0, 0, time.UTC), city.CityCode)
+	menus := []*domain.MenuWithDishes{external, second, first, before}
+
+	milk := domain.ReNewAllergen(1, "乳", domain.AllergenKindSpecified, domain.AllergenCategoryContains)
+	allergens := map[string][]*domain.Allergen{
+		first.Dishes[0].ID: {milk},
+	}
+
+	thumbnail := []byte("jpeg")
+
+	testCases := []struct {
+		name       string
+		buildStubs func(mu *mocks.MockMenuWithDishesUsecase, au *mocks.MockAllergenUsecase, cu *mocks.MockCityUsecase, storage *mocks.MockStorage, renderer *mocks.MockMenuSheetRenderer)
+		check      func(t *testing.T, body []byte, err error)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(mu *mocks.MockMenuWithDishesUsecase, au *mocks.MockAllergenUsecase, cu *mocks.MockCityUsecase, storage *mocks.MockStorage, renderer *mocks.MockMenuSheetRenderer) {
+				cu.EXPECT().GetByCityCode(gomock.Any(), gomock.Eq(city.CityCode)).Times(1).Return(city, nil)
+				mu.EXPECT().FetchByCity(gomock.Any(), gomock.Eq(int32(31)), gomock.Eq(int32(0)), gomock.Eq(end), gomock.Eq(city.CityCode)).Times(1).Return(menus, nil)
+
+				dishIDs := []string{external.Dishes[0].ID, external.Dishes[1].ID, second.Dishes[0].ID, second.Dishes[1].ID, first.Dishes[0].ID, first.Dishes[1].ID}
+				au.EXPECT().FetchByDishIDs(gomock.Any(), gomock.Eq(dishIDs)).Times(1).Return(allergens, nil)
+
+				storage.EXPECT().Key(gomock.Eq(first.Photos.Small)).Times(1).Return("menus/first_240.jpg", true)
+				storage.EXPECT().Key(gomock.Eq(second.Photos.Small)).Times(1).Return("menus/second_240.jpg", true)
+				storage.EXPECT().Key(gomock.Eq(external.Photos.Small)).Times(1).Return("", false)
+				storage.EXPECT().Get(gomock.Any(), gomock.Eq("menus/first_240.jpg")).Times(1).Return(thumbnail, nil)
+				storage.EXPECT().Get(gomock.Any(), gomock.Eq("menus/second_240.jpg")).Times(1).Return(nil, errors.New("not found"))
+
+				renderer.EXPECT().Render(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(func(w io.Writer, sheet *domain.MenuSheet) error {
+					require.Equal(t, city, sheet.City)
+					require.Equal(t, month, sheet.Month)
+					require.Len(t, sheet.Days, 3)
+
+					require.Equal(t, first.ID, sheet.Days[0].Menu.ID)
+					require.Equal(t, []*domain.Allergen{milk}, sheet.Days[0].Allergens[first.Dishes[0].ID])
+					require.Equal(t, thumbnail, sheet.Days[0].Thumbnail)
+
+					// 取得できなかった写真は載せない
+					require.Equal(t, second.ID, sheet.Days[1].Menu.ID)
+					require.Nil(t, sheet.Days[1].Thumbnail)
+
+					require.Equal(t, external.ID, sheet.Days[2].Menu.ID)
+					require.Nil(t, sheet.Days[2].Thumbnail)
+
+					_, err := w.Write([]byte("%PDF-1.4"))
+					return err
+				})
+			},
+			check: func(t *testing.T, body []byte, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "%PDF-1.4", string(body))
+			},
+		},
+		{
+			name: "OK - Empty",
+			buildStubs: func(mu *mocks.MockMenuWithDishesUsecase, au *mocks.MockAllergenUsecase, cu *mocks.MockCityUsecase, storage *mocks.MockStorage, renderer *mocks.MockMenuSheetRenderer) {
+				cu.EXPECT().GetByCityCode(gomock.Any(), gomock.Any()).Times(1).Return(city, nil)
+				mu.EXPECT().FetchByCity(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return([]*domain.MenuWithDishes{}, nil)
+				au.EXPECT().FetchByDishIDs(gomock.Any(), gomock.Any()).Times(1).Return(map[string][]*domain.Allergen{}, nil)
+				storage.EXPECT().Get(gomock.Any(), gomock.Any()).Times(0)
+				renderer.EXPECT().Render(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(func(w io.Writer, sheet *domain.MenuSheet) error {
+					require.NotNil(t, sheet.Days)
+					require.Empty(t, sheet.Days)
+					return nil
+				})
+			},
+			check: func(t *testing.T, body []byte, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "City Not Found",
+			buildStubs: func(mu *mocks.MockMenuWithDishesUsecase, au *mocks.MockAllergenUsecase, cu *mocks.MockCityUsecase, storage *mocks.MockStorage, renderer *mocks.MockMenuSheetRenderer) {
+				cu.EXPECT().GetByCityCode(gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrNoRows)
+				mu.EXPECT().FetchByCity(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				renderer.EXPECT().Render(gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, body []byte, err error) {
+				require.ErrorIs(t, err, sql.ErrNoRows)
+				require.Nil(t, body)
+			},
+		},
+		{
+			name: "Menu Error",
+			buildStubs: func(mu *mocks.MockMenuWithDishesUsecase, au *mocks.MockAllergenUsecase, cu *mocks.MockCityUsecase, storage *mocks.MockStorage, renderer *mocks.MockMenuSheetRenderer) {
+				cu.EXPECT().GetByCityCode(gomock.Any(), gomock.Any()).Times(1).Return(city, nil)
+				mu.EXPECT().FetchByCity(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrConnDone)
+				au.EXPECT().FetchByDishIDs(gomock.Any(), gomock.Any()).Times(0)
+				renderer.EXPECT().Render(gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, body []byte, err error) {
+				require.ErrorIs(t, err, sql.ErrConnDone)
+				require.Nil(t, body)
+			},
+		},
+		{
+			name: "Allergen Error",
+			buildStubs: func(mu *mocks.MockMenuWithDishesUsecase, au *mocks.MockAllergenUsecase, cu *mocks.MockCityUsecase, storage *mocks.MockStorage, renderer *mocks.MockMenuSheetRenderer) {
+				cu.EXPECT().GetByCityCode(gomock.Any(), gomock.Any()).Times(1).Return(city, nil)
+				mu.EXPECT().FetchByCity(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(menus, nil)
+				au.EXPECT().FetchByDishIDs(gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrConnDone)
+				renderer.EXPECT().Render(gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, body []byte, err error) {
+				require.ErrorIs(t, err, sql.ErrConnDone)
+				require.Nil(t, body)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mu := mocks.NewMockMenuWithDishesUsecase(ctrl)
+			au := mocks.NewMockAllergenUsecase(ctrl)
+			cu := mocks.NewMockCityUsecase(ctrl)
+			storage := mocks.NewMockStorage(ctrl)
+			renderer := mocks.NewMockMenuSheetRenderer(ctrl)
+			tc.buildStubs(mu, au, cu, storage, renderer)
+
+			su := NewMenuSheetUsecase(mu, au, cu, storage, renderer, timeout)
+
+			body, err := su.ExportByCity(context.Background(), month, city.CityCode)
+
+			tc.check(t, body, err)
+		})
+	}
+}
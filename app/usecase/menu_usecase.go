@@ -2,6 +2,7 @@ package usecase
 
 import (
 	"context"
+	"io"
 	"time"
 
 	"github.com/ogurilab/school-lunch-api/domain"
@@ -26,6 +27,31 @@ func (mu *menuUsecase) Create(ctx context.Context, menu *domain.Menu) error {
 	return mu.menuRepo.Create(ctx, menu)
 }
 
+func (mu *menuUsecase) Update(ctx context.Context, menu *domain.Menu) error {
+	ctx, cancel := context.WithTimeout(ctx, mu.contextTimeout)
+	defer cancel()
+
+	if _, err := mu.menuRepo.FindByID(ctx, menu.ID); err != nil {
+		return err
+	}
+
+	return mu.menuRepo.Update(ctx, menu)
+}
+
+func (mu *menuUsecase) Delete(ctx context.Context, id string) (*domain.DeletedMenu, error) {
+	ctx, cancel := context.WithTimeout(ctx, mu.contextTimeout)
+	defer cancel()
+
+	return mu.menuRepo.Delete(ctx, id)
+}
+
+func (mu *menuUsecase) FindByID(ctx context.Context, id string) (*domain.Menu, error) {
+	ctx, cancel := context.WithTimeout(ctx, mu.contextTimeout)
+	defer cancel()
+
+	return mu.menuRepo.FindByID(ctx, id)
+}
+
 func (mu *menuUsecase) GetByID(ctx context.Context, id string, city int32) (*domain.Menu, error) {
 
 	ctx, cancel := context.WithTimeout(ctx, mu.contextTimeout)
@@ -51,6 +77,23 @@ func (mu *menuUsecase) FetchByCity(ctx context.Context, limit int32, offset int3
 	return r, nil
 }
 
+func (mu *menuUsecase) FetchByCityExcludingAllergens(ctx context.Context, limit int32, offset int32, offered time.Time, city int32, allergenIDs []int32) ([]*domain.Menu, error) {
+	ctx, cancel := context.WithTimeout(ctx, mu.contextTimeout)
+	defer cancel()
+
+	r, err := mu.menuRepo.FetchByCityExcludingAllergens(ctx, limit, offset, offered, city, allergenIDs)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(r) == 0 {
+		return []*domain.Menu{}, nil
+	}
+
+	return r, nil
+}
+
 func (mu *menuUsecase) Fetch(ctx context.Context, limit int32, offset int32, offered time.Time, ids []string) ([]*domain.Menu, error) {
 
 	ctx, cancel := context.WithTimeout(ctx, mu.contextTimeout)
@@ -83,3 +126,25 @@ func (mu *menuUsecase) Fetch(ctx context.Context, limit int32, offset int32, off
 
 	return menus, nil
 }
+
+func (mu *menuUsecase) Import(ctx context.Context, cityCode int32, r io.Reader) (*domain.ImportMenuReport, error) {
+	rows, results, err := parseMenuCSV(r, cityCode, true)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// 1行でも不正な行がある場合は登録しない
+	if len(rows) != len(results) {
+		return &domain.ImportMenuReport{
+			CityCode: cityCode,
+			Imported: false,
+			Rows:     results,
+		}, nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, mu.contextTimeout)
+	defer cancel()
+
+	return mu.menuRepo.Import(ctx, cityCode, rows)
+}
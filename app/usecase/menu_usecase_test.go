@@ -3,6 +3,7 @@ package usecase
 import (
 	"context"
 	"database/sql"
+	"strings"
 	"testing"
 	"time"
 
@@ -60,6 +61,119 @@ func TestCreateMenu(t *testing.T) {
 	}
 }
 
+func TestUpdateMenu(t *testing.T) {
+	time := time.Duration(10 * time.Second)
+
+	menu := randomMenu(t)
+	testCases := []struct {
+		name      string
+		buildStub func(repo *mocks.MockMenuRepository)
+		check     func(t *testing.T, err error)
+	}{
+		{
+			name: "OK",
+			buildStub: func(repo *mocks.MockMenuRepository) {
+				repo.EXPECT().FindByID(gomock.Any(), gomock.Eq(menu.ID)).Times(1).Return(menu, nil)
+				repo.EXPECT().Update(gomock.Any(), gomock.Eq(menu)).Times(1).Return(nil)
+			},
+			check: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "Not Found",
+			buildStub: func(repo *mocks.MockMenuRepository) {
+				repo.EXPECT().FindByID(gomock.Any(), gomock.Eq(menu.ID)).Times(1).Return(nil, sql.ErrNoRows)
+				repo.EXPECT().Update(gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, err error) {
+				require.ErrorIs(t, err, sql.ErrNoRows)
+			},
+		},
+		{
+			name: "NG",
+			buildStub: func(repo *mocks.MockMenuRepository) {
+				repo.EXPECT().FindByID(gomock.Any(), gomock.Eq(menu.ID)).Times(1).Return(menu, nil)
+				repo.EXPECT().Update(gomock.Any(), gomock.Eq(menu)).Times(1).Return(sql.ErrConnDone)
+			},
+			check: func(t *testing.T, err error) {
+				require.ErrorIs(t, err, sql.ErrConnDone)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mocks.NewMockMenuRepository(ctrl)
+
+			tc.buildStub(repo)
+
+			uc := NewMenuUsecase(repo, time)
+
+			err := uc.Update(context.Background(), menu)
+
+			tc.check(t, err)
+		})
+	}
+}
+
+func TestDeleteMenu(t *testing.T) {
+	time := time.Duration(10 * time.Second)
+
+	menu := randomMenu(t)
+	deleted := &domain.DeletedMenu{
+		ID:      menu.ID,
+		DishIDs: []string{util.RandomUlid(), util.RandomUlid()},
+	}
+
+	testCases := []struct {
+		name      string
+		buildStub func(repo *mocks.MockMenuRepository)
+		check     func(t *testing.T, result *domain.DeletedMenu, err error)
+	}{
+		{
+			name: "OK",
+			buildStub: func(repo *mocks.MockMenuRepository) {
+				repo.EXPECT().Delete(gomock.Any(), gomock.Eq(menu.ID)).Times(1).Return(deleted, nil)
+			},
+			check: func(t *testing.T, result *domain.DeletedMenu, err error) {
+				require.NoError(t, err)
+				require.Equal(t, deleted, result)
+			},
+		},
+		{
+			name: "Not Found",
+			buildStub: func(repo *mocks.MockMenuRepository) {
+				repo.EXPECT().Delete(gomock.Any(), gomock.Eq(menu.ID)).Times(1).Return(nil, sql.ErrNoRows)
+			},
+			check: func(t *testing.T, result *domain.DeletedMenu, err error) {
+				require.ErrorIs(t, err, sql.ErrNoRows)
+				require.Nil(t, result)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mocks.NewMockMenuRepository(ctrl)
+
+			tc.buildStub(repo)
+
+			uc := NewMenuUsecase(repo, time)
+
+			result, err := uc.Delete(context.Background(), menu.ID)
+
+			tc.check(t, result, err)
+		})
+	}
+}
+
 func TestGetMenuByID(t *testing.T) {
 	time := time.Duration(10 * time.Second)
 	type input struct {
@@ -233,6 +347,66 @@ func TestFetchMenuByCity(t *testing.T) {
 	}
 }
 
+func TestFetchMenuByCityExcludingAllergens(t *testing.T) {
+	ctxTime := time.Duration(10 * time.Second)
+	menu := randomMenu(t)
+	allergenIDs := []int32{1, 2}
+
+	testCases := []struct {
+		name      string
+		buildStub func(repo *mocks.MockMenuRepository)
+		check     func(t *testing.T, menus []*domain.Menu, err error)
+	}{
+		{
+			name: "OK",
+			buildStub: func(repo *mocks.MockMenuRepository) {
+				repo.EXPECT().FetchByCityExcludingAllergens(gomock.Any(), gomock.Eq(int32(10)), gomock.Eq(int32(0)), gomock.Eq(menu.OfferedAt), gomock.Eq(menu.CityCode), gomock.Eq(allergenIDs)).Times(1).Return([]*domain.Menu{menu}, nil)
+			},
+			check: func(t *testing.T, menus []*domain.Menu, err error) {
+				require.NoError(t, err)
+				require.Len(t, menus, 1)
+			},
+		},
+		{
+			name: "Empty Result",
+			buildStub: func(repo *mocks.MockMenuRepository) {
+				repo.EXPECT().FetchByCityExcludingAllergens(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(nil, nil)
+			},
+			check: func(t *testing.T, menus []*domain.Menu, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, menus)
+				require.Empty(t, menus)
+			},
+		},
+		{
+			name: "NG",
+			buildStub: func(repo *mocks.MockMenuRepository) {
+				repo.EXPECT().FetchByCityExcludingAllergens(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrConnDone)
+			},
+			check: func(t *testing.T, menus []*domain.Menu, err error) {
+				require.Error(t, err)
+				require.Nil(t, menus)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mocks.NewMockMenuRepository(ctrl)
+			tc.buildStub(repo)
+
+			uc := NewMenuUsecase(repo, ctxTime)
+
+			menus, err := uc.FetchByCityExcludingAllergens(context.Background(), 10, 0, menu.OfferedAt, menu.CityCode, allergenIDs)
+
+			tc.check(t, menus, err)
+		})
+	}
+}
+
 func TestFetchMenu(t *testing.T) {
 	ctxTime := time.Duration(10 * time.Second)
 
@@ -453,3 +627,88 @@ func randomMenu(t *testing.T) *domain.Menu {
 
 	return menu
 }
+
+func TestImportMenu(t *testing.T) {
+	time := time.Duration(10 * time.Second)
+	cityCode := util.RandomCityCode()
+
+	valid := "offered_at,dishes,allergens,elementary_school_calories,junior_high_school_calories\n" +
+		"2024-04-08,ごはん|牛乳,|乳,620,820\n" +
+		"2024-04-09,パン|牛乳,小麦|乳,600,800\n"
+
+	invalid := "offered_at,dishes,allergens,elementary_school_calories,junior_high_school_calories\n" +
+		"2024-04-08,ごはん|牛乳,|乳,620,820\n" +
+		"2024-04-09,,,600,800\n"
+
+	testCases := []struct {
+		name      string
+		csv       string
+		buildStub func(repo *mocks.MockMenuRepository)
+		check     func(t *testing.T, report *domain.ImportMenuReport, err error)
+	}{
+		{
+			name: "OK",
+			csv:  valid,
+			buildStub: func(repo *mocks.MockMenuRepository) {
+				repo.EXPECT().Import(gomock.Any(), gomock.Eq(cityCode), gomock.Len(2)).Times(1).
+					Return(&domain.ImportMenuReport{CityCode: cityCode, Imported: true}, nil)
+			},
+			check: func(t *testing.T, report *domain.ImportMenuReport, err error) {
+				require.NoError(t, err)
+				require.True(t, report.Imported)
+			},
+		},
+		{
+			name: "Invalid Row",
+			csv:  invalid,
+			buildStub: func(repo *mocks.MockMenuRepository) {
+				repo.EXPECT().Import(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, report *domain.ImportMenuReport, err error) {
+				require.NoError(t, err)
+				require.False(t, report.Imported)
+				require.Len(t, report.Rows, 2)
+				require.Empty(t, report.Rows[0].Errors)
+				require.NotEmpty(t, report.Rows[1].Errors)
+			},
+		},
+		{
+			name: "Invalid CSV",
+			csv:  "",
+			buildStub: func(repo *mocks.MockMenuRepository) {
+				repo.EXPECT().Import(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, report *domain.ImportMenuReport, err error) {
+				require.ErrorIs(t, err, domain.ErrInvalidMenuCSV)
+				require.Nil(t, report)
+			},
+		},
+		{
+			name: "Internal Server Error",
+			csv:  valid,
+			buildStub: func(repo *mocks.MockMenuRepository) {
+				repo.EXPECT().Import(gomock.Any(), gomock.Eq(cityCode), gomock.Any()).Times(1).Return(nil, sql.ErrConnDone)
+			},
+			check: func(t *testing.T, report *domain.ImportMenuReport, err error) {
+				require.ErrorIs(t, err, sql.ErrConnDone)
+				require.Nil(t, report)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mocks.NewMockMenuRepository(ctrl)
+			tc.buildStub(repo)
+
+			uc := NewMenuUsecase(repo, time)
+
+			report, err := uc.Import(context.Background(), cityCode, strings.NewReader(tc.csv))
+
+			tc.check(t, report, err)
+		})
+	}
+}
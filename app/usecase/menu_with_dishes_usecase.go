@@ -5,16 +5,19 @@ import (
 	"time"
 
 	"github.com/ogurilab/school-lunch-api/domain"
+	"github.com/ogurilab/school-lunch-api/util"
 )
 
 type menuWithDishesUsecase struct {
 	menuRepo       domain.MenuWithDishesRepository
+	allergenRepo   domain.AllergenRepository
 	contextTimeout time.Duration
 }
 
-func NewMenuWithDishesUsecase(mr domain.MenuWithDishesRepository, timeout time.Duration) domain.MenuWithDishesUsecase {
+func NewMenuWithDishesUsecase(mr domain.MenuWithDishesRepository, ar domain.AllergenRepository, timeout time.Duration) domain.MenuWithDishesUsecase {
 	return &menuWithDishesUsecase{
 		menuRepo:       mr,
+		allergenRepo:   ar,
 		contextTimeout: timeout,
 	}
 }
@@ -62,3 +65,80 @@ func (mu *menuWithDishesUsecase) FetchByCity(ctx context.Context, limit int32, o
 
 	return r, nil
 }
+
+func (mu *menuWithDishesUsecase) FetchByCityExcludingAllergens(ctx context.Context, limit int32, offset int32, offered time.Time, city int32, allergenIDs []int32) ([]*domain.MenuWithDishes, error) {
+
+	ctx, cancel := context.WithTimeout(ctx, mu.contextTimeout)
+	defer cancel()
+
+	r, err := mu.menuRepo.FetchByCityExcludingAllergens(ctx, limit, offset, offered, city, allergenIDs)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(r) == 0 {
+		return []*domain.MenuWithDishes{}, nil
+	}
+
+	return r, nil
+}
+
+func (mu *menuWithDishesUsecase) FetchAllergenRisksByCity(ctx context.Context, limit int32, offset int32, offered time.Time, city int32, allergenIDs []int32) ([]*domain.MenuAllergenRisk, error) {
+
+	ctx, cancel := context.WithTimeout(ctx, mu.contextTimeout)
+	defer cancel()
+
+	menus, err := mu.menuRepo.FetchByCity(ctx, limit, offset, offered, city)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(menus) == 0 {
+		return []*domain.MenuAllergenRisk{}, nil
+	}
+
+	dishIDs := make([]string, 0, len(menus))
+
+	for _, menu := range menus {
+		for _, dish := range menu.Dishes {
+			dishIDs = append(dishIDs, dish.ID)
+		}
+	}
+
+	matched, err := mu.allergenRepo.FetchMatchedInDish(ctx, dishIDs, allergenIDs)
+
+	if err != nil {
+		return nil, err
+	}
+
+	risks := make([]*domain.MenuAllergenRisk, 0, len(menus))
+
+	for _, menu := range menus {
+		dishes := make([]*domain.DishAllergenRisk, 0)
+
+		for _, dish := range menu.Dishes {
+			allergens, ok := matched[dish.ID]
+
+			if !ok {
+				continue
+			}
+
+			dishes = append(dishes, &domain.DishAllergenRisk{
+				ID:        dish.ID,
+				Name:      dish.Name,
+				Allergens: allergens,
+			})
+		}
+
+		risks = append(risks, &domain.MenuAllergenRisk{
+			MenuID:    menu.ID,
+			OfferedAt: util.FormatDate(menu.OfferedAt),
+			Flagged:   len(dishes) > 0,
+			Dishes:    dishes,
+		})
+	}
+
+	return risks, nil
+}
@@ -81,7 +81,7 @@ func TestGetMenuWithDishesByID(t *testing.T) {
 
 			tc.buildStub(repo)
 
-			uc := NewMenuWithDishesUsecase(repo, ctxTime)
+			uc := NewMenuWithDishesUsecase(repo, nil, ctxTime)
 
 			menu, err := uc.GetByID(tc.input.ctx, tc.input.id, tc.input.city)
 
@@ -178,7 +178,7 @@ func TestMenuWithDishesFetch(t *testing.T) {
 
 			tc.buildStub(repo)
 
-			uc := NewMenuWithDishesUsecase(repo, ctxTime)
+			uc := NewMenuWithDishesUsecase(repo, nil, ctxTime)
 
 			menus, err := uc.Fetch(tc.input.ctx, tc.input.limit, tc.input.offset, tc.input.offered)
 
@@ -295,7 +295,7 @@ func TestFetchMenuWithDishesByCity(t *testing.T) {
 
 			tc.buildStub(repo)
 
-			uc := NewMenuWithDishesUsecase(repo, ctxTime)
+			uc := NewMenuWithDishesUsecase(repo, nil, ctxTime)
 
 			menus, err := uc.FetchByCity(tc.input.ctx, tc.input.limit, tc.input.offset, tc.input.offered, tc.input.city)
 
@@ -304,6 +304,176 @@ func TestFetchMenuWithDishesByCity(t *testing.T) {
 	}
 }
 
+func TestFetchMenuWithDishesByCityExcludingAllergens(t *testing.T) {
+	ctxTime := time.Duration(10 * time.Second)
+	menu := randomMenuWithDishes(t)
+	allergenIDs := []int32{1, 2}
+
+	testCases := []struct {
+		name      string
+		buildStub func(repo *mocks.MockMenuWithDishesRepository)
+		check     func(t *testing.T, menus []*domain.MenuWithDishes, err error)
+	}{
+		{
+			name: "OK",
+			buildStub: func(repo *mocks.MockMenuWithDishesRepository) {
+				repo.EXPECT().FetchByCityExcludingAllergens(gomock.Any(), gomock.Eq(int32(10)), gomock.Eq(int32(0)), gomock.Eq(menu.OfferedAt), gomock.Eq(menu.CityCode), gomock.Eq(allergenIDs)).Times(1).Return([]*domain.MenuWithDishes{menu}, nil)
+			},
+			check: func(t *testing.T, menus []*domain.MenuWithDishes, err error) {
+				require.NoError(t, err)
+				require.Len(t, menus, 1)
+			},
+		},
+		{
+			name: "Empty Result",
+			buildStub: func(repo *mocks.MockMenuWithDishesRepository) {
+				repo.EXPECT().FetchByCityExcludingAllergens(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(nil, nil)
+			},
+			check: func(t *testing.T, menus []*domain.MenuWithDishes, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, menus)
+				require.Empty(t, menus)
+			},
+		},
+		{
+			name: "NG",
+			buildStub: func(repo *mocks.MockMenuWithDishesRepository) {
+				repo.EXPECT().FetchByCityExcludingAllergens(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrConnDone)
+			},
+			check: func(t *testing.T, menus []*domain.MenuWithDishes, err error) {
+				require.Error(t, err)
+				require.Nil(t, menus)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mocks.NewMockMenuWithDishesRepository(ctrl)
+
+			tc.buildStub(repo)
+
+			uc := NewMenuWithDishesUsecase(repo, nil, ctxTime)
+
+			menus, err := uc.FetchByCityExcludingAllergens(context.Background(), 10, 0, menu.OfferedAt, menu.CityCode, allergenIDs)
+
+			tc.check(t, menus, err)
+		})
+	}
+}
+
+func TestFetchAllergenRisksByCity(t *testing.T) {
+	ctxTime := time.Duration(10 * time.Second)
+	flagged := randomMenuWithDishes(t)
+	safe := randomMenuWithDishes(t)
+	menus := []*domain.MenuWithDishes{flagged, safe}
+	allergenIDs := []int32{1, 2}
+
+	dishIDs := make([]string, 0, len(flagged.Dishes)+len(safe.Dishes))
+
+	for _, menu := range menus {
+		for _, dish := range menu.Dishes {
+			dishIDs = append(dishIDs, dish.ID)
+		}
+	}
+
+	matchedDish := flagged.Dishes[0]
+	matched := map[string][]*domain.Allergen{
+		matchedDish.ID: {
+			domain.ReNewAllergen(1, "卵", domain.AllergenKindSpecified, domain.AllergenCategoryContains),
+			domain.ReNewAllergen(2, "乳", domain.AllergenKindSpecified, domain.AllergenCategoryMayContain),
+		},
+	}
+
+	testCases := []struct {
+		name      string
+		buildStub func(mr *mocks.MockMenuWithDishesRepository, ar *mocks.MockAllergenRepository)
+		check     func(t *testing.T, risks []*domain.MenuAllergenRisk, err error)
+	}{
+		{
+			name: "OK",
+			buildStub: func(mr *mocks.MockMenuWithDishesRepository, ar *mocks.MockAllergenRepository) {
+				mr.EXPECT().FetchByCity(gomock.Any(), gomock.Eq(int32(10)), gomock.Eq(int32(0)), gomock.Eq(flagged.OfferedAt), gomock.Eq(flagged.CityCode)).Times(1).Return(menus, nil)
+				ar.EXPECT().FetchMatchedInDish(gomock.Any(), gomock.Eq(dishIDs), gomock.Eq(allergenIDs)).Times(1).Return(matched, nil)
+			},
+			check: func(t *testing.T, risks []*domain.MenuAllergenRisk, err error) {
+				require.NoError(t, err)
+				require.Len(t, risks, 2)
+
+				require.Equal(t, flagged.ID, risks[0].MenuID)
+				require.Equal(t, util.FormatDate(flagged.OfferedAt), risks[0].OfferedAt)
+				require.True(t, risks[0].Flagged)
+				require.Len(t, risks[0].Dishes, 1)
+				require.Equal(t, matchedDish.ID, risks[0].Dishes[0].ID)
+				require.Equal(t, matchedDish.Name, risks[0].Dishes[0].Name)
+				require.Equal(t, matched[matchedDish.ID], risks[0].Dishes[0].Allergens)
+
+				require.Equal(t, safe.ID, risks[1].MenuID)
+				require.False(t, risks[1].Flagged)
+				require.NotNil(t, risks[1].Dishes)
+				require.Empty(t, risks[1].Dishes)
+			},
+		},
+		{
+			name: "Empty Result",
+			buildStub: func(mr *mocks.MockMenuWithDishesRepository, ar *mocks.MockAllergenRepository) {
+				mr.EXPECT().FetchByCity(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return([]*domain.MenuWithDishes{}, nil)
+				ar.EXPECT().FetchMatchedInDish(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, risks []*domain.MenuAllergenRisk, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, risks)
+				require.Empty(t, risks)
+			},
+		},
+		{
+			name: "NG - FetchByCity",
+			buildStub: func(mr *mocks.MockMenuWithDishesRepository, ar *mocks.MockAllergenRepository) {
+				mr.EXPECT().FetchByCity(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrConnDone)
+				ar.EXPECT().FetchMatchedInDish(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, risks []*domain.MenuAllergenRisk, err error) {
+				require.Error(t, err)
+				require.Nil(t, risks)
+			},
+		},
+		{
+			name: "NG - FetchMatchedInDish",
+			buildStub: func(mr *mocks.MockMenuWithDishesRepository, ar *mocks.MockAllergenRepository) {
+				mr.EXPECT().FetchByCity(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(menus, nil)
+				ar.EXPECT().FetchMatchedInDish(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrConnDone)
+			},
+			check: func(t *testing.T, risks []*domain.MenuAllergenRisk, err error) {
+				require.Error(t, err)
+				require.Nil(t, risks)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mr := mocks.NewMockMenuWithDishesRepository(ctrl)
+			ar := mocks.NewMockAllergenRepository(ctrl)
+
+			tc.buildStub(mr, ar)
+
+			uc := NewMenuWithDishesUsecase(mr, ar, ctxTime)
+
+			risks, err := uc.FetchAllergenRisksByCity(context.Background(), 10, 0, flagged.OfferedAt, flagged.CityCode, allergenIDs)
+
+			tc.check(t, risks, err)
+		})
+	}
+}
+
 func randomMenuWithDishes(t *testing.T) *domain.MenuWithDishes {
 	var dishes []*domain.Dish
 
@@ -0,0 +1,60 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/ogurilab/school-lunch-api/domain"
+)
+
+type nutritionUsecase struct {
+	nutritionRepo  domain.NutritionRepository
+	menuRepo       domain.MenuRepository
+	contextTimeout time.Duration
+}
+
+func NewNutritionUsecase(nr domain.NutritionRepository, mr domain.MenuRepository, timeout time.Duration) domain.NutritionUsecase {
+	return &nutritionUsecase{
+		nutritionRepo:  nr,
+		menuRepo:       mr,
+		contextTimeout: timeout,
+	}
+}
+
+// Upsert は献立の学校種別の栄養価を登録し、献立のすべての学校種別の栄養価を返す
+// 既に登録されている場合は、指定されなかった項目も含めて置き換える
+func (nu *nutritionUsecase) Upsert(ctx context.Context, menuID string, level domain.SchoolLevel, facts *domain.NutritionFacts) (*domain.MenuNutrition, error) {
+	ctx, cancel := context.WithTimeout(ctx, nu.contextTimeout)
+	defer cancel()
+
+	// 存在しない献立の栄養価を登録しないように確認する
+	if _, err := nu.menuRepo.FindByID(ctx, menuID); err != nil {
+		return nil, err
+	}
+
+	if err := nu.nutritionRepo.Upsert(ctx, menuID, level, facts); err != nil {
+		return nil, err
+	}
+
+	nutritions, err := nu.nutritionRepo.FetchByMenuIDs(ctx, []string{menuID})
+
+	if err != nil {
+		return nil, err
+	}
+
+	nutrition, ok := nutritions[menuID]
+
+	if !ok {
+		nutrition = &domain.MenuNutrition{}
+		nutrition.Set(level, facts)
+	}
+
+	return nutrition, nil
+}
+
+func (nu *nutritionUsecase) Delete(ctx context.Context, menuID string, level domain.SchoolLevel) error {
+	ctx, cancel := context.WithTimeout(ctx, nu.contextTimeout)
+	defer cancel()
+
+	return nu.nutritionRepo.Delete(ctx, menuID, level)
+}
@@ -0,0 +1,96 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/ogurilab/school-lunch-api/domain"
+	"github.com/ogurilab/school-lunch-api/domain/mocks"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestUpsertNutrition(t *testing.T) {
+	menu := randomMenu(t)
+	protein := 25.5
+	facts := &domain.NutritionFacts{Protein: &protein}
+	stored := &domain.MenuNutrition{Elementary: facts}
+
+	testCases := []struct {
+		name      string
+		buildStub func(nr *mocks.MockNutritionRepository, mr *mocks.MockMenuRepository)
+		check     func(t *testing.T, nutrition *domain.MenuNutrition, err error)
+	}{
+		{
+			name: "OK",
+			buildStub: func(nr *mocks.MockNutritionRepository, mr *mocks.MockMenuRepository) {
+				mr.EXPECT().FindByID(gomock.Any(), gomock.Eq(menu.ID)).Times(1).Return(menu, nil)
+				nr.EXPECT().Upsert(gomock.Any(), gomock.Eq(menu.ID), gomock.Eq(domain.SchoolLevelElementary), gomock.Eq(facts)).Times(1).Return(nil)
+				nr.EXPECT().FetchByMenuIDs(gomock.Any(), gomock.Eq([]string{menu.ID})).Times(1).
+					Return(map[string]*domain.MenuNutrition{menu.ID: stored}, nil)
+			},
+			check: func(t *testing.T, nutrition *domain.MenuNutrition, err error) {
+				require.NoError(t, err)
+				require.Equal(t, stored, nutrition)
+			},
+		},
+		{
+			name: "Not Found",
+			buildStub: func(nr *mocks.MockNutritionRepository, mr *mocks.MockMenuRepository) {
+				mr.EXPECT().FindByID(gomock.Any(), gomock.Eq(menu.ID)).Times(1).Return(nil, sql.ErrNoRows)
+				nr.EXPECT().Upsert(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, nutrition *domain.MenuNutrition, err error) {
+				require.ErrorIs(t, err, sql.ErrNoRows)
+				require.Nil(t, nutrition)
+			},
+		},
+		{
+			name: "Upsert Error",
+			buildStub: func(nr *mocks.MockNutritionRepository, mr *mocks.MockMenuRepository) {
+				mr.EXPECT().FindByID(gomock.Any(), gomock.Eq(menu.ID)).Times(1).Return(menu, nil)
+				nr.EXPECT().Upsert(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(sql.ErrConnDone)
+				nr.EXPECT().FetchByMenuIDs(gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, nutrition *domain.MenuNutrition, err error) {
+				require.ErrorIs(t, err, sql.ErrConnDone)
+				require.Nil(t, nutrition)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			nr := mocks.NewMockNutritionRepository(ctrl)
+			mr := mocks.NewMockMenuRepository(ctrl)
+			tc.buildStub(nr, mr)
+
+			nu := NewNutritionUsecase(nr, mr, 10*time.Second)
+
+			nutrition, err := nu.Upsert(context.Background(), menu.ID, domain.SchoolLevelElementary, facts)
+
+			tc.check(t, nutrition, err)
+		})
+	}
+}
+
+func TestDeleteNutrition(t *testing.T) {
+	menu := randomMenu(t)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	nr := mocks.NewMockNutritionRepository(ctrl)
+	nr.EXPECT().Delete(gomock.Any(), gomock.Eq(menu.ID), gomock.Eq(domain.SchoolLevelJuniorHigh)).Times(1).Return(sql.ErrNoRows)
+
+	nu := NewNutritionUsecase(nr, mocks.NewMockMenuRepository(ctrl), 10*time.Second)
+
+	err := nu.Delete(context.Background(), menu.ID, domain.SchoolLevelJuniorHigh)
+
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
@@ -0,0 +1,185 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/ogurilab/school-lunch-api/domain"
+	"github.com/ogurilab/school-lunch-api/util"
+	"github.com/rs/zerolog/log"
+)
+
+type photoUsecase struct {
+	menuRepo       domain.MenuRepository
+	storage        domain.Storage
+	resizer        domain.PhotoResizer
+	publisher      domain.PhotoPublisher
+	contextTimeout time.Duration
+}
+
+// NewPhotoUsecase は写真と resizer で作成したサムネイルを storage に保存する PhotoUsecase を返す
+// publisher が nil の場合は Wikimedia Commons への公開を受け付けない
+func NewPhotoUsecase(mr domain.MenuRepository, storage domain.Storage, resizer domain.PhotoResizer, publisher domain.PhotoPublisher, timeout time.Duration) domain.PhotoUsecase {
+	return &photoUsecase{
+		menuRepo:       mr,
+		storage:        storage,
+		resizer:        resizer,
+		publisher:      publisher,
+		contextTimeout: timeout,
+	}
+}
+
+// UploadMenuPhoto は写真とサムネイルを保存し、献立の photo_url と photos を保存先のURLに更新する
+// 公開する場合は Wikimedia Commons にもアップロードし、ファイルページとクレジットを献立に保存する
+// 公開や献立の更新に失敗した場合は保存した写真とサムネイルを削除する
+// 献立の更新に成功した場合は、差し替える前の写真とサムネイルを削除する
+func (pu *photoUsecase) UploadMenuPhoto(ctx context.Context, upload *domain.MenuPhotoUpload) (*domain.Menu, error) {
+	ctx, cancel := context.WithTimeout(ctx, pu.contextTimeout)
+	defer cancel()
+
+	if len(upload.Body) > domain.MaxPhotoSize {
+		return nil, domain.ErrPhotoTooLarge
+	}
+
+	contentType := http.DetectContentType(upload.Body)
+	ext, ok := domain.PhotoContentTypes[contentType]
+
+	if !ok {
+		return nil, domain.ErrUnsupportedPhotoType
+	}
+
+	if upload.Publish && pu.publisher == nil {
+		return nil, domain.ErrPhotoPublishDisabled
+	}
+
+	menu, err := pu.menuRepo.FindByID(ctx, upload.MenuID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	photos := &domain.MenuPhotos{}
+	sizes := []struct {
+		width int
+		url   *string
+	}{
+		{domain.SmallPhotoWidth, &photos.Small},
+		{domain.MediumPhotoWidth, &photos.Medium},
+		{domain.LargePhotoWidth, &photos.Large},
+	}
+
+	widths := make([]int, len(sizes))
+
+	for i, size := range sizes {
+		widths[i] = size.width
+	}
+
+	thumbnails, err := pu.resizer.Resize(upload.Body, widths)
+
+	if err != nil {
+		return nil, err
+	}
+
+	name := fmt.Sprintf("menus/%s/%s", menu.ID, util.NewUlid())
+	key := name + ext
+
+	url, err := pu.storage.Put(ctx, key, upload.Body, contentType)
+
+	if err != nil {
+		return nil, err
+	}
+
+	keys := []string{key}
+
+	for i, size := range sizes {
+		thumbnailKey := fmt.Sprintf("%s_%d.jpg", name, size.width)
+
+		thumbnailUrl, err := pu.storage.Put(ctx, thumbnailKey, thumbnails[i], domain.ThumbnailContentType)
+
+		if err != nil {
+			pu.deletePhotos(ctx, keys)
+
+			return nil, err
+		}
+
+		keys = append(keys, thumbnailKey)
+		*size.url = thumbnailUrl
+	}
+
+	var credit *domain.PhotoCredit
+
+	if upload.Publish {
+		credit, err = pu.publisher.Publish(ctx, &domain.PublishPhoto{
+			FileName:    fmt.Sprintf("School lunch %d %s %s%s", menu.CityCode, util.FormatDate(menu.OfferedAt), menu.ID, ext),
+			Description: fmt.Sprintf("%s の学校給食 (市区町村コード %d)", util.FormatDate(menu.OfferedAt), menu.CityCode),
+			Author:      upload.Author,
+			Date:        menu.OfferedAt,
+			ContentType: contentType,
+			Body:        upload.Body,
+		})
+
+		if err != nil {
+			pu.deletePhotos(ctx, keys)
+
+			return nil, fmt.Errorf("%w: %v", domain.ErrPhotoPublishFailed, err)
+		}
+	}
+
+	photoUrl := sql.NullString{String: url, Valid: true}
+
+	if err := pu.menuRepo.UpdatePhoto(ctx, menu.ID, photoUrl, photos, credit); err != nil {
+		pu.deletePhotos(ctx, keys)
+
+		return nil, err
+	}
+
+	pu.deleteReplacedPhotos(ctx, menu)
+
+	menu.PhotoUrl = photoUrl
+	menu.PhotoCredit = credit
+	menu.Photos = photos
+
+	return menu, nil
+}
+
+// deletePhotos は保存した写真を削除する
+// 削除に失敗したファイルは残るが、献立からは参照されない
+func (pu *photoUsecase) deletePhotos(ctx context.Context, keys []string) {
+	for _, key := range keys {
+		_ = pu.storage.Delete(ctx, key)
+	}
+}
+
+// deleteReplacedPhotos は差し替える前の献立の写真とサムネイルを削除する
+// 献立は更新済みのため、削除に失敗してもエラーにはせずログに残す
+// storage 以外のURLは削除しない
+func (pu *photoUsecase) deleteReplacedPhotos(ctx context.Context, menu *domain.Menu) {
+	urls := make([]string, 0, 4)
+
+	if menu.PhotoUrl.Valid {
+		urls = append(urls, menu.PhotoUrl.String)
+	}
+
+	if menu.Photos != nil {
+		urls = append(urls, menu.Photos.Small, menu.Photos.Medium, menu.Photos.Large)
+	}
+
+	for _, url := range urls {
+		if url == "" {
+			continue
+		}
+
+		key, ok := pu.storage.Key(url)
+
+		if !ok {
+			continue
+		}
+
+		if err := pu.storage.Delete(ctx, key); err != nil {
+			log.Warn().Err(err).Str("menu_id", menu.ID).Str("key", key).Msg("failed to delete replaced menu photo")
+		}
+	}
+}
@@ -0,0 +1,319 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"image"
+	"image/png"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ogurilab/school-lunch-api/domain"
+	"github.com/ogurilab/school-lunch-api/domain/mocks"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestUploadMenuPhoto(t *testing.T) {
+	menu := randomMenu(t)
+	menu.PhotoUrl = sql.NullString{}
+	photo := randomPNG(t)
+
+	// findMenu は UploadMenuPhoto が更新しても他のケースに影響しないよう献立の複製を返す
+	findMenu := func() *domain.Menu {
+		m := *menu
+
+		return &m
+	}
+
+	replaced := findMenu()
+	replaced.PhotoUrl = sql.NullString{String: "http://localhost:8080/storage/old.png", Valid: true}
+	replaced.Photos = &domain.MenuPhotos{
+		Small:  "http://localhost:8080/storage/old_240.jpg",
+		Medium: "http://localhost:8080/storage/old_640.jpg",
+		Large:  "https://example.com/old_1280.jpg",
+	}
+	url := "http://localhost:8080/storage/photo.png"
+	photoUrl := sql.NullString{String: url, Valid: true}
+
+	widths := []int{domain.SmallPhotoWidth, domain.MediumPhotoWidth, domain.LargePhotoWidth}
+	thumbnails := [][]byte{[]byte("small"), []byte("medium"), []byte("large")}
+	photos := &domain.MenuPhotos{
+		Small:  "http://localhost:8080/storage/photo_240.jpg",
+		Medium: "http://localhost:8080/storage/photo_640.jpg",
+		Large:  "http://localhost:8080/storage/photo_1280.jpg",
+	}
+
+	// putThumbnails はサムネイルの保存を期待する
+	putThumbnails := func(storage *mocks.MockStorage) {
+		for i, u := range []string{photos.Small, photos.Medium, photos.Large} {
+			u := u
+			width := widths[i]
+
+			storage.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Eq(thumbnails[i]), gomock.Eq(domain.ThumbnailContentType)).Times(1).
+				DoAndReturn(func(_ context.Context, key string, _ []byte, _ string) (string, error) {
+					require.True(t, strings.HasPrefix(key, "menus/"+menu.ID+"/"))
+					require.True(t, strings.HasSuffix(key, fmt.Sprintf("_%d.jpg", width)))
+
+					return u, nil
+				})
+		}
+	}
+
+	credit := &domain.PhotoCredit{
+		FilePage:    "https://commons.wikimedia.org/wiki/File:School_lunch.png",
+		License:     "CC BY-SA 4.0",
+		Attribution: "author, CC BY-SA 4.0, via Wikimedia Commons",
+	}
+
+	testCases := []struct {
+		name      string
+		upload    *domain.MenuPhotoUpload
+		publisher bool
+		buildStub func(repo *mocks.MockMenuRepository, storage *mocks.MockStorage, resizer *mocks.MockPhotoResizer, publisher *mocks.MockPhotoPublisher)
+		check     func(t *testing.T, menu *domain.Menu, err error)
+	}{
+		{
+			name:   "OK",
+			upload: &domain.MenuPhotoUpload{MenuID: menu.ID, Body: photo},
+			buildStub: func(repo *mocks.MockMenuRepository, storage *mocks.MockStorage, resizer *mocks.MockPhotoResizer, publisher *mocks.MockPhotoPublisher) {
+				repo.EXPECT().FindByID(gomock.Any(), gomock.Eq(menu.ID)).Times(1).Return(findMenu(), nil)
+				resizer.EXPECT().Resize(gomock.Eq(photo), gomock.Eq(widths)).Times(1).Return(thumbnails, nil)
+				storage.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Eq(photo), gomock.Eq("image/png")).Times(1).
+					DoAndReturn(func(_ context.Context, key string, _ []byte, _ string) (string, error) {
+						require.True(t, strings.HasPrefix(key, "menus/"+menu.ID+"/"))
+						require.True(t, strings.HasSuffix(key, ".png"))
+
+						return url, nil
+					})
+				putThumbnails(storage)
+				// 公開しない場合はクレジットを削除する
+				repo.EXPECT().UpdatePhoto(gomock.Any(), gomock.Eq(menu.ID), gomock.Eq(photoUrl), gomock.Eq(photos), gomock.Nil()).Times(1).Return(nil)
+			},
+			check: func(t *testing.T, m *domain.Menu, err error) {
+				require.NoError(t, err)
+				require.Equal(t, photoUrl, m.PhotoUrl)
+				require.Equal(t, photos, m.Photos)
+				require.Nil(t, m.PhotoCredit)
+			},
+		},
+		{
+			name:   "OK - Replace Photo",
+			upload: &domain.MenuPhotoUpload{MenuID: menu.ID, Body: photo},
+			buildStub: func(repo *mocks.MockMenuRepository, storage *mocks.MockStorage, resizer *mocks.MockPhotoResizer, publisher *mocks.MockPhotoPublisher) {
+				old := *replaced
+				repo.EXPECT().FindByID(gomock.Any(), gomock.Eq(menu.ID)).Times(1).Return(&old, nil)
+				resizer.EXPECT().Resize(gomock.Any(), gomock.Any()).Times(1).Return(thumbnails, nil)
+				storage.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Eq(photo), gomock.Any()).Times(1).Return(url, nil)
+				putThumbnails(storage)
+				repo.EXPECT().UpdatePhoto(gomock.Any(), gomock.Eq(menu.ID), gomock.Eq(photoUrl), gomock.Eq(photos), gomock.Nil()).Times(1).Return(nil)
+				// 差し替える前の写真とサムネイルを削除し、storage 以外のURLは削除しない
+				storage.EXPECT().Key(gomock.Eq(replaced.PhotoUrl.String)).Times(1).Return("old.png", true)
+				storage.EXPECT().Key(gomock.Eq(replaced.Photos.Small)).Times(1).Return("old_240.jpg", true)
+				storage.EXPECT().Key(gomock.Eq(replaced.Photos.Medium)).Times(1).Return("old_640.jpg", true)
+				storage.EXPECT().Key(gomock.Eq(replaced.Photos.Large)).Times(1).Return("", false)
+				storage.EXPECT().Delete(gomock.Any(), gomock.Eq("old.png")).Times(1).Return(nil)
+				storage.EXPECT().Delete(gomock.Any(), gomock.Eq("old_240.jpg")).Times(1).Return(nil)
+				// 削除に失敗しても献立は更新済みのためエラーにしない
+				storage.EXPECT().Delete(gomock.Any(), gomock.Eq("old_640.jpg")).Times(1).Return(errors.New("storage error"))
+			},
+			check: func(t *testing.T, m *domain.Menu, err error) {
+				require.NoError(t, err)
+				require.Equal(t, photoUrl, m.PhotoUrl)
+				require.Equal(t, photos, m.Photos)
+			},
+		},
+		{
+			name:      "OK - Publish",
+			upload:    &domain.MenuPhotoUpload{MenuID: menu.ID, Body: photo, Publish: true, Author: "author"},
+			publisher: true,
+			buildStub: func(repo *mocks.MockMenuRepository, storage *mocks.MockStorage, resizer *mocks.MockPhotoResizer, publisher *mocks.MockPhotoPublisher) {
+				repo.EXPECT().FindByID(gomock.Any(), gomock.Eq(menu.ID)).Times(1).Return(findMenu(), nil)
+				resizer.EXPECT().Resize(gomock.Any(), gomock.Any()).Times(1).Return(thumbnails, nil)
+				storage.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Eq(photo), gomock.Any()).Times(1).Return(url, nil)
+				putThumbnails(storage)
+				publisher.EXPECT().Publish(gomock.Any(), gomock.Any()).Times(1).
+					DoAndReturn(func(_ context.Context, p *domain.PublishPhoto) (*domain.PhotoCredit, error) {
+						require.Equal(t, "author", p.Author)
+						require.Equal(t, "image/png", p.ContentType)
+						require.Equal(t, photo, p.Body)
+						require.Contains(t, p.FileName, menu.ID)
+						require.True(t, strings.HasSuffix(p.FileName, ".png"))
+
+						return credit, nil
+					})
+				repo.EXPECT().UpdatePhoto(gomock.Any(), gomock.Eq(menu.ID), gomock.Eq(photoUrl), gomock.Eq(photos), gomock.Eq(credit)).Times(1).Return(nil)
+			},
+			check: func(t *testing.T, m *domain.Menu, err error) {
+				require.NoError(t, err)
+				require.Equal(t, photoUrl, m.PhotoUrl)
+				require.Equal(t, credit, m.PhotoCredit)
+			},
+		},
+		{
+			name:   "Publish Disabled",
+			upload: &domain.MenuPhotoUpload{MenuID: menu.ID, Body: photo, Publish: true},
+			buildStub: func(repo *mocks.MockMenuRepository, storage *mocks.MockStorage, resizer *mocks.MockPhotoResizer, publisher *mocks.MockPhotoPublisher) {
+				repo.EXPECT().FindByID(gomock.Any(), gomock.Any()).Times(0)
+				storage.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, m *domain.Menu, err error) {
+				require.ErrorIs(t, err, domain.ErrPhotoPublishDisabled)
+				require.Nil(t, m)
+			},
+		},
+		{
+			name:      "Publish Failed",
+			upload:    &domain.MenuPhotoUpload{MenuID: menu.ID, Body: photo, Publish: true},
+			publisher: true,
+			buildStub: func(repo *mocks.MockMenuRepository, storage *mocks.MockStorage, resizer *mocks.MockPhotoResizer, publisher *mocks.MockPhotoPublisher) {
+				repo.EXPECT().FindByID(gomock.Any(), gomock.Eq(menu.ID)).Times(1).Return(findMenu(), nil)
+				resizer.EXPECT().Resize(gomock.Any(), gomock.Any()).Times(1).Return(thumbnails, nil)
+				storage.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Eq(photo), gomock.Any()).Times(1).Return(url, nil)
+				putThumbnails(storage)
+				publisher.EXPECT().Publish(gomock.Any(), gomock.Any()).Times(1).Return(nil, errors.New("login failed"))
+				// 保存した写真とサムネイルは削除し、献立は更新しない
+				storage.EXPECT().Delete(gomock.Any(), gomock.Any()).Times(4).Return(nil)
+				repo.EXPECT().UpdatePhoto(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, m *domain.Menu, err error) {
+				require.True(t, domain.IsPhotoPublishFailed(err))
+				require.Nil(t, m)
+			},
+		},
+		{
+			name:   "Unsupported Type",
+			upload: &domain.MenuPhotoUpload{MenuID: menu.ID, Body: []byte("not a photo")},
+			buildStub: func(repo *mocks.MockMenuRepository, storage *mocks.MockStorage, resizer *mocks.MockPhotoResizer, publisher *mocks.MockPhotoPublisher) {
+				repo.EXPECT().FindByID(gomock.Any(), gomock.Any()).Times(0)
+				storage.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, m *domain.Menu, err error) {
+				require.ErrorIs(t, err, domain.ErrUnsupportedPhotoType)
+				require.Nil(t, m)
+			},
+		},
+		{
+			name:   "Too Large",
+			upload: &domain.MenuPhotoUpload{MenuID: menu.ID, Body: append(photo, make([]byte, domain.MaxPhotoSize)...)},
+			buildStub: func(repo *mocks.MockMenuRepository, storage *mocks.MockStorage, resizer *mocks.MockPhotoResizer, publisher *mocks.MockPhotoPublisher) {
+				repo.EXPECT().FindByID(gomock.Any(), gomock.Any()).Times(0)
+				storage.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, m *domain.Menu, err error) {
+				require.ErrorIs(t, err, domain.ErrPhotoTooLarge)
+				require.Nil(t, m)
+			},
+		},
+		{
+			name:   "Not Found",
+			upload: &domain.MenuPhotoUpload{MenuID: menu.ID, Body: photo},
+			buildStub: func(repo *mocks.MockMenuRepository, storage *mocks.MockStorage, resizer *mocks.MockPhotoResizer, publisher *mocks.MockPhotoPublisher) {
+				repo.EXPECT().FindByID(gomock.Any(), gomock.Eq(menu.ID)).Times(1).Return(nil, sql.ErrNoRows)
+				storage.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, m *domain.Menu, err error) {
+				require.ErrorIs(t, err, sql.ErrNoRows)
+				require.Nil(t, m)
+			},
+		},
+		{
+			name:   "Update Error",
+			upload: &domain.MenuPhotoUpload{MenuID: menu.ID, Body: photo},
+			buildStub: func(repo *mocks.MockMenuRepository, storage *mocks.MockStorage, resizer *mocks.MockPhotoResizer, publisher *mocks.MockPhotoPublisher) {
+				repo.EXPECT().FindByID(gomock.Any(), gomock.Eq(menu.ID)).Times(1).Return(findMenu(), nil)
+				resizer.EXPECT().Resize(gomock.Any(), gomock.Any()).Times(1).Return(thumbnails, nil)
+				storage.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Eq(photo), gomock.Any()).Times(1).Return(url, nil)
+				putThumbnails(storage)
+				repo.EXPECT().UpdatePhoto(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(sql.ErrConnDone)
+				// 保存した写真とサムネイルは削除する
+				storage.EXPECT().Delete(gomock.Any(), gomock.Any()).Times(4).Return(nil)
+			},
+			check: func(t *testing.T, m *domain.Menu, err error) {
+				require.ErrorIs(t, err, sql.ErrConnDone)
+				require.Nil(t, m)
+			},
+		},
+		{
+			name:   "Storage Error",
+			upload: &domain.MenuPhotoUpload{MenuID: menu.ID, Body: photo},
+			buildStub: func(repo *mocks.MockMenuRepository, storage *mocks.MockStorage, resizer *mocks.MockPhotoResizer, publisher *mocks.MockPhotoPublisher) {
+				repo.EXPECT().FindByID(gomock.Any(), gomock.Eq(menu.ID)).Times(1).Return(findMenu(), nil)
+				resizer.EXPECT().Resize(gomock.Any(), gomock.Any()).Times(1).Return(thumbnails, nil)
+				storage.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return("", errors.New("storage error"))
+				repo.EXPECT().UpdatePhoto(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, m *domain.Menu, err error) {
+				require.Error(t, err)
+				require.Nil(t, m)
+			},
+		},
+		{
+			name:   "Thumbnail Storage Error",
+			upload: &domain.MenuPhotoUpload{MenuID: menu.ID, Body: photo},
+			buildStub: func(repo *mocks.MockMenuRepository, storage *mocks.MockStorage, resizer *mocks.MockPhotoResizer, publisher *mocks.MockPhotoPublisher) {
+				repo.EXPECT().FindByID(gomock.Any(), gomock.Eq(menu.ID)).Times(1).Return(findMenu(), nil)
+				resizer.EXPECT().Resize(gomock.Any(), gomock.Any()).Times(1).Return(thumbnails, nil)
+				storage.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Eq(photo), gomock.Any()).Times(1).Return(url, nil)
+				storage.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Eq(thumbnails[0]), gomock.Any()).Times(1).Return("", errors.New("storage error"))
+				// 保存済みの写真は削除する
+				storage.EXPECT().Delete(gomock.Any(), gomock.Any()).Times(1).Return(nil)
+				repo.EXPECT().UpdatePhoto(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, m *domain.Menu, err error) {
+				require.Error(t, err)
+				require.Nil(t, m)
+			},
+		},
+		{
+			name:   "Invalid Photo",
+			upload: &domain.MenuPhotoUpload{MenuID: menu.ID, Body: photo},
+			buildStub: func(repo *mocks.MockMenuRepository, storage *mocks.MockStorage, resizer *mocks.MockPhotoResizer, publisher *mocks.MockPhotoPublisher) {
+				repo.EXPECT().FindByID(gomock.Any(), gomock.Eq(menu.ID)).Times(1).Return(findMenu(), nil)
+				resizer.EXPECT().Resize(gomock.Any(), gomock.Any()).Times(1).Return(nil, fmt.Errorf("%w: unexpected EOF", domain.ErrInvalidPhoto))
+				storage.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, m *domain.Menu, err error) {
+				require.True(t, domain.IsInvalidPhoto(err))
+				require.Nil(t, m)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mocks.NewMockMenuRepository(ctrl)
+			storage := mocks.NewMockStorage(ctrl)
+			resizer := mocks.NewMockPhotoResizer(ctrl)
+			publisher := mocks.NewMockPhotoPublisher(ctrl)
+			tc.buildStub(repo, storage, resizer, publisher)
+
+			var p domain.PhotoPublisher
+
+			if tc.publisher {
+				p = publisher
+			}
+
+			uc := NewPhotoUsecase(repo, storage, resizer, p, 10*time.Second)
+
+			result, err := uc.UploadMenuPhoto(context.Background(), tc.upload)
+
+			tc.check(t, result, err)
+		})
+	}
+}
+
+func randomPNG(t *testing.T) []byte {
+	var buf bytes.Buffer
+
+	require.NoError(t, png.Encode(&buf, image.NewRGBA(image.Rect(0, 0, 2, 2))))
+
+	return buf.Bytes()
+}
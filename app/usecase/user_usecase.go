@@ -0,0 +1,147 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/ogurilab/school-lunch-api/domain"
+	"github.com/ogurilab/school-lunch-api/util"
+)
+
+type userUsecase struct {
+	userRepo            domain.UserRepository
+	tokenMaker          domain.TokenMaker
+	accessTokenDuration time.Duration
+	contextTimeout      time.Duration
+}
+
+func NewUserUsecase(
+	ur domain.UserRepository,
+	maker domain.TokenMaker,
+	accessTokenDuration time.Duration,
+	timeout time.Duration,
+) domain.UserUsecase {
+	return &userUsecase{
+		userRepo:            ur,
+		tokenMaker:          maker,
+		accessTokenDuration: accessTokenDuration,
+		contextTimeout:      timeout,
+	}
+}
+
+// Create はパスワードをハッシュ化してユーザーを登録する
+func (u *userUsecase) Create(ctx context.Context, user *domain.User, password string) (*domain.User, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	hashed, err := util.HashPassword(password)
+
+	if err != nil {
+		return nil, err
+	}
+
+	user.HashedPassword = hashed
+
+	return u.userRepo.Create(ctx, user)
+}
+
+func (u *userUsecase) GetByID(ctx context.Context, id int32) (*domain.User, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	return u.userRepo.GetByID(ctx, id)
+}
+
+func (u *userUsecase) Fetch(ctx context.Context, limit int32, offset int32) ([]*domain.User, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	return u.userRepo.Fetch(ctx, limit, offset)
+}
+
+func (u *userUsecase) Delete(ctx context.Context, id int32) error {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	return u.userRepo.Delete(ctx, id)
+}
+
+// RevokeSessions はユーザーに発行済みの全てのトークンを無効化する
+func (u *userUsecase) RevokeSessions(ctx context.Context, id int32) error {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	if _, err := u.userRepo.GetByID(ctx, id); err != nil {
+		return err
+	}
+
+	return u.userRepo.RevokeSessions(ctx, id)
+}
+
+// Login はユーザー名とパスワードを検証し、アクセストークンを発行する
+// ユーザーが存在しない場合もパスワードが誤っている場合も ErrInvalidCredentials を返す
+func (u *userUsecase) Login(ctx context.Context, username string, password string) (*domain.LoginResult, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	user, err := u.userRepo.GetByUsername(ctx, username)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domain.ErrInvalidCredentials
+		}
+
+		return nil, err
+	}
+
+	if err := util.CheckPassword(password, user.HashedPassword); err != nil {
+		return nil, domain.ErrInvalidCredentials
+	}
+
+	token, payload, err := u.tokenMaker.CreateToken(user, u.accessTokenDuration)
+
+	if err != nil {
+		return nil, err
+	}
+
+	session := domain.NewSession(payload.ID, user.ID, payload.ExpiredAt)
+
+	if err := u.userRepo.CreateSession(ctx, session); err != nil {
+		return nil, err
+	}
+
+	return &domain.LoginResult{
+		AccessToken:          token,
+		AccessTokenExpiresAt: payload.ExpiredAt,
+		User:                 user,
+	}, nil
+}
+
+// Authenticate はトークンを検証し、対応するセッションが無効化されていないことを確認する
+func (u *userUsecase) Authenticate(ctx context.Context, token string) (*domain.TokenPayload, error) {
+	payload, err := u.tokenMaker.VerifyToken(token)
+
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	session, err := u.userRepo.GetSession(ctx, payload.ID)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domain.ErrInvalidToken
+		}
+
+		return nil, err
+	}
+
+	if session.Revoked || session.UserID != payload.UserID {
+		return nil, domain.ErrSessionRevoked
+	}
+
+	return payload, nil
+}
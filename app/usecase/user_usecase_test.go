@@ -0,0 +1,285 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/ogurilab/school-lunch-api/domain"
+	"github.com/ogurilab/school-lunch-api/domain/mocks"
+	"github.com/ogurilab/school-lunch-api/infrastructure/token"
+	"github.com/ogurilab/school-lunch-api/util"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCreateUser(t *testing.T) {
+	user, password := randomUser(t)
+	user.HashedPassword = ""
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mocks.NewMockUserRepository(ctrl)
+	repo.EXPECT().Create(gomock.Any(), gomock.Any()).Times(1).
+		DoAndReturn(func(_ context.Context, u *domain.User) (*domain.User, error) {
+			// 平文のパスワードは保存しない
+			require.NotEqual(t, password, u.HashedPassword)
+			require.NoError(t, util.CheckPassword(password, u.HashedPassword))
+
+			return u, nil
+		})
+
+	uc := NewUserUsecase(repo, newTestTokenMaker(t), time.Minute, 10*time.Second)
+
+	created, err := uc.Create(context.Background(), user, password)
+
+	require.NoError(t, err)
+	require.Equal(t, user.Username, created.Username)
+}
+
+func TestLogin(t *testing.T) {
+	user, password := randomUser(t)
+
+	testCases := []struct {
+		name      string
+		username  string
+		password  string
+		buildStub func(repo *mocks.MockUserRepository)
+		check     func(t *testing.T, result *domain.LoginResult, err error)
+	}{
+		{
+			name:     "OK",
+			username: user.Username,
+			password: password,
+			buildStub: func(repo *mocks.MockUserRepository) {
+				repo.EXPECT().GetByUsername(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(user, nil)
+				repo.EXPECT().CreateSession(gomock.Any(), gomock.Any()).Times(1).
+					DoAndReturn(func(_ context.Context, session *domain.Session) error {
+						require.Equal(t, user.ID, session.UserID)
+						require.NotEmpty(t, session.ID)
+
+						return nil
+					})
+			},
+			check: func(t *testing.T, result *domain.LoginResult, err error) {
+				require.NoError(t, err)
+				require.NotEmpty(t, result.AccessToken)
+				require.Equal(t, user, result.User)
+				require.WithinDuration(t, time.Now().Add(time.Minute), result.AccessTokenExpiresAt, time.Second)
+			},
+		},
+		{
+			name:     "Wrong Password",
+			username: user.Username,
+			password: "wrong password",
+			buildStub: func(repo *mocks.MockUserRepository) {
+				repo.EXPECT().GetByUsername(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(user, nil)
+				repo.EXPECT().CreateSession(gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, result *domain.LoginResult, err error) {
+				require.ErrorIs(t, err, domain.ErrInvalidCredentials)
+				require.Nil(t, result)
+			},
+		},
+		{
+			name:     "User Not Found",
+			username: "unknown",
+			password: password,
+			buildStub: func(repo *mocks.MockUserRepository) {
+				repo.EXPECT().GetByUsername(gomock.Any(), gomock.Eq("unknown")).Times(1).Return(nil, sql.ErrNoRows)
+				repo.EXPECT().CreateSession(gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, result *domain.LoginResult, err error) {
+				require.ErrorIs(t, err, domain.ErrInvalidCredentials)
+				require.Nil(t, result)
+			},
+		},
+		{
+			name:     "Internal Server Error",
+			username: user.Username,
+			password: password,
+			buildStub: func(repo *mocks.MockUserRepository) {
+				repo.EXPECT().GetByUsername(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(nil, sql.ErrConnDone)
+				repo.EXPECT().CreateSession(gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, result *domain.LoginResult, err error) {
+				require.ErrorIs(t, err, sql.ErrConnDone)
+				require.Nil(t, result)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mocks.NewMockUserRepository(ctrl)
+			tc.buildStub(repo)
+
+			uc := NewUserUsecase(repo, newTestTokenMaker(t), time.Minute, 10*time.Second)
+
+			result, err := uc.Login(context.Background(), tc.username, tc.password)
+
+			tc.check(t, result, err)
+		})
+	}
+}
+
+func TestAuthenticate(t *testing.T) {
+	user, _ := randomUser(t)
+	maker := newTestTokenMaker(t)
+
+	accessToken, payload, err := maker.CreateToken(user, time.Minute)
+	require.NoError(t, err)
+
+	expired, _, err := maker.CreateToken(user, -time.Minute)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name      string
+		token     string
+		buildStub func(repo *mocks.MockUserRepository)
+		check     func(t *testing.T, result *domain.TokenPayload, err error)
+	}{
+		{
+			name:  "OK",
+			token: accessToken,
+			buildStub: func(repo *mocks.MockUserRepository) {
+				session := domain.NewSession(payload.ID, user.ID, payload.ExpiredAt)
+				repo.EXPECT().GetSession(gomock.Any(), gomock.Eq(payload.ID)).Times(1).Return(session, nil)
+			},
+			check: func(t *testing.T, result *domain.TokenPayload, err error) {
+				require.NoError(t, err)
+				require.Equal(t, user.ID, result.UserID)
+				require.Equal(t, user.Role, result.Role)
+			},
+		},
+		{
+			name:  "Revoked",
+			token: accessToken,
+			buildStub: func(repo *mocks.MockUserRepository) {
+				session := domain.NewSession(payload.ID, user.ID, payload.ExpiredAt)
+				session.Revoked = true
+				repo.EXPECT().GetSession(gomock.Any(), gomock.Eq(payload.ID)).Times(1).Return(session, nil)
+			},
+			check: func(t *testing.T, result *domain.TokenPayload, err error) {
+				require.ErrorIs(t, err, domain.ErrSessionRevoked)
+				require.Nil(t, result)
+			},
+		},
+		{
+			name:  "Session Not Found",
+			token: accessToken,
+			buildStub: func(repo *mocks.MockUserRepository) {
+				repo.EXPECT().GetSession(gomock.Any(), gomock.Eq(payload.ID)).Times(1).Return(nil, sql.ErrNoRows)
+			},
+			check: func(t *testing.T, result *domain.TokenPayload, err error) {
+				require.ErrorIs(t, err, domain.ErrInvalidToken)
+				require.Nil(t, result)
+			},
+		},
+		{
+			name:  "Expired",
+			token: expired,
+			buildStub: func(repo *mocks.MockUserRepository) {
+				repo.EXPECT().GetSession(gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, result *domain.TokenPayload, err error) {
+				require.ErrorIs(t, err, domain.ErrExpiredToken)
+				require.Nil(t, result)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mocks.NewMockUserRepository(ctrl)
+			tc.buildStub(repo)
+
+			uc := NewUserUsecase(repo, maker, time.Minute, 10*time.Second)
+
+			result, err := uc.Authenticate(context.Background(), tc.token)
+
+			tc.check(t, result, err)
+		})
+	}
+}
+
+func TestRevokeSessions(t *testing.T) {
+	user, _ := randomUser(t)
+
+	testCases := []struct {
+		name      string
+		buildStub func(repo *mocks.MockUserRepository)
+		check     func(t *testing.T, err error)
+	}{
+		{
+			name: "OK",
+			buildStub: func(repo *mocks.MockUserRepository) {
+				repo.EXPECT().GetByID(gomock.Any(), gomock.Eq(user.ID)).Times(1).Return(user, nil)
+				repo.EXPECT().RevokeSessions(gomock.Any(), gomock.Eq(user.ID)).Times(1).Return(nil)
+			},
+			check: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "Not Found",
+			buildStub: func(repo *mocks.MockUserRepository) {
+				repo.EXPECT().GetByID(gomock.Any(), gomock.Eq(user.ID)).Times(1).Return(nil, sql.ErrNoRows)
+				repo.EXPECT().RevokeSessions(gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, err error) {
+				require.ErrorIs(t, err, sql.ErrNoRows)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mocks.NewMockUserRepository(ctrl)
+			tc.buildStub(repo)
+
+			uc := NewUserUsecase(repo, newTestTokenMaker(t), time.Minute, 10*time.Second)
+
+			err := uc.RevokeSessions(context.Background(), user.ID)
+
+			tc.check(t, err)
+		})
+	}
+}
+
+func randomUser(t *testing.T) (*domain.User, string) {
+	password := util.RandomString(12)
+
+	hashed, err := util.HashPassword(password)
+	require.NoError(t, err)
+
+	user := domain.ReNewUser(
+		util.RandomInt32()+1,
+		util.RandomString(10),
+		hashed,
+		util.RandomString(10)+"@example.com",
+		domain.UserRoleMunicipality,
+		util.RandomCityCode(),
+		time.Now(),
+	)
+
+	return user, password
+}
+
+func newTestTokenMaker(t *testing.T) domain.TokenMaker {
+	maker, err := token.NewJWTMaker(util.RandomString(32))
+	require.NoError(t, err)
+
+	return maker
+}
@@ -0,0 +1,35 @@
+package util
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+)
+
+const (
+	apiKeyPrefix      = "slk_"
+	apiKeyBytes       = 24
+	apiKeyPrefixChars = 8
+)
+
+// GenerateAPIKey はランダムなAPIキーと、キーを識別するための先頭の文字列を返す
+func GenerateAPIKey() (key string, prefix string, err error) {
+	b := make([]byte, apiKeyBytes)
+
+	if _, err := rand.Read(b); err != nil {
+		return "", "", fmt.Errorf("failed to generate api key: %w", err)
+	}
+
+	key = apiKeyPrefix + hex.EncodeToString(b)
+
+	return key, key[:len(apiKeyPrefix)+apiKeyPrefixChars], nil
+}
+
+// HashAPIKey は SHA-256 でハッシュ化したAPIキーを返す
+// キーは十分な長さのランダムな値のため、パスワードとは異なり bcrypt は使わない
+func HashAPIKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+
+	return hex.EncodeToString(sum[:])
+}
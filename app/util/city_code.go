@@ -0,0 +1,80 @@
+package util
+
+import (
+	"fmt"
+	"strconv"
+)
+
+const (
+	minPrefectureCode = 1
+	maxPrefectureCode = 47
+)
+
+// IsValidCityCode は5桁の団体コードかどうかを判定する
+// 数値では6桁の全国地方公共団体コードの先頭の0が失われ、5桁のコードと区別できないため受け付けない
+// 6桁のコードは文字列で受け取り、ParseCityCode で変換する
+func IsValidCityCode(code int32) bool {
+	return code > 0 && code < 100000 && isValidPrefecture(code)
+}
+
+// ParseCityCode は文字列の市区町村コードを5桁の団体コードに変換する
+// 6桁の場合は検査数字を検証した上で取り除く
+func ParseCityCode(s string) (int32, error) {
+	if len(s) == 0 || len(s) > 6 {
+		return 0, fmt.Errorf("invalid city code: %s", s)
+	}
+
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return 0, fmt.Errorf("invalid city code: %s", s)
+		}
+	}
+
+	n, err := strconv.ParseInt(s, 10, 32)
+
+	if err != nil {
+		return 0, err
+	}
+
+	code := int32(n)
+
+	if len(s) == 6 {
+		base := code / 10
+
+		if !isValidPrefecture(base) || CityCodeCheckDigit(base) != code%10 {
+			return 0, fmt.Errorf("invalid check digit: %s", s)
+		}
+
+		return base, nil
+	}
+
+	if !isValidPrefecture(code) {
+		return 0, fmt.Errorf("invalid city code: %s", s)
+	}
+
+	return code, nil
+}
+
+// CityPrefectureCode は市区町村コードの上2桁の都道府県コードを返す
+func CityPrefectureCode(code int32) int32 {
+	return code / 1000
+}
+
+// CityCodeCheckDigit は5桁の団体コードに対する検査数字を算出する
+func CityCodeCheckDigit(code int32) int32 {
+	sum := int32(0)
+	weight := int32(2)
+
+	for n := code; weight <= 6; n /= 10 {
+		sum += (n % 10) * weight
+		weight++
+	}
+
+	return (11 - sum%11) % 10
+}
+
+func isValidPrefecture(code int32) bool {
+	prefecture := code / 1000
+
+	return prefecture >= minPrefectureCode && prefecture <= maxPrefectureCode
+}
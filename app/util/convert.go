@@ -22,3 +22,45 @@ func PointerToNullString(s *string) sql.NullString {
 		}
 	}
 }
+
+func NullFloat64ToPointer(f sql.NullFloat64) *float64 {
+	if f.Valid {
+		return &f.Float64
+	} else {
+		return nil
+	}
+}
+
+func PointerToNullFloat64(f *float64) sql.NullFloat64 {
+	if f == nil {
+		return sql.NullFloat64{
+			Valid: false,
+		}
+	} else {
+		return sql.NullFloat64{
+			Valid:   true,
+			Float64: *f,
+		}
+	}
+}
+
+func NullInt32ToPointer(i sql.NullInt32) *int32 {
+	if i.Valid {
+		return &i.Int32
+	} else {
+		return nil
+	}
+}
+
+func PointerToNullInt32(i *int32) sql.NullInt32 {
+	if i == nil {
+		return sql.NullInt32{
+			Valid: false,
+		}
+	} else {
+		return sql.NullInt32{
+			Valid: true,
+			Int32: *i,
+		}
+	}
+}
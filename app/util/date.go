@@ -12,6 +12,11 @@ func ParseDate(s string) (time.Time, error) {
 	return time.Parse("2006-01-02", s)
 }
 
+// ParseMonth は YYYY-MM 形式の文字列を、その月の1日として返す
+func ParseMonth(s string) (time.Time, error) {
+	return time.Parse("2006-01", s)
+}
+
 func FormatDate(t time.Time) string {
 	return t.Format("2006-01-02")
 }
@@ -0,0 +1,15 @@
+package util
+
+import (
+	"errors"
+
+	"github.com/go-sql-driver/mysql"
+)
+
+const mysqlErrDuplicateEntry = 1062
+
+func IsDuplicateEntry(err error) bool {
+	var mysqlErr *mysql.MySQLError
+
+	return errors.As(err, &mysqlErr) && mysqlErr.Number == mysqlErrDuplicateEntry
+}
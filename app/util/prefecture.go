@@ -0,0 +1,26 @@
+package util
+
+// prefectureNames は JIS X 0401 の都道府県コード順の都道府県名
+var prefectureNames = [maxPrefectureCode]string{
+	"北海道", "青森県", "岩手県", "宮城県", "秋田県", "山形県", "福島県",
+	"茨城県", "栃木県", "群馬県", "埼玉県", "千葉県", "東京都", "神奈川県",
+	"新潟県", "富山県", "石川県", "福井県", "山梨県", "長野県", "岐阜県",
+	"静岡県", "愛知県", "三重県", "滋賀県", "京都府", "大阪府", "兵庫県",
+	"奈良県", "和歌山県", "鳥取県", "島根県", "岡山県", "広島県", "山口県",
+	"徳島県", "香川県", "愛媛県", "高知県", "福岡県", "佐賀県", "長崎県",
+	"熊本県", "大分県", "宮崎県", "鹿児島県", "沖縄県",
+}
+
+// IsValidPrefectureCode は1から47までの都道府県コードかどうかを判定する
+func IsValidPrefectureCode(code int32) bool {
+	return code >= minPrefectureCode && code <= maxPrefectureCode
+}
+
+// PrefectureName は都道府県コードに対応する都道府県名を返す
+func PrefectureName(code int32) (string, bool) {
+	if !IsValidPrefectureCode(code) {
+		return "", false
+	}
+
+	return prefectureNames[code-1], true
+}
@@ -59,8 +59,9 @@ func RandomInt32() int32 {
 	return int32(RandomInt(0, 100))
 }
 
+// RandomCityCode は都道府県コードが 01〜47 の範囲に収まる5桁の団体コードを返す
 func RandomCityCode() int32 {
-	return int32(RandomInt(0, 10000))
+	return int32(RandomInt(1000, 47999))
 }
 
 func RandomYYYYMMDD() string {
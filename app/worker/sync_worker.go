@@ -0,0 +1,78 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/ogurilab/school-lunch-api/domain"
+	"github.com/rs/zerolog/log"
+)
+
+// SyncWorker は一定間隔で Active な外部データソースを同期する
+type SyncWorker struct {
+	usecase  domain.ExternalDataSourceUsecase
+	interval time.Duration
+}
+
+func NewSyncWorker(su domain.ExternalDataSourceUsecase, interval time.Duration) *SyncWorker {
+	return &SyncWorker{
+		usecase:  su,
+		interval: interval,
+	}
+}
+
+// Run は起動直後に1度同期し、その後 ctx がキャンセルされるまで interval ごとに同期する
+func (w *SyncWorker) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		w.RunOnce(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce は Active な外部データソースを1度だけ同期する
+// ワーカーは recover のない goroutine で動くため、panic してもログに残して次の同期を待つ
+func (w *SyncWorker) RunOnce(ctx context.Context) (results []*domain.DataSourceSyncResult) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Error().Interface("panic", r).Msg("data source sync panicked")
+
+			results = nil
+		}
+	}()
+
+	results, err := w.usecase.SyncActive(ctx)
+
+	if err != nil {
+		log.Error().Err(err).Msg("failed to sync data sources")
+
+		return nil
+	}
+
+	for _, result := range results {
+		if result.Error != "" {
+			log.Error().
+				Int32("source_id", result.SourceID).
+				Str("status", result.Status).
+				Str("error", result.Error).
+				Msg("failed to sync data source")
+
+			continue
+		}
+
+		log.Info().
+			Int32("source_id", result.SourceID).
+			Int("imported", result.Imported).
+			Int("skipped", result.Skipped).
+			Msg("data source synced")
+	}
+
+	return results
+}
@@ -0,0 +1,138 @@
+package worker
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ogurilab/school-lunch-api/domain"
+	"github.com/ogurilab/school-lunch-api/domain/mocks"
+	"github.com/ogurilab/school-lunch-api/infrastructure/dataset"
+	"github.com/ogurilab/school-lunch-api/usecase"
+	"github.com/ogurilab/school-lunch-api/util"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRunOnce(t *testing.T) {
+	server := httptest.NewServer(http.FileServer(http.Dir("testdata")))
+	defer server.Close()
+
+	cityCode := util.RandomCityCode()
+	existing := time.Date(2024, 4, 9, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name      string
+		source    *domain.ExternalDataSource
+		buildStub func(sr *mocks.MockExternalDataSourceRepository, mr *mocks.MockMenuRepository, source *domain.ExternalDataSource)
+		check     func(t *testing.T, results []*domain.DataSourceSyncResult)
+	}{
+		{
+			name:   "OK",
+			source: newTestDataSource(1, cityCode, server.URL+"/menus.csv"),
+			buildStub: func(sr *mocks.MockExternalDataSourceRepository, mr *mocks.MockMenuRepository, source *domain.ExternalDataSource) {
+				sr.EXPECT().FetchByStatus(gomock.Any(), domain.DataSourceStatusActive).Times(1).Return([]*domain.ExternalDataSource{source}, nil)
+				sr.EXPECT().GetByID(gomock.Any(), source.ID).Times(1).Return(source, nil)
+				sr.EXPECT().StartSync(gomock.Any(), source.ID).Times(1).Return(nil)
+
+				mr.EXPECT().FetchOfferedAtByCity(gomock.Any(), cityCode, gomock.Any(), gomock.Any()).Times(1).Return([]time.Time{existing}, nil)
+				mr.EXPECT().Import(gomock.Any(), cityCode, gomock.Any()).Times(1).
+					DoAndReturn(func(_ context.Context, _ int32, rows []*domain.ImportMenuRow) (*domain.ImportMenuReport, error) {
+						// 既に登録済みの 2024-04-09 は除外される
+						require.Len(t, rows, 2)
+
+						for _, row := range rows {
+							require.False(t, row.Menu.OfferedAt.Equal(existing))
+						}
+
+						return &domain.ImportMenuReport{CityCode: cityCode, Imported: true}, nil
+					})
+
+				sr.EXPECT().UpdateStatus(gomock.Any(), source.ID, domain.DataSourceStatusActive, gomock.Any()).Times(1).Return(nil)
+			},
+			check: func(t *testing.T, results []*domain.DataSourceSyncResult) {
+				require.Len(t, results, 1)
+				require.Equal(t, domain.DataSourceStatusActive, results[0].Status)
+				require.Equal(t, 2, results[0].Imported)
+				require.Equal(t, 1, results[0].Skipped)
+				require.Empty(t, results[0].Error)
+			},
+		},
+		{
+			name:   "Dataset Not Found",
+			source: newTestDataSource(2, cityCode, server.URL+"/missing.csv"),
+			buildStub: func(sr *mocks.MockExternalDataSourceRepository, mr *mocks.MockMenuRepository, source *domain.ExternalDataSource) {
+				sr.EXPECT().FetchByStatus(gomock.Any(), domain.DataSourceStatusActive).Times(1).Return([]*domain.ExternalDataSource{source}, nil)
+				sr.EXPECT().GetByID(gomock.Any(), source.ID).Times(1).Return(source, nil)
+				sr.EXPECT().StartSync(gomock.Any(), source.ID).Times(1).Return(nil)
+
+				mr.EXPECT().FetchOfferedAtByCity(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				mr.EXPECT().Import(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+				sr.EXPECT().UpdateStatus(gomock.Any(), source.ID, domain.DataSourceStatusError, gomock.Any()).Times(1).
+					DoAndReturn(func(_ context.Context, _ int32, _ string, description sql.NullString) error {
+						require.True(t, description.Valid)
+						require.Contains(t, description.String, "404")
+
+						return nil
+					})
+			},
+			check: func(t *testing.T, results []*domain.DataSourceSyncResult) {
+				require.Len(t, results, 1)
+				require.Equal(t, domain.DataSourceStatusError, results[0].Status)
+				require.Zero(t, results[0].Imported)
+				require.NotEmpty(t, results[0].Error)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			sr := mocks.NewMockExternalDataSourceRepository(ctrl)
+			mr := mocks.NewMockMenuRepository(ctrl)
+			tc.buildStub(sr, mr, tc.source)
+
+			su := usecase.NewExternalDataSourceUsecase(sr, mr, dataset.NewHTTPFetcher(nil), time.Second*2)
+
+			results := NewSyncWorker(su, time.Minute).RunOnce(context.Background())
+
+			tc.check(t, results)
+		})
+	}
+}
+
+func newTestDataSource(id int32, cityCode int32, url string) *domain.ExternalDataSource {
+	return domain.ReNewExternalDataSource(
+		id,
+		cityCode,
+		url,
+		2024,
+		time.Now(),
+		domain.DataSourceStatusActive,
+		domain.DataSourceCategoryMenu,
+		sql.NullString{},
+	)
+}
+
+func TestRunOncePanic(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	su := mocks.NewMockExternalDataSourceUsecase(ctrl)
+	su.EXPECT().SyncActive(gomock.Any()).Times(1).DoAndReturn(func(_ context.Context) ([]*domain.DataSourceSyncResult, error) {
+		panic("unexpected")
+	})
+
+	// panic はワーカーの外に伝わらない
+	require.NotPanics(t, func() {
+		results := NewSyncWorker(su, time.Minute).RunOnce(context.Background())
+
+		require.Nil(t, results)
+	})
+}